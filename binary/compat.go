@@ -13,6 +13,10 @@ import (
 type TypeID = binaryCompat.TypeID
 type Decoder = binaryCompat.Decoder
 type Encoder = binaryCompat.Encoder
+type Uint128 = binaryCompat.Uint128
+type Int128 = binaryCompat.Int128
+
+var LE = binaryCompat.LE
 
 func NewBorshEncoder(writer io.Writer) *Encoder {
 	return binaryCompat.NewBorshEncoder(writer)
@@ -58,6 +62,39 @@ func NewVariantDefinitionAnchorType(types []VariantTypeHash) (out *VariantDefini
 	return out
 }
 
+const (
+	Uint8TypeIDEncoding  = binaryCompat.Uint8TypeIDEncoding
+	Uint32TypeIDEncoding = binaryCompat.Uint32TypeIDEncoding
+	AnchorTypeIDEncoding = binaryCompat.AnchorTypeIDEncoding
+)
+
+func TypeIDFromBytes(data []byte) TypeID {
+	return binaryCompat.TypeIDFromBytes(data)
+}
+
+// VariantTypeID a variant with an explicit type id,
+// used when the ids are neither the position of the variant nor a known sighash
+type VariantTypeID struct {
+	Name string
+	ID   TypeID
+	Type interface{}
+}
+
+func NewVariantDefinitionTypeID(typeIDEncoding TypeIDEncoding, types []VariantTypeID) (out *VariantDefinition) {
+	out = &VariantDefinition{
+		typeIDEncoding: typeIDEncoding,
+		typeIDToType:   make(map[TypeID]reflect.Type, len(types)),
+		typeIDToName:   make(map[TypeID]string, len(types)),
+		typeNameToID:   make(map[string]TypeID, len(types)),
+	}
+	for _, typeDef := range types {
+		out.typeIDToType[typeDef.ID] = reflect.TypeOf(typeDef.Type)
+		out.typeIDToName[typeDef.ID] = typeDef.Name
+		out.typeNameToID[typeDef.Name] = typeDef.ID
+	}
+	return out
+}
+
 func Sighash(data []byte) []byte {
 	sum := sha256.Sum256(data)
 	return sum[0:8]
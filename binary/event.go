@@ -0,0 +1,205 @@
+package binary
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	binaryCompat "github.com/gagliardetto/binary"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const programDataPrefix = "Program data: "
+
+// EventIxTag The 8 bytes prefix of the self-CPI instruction emitted by `emit_cpi!`.
+// It is the u64 0x1d9acb512ea545e4 (sha256("anchor:event")[..8]) in little endian
+var EventIxTag = [8]byte{0xe4, 0x45, 0xa5, 0x2e, 0x51, 0xcb, 0x9a, 0x1d}
+
+var ErrUnknownEvent = errors.New("unknown event discriminator")
+
+// EventDiscriminator returns the anchor discriminator of an event: sha256("event:<name>")[..8]
+func EventDiscriminator(name string) TypeID {
+	return binaryCompat.TypeIDFromSighash(Sighash([]byte("event:" + name)))
+}
+
+// Event a decoded anchor event
+type Event struct {
+	Name          string
+	Discriminator TypeID
+	Data          interface{}
+}
+
+// EventDefinition a registry of anchor events, the key is the name of the event struct
+// which is used in the program (e.g. `#[event] pub struct TradeEvent`)
+type EventDefinition struct {
+	mu                  sync.RWMutex
+	typeIDToType        map[TypeID]reflect.Type
+	typeIDToName        map[TypeID]string
+	typeNameToID        map[string]TypeID
+	typeIDEncodingBytes int
+}
+
+// NewEventDefinition creates an event registry, the Name of VariantType is the event name
+func NewEventDefinition(types []VariantType) *EventDefinition {
+	out := &EventDefinition{
+		typeIDToType:        make(map[TypeID]reflect.Type, len(types)),
+		typeIDToName:        make(map[TypeID]string, len(types)),
+		typeNameToID:        make(map[string]TypeID, len(types)),
+		typeIDEncodingBytes: 8,
+	}
+	for _, typeDef := range types {
+		out.Register(typeDef.Name, typeDef.Type)
+	}
+	return out
+}
+
+// Register adds an event type to the registry.
+// typ is a pointer or a value of the event struct, e.g. (*TradeEvent)(nil)
+func (d *EventDefinition) Register(name string, typ interface{}) TypeID {
+	typeID := EventDiscriminator(name)
+	d.RegisterWithDiscriminator(name, typeID, typ)
+	return typeID
+}
+
+// RegisterWithDiscriminator adds an event type with a custom discriminator
+func (d *EventDefinition) RegisterWithDiscriminator(name string, typeID TypeID, typ interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.typeIDToType[typeID] = reflect.TypeOf(typ)
+	d.typeIDToName[typeID] = name
+	d.typeNameToID[name] = typeID
+}
+
+// TypeID returns the discriminator of the registered event
+func (d *EventDefinition) TypeID(name string) (TypeID, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	id, ok := d.typeNameToID[name]
+	return id, ok
+}
+
+// Name returns the name of the registered event
+func (d *EventDefinition) Name(id TypeID) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.typeIDToName[id]
+}
+
+// Decode decodes the event data (discriminator + borsh serialized event)
+func (d *EventDefinition) Decode(data []byte) (*Event, error) {
+	if len(data) < d.typeIDEncodingBytes {
+		return nil, fmt.Errorf("event data too short: %d", len(data))
+	}
+	typeID := binaryCompat.TypeIDFromBytes(data[:d.typeIDEncodingBytes])
+
+	d.mu.RLock()
+	typeGo, name := d.typeIDToType[typeID], d.typeIDToName[typeID]
+	d.mu.RUnlock()
+	if typeGo == nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownEvent, typeID.Bytes())
+	}
+
+	var impl interface{}
+	decoder := NewBorshDecoder(data[d.typeIDEncodingBytes:])
+	if typeGo.Kind() == reflect.Ptr {
+		impl = reflect.New(typeGo.Elem()).Interface()
+		if err := decoder.Decode(impl); err != nil {
+			return nil, fmt.Errorf("unable to decode event %s: %w", name, err)
+		}
+	} else {
+		value := reflect.New(typeGo)
+		if err := decoder.Decode(value.Interface()); err != nil {
+			return nil, fmt.Errorf("unable to decode event %s: %w", name, err)
+		}
+		impl = value.Elem().Interface()
+	}
+	return &Event{
+		Name:          name,
+		Discriminator: typeID,
+		Data:          impl,
+	}, nil
+}
+
+// DecodeCpiInstruction decodes the instruction data of a self-CPI emitted by `emit_cpi!`
+// The layout is: EventIxTag + discriminator + borsh serialized event
+func (d *EventDefinition) DecodeCpiInstruction(data []byte) (*Event, error) {
+	if !IsEventCpiInstruction(data) {
+		return nil, errors.New("not an event cpi instruction")
+	}
+	return d.Decode(data[len(EventIxTag):])
+}
+
+// IsEventCpiInstruction reports whether the instruction data is an `emit_cpi!` instruction
+func IsEventCpiInstruction(data []byte) bool {
+	return len(data) >= len(EventIxTag) && bytes.Equal(data[:len(EventIxTag)], EventIxTag[:])
+}
+
+// DecodeLogs decodes all `Program data:` entries emitted by programId.
+// The invoke stack is tracked, so entries emitted by other programs (CPI) are ignored.
+// If programId is empty, all `Program data:` entries are decoded.
+// Unknown events are skipped.
+func (d *EventDefinition) DecodeLogs(programId string, logs []string) ([]*Event, error) {
+	var events []*Event
+	for _, data := range ProgramDataFromLogs(programId, logs) {
+		event, err := d.Decode(data)
+		if err != nil {
+			if errors.Is(err, ErrUnknownEvent) {
+				continue
+			}
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ProgramDataFromLogs returns the decoded base64 payload of every `Program data:`
+// entry emitted by programId (all programs when programId is empty)
+func ProgramDataFromLogs(programId string, logs []string) [][]byte {
+	var stack []string
+	var out [][]byte
+	for _, log := range logs {
+		if program, ok := parseInvoke(log); ok {
+			stack = append(stack, program)
+			continue
+		}
+		if program, ok := parseProgramEnd(log); ok {
+			if len(stack) > 0 && stack[len(stack)-1] == program {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+		if !strings.HasPrefix(log, programDataPrefix) {
+			continue
+		}
+		if len(programId) > 0 && (len(stack) == 0 || stack[len(stack)-1] != programId) {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(log, programDataPrefix))
+		if err != nil {
+			continue
+		}
+		out = append(out, data)
+	}
+	return out
+}
+
+// parseInvoke parses `Program <id> invoke [<depth>]`
+func parseInvoke(log string) (string, bool) {
+	fields := strings.Fields(log)
+	if len(fields) == 4 && fields[0] == "Program" && fields[2] == "invoke" {
+		return fields[1], true
+	}
+	return "", false
+}
+
+// parseProgramEnd parses `Program <id> success` and `Program <id> failed: <reason>`
+func parseProgramEnd(log string) (string, bool) {
+	fields := strings.Fields(log)
+	if len(fields) >= 3 && fields[0] == "Program" && (fields[2] == "success" || fields[2] == "failed:") {
+		return fields[1], true
+	}
+	return "", false
+}
@@ -0,0 +1,92 @@
+package binary
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+type testTradeEvent struct {
+	Amount uint64
+	Buyer  [32]byte
+	Memo   string
+}
+
+func encodeTestEvent(t *testing.T, name string, event testTradeEvent) []byte {
+	buf := new(bytes.Buffer)
+	id := EventDiscriminator(name)
+	buf.Write(id[:])
+	if err := NewBorshEncoder(buf).Encode(event); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestEventDiscriminator(t *testing.T) {
+	// sha256("event:TradeEvent")[..8], the discriminator of the TradeEvent of the pump.fun IDL
+	want := []byte{189, 219, 127, 211, 78, 230, 97, 238}
+	got := EventDiscriminator("TradeEvent")
+	if !bytes.Equal(got[:], want) {
+		t.Fatalf("unexpected discriminator %v", got)
+	}
+}
+
+func TestEventDecodeLogs(t *testing.T) {
+	def := NewEventDefinition([]VariantType{
+		{Name: "TradeEvent", Type: (*testTradeEvent)(nil)},
+	})
+	event := testTradeEvent{Amount: 42, Memo: "hello"}
+	event.Buyer[0] = 7
+	data := encodeTestEvent(t, "TradeEvent", event)
+	payload := base64.StdEncoding.EncodeToString(data)
+
+	const program = "Prog1111111111111111111111111111111111111111"
+	const other = "Othr1111111111111111111111111111111111111111"
+	logs := []string{
+		"Program " + program + " invoke [1]",
+		"Program log: Instruction: Trade",
+		"Program " + other + " invoke [2]",
+		"Program data: " + payload,
+		"Program " + other + " success",
+		"Program data: " + payload,
+		"Program data: " + base64.StdEncoding.EncodeToString([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9}),
+		"Program " + program + " success",
+	}
+	events, err := def.DecodeLogs(program, logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	got := events[0].Data.(*testTradeEvent)
+	if events[0].Name != "TradeEvent" || *got != event {
+		t.Fatalf("unexpected event %+v", events[0])
+	}
+
+	all, err := def.DecodeLogs("", logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(all))
+	}
+}
+
+func TestEventDecodeCpiInstruction(t *testing.T) {
+	def := NewEventDefinition(nil)
+	def.Register("TradeEvent", testTradeEvent{})
+	event := testTradeEvent{Amount: 1}
+	data := append(EventIxTag[:], encodeTestEvent(t, "TradeEvent", event)...)
+
+	decoded, err := def.DecodeCpiInstruction(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Data.(testTradeEvent) != event {
+		t.Fatalf("unexpected event %+v", decoded)
+	}
+	if _, err := def.DecodeCpiInstruction(data[8:]); err == nil {
+		t.Fatal("expected error for data without the event tag")
+	}
+}
@@ -0,0 +1,70 @@
+// Command idlgen generates go bindings from an Anchor, Shank or Codama IDL.
+//
+//	idlgen -idl ./target/idl/counter.json -out ./counter
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/donutnomad/solana-web3/idl"
+	"github.com/donutnomad/solana-web3/idl/codegen"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	var (
+		idlPath     = flag.String("idl", "", "path of the IDL json file")
+		out         = flag.String("out", "", "output directory, defaults to ./<package>")
+		pkg         = flag.String("pkg", "", "go package name, defaults to the snake case program name")
+		programName = flag.String("program-name", "", "value of ProgramName, defaults to the snake case program name")
+		programID   = flag.String("program-id", "", "default ProgramID, defaults to the address of the IDL")
+	)
+	flag.Parse()
+	if len(*idlPath) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*idlPath, *out, codegen.Options{
+		Package:     *pkg,
+		ProgramName: *programName,
+		ProgramID:   *programID,
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "idlgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(idlPath, out string, opts codegen.Options) error {
+	program, err := idl.ParseFile(idlPath)
+	if err != nil {
+		return err
+	}
+	files, err := codegen.Generate(program, opts)
+	if err != nil {
+		return err
+	}
+	if len(out) == 0 {
+		out = opts.Package
+		if len(out) == 0 {
+			out = idl.ToSnake(program.Name)
+		}
+	}
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(out, name)
+		if err := os.WriteFile(path, files[name], 0644); err != nil {
+			return err
+		}
+		fmt.Println(path)
+	}
+	return nil
+}
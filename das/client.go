@@ -0,0 +1,215 @@
+package das
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"iter"
+)
+
+// MaxPageLimit the maximum number of assets of a page
+const MaxPageLimit = 1000
+
+var (
+	ErrAssetNotFound    = errors.New("das: asset not found")
+	ErrUnexpectedAssets = errors.New("das: unexpected number of assets")
+)
+
+// SortBy the sort field of the assets
+type SortBy string
+
+const (
+	SortByCreated      SortBy = "created"
+	SortByUpdated      SortBy = "updated"
+	SortByRecentAction SortBy = "recent_action"
+	SortByNone         SortBy = "none"
+)
+
+// SortDirection the sort direction of the assets
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+type Sort struct {
+	SortBy        SortBy        `json:"sortBy"`
+	SortDirection SortDirection `json:"sortDirection,omitempty"`
+}
+
+// Pagination the page of a list request, by the page number starting at 1, or by a cursor
+type Pagination struct {
+	Page   int    `json:"page,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+	SortBy *Sort  `json:"sortBy,omitempty"`
+}
+
+// SearchAssetsParams the conditions of SearchAssets, the empty conditions are ignored
+type SearchAssetsParams struct {
+	OwnerAddress     *web3.PublicKey `json:"ownerAddress,omitempty"`
+	CreatorAddress   *web3.PublicKey `json:"creatorAddress,omitempty"`
+	CreatorVerified  *bool           `json:"creatorVerified,omitempty"`
+	AuthorityAddress *web3.PublicKey `json:"authorityAddress,omitempty"`
+	// the group key and the group value, such as ["collection", mint]
+	Grouping      []string        `json:"grouping,omitempty"`
+	Delegate      *web3.PublicKey `json:"delegate,omitempty"`
+	Frozen        *bool           `json:"frozen,omitempty"`
+	Compressed    *bool           `json:"compressed,omitempty"`
+	Burnt         *bool           `json:"burnt,omitempty"`
+	Interface     Interface       `json:"interface,omitempty"`
+	JsonUri       string          `json:"jsonUri,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	ConditionType string          `json:"conditionType,omitempty"` // "all" or "any"
+	Pagination
+}
+
+// Client a client of the Digital Asset Standard API, served by the RPC endpoint of the connection
+type Client struct {
+	connection *web3.Connection
+}
+
+func NewClient(connection *web3.Connection) *Client {
+	return &Client{connection: connection}
+}
+
+// GetAsset gets the asset, ErrAssetNotFound if the asset does not exist
+func (c *Client) GetAsset(ctx context.Context, id web3.PublicKey) (*Asset, error) {
+	ret, err := web3.RequestParams[Asset](ctx, c.connection, "getAsset", map[string]any{"id": id}, fmt.Sprintf("failed to get asset %s", id))
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAssetNotFound, id)
+	}
+	return ret, nil
+}
+
+// GetAssetBatch gets the assets, the asset of an id is nil if it does not exist
+func (c *Client) GetAssetBatch(ctx context.Context, ids []web3.PublicKey) ([]*Asset, error) {
+	ret, err := web3.RequestParams[[]*Asset](ctx, c.connection, "getAssetBatch", map[string]any{"ids": ids}, "failed to get assets")
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil || len(*ret) != len(ids) {
+		return nil, ErrUnexpectedAssets
+	}
+	return *ret, nil
+}
+
+// GetAssetProof gets the proof of the compressed NFT
+func (c *Client) GetAssetProof(ctx context.Context, id web3.PublicKey) (*AssetProof, error) {
+	ret, err := web3.RequestParams[AssetProof](ctx, c.connection, "getAssetProof", map[string]any{"id": id}, fmt.Sprintf("failed to get asset proof %s", id))
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAssetNotFound, id)
+	}
+	return ret, nil
+}
+
+func (c *Client) getAssetList(ctx context.Context, method string, params any) (*AssetList, error) {
+	ret, err := web3.RequestParams[AssetList](ctx, c.connection, method, params, fmt.Sprintf("failed to %s", method))
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &AssetList{}, nil
+	}
+	return ret, nil
+}
+
+// GetAssetsByOwner gets a page of the assets of the owner
+func (c *Client) GetAssetsByOwner(ctx context.Context, owner web3.PublicKey, page Pagination) (*AssetList, error) {
+	return c.getAssetList(ctx, "getAssetsByOwner", struct {
+		OwnerAddress web3.PublicKey `json:"ownerAddress"`
+		Pagination
+	}{owner, page})
+}
+
+// GetAssetsByGroup gets a page of the assets of the group, such as the items of a collection (GroupKeyCollection)
+func (c *Client) GetAssetsByGroup(ctx context.Context, groupKey, groupValue string, page Pagination) (*AssetList, error) {
+	return c.getAssetList(ctx, "getAssetsByGroup", struct {
+		GroupKey   string `json:"groupKey"`
+		GroupValue string `json:"groupValue"`
+		Pagination
+	}{groupKey, groupValue, page})
+}
+
+// SearchAssets gets a page of the assets matching the conditions
+func (c *Client) SearchAssets(ctx context.Context, params SearchAssetsParams) (*AssetList, error) {
+	return c.getAssetList(ctx, "searchAssets", params)
+}
+
+// paginate iterates the assets of the pages from the first page, by the cursor of the pages if any, otherwise by the page number.
+// The iteration stops at the first error or at a page shorter than the limit
+func paginate(page Pagination, fetch func(Pagination) (*AssetList, error)) iter.Seq2[*Asset, error] {
+	return func(yield func(*Asset, error) bool) {
+		if page.Limit == 0 {
+			page.Limit = MaxPageLimit
+		}
+		byCursor := page.Cursor != ""
+		if !byCursor && page.Before == "" && page.After == "" && page.Page == 0 {
+			page.Page = 1
+		}
+		for {
+			list, err := fetch(page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for i := range list.Items {
+				if !yield(&list.Items[i], nil) {
+					return
+				}
+			}
+			if len(list.Items) < page.Limit {
+				return
+			}
+			switch {
+			case byCursor:
+				if list.Cursor == "" || list.Cursor == page.Cursor {
+					return
+				}
+				page.Cursor = list.Cursor
+			case page.Page > 0:
+				page.Page++
+			default:
+				// the pages before or after a key
+				last := list.Items[len(list.Items)-1].Id.String()
+				if page.After != "" {
+					page.After = last
+				} else {
+					page.Before = last
+				}
+			}
+		}
+	}
+}
+
+// AssetsByOwner iterates the assets of the owner from the page, see GetAssetsByOwner
+func (c *Client) AssetsByOwner(ctx context.Context, owner web3.PublicKey, page Pagination) iter.Seq2[*Asset, error] {
+	return paginate(page, func(page Pagination) (*AssetList, error) {
+		return c.GetAssetsByOwner(ctx, owner, page)
+	})
+}
+
+// AssetsByGroup iterates the assets of the group from the page, see GetAssetsByGroup
+func (c *Client) AssetsByGroup(ctx context.Context, groupKey, groupValue string, page Pagination) iter.Seq2[*Asset, error] {
+	return paginate(page, func(page Pagination) (*AssetList, error) {
+		return c.GetAssetsByGroup(ctx, groupKey, groupValue, page)
+	})
+}
+
+// SearchAssetsAll iterates the assets matching the conditions from the page of the params, see SearchAssets
+func (c *Client) SearchAssetsAll(ctx context.Context, params SearchAssetsParams) iter.Seq2[*Asset, error] {
+	return paginate(params.Pagination, func(page Pagination) (*AssetList, error) {
+		params.Pagination = page
+		return c.SearchAssets(ctx, params)
+	})
+}
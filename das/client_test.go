@@ -0,0 +1,157 @@
+package das
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type fixtureRequest struct {
+	Method string         `json:"method"`
+	Params map[string]any `json:"params"`
+}
+
+// newFixtureConnection serves the JSON-RPC requests by the handler, the websocket of the connection is accepted and idle
+func newFixtureConnection(t *testing.T, handler func(request fixtureRequest) any) *web3.Connection {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+		var request fixtureRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch result := handler(request).(type) {
+		case []byte:
+			_, _ = w.Write(result)
+		case error:
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 0, "error": map[string]any{"code": -32000, "message": result.Error()}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 0, "result": result})
+		}
+	}))
+	t.Cleanup(server.Close)
+	wsEndpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	connection, err := web3.NewConnection(server.URL, &web3.ConnectionConfig{WsEndpoint: &wsEndpoint})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return connection
+}
+
+func TestClient(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/get_asset.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncompressed, err := os.ReadFile("testdata/get_asset_uncompressed.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := web3.Keypair.Generate().PublicKey()
+	var ids []web3.PublicKey
+	for i := 0; i < 5; i++ {
+		ids = append(ids, web3.Keypair.Generate().PublicKey())
+	}
+	var requests []fixtureRequest
+	connection := newFixtureConnection(t, func(request fixtureRequest) any {
+		requests = append(requests, request)
+		switch request.Method {
+		case "getAsset":
+			switch request.Params["id"] {
+			case "JEGruwYE13mhX2wi2MGrPmeLiVyZtbBptmVy9vG3pXRC":
+				return fixture
+			case "FKcBvrQhPK98gwi2m11LYu85EdpGvWPWoHWNdyDYFWM7":
+				return uncompressed
+			}
+			return errors.New("Asset Not Found")
+		case "getAssetBatch":
+			return []any{map[string]any{"interface": "V1_NFT", "id": ids[0]}, nil}
+		case "getAssetsByOwner", "searchAssets":
+			// pages of 2 assets
+			page := int(request.Params["page"].(float64))
+			var items []map[string]any
+			for i := (page - 1) * 2; i < min(page*2, len(ids)); i++ {
+				items = append(items, map[string]any{"interface": "V1_NFT", "id": ids[i], "ownership": map[string]any{"owner": owner}})
+			}
+			return map[string]any{"total": len(items), "limit": 2, "page": page, "items": items}
+		}
+		return fmt.Errorf("unexpected method %s", request.Method)
+	})
+	client := NewClient(connection)
+	ctx := context.Background()
+
+	asset, err := client.GetAsset(ctx, web3.MustPublicKey("JEGruwYE13mhX2wi2MGrPmeLiVyZtbBptmVy9vG3pXRC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Interface != InterfaceV1NFT || !asset.IsCompressed() || asset.Compression.LeafId != 1 || asset.Compression.DataHash.String() != "7Gk6k53HZHjf3hHSqZYNJx1FyEsR1tC5QJtK4Vp1TmGz" {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	if collection := asset.Collection(); collection == nil || collection.String() != "BvhqmuhxL9JcSxr8AazkL5mPL5jy8xRTn8bkhSCw7oRz" {
+		t.Fatalf("unexpected collection %v", collection)
+	}
+	if asset.Ownership.Delegate != nil || asset.Royalty.BasisPoints != 500 || asset.Content.Metadata.Attributes[1].Value != float64(7) {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	if asset.Compression.Tree == nil || asset.Compression.Tree.String() != "GXTXbFwcbNdWbiCWzZc3J2XGofopnhN9T98jnG29D2Yw" {
+		t.Fatalf("unexpected tree %v", asset.Compression.Tree)
+	}
+	// the hashes and the tree of an uncompressed asset are empty strings
+	asset, err = client.GetAsset(ctx, web3.MustPublicKey("FKcBvrQhPK98gwi2m11LYu85EdpGvWPWoHWNdyDYFWM7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Interface != InterfaceProgrammableNFT || asset.IsCompressed() || asset.Compression.Tree != nil || asset.Compression.DataHash != (Hash{}) || !asset.Ownership.Frozen {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	if _, err := client.GetAsset(ctx, owner); err == nil || !strings.Contains(err.Error(), "Asset Not Found") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	assets, err := client.GetAssetBatch(ctx, ids[:2])
+	if err != nil || assets[0].Id != ids[0] || assets[1] != nil {
+		t.Fatalf("unexpected assets %v %v", assets, err)
+	}
+
+	requests = nil
+	var found []web3.PublicKey
+	for asset, err := range client.AssetsByOwner(ctx, owner, Pagination{Limit: 2}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		found = append(found, asset.Id)
+	}
+	if len(found) != 5 || found[4] != ids[4] || len(requests) != 3 || requests[0].Params["ownerAddress"] != owner.String() {
+		t.Fatalf("unexpected assets %v, %d requests", found, len(requests))
+	}
+	// stop after the first asset of the second page
+	requests = nil
+	compressed := true
+	for asset := range client.SearchAssetsAll(ctx, SearchAssetsParams{OwnerAddress: &owner, Compressed: &compressed, Pagination: Pagination{Limit: 2}}) {
+		if asset.Id == ids[2] {
+			break
+		}
+	}
+	if len(requests) != 2 || requests[1].Params["compressed"] != true || requests[1].Params["page"] != float64(2) {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+}
@@ -0,0 +1,236 @@
+package das
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/mr-tron/base58"
+)
+
+// Hash a base58 encoded 32 bytes hash, such as a node of a merkle tree
+type Hash [32]byte
+
+func (h Hash) String() string {
+	return base58.Encode(h[:])
+}
+
+func (h Hash) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+func (h *Hash) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*h = Hash{}
+		return nil
+	}
+	bs, err := base58.Decode(s)
+	if err != nil {
+		return err
+	}
+	if len(bs) != len(h) {
+		return fmt.Errorf("das: invalid hash length %d", len(bs))
+	}
+	copy(h[:], bs)
+	return nil
+}
+
+// Interface the interface of an asset
+type Interface string
+
+const (
+	InterfaceV1NFT             Interface = "V1_NFT"
+	InterfaceV1Print           Interface = "V1_PRINT"
+	InterfaceLegacyNFT         Interface = "LEGACY_NFT"
+	InterfaceV2NFT             Interface = "V2_NFT"
+	InterfaceFungibleAsset     Interface = "FungibleAsset"
+	InterfaceFungibleToken     Interface = "FungibleToken"
+	InterfaceCustom            Interface = "Custom"
+	InterfaceIdentity          Interface = "Identity"
+	InterfaceExecutable        Interface = "Executable"
+	InterfaceProgrammableNFT   Interface = "ProgrammableNFT"
+	InterfaceMplCoreAsset      Interface = "MplCoreAsset"
+	InterfaceMplCoreCollection Interface = "MplCoreCollection"
+)
+
+// GroupKeyCollection the group key of the collection of an asset, see GetAssetsByGroup
+const GroupKeyCollection = "collection"
+
+// Asset an asset of the DAS API: an NFT, a compressed NFT, a fungible token or a Core asset
+type Asset struct {
+	Interface   Interface      `json:"interface"`
+	Id          web3.PublicKey `json:"id"`
+	Content     *Content       `json:"content,omitempty"`
+	Authorities []Authority    `json:"authorities,omitempty"`
+	Compression *Compression   `json:"compression,omitempty"`
+	Grouping    []Group        `json:"grouping,omitempty"`
+	Royalty     *Royalty       `json:"royalty,omitempty"`
+	Creators    []Creator      `json:"creators,omitempty"`
+	Ownership   Ownership      `json:"ownership"`
+	Supply      *Supply        `json:"supply,omitempty"`
+	Mutable     bool           `json:"mutable"`
+	Burnt       bool           `json:"burnt"`
+}
+
+// Collection the collection of the asset, nil if the asset is not in a collection
+func (a *Asset) Collection() *web3.PublicKey {
+	for _, group := range a.Grouping {
+		if group.GroupKey == GroupKeyCollection {
+			if key, err := web3.NewPublicKey(group.GroupValue); err == nil {
+				return &key
+			}
+		}
+	}
+	return nil
+}
+
+// IsCompressed returns true if the asset is a compressed NFT
+func (a *Asset) IsCompressed() bool {
+	return a.Compression != nil && a.Compression.Compressed && a.Compression.Tree != nil
+}
+
+// Content the off-chain metadata of an asset
+type Content struct {
+	Schema   string          `json:"$schema,omitempty"`
+	JsonUri  string          `json:"json_uri"`
+	Files    []File          `json:"files,omitempty"`
+	Metadata ContentMetadata `json:"metadata"`
+	Links    map[string]any  `json:"links,omitempty"`
+}
+
+// File a file of the off-chain metadata
+type File struct {
+	Uri    string `json:"uri,omitempty"`
+	CdnUri string `json:"cdn_uri,omitempty"`
+	Mime   string `json:"mime,omitempty"`
+}
+
+// ContentMetadata the name, the symbol and the attributes of the off-chain metadata
+type ContentMetadata struct {
+	Name          string      `json:"name,omitempty"`
+	Symbol        string      `json:"symbol,omitempty"`
+	Description   string      `json:"description,omitempty"`
+	TokenStandard string      `json:"token_standard,omitempty"`
+	Attributes    []Attribute `json:"attributes,omitempty"`
+}
+
+// Attribute an attribute of the off-chain metadata, the value is a string or a number
+type Attribute struct {
+	TraitType string `json:"trait_type"`
+	Value     any    `json:"value"`
+}
+
+// Authority an authority of the asset and its scopes, such as "full"
+type Authority struct {
+	Address web3.PublicKey `json:"address"`
+	Scopes  []string       `json:"scopes"`
+}
+
+// Compression the leaf of a compressed NFT, the fields of an uncompressed asset are empty
+type Compression struct {
+	Eligible    bool            `json:"eligible"`
+	Compressed  bool            `json:"compressed"`
+	DataHash    Hash            `json:"data_hash"`
+	CreatorHash Hash            `json:"creator_hash"`
+	AssetHash   Hash            `json:"asset_hash"`
+	Tree        *web3.PublicKey `json:"tree"`
+	Seq         uint64          `json:"seq"`
+	LeafId      uint64          `json:"leaf_id"`
+}
+
+// UnmarshalJSON reads the empty tree of an uncompressed asset as nil
+func (c *Compression) UnmarshalJSON(data []byte) error {
+	type compression Compression
+	var ret struct {
+		compression
+		Tree string `json:"tree"`
+	}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return err
+	}
+	*c = Compression(ret.compression)
+	if ret.Tree != "" {
+		tree, err := web3.NewPublicKey(ret.Tree)
+		if err != nil {
+			return err
+		}
+		c.Tree = &tree
+	}
+	return nil
+}
+
+// Group a group of the asset, such as its collection
+type Group struct {
+	GroupKey   string `json:"group_key"`
+	GroupValue string `json:"group_value"`
+}
+
+// Royalty the royalty of the asset
+type Royalty struct {
+	RoyaltyModel        string          `json:"royalty_model"`
+	Target              *web3.PublicKey `json:"target"`
+	Percent             float64         `json:"percent"`
+	BasisPoints         uint16          `json:"basis_points"`
+	PrimarySaleHappened bool            `json:"primary_sale_happened"`
+	Locked              bool            `json:"locked"`
+}
+
+// Creator a creator of the asset
+type Creator struct {
+	Address  web3.PublicKey `json:"address"`
+	Share    uint8          `json:"share"`
+	Verified bool           `json:"verified"`
+}
+
+// Ownership the owner and the delegate of the asset
+type Ownership struct {
+	Frozen         bool            `json:"frozen"`
+	Delegated      bool            `json:"delegated"`
+	Delegate       *web3.PublicKey `json:"delegate"`
+	OwnershipModel string          `json:"ownership_model"`
+	Owner          web3.PublicKey  `json:"owner"`
+}
+
+// Supply the edition supply of the asset
+type Supply struct {
+	PrintMaxSupply     *uint64 `json:"print_max_supply"`
+	PrintCurrentSupply uint64  `json:"print_current_supply"`
+	EditionNonce       *uint8  `json:"edition_nonce"`
+}
+
+// AssetProof the proof of the leaf of a compressed NFT
+type AssetProof struct {
+	Root      Hash           `json:"root"`
+	Proof     []Hash         `json:"proof"`
+	NodeIndex uint64         `json:"node_index"`
+	Leaf      Hash           `json:"leaf"`
+	TreeId    web3.PublicKey `json:"tree_id"`
+}
+
+// Nodes the proof nodes from the leaf to the root
+func (p *AssetProof) Nodes() [][32]byte {
+	ret := make([][32]byte, len(p.Proof))
+	for i, node := range p.Proof {
+		ret[i] = node
+	}
+	return ret
+}
+
+// LeafIndex the index of the leaf in the tree, the node index counts the nodes of the tree from the root
+func (p *AssetProof) LeafIndex() uint32 {
+	return uint32(p.NodeIndex - 1<<len(p.Proof))
+}
+
+// AssetList a page of assets
+type AssetList struct {
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Page   int     `json:"page,omitempty"`
+	Before string  `json:"before,omitempty"`
+	After  string  `json:"after,omitempty"`
+	Cursor string  `json:"cursor,omitempty"`
+	Items  []Asset `json:"items"`
+}
@@ -0,0 +1,441 @@
+package idl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/donutnomad/solana-web3/binary"
+)
+
+type anchorField struct {
+	Name string          `json:"name"`
+	Docs []string        `json:"docs"`
+	Type json.RawMessage `json:"type"`
+}
+
+type anchorAccountItem struct {
+	Name     string              `json:"name"`
+	Docs     []string            `json:"docs"`
+	IsMut    bool                `json:"isMut"`
+	IsSigner bool                `json:"isSigner"`
+	Writable bool                `json:"writable"`
+	Signer   bool                `json:"signer"`
+	Optional bool                `json:"optional"`
+	IsOpt    bool                `json:"isOptional"`
+	Address  string              `json:"address"`
+	Accounts []anchorAccountItem `json:"accounts"`
+}
+
+type anchorVariant struct {
+	Name   string          `json:"name"`
+	Docs   []string        `json:"docs"`
+	Fields json.RawMessage `json:"fields"`
+}
+
+type anchorTypeDefTy struct {
+	Kind     string          `json:"kind"`
+	Fields   json.RawMessage `json:"fields"`
+	Variants []anchorVariant `json:"variants"`
+	Alias    json.RawMessage `json:"alias"`
+	Value    json.RawMessage `json:"value"`
+}
+
+type anchorTypeDef struct {
+	Name string          `json:"name"`
+	Docs []string        `json:"docs"`
+	Type anchorTypeDefTy `json:"type"`
+}
+
+// shankDiscriminant e.g. `{"type": "u8", "value": 3}`
+type shankDiscriminant struct {
+	Type  string `json:"type"`
+	Value int    `json:"value"`
+}
+
+type anchorIDL struct {
+	Address  string   `json:"address"`
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Docs     []string `json:"docs"`
+	Metadata struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		Address string `json:"address"`
+	} `json:"metadata"`
+	Instructions []struct {
+		Name         string              `json:"name"`
+		Docs         []string            `json:"docs"`
+		RawDisc      json.RawMessage     `json:"discriminator"`
+		Discriminant *shankDiscriminant  `json:"discriminant"`
+		Accounts     []anchorAccountItem `json:"accounts"`
+		Args         []anchorField       `json:"args"`
+	} `json:"instructions"`
+	Accounts []struct {
+		Name    string           `json:"name"`
+		Docs    []string         `json:"docs"`
+		RawDisc json.RawMessage  `json:"discriminator"`
+		Type    *anchorTypeDefTy `json:"type"`
+	} `json:"accounts"`
+	Types  []anchorTypeDef `json:"types"`
+	Events []struct {
+		Name    string          `json:"name"`
+		RawDisc json.RawMessage `json:"discriminator"`
+		Fields  []anchorField   `json:"fields"`
+	} `json:"events"`
+	Errors []struct {
+		Code int    `json:"code"`
+		Name string `json:"name"`
+		Msg  string `json:"msg"`
+	} `json:"errors"`
+}
+
+// parseAnchor parses the IDL of anchor >= 0.30, discriminators are part of the IDL
+func parseAnchor(data []byte) (*IDL, error) {
+	return parseAnchorIDL(data, FormatAnchor)
+}
+
+// parseAnchorLegacy parses the IDL of anchor < 0.30 and shank,
+// discriminators are derived from the names (anchor) or read from `discriminant` (shank)
+func parseAnchorLegacy(data []byte, shank bool) (*IDL, error) {
+	if shank {
+		return parseAnchorIDL(data, FormatShank)
+	}
+	return parseAnchorIDL(data, FormatAnchorLegacy)
+}
+
+func parseAnchorIDL(data []byte, format Format) (*IDL, error) {
+	var raw anchorIDL
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid anchor idl: %w", err)
+	}
+	out := &IDL{
+		Format:  format,
+		Name:    raw.Name,
+		Version: raw.Version,
+		Address: raw.Address,
+		Docs:    raw.Docs,
+	}
+	if len(out.Name) == 0 {
+		out.Name = raw.Metadata.Name
+	}
+	if len(out.Version) == 0 {
+		out.Version = raw.Metadata.Version
+	}
+	if len(out.Address) == 0 {
+		out.Address = raw.Metadata.Address
+	}
+
+	// types first, anchor >= 0.30 keeps the layout of accounts and events in `types`
+	for _, def := range raw.Types {
+		typeDef, err := parseAnchorTypeDef(def)
+		if err != nil {
+			return nil, err
+		}
+		out.Types = append(out.Types, *typeDef)
+	}
+
+	for idx, ins := range raw.Instructions {
+		item := Instruction{Name: ins.Name, Docs: ins.Docs}
+		switch {
+		case len(ins.RawDisc) > 0:
+			disc, err := parseDiscriminator(ins.RawDisc)
+			if err != nil {
+				return nil, fmt.Errorf("instruction %s: %w", ins.Name, err)
+			}
+			item.Discriminator = disc
+			item.DiscriminatorSeed = matchSeed(disc, "global:"+ToSnake(ins.Name))
+		case format == FormatShank:
+			value := idx
+			if ins.Discriminant != nil {
+				value = ins.Discriminant.Value
+			}
+			item.Discriminator = []byte{byte(value)}
+		default:
+			item.DiscriminatorSeed = "global:" + ToSnake(ins.Name)
+			item.Discriminator = binary.Sighash([]byte(item.DiscriminatorSeed))
+		}
+		item.Accounts = flattenAnchorAccounts(ins.Accounts)
+		args, err := parseAnchorFields(ins.Args)
+		if err != nil {
+			return nil, fmt.Errorf("instruction %s: %w", ins.Name, err)
+		}
+		item.Args = args
+		out.Instructions = append(out.Instructions, item)
+	}
+
+	for _, acc := range raw.Accounts {
+		item := Account{Name: acc.Name, Docs: acc.Docs}
+		if len(acc.RawDisc) > 0 {
+			disc, err := parseDiscriminator(acc.RawDisc)
+			if err != nil {
+				return nil, fmt.Errorf("account %s: %w", acc.Name, err)
+			}
+			item.Discriminator = disc
+			item.DiscriminatorSeed = matchSeed(disc, "account:"+acc.Name)
+		} else if format == FormatAnchorLegacy {
+			item.DiscriminatorSeed = "account:" + acc.Name
+			item.Discriminator = binary.Sighash([]byte(item.DiscriminatorSeed))
+		}
+		if acc.Type != nil {
+			typeDef, err := parseAnchorTypeDef(anchorTypeDef{Name: acc.Name, Docs: acc.Docs, Type: *acc.Type})
+			if err != nil {
+				return nil, err
+			}
+			item.Fields = typeDef.Fields
+		} else if typeDef := out.FindType(acc.Name); typeDef != nil {
+			item.Fields = typeDef.Fields
+			if len(item.Docs) == 0 {
+				item.Docs = typeDef.Docs
+			}
+		} else {
+			return nil, fmt.Errorf("account %s: layout not found", acc.Name)
+		}
+		out.Accounts = append(out.Accounts, item)
+	}
+
+	for _, ev := range raw.Events {
+		item := Event{Name: ev.Name}
+		if len(ev.RawDisc) > 0 {
+			disc, err := parseDiscriminator(ev.RawDisc)
+			if err != nil {
+				return nil, fmt.Errorf("event %s: %w", ev.Name, err)
+			}
+			item.Discriminator = disc
+			item.DiscriminatorSeed = matchSeed(disc, "event:"+ev.Name)
+		} else {
+			item.DiscriminatorSeed = "event:" + ev.Name
+			item.Discriminator = binary.Sighash([]byte(item.DiscriminatorSeed))
+		}
+		if len(ev.Fields) > 0 {
+			fields, err := parseAnchorFields(ev.Fields)
+			if err != nil {
+				return nil, fmt.Errorf("event %s: %w", ev.Name, err)
+			}
+			item.Fields = fields
+		} else if typeDef := out.FindType(ev.Name); typeDef != nil {
+			item.Fields = typeDef.Fields
+		}
+		out.Events = append(out.Events, item)
+	}
+
+	for _, e := range raw.Errors {
+		out.Errors = append(out.Errors, ErrorCode{Code: e.Code, Name: e.Name, Msg: e.Msg})
+	}
+	return out, nil
+}
+
+// matchSeed returns seed if the discriminator is the sighash of seed
+func matchSeed(disc []byte, seed string) string {
+	if bytes.Equal(disc, binary.Sighash([]byte(seed))) {
+		return seed
+	}
+	return ""
+}
+
+func parseDiscriminator(raw json.RawMessage) ([]byte, error) {
+	var values []int
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("invalid discriminator: %w", err)
+	}
+	out := make([]byte, len(values))
+	for i, v := range values {
+		out[i] = byte(v)
+	}
+	return out, nil
+}
+
+func flattenAnchorAccounts(items []anchorAccountItem) []InstructionAccount {
+	var out []InstructionAccount
+	for _, item := range items {
+		if len(item.Accounts) > 0 {
+			out = append(out, flattenAnchorAccounts(item.Accounts)...)
+			continue
+		}
+		out = append(out, InstructionAccount{
+			Name:     item.Name,
+			Docs:     item.Docs,
+			Writable: item.IsMut || item.Writable,
+			Signer:   item.IsSigner || item.Signer,
+			Optional: item.IsOpt || item.Optional,
+			Address:  item.Address,
+		})
+	}
+	return out
+}
+
+func parseAnchorFields(fields []anchorField) ([]Field, error) {
+	out := make([]Field, 0, len(fields))
+	for _, f := range fields {
+		typ, err := parseAnchorType(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		out = append(out, Field{Name: f.Name, Docs: f.Docs, Type: typ})
+	}
+	return out, nil
+}
+
+// parseAnchorStructFields parses named (`[{name, type}]`) and tuple (`[type]`) fields
+func parseAnchorStructFields(raw json.RawMessage) (fields []Field, tuple bool, err error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, false, nil
+	}
+	var items []json.RawMessage
+	if err = json.Unmarshal(raw, &items); err != nil {
+		return nil, false, fmt.Errorf("invalid fields: %w", err)
+	}
+	for _, item := range items {
+		var named anchorField
+		if json.Unmarshal(item, &named) == nil && len(named.Name) > 0 && len(named.Type) > 0 {
+			typ, err := parseAnchorType(named.Type)
+			if err != nil {
+				return nil, false, fmt.Errorf("field %s: %w", named.Name, err)
+			}
+			fields = append(fields, Field{Name: named.Name, Docs: named.Docs, Type: typ})
+			continue
+		}
+		typ, err := parseAnchorType(item)
+		if err != nil {
+			return nil, false, err
+		}
+		fields = append(fields, Field{Type: typ})
+		tuple = true
+	}
+	return fields, tuple, nil
+}
+
+func parseAnchorTypeDef(def anchorTypeDef) (*TypeDef, error) {
+	out := &TypeDef{Name: def.Name, Docs: def.Docs}
+	switch def.Type.Kind {
+	case "struct":
+		fields, tuple, err := parseAnchorStructFields(def.Type.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", def.Name, err)
+		}
+		out.Kind = TypeStruct
+		if tuple {
+			out.Kind = TypeTuple
+		}
+		out.Fields = fields
+	case "enum":
+		out.Kind = TypeDefEnum
+		for _, v := range def.Type.Variants {
+			fields, tuple, err := parseAnchorStructFields(v.Fields)
+			if err != nil {
+				return nil, fmt.Errorf("type %s variant %s: %w", def.Name, v.Name, err)
+			}
+			out.Variants = append(out.Variants, EnumVariant{Name: v.Name, Docs: v.Docs, Fields: fields, Tuple: tuple})
+		}
+	case "alias", "type":
+		raw := def.Type.Alias
+		if len(raw) == 0 {
+			raw = def.Type.Value
+		}
+		typ, err := parseAnchorType(raw)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", def.Name, err)
+		}
+		out.Kind = TypeDefAlias
+		out.Alias = typ
+	default:
+		return nil, fmt.Errorf("type %s: %w: kind %q", def.Name, errUnsupportedType, def.Type.Kind)
+	}
+	return out, nil
+}
+
+var anchorPrimitives = map[string]TypeKind{
+	"bool": TypeBool, "u8": TypeU8, "i8": TypeI8, "u16": TypeU16, "i16": TypeI16,
+	"u32": TypeU32, "i32": TypeI32, "f32": TypeF32, "u64": TypeU64, "i64": TypeI64,
+	"f64": TypeF64, "u128": TypeU128, "i128": TypeI128, "string": TypeString,
+	"bytes": TypeBytes, "publicKey": TypePubkey, "pubkey": TypePubkey,
+}
+
+func parseAnchorType(raw json.RawMessage) (*Type, error) {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		if kind, ok := anchorPrimitives[name]; ok {
+			return &Type{Kind: kind}, nil
+		}
+		return nil, fmt.Errorf("%w: %q", errUnsupportedType, name)
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil || len(obj) != 1 {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedType, string(raw))
+	}
+	for key, value := range obj {
+		switch key {
+		case "option", "coption", "vec", "hashSet", "bTreeSet":
+			elem, err := parseAnchorType(value)
+			if err != nil {
+				return nil, err
+			}
+			kind := TypeVec
+			if key == "option" {
+				kind = TypeOption
+			} else if key == "coption" {
+				kind = TypeCOption
+			}
+			return &Type{Kind: kind, Elem: elem}, nil
+		case "array":
+			var items []json.RawMessage
+			if err := json.Unmarshal(value, &items); err != nil || len(items) != 2 {
+				return nil, fmt.Errorf("%w: array %s", errUnsupportedType, string(value))
+			}
+			elem, err := parseAnchorType(items[0])
+			if err != nil {
+				return nil, err
+			}
+			var size int
+			if err := json.Unmarshal(items[1], &size); err != nil {
+				return nil, fmt.Errorf("%w: generic array length %s", errUnsupportedType, string(items[1]))
+			}
+			return &Type{Kind: TypeArray, Elem: elem, Len: size}, nil
+		case "defined":
+			var definedName string
+			if json.Unmarshal(value, &definedName) != nil {
+				var defined struct {
+					Name     string            `json:"name"`
+					Generics []json.RawMessage `json:"generics"`
+				}
+				if err := json.Unmarshal(value, &defined); err != nil {
+					return nil, fmt.Errorf("%w: defined %s", errUnsupportedType, string(value))
+				}
+				if len(defined.Generics) > 0 {
+					return nil, fmt.Errorf("%w: generic type %s", errUnsupportedType, defined.Name)
+				}
+				definedName = defined.Name
+			}
+			return &Type{Kind: TypeDefined, Name: definedName}, nil
+		case "hashMap", "bTreeMap":
+			var items []json.RawMessage
+			if err := json.Unmarshal(value, &items); err != nil || len(items) != 2 {
+				return nil, fmt.Errorf("%w: map %s", errUnsupportedType, string(value))
+			}
+			k, err := parseAnchorType(items[0])
+			if err != nil {
+				return nil, err
+			}
+			v, err := parseAnchorType(items[1])
+			if err != nil {
+				return nil, err
+			}
+			return &Type{Kind: TypeMap, Key: k, Elem: v}, nil
+		case "tuple":
+			var items []json.RawMessage
+			if err := json.Unmarshal(value, &items); err != nil {
+				return nil, fmt.Errorf("%w: tuple %s", errUnsupportedType, string(value))
+			}
+			out := &Type{Kind: TypeTuple}
+			for _, item := range items {
+				elem, err := parseAnchorType(item)
+				if err != nil {
+					return nil, err
+				}
+				out.Fields = append(out.Fields, Field{Type: elem})
+			}
+			return out, nil
+		}
+		return nil, fmt.Errorf("%w: %q", errUnsupportedType, key)
+	}
+	return nil, errUnsupportedType
+}
@@ -0,0 +1,455 @@
+package idl
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/mr-tron/base58"
+	"sort"
+)
+
+// codamaNode a loose representation of every codama node kind, only the fields used here are decoded
+type codamaNode struct {
+	Kind string   `json:"kind"`
+	Name string   `json:"name"`
+	Docs []string `json:"docs"`
+
+	// programNode
+	Program      *codamaNode   `json:"program"`
+	PublicKey    string        `json:"publicKey"`
+	Version      string        `json:"version"`
+	Instructions []*codamaNode `json:"instructions"`
+	Accounts     []*codamaNode `json:"accounts"`
+	DefinedTypes []*codamaNode `json:"definedTypes"`
+	Errors       []*codamaNode `json:"errors"`
+
+	// instructionNode, instructionAccountNode, instructionArgumentNode
+	Arguments      []*codamaNode   `json:"arguments"`
+	Discriminators []*codamaNode   `json:"discriminators"`
+	IsWritable     bool            `json:"isWritable"`
+	IsSigner       json.RawMessage `json:"isSigner"`
+	IsOptional     bool            `json:"isOptional"`
+	DefaultValue   *codamaNode     `json:"defaultValue"`
+	Offset         int             `json:"offset"`
+	Constant       *codamaNode     `json:"constant"`
+
+	// errorNode
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+
+	// type nodes
+	Format   string          `json:"format"`
+	Endian   string          `json:"endian"`
+	Encoding string          `json:"encoding"`
+	Type     *codamaNode     `json:"type"`
+	Item     *codamaNode     `json:"item"`
+	Key      *codamaNode     `json:"key"`
+	Prefix   *codamaNode     `json:"prefix"`
+	Count    *codamaNode     `json:"count"`
+	Fields   []*codamaNode   `json:"fields"`
+	Items    []*codamaNode   `json:"items"`
+	Variants []*codamaNode   `json:"variants"`
+	Struct   *codamaNode     `json:"struct"`
+	Tuple    *codamaNode     `json:"tuple"`
+	Size     json.RawMessage `json:"size"`
+	Value    json.RawMessage `json:"value"`
+	Number   json.RawMessage `json:"number"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func parseCodama(data []byte) (*IDL, error) {
+	var root codamaNode
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid codama idl: %w", err)
+	}
+	program := root.Program
+	if program == nil {
+		return nil, fmt.Errorf("invalid codama idl: missing program node")
+	}
+	out := &IDL{
+		Format:  FormatCodama,
+		Name:    program.Name,
+		Version: program.Version,
+		Address: program.PublicKey,
+		Docs:    program.Docs,
+	}
+
+	for _, def := range program.DefinedTypes {
+		typeDef, err := codamaTypeDef(def)
+		if err != nil {
+			return nil, err
+		}
+		out.Types = append(out.Types, *typeDef)
+	}
+
+	for _, ins := range program.Instructions {
+		item := Instruction{Name: ins.Name, Docs: ins.Docs}
+		disc, discFields, err := codamaDiscriminator(ins.Discriminators, ins.Arguments)
+		if err != nil {
+			return nil, fmt.Errorf("instruction %s: %w", ins.Name, err)
+		}
+		item.Discriminator = disc
+		for _, arg := range ins.Arguments {
+			if discFields[arg.Name] {
+				continue
+			}
+			typ, err := codamaType(arg.Type)
+			if err != nil {
+				return nil, fmt.Errorf("instruction %s argument %s: %w", ins.Name, arg.Name, err)
+			}
+			item.Args = append(item.Args, Field{Name: arg.Name, Docs: arg.Docs, Type: typ})
+		}
+		for _, acc := range ins.Accounts {
+			account := InstructionAccount{
+				Name:     acc.Name,
+				Docs:     acc.Docs,
+				Writable: acc.IsWritable,
+				Optional: acc.IsOptional,
+			}
+			// isSigner is a boolean or "either"
+			var signer bool
+			if json.Unmarshal(acc.IsSigner, &signer) == nil {
+				account.Signer = signer
+			} else {
+				account.Signer = string(acc.IsSigner) == `"either"`
+			}
+			if acc.DefaultValue != nil && acc.DefaultValue.Kind == "publicKeyValueNode" {
+				account.Address = acc.DefaultValue.PublicKey
+			}
+			item.Accounts = append(item.Accounts, account)
+		}
+		out.Instructions = append(out.Instructions, item)
+	}
+
+	for _, acc := range program.Accounts {
+		item := Account{Name: acc.Name, Docs: acc.Docs}
+		var dataNode codamaNode
+		if err := json.Unmarshal(acc.Data, &dataNode); err != nil {
+			return nil, fmt.Errorf("account %s: invalid data node: %w", acc.Name, err)
+		}
+		disc, discFields, err := codamaDiscriminator(acc.Discriminators, dataNode.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", acc.Name, err)
+		}
+		// only 8 bytes discriminators are hoisted out of the layout,
+		// shorter ones (e.g. a `key` enum) stay as regular fields
+		if len(disc) != 8 {
+			discFields = nil
+		} else {
+			item.Discriminator = disc
+		}
+		for _, field := range dataNode.Fields {
+			if discFields[field.Name] {
+				continue
+			}
+			typ, err := codamaType(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("account %s field %s: %w", acc.Name, field.Name, err)
+			}
+			item.Fields = append(item.Fields, Field{Name: field.Name, Docs: field.Docs, Type: typ})
+		}
+		out.Accounts = append(out.Accounts, item)
+	}
+
+	for _, e := range program.Errors {
+		out.Errors = append(out.Errors, ErrorCode{Code: e.Code, Name: e.Name, Msg: e.Message})
+	}
+	return out, nil
+}
+
+// codamaDiscriminator concatenates the default values of the fields referenced by fieldDiscriminatorNode
+// and constantDiscriminatorNode (ordered by offset), and returns the names of the consumed fields
+func codamaDiscriminator(discriminators []*codamaNode, fields []*codamaNode) ([]byte, map[string]bool, error) {
+	type part struct {
+		offset int
+		data   []byte
+	}
+	var parts []part
+	consumed := make(map[string]bool)
+	for _, disc := range discriminators {
+		switch disc.Kind {
+		case "fieldDiscriminatorNode":
+			var field *codamaNode
+			for _, f := range fields {
+				if f.Name == disc.Name {
+					field = f
+					break
+				}
+			}
+			if field == nil || field.DefaultValue == nil {
+				return nil, nil, fmt.Errorf("discriminator field %s has no default value", disc.Name)
+			}
+			data, err := codamaValueBytes(field.Type, field.DefaultValue)
+			if err != nil {
+				return nil, nil, err
+			}
+			parts = append(parts, part{offset: disc.Offset, data: data})
+			consumed[field.Name] = true
+		case "constantDiscriminatorNode":
+			if disc.Constant == nil {
+				return nil, nil, fmt.Errorf("invalid constant discriminator")
+			}
+			var value codamaNode
+			if err := json.Unmarshal(disc.Constant.Value, &value); err != nil {
+				return nil, nil, fmt.Errorf("invalid constant discriminator: %w", err)
+			}
+			data, err := codamaValueBytes(disc.Constant.Type, &value)
+			if err != nil {
+				return nil, nil, err
+			}
+			parts = append(parts, part{offset: disc.Offset, data: data})
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool { return parts[i].offset < parts[j].offset })
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p.data...)
+	}
+	return out, consumed, nil
+}
+
+// codamaValueBytes serializes numberValueNode and bytesValueNode
+func codamaValueBytes(typ *codamaNode, value *codamaNode) ([]byte, error) {
+	switch value.Kind {
+	case "numberValueNode":
+		var number uint64
+		if err := json.Unmarshal(value.Number, &number); err != nil {
+			return nil, fmt.Errorf("invalid number value: %w", err)
+		}
+		size := 1
+		if typ != nil && typ.Kind == "numberTypeNode" {
+			size = numberSize(typ.Format)
+		}
+		buf := make([]byte, 8)
+		if typ != nil && typ.Endian == "be" {
+			binary.BigEndian.PutUint64(buf, number)
+			return buf[8-size:], nil
+		}
+		binary.LittleEndian.PutUint64(buf, number)
+		return buf[:size], nil
+	case "bytesValueNode":
+		var data string
+		if err := json.Unmarshal(value.Data, &data); err != nil {
+			return nil, fmt.Errorf("invalid bytes value: %w", err)
+		}
+		switch value.Encoding {
+		case "base16":
+			return hex.DecodeString(data)
+		case "base58":
+			return base58.Decode(data)
+		case "base64":
+			return base64.StdEncoding.DecodeString(data)
+		default:
+			return []byte(data), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: discriminator value %s", errUnsupportedType, value.Kind)
+}
+
+func numberSize(format string) int {
+	switch format {
+	case "u8", "i8":
+		return 1
+	case "u16", "i16":
+		return 2
+	case "u32", "i32", "f32":
+		return 4
+	default:
+		return 8
+	}
+}
+
+func codamaTypeDef(def *codamaNode) (*TypeDef, error) {
+	out := &TypeDef{Name: def.Name, Docs: def.Docs}
+	typ := def.Type
+	if typ == nil {
+		return nil, fmt.Errorf("type %s: missing type node", def.Name)
+	}
+	switch typ.Kind {
+	case "enumTypeNode":
+		out.Kind = TypeDefEnum
+		for _, v := range typ.Variants {
+			variant := EnumVariant{Name: v.Name, Docs: v.Docs}
+			switch v.Kind {
+			case "enumStructVariantTypeNode":
+				fields, err := codamaFields(v.Struct)
+				if err != nil {
+					return nil, fmt.Errorf("type %s variant %s: %w", def.Name, v.Name, err)
+				}
+				variant.Fields = fields
+			case "enumTupleVariantTypeNode":
+				fields, err := codamaFields(v.Tuple)
+				if err != nil {
+					return nil, fmt.Errorf("type %s variant %s: %w", def.Name, v.Name, err)
+				}
+				variant.Fields = fields
+				variant.Tuple = true
+			}
+			out.Variants = append(out.Variants, variant)
+		}
+	case "structTypeNode":
+		fields, err := codamaFields(typ)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", def.Name, err)
+		}
+		out.Kind = TypeStruct
+		out.Fields = fields
+	case "tupleTypeNode":
+		fields, err := codamaFields(typ)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", def.Name, err)
+		}
+		out.Kind = TypeTuple
+		out.Fields = fields
+	default:
+		alias, err := codamaType(typ)
+		if err != nil {
+			return nil, fmt.Errorf("type %s: %w", def.Name, err)
+		}
+		out.Kind = TypeDefAlias
+		out.Alias = alias
+	}
+	return out, nil
+}
+
+// codamaFields returns the fields of structTypeNode and the items of tupleTypeNode
+func codamaFields(node *codamaNode) ([]Field, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var out []Field
+	if node.Kind == "tupleTypeNode" {
+		for _, item := range node.Items {
+			typ, err := codamaType(item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Field{Type: typ})
+		}
+		return out, nil
+	}
+	for _, field := range node.Fields {
+		typ, err := codamaType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		out = append(out, Field{Name: field.Name, Docs: field.Docs, Type: typ})
+	}
+	return out, nil
+}
+
+var codamaNumbers = map[string]TypeKind{
+	"u8": TypeU8, "i8": TypeI8, "u16": TypeU16, "i16": TypeI16, "u32": TypeU32, "i32": TypeI32,
+	"f32": TypeF32, "u64": TypeU64, "i64": TypeI64, "f64": TypeF64, "u128": TypeU128, "i128": TypeI128,
+}
+
+// isU32Prefix reports whether the prefix (or count) is the borsh default u32
+func isU32Prefix(prefix *codamaNode) bool {
+	return prefix == nil || (prefix.Kind == "numberTypeNode" && prefix.Format == "u32")
+}
+
+func codamaType(node *codamaNode) (*Type, error) {
+	if node == nil {
+		return nil, fmt.Errorf("%w: missing type node", errUnsupportedType)
+	}
+	switch node.Kind {
+	case "numberTypeNode":
+		if kind, ok := codamaNumbers[node.Format]; ok {
+			return &Type{Kind: kind}, nil
+		}
+	case "booleanTypeNode":
+		return &Type{Kind: TypeBool}, nil
+	case "publicKeyTypeNode":
+		return &Type{Kind: TypePubkey}, nil
+	case "bytesTypeNode":
+		return &Type{Kind: TypeRemainder}, nil
+	case "sizePrefixTypeNode":
+		if node.Type != nil && isU32Prefix(node.Prefix) {
+			switch node.Type.Kind {
+			case "stringTypeNode":
+				return &Type{Kind: TypeString}, nil
+			case "bytesTypeNode":
+				return &Type{Kind: TypeBytes}, nil
+			}
+		}
+	case "fixedSizeTypeNode":
+		var size int
+		if err := json.Unmarshal(node.Size, &size); err != nil {
+			return nil, fmt.Errorf("invalid fixed size: %w", err)
+		}
+		if node.Type != nil && (node.Type.Kind == "bytesTypeNode" || node.Type.Kind == "stringTypeNode") {
+			return &Type{Kind: TypeArray, Elem: &Type{Kind: TypeU8}, Len: size}, nil
+		}
+		return codamaType(node.Type)
+	case "arrayTypeNode", "setTypeNode":
+		elem, err := codamaType(node.Item)
+		if err != nil {
+			return nil, err
+		}
+		if node.Count != nil {
+			switch node.Count.Kind {
+			case "fixedCountNode":
+				var size int
+				if err := json.Unmarshal(node.Count.Value, &size); err != nil {
+					return nil, fmt.Errorf("invalid fixed count: %w", err)
+				}
+				return &Type{Kind: TypeArray, Elem: elem, Len: size}, nil
+			case "prefixedCountNode":
+				if isU32Prefix(node.Count.Prefix) {
+					return &Type{Kind: TypeVec, Elem: elem}, nil
+				}
+			}
+		}
+	case "mapTypeNode":
+		key, err := codamaType(node.Key)
+		if err != nil {
+			return nil, err
+		}
+		var valueNode codamaNode
+		if err := json.Unmarshal(node.Value, &valueNode); err != nil {
+			return nil, fmt.Errorf("invalid map value: %w", err)
+		}
+		value, err := codamaType(&valueNode)
+		if err != nil {
+			return nil, err
+		}
+		if node.Count == nil || isU32Prefix(node.Count.Prefix) {
+			return &Type{Kind: TypeMap, Key: key, Elem: value}, nil
+		}
+	case "optionTypeNode":
+		elem, err := codamaType(node.Item)
+		if err != nil {
+			return nil, err
+		}
+		if node.Prefix != nil && node.Prefix.Format == "u32" {
+			return &Type{Kind: TypeCOption, Elem: elem}, nil
+		}
+		return &Type{Kind: TypeOption, Elem: elem}, nil
+	case "zeroableOptionTypeNode":
+		// the zero value means none, the layout is the one of the item
+		return codamaType(node.Item)
+	case "definedTypeLinkNode":
+		return &Type{Kind: TypeDefined, Name: node.Name}, nil
+	case "structTypeNode":
+		fields, err := codamaFields(node)
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: TypeStruct, Fields: fields}, nil
+	case "tupleTypeNode":
+		fields, err := codamaFields(node)
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: TypeTuple, Fields: fields}, nil
+	case "dateTimeTypeNode", "amountTypeNode", "solAmountTypeNode":
+		var number codamaNode
+		if err := json.Unmarshal(node.Number, &number); err != nil {
+			return nil, fmt.Errorf("invalid number node: %w", err)
+		}
+		return codamaType(&number)
+	}
+	return nil, fmt.Errorf("%w: %s", errUnsupportedType, node.Kind)
+}
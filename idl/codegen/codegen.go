@@ -0,0 +1,281 @@
+// Package codegen generates go bindings from an IDL,
+// the layout of the generated package is the one of spl_token_2022 and mpl_token_metadata.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/donutnomad/solana-web3/idl"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// This code was AUTOGENERATED using the library.\n// Please DO NOT EDIT THIS FILE.\n\n"
+
+const zeroProgramID = "11111111111111111111111111111111"
+
+// Options of the generator
+type Options struct {
+	// Package the go package name, defaults to the snake case name of the program
+	Package string
+	// ProgramName the value of `ProgramName`, defaults to the snake case name of the program
+	ProgramName string
+	// ProgramID the default `ProgramID`, defaults to the address of the IDL
+	ProgramID string
+}
+
+var importPaths = map[string]string{
+	"bytes":    "bytes",
+	"errors":   "errors",
+	"fmt":      "fmt",
+	"spew":     "github.com/davecgh/go-spew/spew",
+	"common":   "github.com/donutnomad/solana-web3/common",
+	"binary":   "github.com/gagliardetto/binary",
+	"solanago": "github.com/gagliardetto/solana-go",
+	"text":     "github.com/gagliardetto/solana-go/text",
+	"format":   "github.com/gagliardetto/solana-go/text/format",
+	"treeout":  "github.com/gagliardetto/treeout",
+}
+
+const localBinaryPath = "github.com/donutnomad/solana-web3/binary"
+
+// builtinTypes defined types which are mapped to existing go types instead of being generated
+var builtinTypes = map[string]string{
+	"UnixTimestamp": "solanago.UnixTimeSeconds",
+}
+
+type generator struct {
+	idl  *idl.IDL
+	opts Options
+	// idEncoding the encoding of instruction ids: 1 (u8), 4 (u32) or 8 (sighash)
+	idSize int
+	// idExplicit the ids are registered explicitly (not derived from position or sighash)
+	idExplicit bool
+}
+
+// Generate returns the generated files (file name => formatted source)
+func Generate(program *idl.IDL, opts Options) (map[string][]byte, error) {
+	if len(opts.ProgramName) == 0 {
+		opts.ProgramName = idl.ToSnake(program.Name)
+	}
+	if len(opts.Package) == 0 {
+		opts.Package = opts.ProgramName
+	}
+	if len(opts.ProgramID) == 0 {
+		opts.ProgramID = program.Address
+	}
+	if len(opts.ProgramID) == 0 {
+		opts.ProgramID = zeroProgramID
+	}
+	g := &generator{idl: program, opts: opts}
+	if err := g.resolveInstructionIDs(); err != nil {
+		return nil, err
+	}
+
+	out := make(map[string][]byte)
+	add := func(name string, body string, localBinary bool) error {
+		src, err := g.file(body, localBinary)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		out[name] = src
+		return nil
+	}
+	if err := add("mod.go", g.genMod(), g.idSize == 8 || g.idExplicit); err != nil {
+		return nil, err
+	}
+	if len(program.Instructions) > 0 {
+		if err := add("instructions.go", g.genInstructions(), false); err != nil {
+			return nil, err
+		}
+	}
+	if len(program.Accounts) > 0 {
+		if err := add("accounts.go", g.genAccounts(), false); err != nil {
+			return nil, err
+		}
+	}
+	if len(program.Types) > 0 {
+		if err := add("types.go", g.genTypes(), false); err != nil {
+			return nil, err
+		}
+	}
+	if len(program.Errors) > 0 {
+		if err := add("errors.go", g.genErrors(), false); err != nil {
+			return nil, err
+		}
+	}
+	if len(program.Events) > 0 {
+		if err := add("events.go", g.genEvents(), true); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// resolveInstructionIDs checks that all the discriminators have the same size
+func (g *generator) resolveInstructionIDs() error {
+	for idx, ins := range g.idl.Instructions {
+		size := len(ins.Discriminator)
+		if size != 1 && size != 4 && size != 8 {
+			return fmt.Errorf("instruction %s: unsupported discriminator size %d", ins.Name, size)
+		}
+		if idx == 0 {
+			g.idSize = size
+		} else if g.idSize != size {
+			return fmt.Errorf("instruction %s: discriminator size %d, expected %d", ins.Name, size, g.idSize)
+		}
+		switch size {
+		case 8:
+			if len(ins.DiscriminatorSeed) == 0 {
+				g.idExplicit = true
+			}
+		case 4:
+			// only the local variant definition registers uint32 ids by value
+			g.idExplicit = true
+		default:
+			// gagliardetto's NewVariantDefinition uses the position as id
+			if ins.Discriminator[0] != byte(idx) || !isZero(ins.Discriminator[1:]) {
+				g.idExplicit = true
+			}
+		}
+	}
+	return nil
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// file formats the body and prepends the header, the package clause and the used imports
+func (g *generator) file(body string, localBinary bool) ([]byte, error) {
+	pkgClause := "package " + g.opts.Package + "\n\n"
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", pkgClause+body, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated code: %w\n%s", err, body)
+	}
+	used := make(map[string]bool)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if _, known := importPaths[ident.Name]; known {
+					used[ident.Name] = true
+				}
+			}
+		}
+		return true
+	})
+
+	type spec struct{ alias, path string }
+	var specs []spec
+	for alias := range used {
+		path := importPaths[alias]
+		if alias == "binary" && localBinary {
+			path = localBinaryPath
+		}
+		specs = append(specs, spec{alias, path})
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].path < specs[j].path })
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString(pkgClause)
+	format1 := func(s spec) string {
+		if s.alias == s.path {
+			return strconv.Quote(s.path)
+		}
+		return s.alias + " " + strconv.Quote(s.path)
+	}
+	switch len(specs) {
+	case 0:
+	case 1:
+		buf.WriteString("import " + format1(specs[0]) + "\n\n")
+	default:
+		buf.WriteString("import (\n")
+		for _, s := range specs {
+			buf.WriteString("\t" + format1(s) + "\n")
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.WriteString(body)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// writer a line based code builder
+type writer struct {
+	strings.Builder
+}
+
+// P writes a line, the arguments are concatenated
+func (w *writer) P(args ...string) {
+	for _, arg := range args {
+		w.WriteString(arg)
+	}
+	w.WriteByte('\n')
+}
+
+// Docs writes the docs as line comments
+func (w *writer) Docs(docs []string) {
+	for _, doc := range docs {
+		for _, line := range strings.Split(doc, "\n") {
+			w.P("// ", strings.TrimRight(line, " \t"))
+		}
+	}
+}
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true,
+	"if": true, "import": true, "interface": true, "map": true, "package": true, "range": true,
+	"return": true, "select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+// paramName the name of a function parameter
+func paramName(name string) string {
+	name = idl.ToCamel(name)
+	if goKeywords[name] || importPaths[name] != "" {
+		return name + "_"
+	}
+	return name
+}
+
+// fieldName the name of an exported field, unnamed (tuple) fields are named FieldN
+func fieldName(name string, idx int) string {
+	if len(name) == 0 {
+		return "Field" + strconv.Itoa(idx)
+	}
+	return idl.ToPascal(name)
+}
+
+func joinDocs(docs []string) string {
+	return strings.Join(strings.Fields(strings.Join(docs, " ")), " ")
+}
+
+func padLeft(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(" ", width-len(s)) + s
+}
+
+func byteList(data []byte) string {
+	items := make([]string, len(data))
+	for i, b := range data {
+		items[i] = strconv.Itoa(int(b))
+	}
+	return strings.Join(items, ", ")
+}
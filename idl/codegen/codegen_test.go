@@ -0,0 +1,112 @@
+package codegen
+
+import (
+	"bytes"
+	"github.com/donutnomad/solana-web3/idl"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, fixture string) map[string][]byte {
+	program, err := idl.ParseFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(program, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// the generated files must match the packages which are already committed
+func TestGenerateGolden(t *testing.T) {
+	tests := []struct {
+		fixture string
+		dir     string
+		files   []string
+	}{
+		{"cpi_guard.json", "cpi_guard", []string{"mod.go", "instructions.go", "accounts.go"}},
+		{"interest_bearing_mint.json", "interest_bearing_mint", []string{"mod.go", "instructions.go", "accounts.go", "types.go"}},
+		{"token_group.json", "token_group", []string{"errors.go"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			files := generate(t, tt.fixture)
+			for _, name := range tt.files {
+				want, err := os.ReadFile(filepath.Join("..", "..", "spl_token_2022", "extension", tt.dir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := files[name]; !bytes.Equal(got, want) {
+					t.Errorf("%s mismatch:\n%s", name, got)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateAnchorLegacy(t *testing.T) {
+	files := generate(t, "counter.json")
+	for _, name := range []string{"mod.go", "instructions.go", "accounts.go", "types.go", "errors.go", "events.go"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("missing %s", name)
+		}
+	}
+	mod := string(files["mod.go"])
+	for _, want := range []string{
+		`binary "github.com/donutnomad/solana-web3/binary"`,
+		`"initialize", "global:initialize", (*Initialize)(nil),`,
+		`func InstructionIDToName(id binary.TypeID) string {`,
+	} {
+		if !strings.Contains(mod, want) {
+			t.Errorf("mod.go does not contain %q", want)
+		}
+	}
+	accounts := string(files["accounts.go"])
+	if !strings.Contains(accounts, "// CounterDiscriminator DETERMINANT: account:Counter") {
+		t.Errorf("accounts.go does not declare the discriminator:\n%s", accounts)
+	}
+	types := string(files["types.go"])
+	for _, want := range []string{
+		"type Direction binary.BorshEnum",
+		"func NewMode_By(field0 uint64) Mode {",
+		"func NewMode_One() Mode {",
+	} {
+		if !strings.Contains(types, want) {
+			t.Errorf("types.go does not contain %q", want)
+		}
+	}
+	if !strings.Contains(string(files["errors.go"]), "func GetCounterErrorFromCode(code int) ProgramError {") {
+		t.Error("errors.go does not declare GetCounterErrorFromCode")
+	}
+}
+
+// the elements of the vectors and the arrays of defined types are encoded one by one,
+// the encoder does not call the MarshalWithEncoder of the elements of a slice
+func TestGenerateDefinedElems(t *testing.T) {
+	types := string(generate(t, "counter.json")["types.go"])
+	start := strings.Index(types, "func (obj *Plan) MarshalWithEncoder(")
+	if start < 0 {
+		t.Fatalf("types.go does not declare Plan.MarshalWithEncoder:\n%s", types)
+	}
+	marshal := types[start : start+strings.Index(types[start:], "\n}\n")]
+	for _, want := range []string{
+		"if err = encoder.WriteUint32(uint32(len(obj.Steps)), binary.LE); err != nil {",
+		"for i := range obj.Steps {\n\t\tif err = encoder.Encode(&obj.Steps[i]); err != nil {",
+		"if obj.Fallback != nil {\n\t\tif err = encoder.WriteUint32(uint32(len(obj.Fallback)), binary.LE); err != nil {",
+		"for i := range obj.Fallback {\n\t\t\tif err = encoder.Encode(&obj.Fallback[i]); err != nil {",
+		"for i := range obj.Pair {\n\t\tif err = encoder.Encode(&obj.Pair[i]); err != nil {",
+	} {
+		if !strings.Contains(marshal, want) {
+			t.Errorf("Plan.MarshalWithEncoder does not contain %q:\n%s", want, marshal)
+		}
+	}
+	for _, unwanted := range []string{"encoder.Encode(&obj.Steps)", "encoder.Encode(obj.Fallback)", "encoder.Encode(&obj.Pair)"} {
+		if strings.Contains(marshal, unwanted) {
+			t.Errorf("Plan.MarshalWithEncoder contains %q", unwanted)
+		}
+	}
+}
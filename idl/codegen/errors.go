@@ -0,0 +1,79 @@
+package codegen
+
+import (
+	"github.com/donutnomad/solana-web3/idl"
+	"strconv"
+)
+
+func (g *generator) genErrors() string {
+	var w writer
+	w.P(`type ProgramError interface {
+	Code() int
+	Error() string
+}
+
+var codeToErrorMap = make(map[int]ProgramError)
+var nameToErrorMap = make(map[string]ProgramError)
+`)
+	w.P("func init() {")
+	for _, e := range g.idl.Errors {
+		name := idl.ToPascal(e.Name)
+		w.P("codeToErrorMap[", strconv.Itoa(e.Code), "] = new(", name, "Error)")
+		w.P("nameToErrorMap[", strconv.Quote(name), "] = new(", name, "Error)")
+	}
+	w.P("}")
+	w.P()
+	program := idl.ToPascal(g.opts.ProgramName)
+	w.P("func Get", program, "ErrorFromCode(code int) ProgramError {")
+	w.P("return codeToErrorMap[code]")
+	w.P("}")
+	w.P()
+	w.P("func Get", program, "ErrorFromName(name string) ProgramError {")
+	w.P("return nameToErrorMap[name]")
+	w.P("}")
+	w.P()
+	for _, e := range g.idl.Errors {
+		name := idl.ToPascal(e.Name) + "Error"
+		code := strconv.Itoa(e.Code)
+		w.P("// ", name, " Error: ", code, " `", e.Msg, "`")
+		w.P("type ", name, " struct{}")
+		w.P()
+		w.P("func (e ", name, ") Code() int {")
+		w.P("return ", code)
+		w.P("}")
+		w.P("func (e ", name, ") Error() string {")
+		w.P("return ", strconv.Quote(e.Msg))
+		w.P("}")
+		w.P()
+	}
+	return w.String()
+}
+
+// genEvents generates the event structs which are not already declared in types.go,
+// and registers them to EventImplDef
+func (g *generator) genEvents() string {
+	var w writer
+	declared := make(map[string]bool)
+	for _, def := range g.idl.Types {
+		declared[idl.ToPascal(def.Name)] = true
+	}
+	for _, ev := range g.idl.Events {
+		name := idl.ToPascal(ev.Name)
+		if declared[name] {
+			continue
+		}
+		g.writeStruct(&w, name, nil, ev.Fields)
+	}
+	w.P("var EventImplDef = binary.NewEventDefinition(nil)")
+	w.P()
+	w.P("func init() {")
+	for _, ev := range g.idl.Events {
+		name := idl.ToPascal(ev.Name)
+		if len(ev.DiscriminatorSeed) > 0 {
+			w.P("// DETERMINANT: ", ev.DiscriminatorSeed)
+		}
+		w.P("EventImplDef.RegisterWithDiscriminator(", strconv.Quote(name), ", binary.TypeID([8]byte{", byteList(ev.Discriminator), "}), (*", name, ")(nil))")
+	}
+	w.P("}")
+	return w.String()
+}
@@ -0,0 +1,298 @@
+package codegen
+
+import (
+	"github.com/donutnomad/solana-web3/idl"
+	"strconv"
+	"strings"
+)
+
+func (g *generator) genInstructions() string {
+	var w writer
+	for i := range g.idl.Instructions {
+		g.writeInstruction(&w, &g.idl.Instructions[i])
+	}
+	return w.String()
+}
+
+// argFieldType the type of the instruction field, values are stored as pointers so that Validate can detect them
+func (g *generator) argFieldType(t *idl.Type) string {
+	if isOptional(t) || isSliceLike(t) {
+		return g.goType(t)
+	}
+	return "*" + g.goType(t)
+}
+
+func accountFlags(acc idl.InstructionAccount) string {
+	var flags []string
+	if acc.Writable {
+		flags = append(flags, "WRITE")
+	}
+	if acc.Signer {
+		flags = append(flags, "SIGNER")
+	}
+	return strings.Join(flags, ", ")
+}
+
+func accountMeta(acc idl.InstructionAccount, param string) string {
+	meta := "common.Meta(" + param + ")"
+	if acc.Writable {
+		meta += ".WRITE()"
+	}
+	if acc.Signer {
+		meta += ".SIGNER()"
+	}
+	return meta
+}
+
+func (g *generator) writeInstruction(w *writer, ins *idl.Instruction) {
+	name := idl.ToPascal(ins.Name)
+	args := ins.Args
+	accounts := ins.Accounts
+
+	// struct
+	w.P("// ", name, " Instruction")
+	w.Docs(ins.Docs)
+	w.P("type ", name, " struct {")
+	for i, arg := range args {
+		w.Docs(arg.Docs)
+		tag := ""
+		if isOptional(arg.Type) {
+			tag = " `bin:\"optional\"`"
+		}
+		w.P(fieldName(arg.Name, i), " ", g.argFieldType(arg.Type), tag)
+	}
+	for i, acc := range accounts {
+		w.P("// [", strconv.Itoa(i), "] = [", accountFlags(acc), "] ", idl.ToCamel(acc.Name), " `", joinDocs(acc.Docs), "`")
+	}
+	w.P("common.AccountMetaSlice `bin:\"-\"`")
+	w.P("_programId *common.PublicKey")
+	w.P("}")
+	w.P()
+
+	// builder
+	w.P("// New", name, "InstructionBuilder creates a new `", name, "` instruction builder.")
+	w.P("func New", name, "InstructionBuilder() *", name, " {")
+	w.P("return &", name, "{")
+	w.P("AccountMetaSlice: make(common.AccountMetaSlice, ", strconv.Itoa(len(accounts)), "),")
+	w.P("}")
+	w.P("}")
+	w.P()
+
+	// constructor
+	w.P("// New", name, "Instruction")
+	w.P("//")
+	w.P("// Parameters:")
+	w.P("//")
+	for i, arg := range args {
+		g.writeParamDoc(w, paramName(fieldName(arg.Name, i)), arg.Docs)
+	}
+	for _, acc := range accounts {
+		g.writeParamDoc(w, paramName(acc.Name), acc.Docs)
+	}
+	w.P("func New", name, "Instruction(")
+	for i, arg := range args {
+		if isOptional(arg.Type) {
+			w.P("// optional,")
+		}
+		w.P(paramName(fieldName(arg.Name, i)), " ", g.goType(arg.Type), ",")
+	}
+	for _, acc := range accounts {
+		w.P(paramName(acc.Name), " common.PublicKey,")
+	}
+	w.P(") *", name, " {")
+	var calls []string
+	for i, arg := range args {
+		calls = append(calls, "Set"+fieldName(arg.Name, i)+"("+paramName(fieldName(arg.Name, i))+")")
+	}
+	for _, acc := range accounts {
+		calls = append(calls, "Set"+idl.ToPascal(acc.Name)+"Account("+paramName(acc.Name)+")")
+	}
+	if len(calls) == 0 {
+		w.P("return New", name, "InstructionBuilder()")
+	} else {
+		w.P("return New", name, "InstructionBuilder().")
+		for i, call := range calls {
+			if i == len(calls)-1 {
+				w.P(call)
+			} else {
+				w.P(call, ".")
+			}
+		}
+	}
+	w.P("}")
+	w.P()
+
+	// arg setters
+	for i, arg := range args {
+		field := fieldName(arg.Name, i)
+		param := paramName(field)
+		w.P("// Set", field, " sets the \"", idl.ToCamel(field), "\" parameter.")
+		w.P("func (obj *", name, ") Set", field, "(", param, " ", g.goType(arg.Type), ") *", name, " {")
+		if isOptional(arg.Type) || isSliceLike(arg.Type) {
+			w.P("obj.", field, " = ", param)
+		} else {
+			w.P("obj.", field, " = &", param)
+		}
+		w.P("return obj")
+		w.P("}")
+		w.P()
+	}
+
+	// account setters and getters
+	for i, acc := range accounts {
+		accName := idl.ToPascal(acc.Name)
+		param := paramName(acc.Name)
+		idx := strconv.Itoa(i)
+		w.P("// Set", accName, "Account sets the \"", idl.ToCamel(acc.Name), "\" parameter.")
+		w.Docs(acc.Docs)
+		if i == len(accounts)-1 {
+			w.P("func (obj *", name, ") Set", accName, "Account(", param, " common.PublicKey, multiSigners ...common.PublicKey) *", name, " {")
+			w.P("if len(multiSigners) > 0 {")
+			w.P("obj.AccountMetaSlice[", idx, "] = common.Meta(", param, ")")
+			w.P("for _, value := range multiSigners {")
+			w.P("obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))")
+			w.P("}")
+			w.P("} else {")
+			w.P("obj.AccountMetaSlice[", idx, "] = ", accountMeta(acc, param))
+			w.P("}")
+		} else {
+			w.P("func (obj *", name, ") Set", accName, "Account(", param, " common.PublicKey) *", name, " {")
+			w.P("obj.AccountMetaSlice[", idx, "] = ", accountMeta(acc, param))
+		}
+		w.P("return obj")
+		w.P("}")
+		w.P()
+		w.P("// Get", accName, "Account gets the \"", idl.ToCamel(acc.Name), "\" parameter.")
+		w.Docs(acc.Docs)
+		w.P("func (obj *", name, ") Get", accName, "Account() *common.AccountMeta {")
+		w.P("return obj.AccountMetaSlice.Get(", idx, ")")
+		w.P("}")
+		w.P()
+	}
+
+	w.P("func (obj *", name, ") SetProgramId(programId *common.PublicKey) *", name, " {")
+	w.P("obj._programId = programId")
+	w.P("return obj")
+	w.P("}")
+	w.P()
+
+	typeID, typeIDLen := g.typeIDExpr(name)
+	w.P("func (obj *", name, ") Build() *Instruction {")
+	w.P("return &Instruction{")
+	w.P("BaseVariant: binary.BaseVariant{")
+	w.P("Impl: obj,")
+	w.P("TypeID: ", typeID, ",")
+	w.P("},")
+	w.P("programId: obj._programId,")
+	w.P("typeIdLen: ", strconv.Itoa(typeIDLen), ",")
+	w.P("}")
+	w.P("}")
+	w.P()
+
+	// validation
+	w.P("func (obj *", name, ") Validate() error {")
+	for i, arg := range args {
+		if isOptional(arg.Type) {
+			continue
+		}
+		field := fieldName(arg.Name, i)
+		w.P("if obj.", field, " == nil {")
+		w.P("return errors.New(\"[", name, "] ", idl.ToCamel(field), " param is not set\")")
+		w.P("}")
+	}
+	w.P()
+	for i, acc := range accounts {
+		if acc.Optional {
+			continue
+		}
+		w.P("if obj.AccountMetaSlice[", strconv.Itoa(i), "] == nil {")
+		w.P("return errors.New(\"[", name, "] accounts.", idl.ToCamel(acc.Name), " is not set\")")
+		w.P("}")
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+	w.P("// ValidateAndBuild validates the instruction parameters and accounts;")
+	w.P("// if there is a validation error, it returns the error.")
+	w.P("// Otherwise, it builds and returns the instruction.")
+	w.P("func (obj *", name, ") ValidateAndBuild() (*Instruction, error) {")
+	w.P("if err := obj.Validate(); err != nil {")
+	w.P("return nil, err")
+	w.P("}")
+	w.P("return obj.Build(), nil")
+	w.P("}")
+	w.P()
+
+	// serialization
+	w.P("func (obj *", name, ") MarshalWithEncoder(encoder *binary.Encoder) (err error) {")
+	for i, arg := range args {
+		g.writeEncodeField(w, "obj."+fieldName(arg.Name, i), arg.Type)
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+	w.P("func (obj *", name, ") UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {")
+	for i, arg := range args {
+		g.writeDecodeField(w, "obj."+fieldName(arg.Name, i), arg.Type)
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+
+	// tree
+	w.P("func (obj *", name, ") EncodeToTree(parent treeout.Branches) {")
+	w.P("parent.Child(format.Program(ProgramName, common.As(ProgramID))).")
+	w.P("ParentFunc(func(programBranch treeout.Branches) {")
+	w.P("programBranch.Child(format.Instruction(", strconv.Quote(name), ")).")
+	w.P("ParentFunc(func(instructionBranch treeout.Branches) {")
+	w.P("// Parameters of the instruction:")
+	if len(args) == 0 {
+		w.P("instructionBranch.Child(\"Params[len=0]\").ParentFunc(func(paramsBranch treeout.Branches) {})")
+	} else {
+		width := 0
+		for i, arg := range args {
+			width = max(width, len(fieldName(arg.Name, i)))
+		}
+		w.P("instructionBranch.Child(\"Params[len=", strconv.Itoa(len(args)), "]\").ParentFunc(func(paramsBranch treeout.Branches) {")
+		for i, arg := range args {
+			field := fieldName(arg.Name, i)
+			label := padLeft(field, width)
+			switch {
+			case isOptional(arg.Type):
+				w.P("paramsBranch.Child(format.Param(", strconv.Quote(label+" (OPT)"), ", obj.", field, "))")
+			case isSliceLike(arg.Type):
+				w.P("paramsBranch.Child(format.Param(", strconv.Quote(label), ", obj.", field, "))")
+			default:
+				w.P("paramsBranch.Child(format.Param(", strconv.Quote(label), ", *obj.", field, "))")
+			}
+		}
+		w.P("})")
+	}
+	w.P("// Accounts of the instruction:")
+	if len(accounts) == 0 {
+		w.P("instructionBranch.Child(\"Accounts[len=0]\").ParentFunc(func(accountsBranch treeout.Branches) {})")
+	} else {
+		width := 0
+		for _, acc := range accounts {
+			width = max(width, len(idl.ToCamel(acc.Name)))
+		}
+		w.P("instructionBranch.Child(\"Accounts[len=", strconv.Itoa(len(accounts)), "]\").ParentFunc(func(accountsBranch treeout.Branches) {")
+		for i, acc := range accounts {
+			w.P("accountsBranch.Child(common.FormatMeta(", strconv.Quote(padLeft(idl.ToCamel(acc.Name), width)), ", obj.AccountMetaSlice.Get(", strconv.Itoa(i), ")))")
+		}
+		w.P("})")
+	}
+	w.P("})")
+	w.P("})")
+	w.P("}")
+	w.P()
+}
+
+func (g *generator) writeParamDoc(w *writer, name string, docs []string) {
+	doc := joinDocs(docs)
+	if len(doc) == 0 {
+		w.P("//\t", name, ":")
+	} else {
+		w.P("//\t", name, ": ", doc)
+	}
+}
@@ -0,0 +1,226 @@
+package codegen
+
+import (
+	"github.com/donutnomad/solana-web3/idl"
+	"strconv"
+)
+
+// instructionIDType the go type of the `Instruction_X` vars
+func (g *generator) instructionIDType() string {
+	switch g.idSize {
+	case 4:
+		return "uint32"
+	case 8:
+		return "binary.TypeID"
+	default:
+		return "uint8"
+	}
+}
+
+// instructionIDValue the value of the `Instruction_X` var
+func instructionIDValue(ins *idl.Instruction) string {
+	switch len(ins.Discriminator) {
+	case 4:
+		return strconv.FormatUint(uint64(ins.Discriminator[0])|uint64(ins.Discriminator[1])<<8|
+			uint64(ins.Discriminator[2])<<16|uint64(ins.Discriminator[3])<<24, 10)
+	case 8:
+		return "binary.TypeID([8]byte{" + byteList(ins.Discriminator) + "})"
+	default:
+		return strconv.Itoa(int(ins.Discriminator[0]))
+	}
+}
+
+func (g *generator) genMod() string {
+	var w writer
+	w.P("var ProgramID common.PublicKey = common.MustPublicKeyFromBase58(", strconv.Quote(g.opts.ProgramID), ")")
+	w.P()
+	w.P("func SetProgramID(pubkey common.PublicKey) {")
+	w.P("ProgramID = pubkey")
+	w.P("if !common.IsZero(ProgramID) {")
+	w.P("solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)")
+	w.P("}")
+	w.P("}")
+	w.P()
+	w.P("const ProgramName = ", strconv.Quote(g.opts.ProgramName))
+	w.P()
+	w.P("func init() {")
+	w.P("if !common.IsZero(ProgramID) {")
+	w.P("solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)")
+	w.P("}")
+	w.P("}")
+	w.P()
+	w.P("func btou32(b bool) uint32 {")
+	w.P("if b {")
+	w.P("return 1")
+	w.P("}")
+	w.P("return 0")
+	w.P("}")
+	w.P()
+
+	if len(g.idl.Instructions) > 0 {
+		w.P("var (")
+		for i := range g.idl.Instructions {
+			ins := &g.idl.Instructions[i]
+			if g.idSize == 8 {
+				w.P("Instruction_", idl.ToPascal(ins.Name), " = ", instructionIDValue(ins))
+			} else {
+				w.P("Instruction_", idl.ToPascal(ins.Name), " ", g.instructionIDType(), " = ", instructionIDValue(ins))
+			}
+		}
+		w.P(")")
+		w.P()
+	}
+
+	g.writeImplDef(&w)
+
+	w.P("// InstructionIDToName returns the name of the instruction given its ID.")
+	w.P("func InstructionIDToName(id ", g.instructionIDType(), ") string {")
+	w.P("switch id {")
+	for _, ins := range g.idl.Instructions {
+		name := idl.ToPascal(ins.Name)
+		w.P("case Instruction_", name, ":")
+		w.P("return ", strconv.Quote(name))
+	}
+	w.P("default:")
+	w.P("return \"\"")
+	w.P("}")
+	w.P("}")
+	w.P()
+
+	w.P(`func registryDecodeInstruction(accounts []*solanago.AccountMeta, data []byte) (interface{}, error) {
+	obj, err := DecodeInstruction(common.ConvertMeta(accounts), data)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func DecodeInstruction(accounts []*common.AccountMeta, data []byte) (*Instruction, error) {
+	obj := new(Instruction)
+	if err := binary.NewBorshDecoder(data).Decode(obj); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	if v, ok := obj.Impl.(common.AccountsSettable); ok {
+		err := v.SetAccounts(accounts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set accounts for instruction: %w", err)
+		}
+	}
+	return obj, nil
+}
+
+type Instruction struct {
+	binary.BaseVariant
+	programId *common.PublicKey
+	typeIdLen uint8
+}
+
+func (obj *Instruction) EncodeToTree(parent treeout.Branches) {
+	if enToTree, ok := obj.Impl.(text.EncodableToTree); ok {
+		enToTree.EncodeToTree(parent)
+	} else {
+		parent.Child(spew.Sdump(obj))
+	}
+}
+
+func (obj *Instruction) ProgramID() common.PublicKey {
+	if obj.programId != nil {
+		return *obj.programId
+	}
+	return ProgramID
+}
+
+func (obj *Instruction) Accounts() (out []*common.AccountMeta) {
+	return obj.Impl.(common.AccountsGettable).GetAccounts()
+}
+
+func (obj *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := binary.NewBorshEncoder(buf).Encode(obj); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (obj *Instruction) TextEncode(encoder *text.Encoder, option *text.Option) error {
+	return encoder.Encode(obj.Impl, option)
+}
+`)
+	w.P("func (obj *Instruction) UnmarshalWithDecoder(decoder *binary.Decoder) error {")
+	if g.idSize == 8 || g.idExplicit {
+		w.P("return InstructionImplDef.UnmarshalBinaryVariant(decoder, &obj.BaseVariant)")
+	} else {
+		w.P("return obj.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionImplDef)")
+	}
+	w.P("}")
+	w.P()
+	w.P(`func (obj *Instruction) MarshalWithEncoder(encoder *binary.Encoder) error {
+	err := encoder.WriteBytes(obj.TypeID.Bytes()[:obj.typeIdLen], false)
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(obj.Impl)
+}`)
+	return w.String()
+}
+
+// writeImplDef writes `InstructionImplDef`
+func (g *generator) writeImplDef(w *writer) {
+	switch {
+	case g.idExplicit:
+		encoding := map[int]string{
+			1: "binary.Uint8TypeIDEncoding",
+			4: "binary.Uint32TypeIDEncoding",
+			8: "binary.AnchorTypeIDEncoding",
+		}[g.idSize]
+		if len(encoding) == 0 {
+			encoding = "binary.Uint8TypeIDEncoding"
+		}
+		w.P("var InstructionImplDef = binary.NewVariantDefinitionTypeID(", encoding, ", []binary.VariantTypeID{")
+		for _, ins := range g.idl.Instructions {
+			name := idl.ToPascal(ins.Name)
+			var id string
+			switch g.idSize {
+			case 8:
+				id = "Instruction_" + name
+			case 1:
+				id = "binary.TypeIDFromBytes([]byte{Instruction_" + name + "})"
+			default:
+				id = "binary.TypeIDFromBytes([]byte{" + byteList(ins.Discriminator) + "})"
+			}
+			w.P("{")
+			w.P(strconv.Quote(idl.ToSnake(ins.Name)), ", ", id, ", (*", name, ")(nil),")
+			w.P("},")
+		}
+		w.P("})")
+	case g.idSize == 8:
+		w.P("var InstructionImplDef = binary.NewVariantDefinitionAnchorType([]binary.VariantTypeHash{")
+		for _, ins := range g.idl.Instructions {
+			w.P("{")
+			w.P(strconv.Quote(idl.ToSnake(ins.Name)), ", ", strconv.Quote(ins.DiscriminatorSeed), ", (*", idl.ToPascal(ins.Name), ")(nil),")
+			w.P("},")
+		}
+		w.P("})")
+	default:
+		w.P("var InstructionImplDef = binary.NewVariantDefinition(binary.Uint8TypeIDEncoding, []binary.VariantType{")
+		for _, ins := range g.idl.Instructions {
+			w.P("{")
+			w.P(strconv.Quote(idl.ToSnake(ins.Name)), ", (*", idl.ToPascal(ins.Name), ")(nil),")
+			w.P("},")
+		}
+		w.P("})")
+	}
+	w.P()
+}
+
+// typeIDExpr the `TypeID` of an instruction in `Build`
+func (g *generator) typeIDExpr(name string) (string, int) {
+	switch g.idSize {
+	case 4:
+		return "binary.TypeIDFromUint32(Instruction_" + name + ", binary.LE)", 4
+	case 8:
+		return "binary.TypeIDFromBytes(Instruction_" + name + "[:])", 8
+	default:
+		return "binary.TypeIDFromBytes([]byte{Instruction_" + name + "})", 1
+	}
+}
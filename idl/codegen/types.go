@@ -0,0 +1,556 @@
+package codegen
+
+import (
+	"fmt"
+	"github.com/donutnomad/solana-web3/idl"
+	"strconv"
+)
+
+// typeName the go name of a defined type
+func (g *generator) typeName(name string) string {
+	pascal := idl.ToPascal(name)
+	if builtin, ok := builtinTypes[pascal]; ok {
+		return builtin
+	}
+	return pascal
+}
+
+// isBuiltin reports whether the defined type is mapped to an existing go type
+func isBuiltin(name string) bool {
+	_, ok := builtinTypes[idl.ToPascal(name)]
+	return ok
+}
+
+// isOptional reports whether the field is tagged `bin:"optional"`
+func isOptional(t *idl.Type) bool {
+	return t.Kind == idl.TypeOption || t.Kind == idl.TypeCOption
+}
+
+// isSliceLike reports whether the zero value of the go type is nil without being a pointer
+func isSliceLike(t *idl.Type) bool {
+	switch t.Kind {
+	case idl.TypeBytes, idl.TypeRemainder, idl.TypeVec, idl.TypeMap:
+		return true
+	}
+	return false
+}
+
+func (g *generator) goType(t *idl.Type) string {
+	switch t.Kind {
+	case idl.TypeBool:
+		return "bool"
+	case idl.TypeU8:
+		return "uint8"
+	case idl.TypeI8:
+		return "int8"
+	case idl.TypeU16:
+		return "uint16"
+	case idl.TypeI16:
+		return "int16"
+	case idl.TypeU32:
+		return "uint32"
+	case idl.TypeI32:
+		return "int32"
+	case idl.TypeF32:
+		return "float32"
+	case idl.TypeU64:
+		return "uint64"
+	case idl.TypeI64:
+		return "int64"
+	case idl.TypeF64:
+		return "float64"
+	case idl.TypeU128:
+		return "binary.Uint128"
+	case idl.TypeI128:
+		return "binary.Int128"
+	case idl.TypeString:
+		return "string"
+	case idl.TypeBytes, idl.TypeRemainder:
+		return "[]byte"
+	case idl.TypePubkey:
+		return "common.PublicKey"
+	case idl.TypeOption, idl.TypeCOption:
+		if isSliceLike(t.Elem) {
+			return g.goType(t.Elem)
+		}
+		return "*" + g.goType(t.Elem)
+	case idl.TypeVec:
+		if t.Elem.Kind == idl.TypeU8 {
+			return "[]byte"
+		}
+		return "[]" + g.goType(t.Elem)
+	case idl.TypeArray:
+		return "[" + strconv.Itoa(t.Len) + "]" + g.goType(t.Elem)
+	case idl.TypeMap:
+		return "map[" + g.goType(t.Key) + "]" + g.goType(t.Elem)
+	case idl.TypeDefined:
+		return g.typeName(t.Name)
+	case idl.TypeStruct, idl.TypeTuple:
+		var w writer
+		w.P("struct {")
+		for i, f := range t.Fields {
+			w.P(fieldName(f.Name, i), " ", g.goType(f.Type))
+		}
+		w.WriteString("}")
+		return w.String()
+	}
+	panic(fmt.Errorf("unsupported type %q", t.Kind))
+}
+
+// size returns the serialized size of a type if it is fixed
+func (g *generator) size(t *idl.Type, depth int) (int, bool) {
+	if depth > 32 {
+		return 0, false
+	}
+	switch t.Kind {
+	case idl.TypeBool, idl.TypeU8, idl.TypeI8:
+		return 1, true
+	case idl.TypeU16, idl.TypeI16:
+		return 2, true
+	case idl.TypeU32, idl.TypeI32, idl.TypeF32:
+		return 4, true
+	case idl.TypeU64, idl.TypeI64, idl.TypeF64:
+		return 8, true
+	case idl.TypeU128, idl.TypeI128:
+		return 16, true
+	case idl.TypePubkey:
+		return 32, true
+	case idl.TypeOption, idl.TypeCOption:
+		size, ok := g.size(t.Elem, depth+1)
+		if t.Kind == idl.TypeOption {
+			return 1 + size, ok
+		}
+		return 4 + size, ok
+	case idl.TypeArray:
+		size, ok := g.size(t.Elem, depth+1)
+		return size * t.Len, ok
+	case idl.TypeStruct, idl.TypeTuple:
+		return g.fieldsSize(t.Fields, depth+1)
+	case idl.TypeDefined:
+		if isBuiltin(t.Name) {
+			return 0, false
+		}
+		def := g.idl.FindType(t.Name)
+		if def == nil {
+			return 0, false
+		}
+		switch def.Kind {
+		case idl.TypeStruct, idl.TypeTuple:
+			return g.fieldsSize(def.Fields, depth+1)
+		case idl.TypeDefAlias:
+			return g.size(def.Alias, depth+1)
+		case idl.TypeDefEnum:
+			// the enum has a fixed size when all the variants have the same size
+			variantSize := -1
+			for _, v := range def.Variants {
+				size, ok := g.fieldsSize(v.Fields, depth+1)
+				if !ok || (variantSize >= 0 && variantSize != size) {
+					return 0, false
+				}
+				variantSize = size
+			}
+			if variantSize < 0 {
+				variantSize = 0
+			}
+			return 1 + variantSize, true
+		}
+	}
+	return 0, false
+}
+
+func (g *generator) fieldsSize(fields []idl.Field, depth int) (int, bool) {
+	total := 0
+	for _, f := range fields {
+		size, ok := g.size(f.Type, depth)
+		if !ok {
+			return 0, false
+		}
+		total += size
+	}
+	return total, true
+}
+
+// writeFields writes the fields of a struct
+func (g *generator) writeFields(w *writer, fields []idl.Field) {
+	for i, f := range fields {
+		w.Docs(f.Docs)
+		tag := ""
+		if isOptional(f.Type) {
+			tag = " `bin:\"optional\"`"
+		}
+		w.P(fieldName(f.Name, i), " ", g.goType(f.Type), tag)
+	}
+}
+
+// writeEncodeField writes the encoding of `target` (e.g. `obj.Amount`)
+func (g *generator) writeEncodeField(w *writer, target string, t *idl.Type) {
+	switch t.Kind {
+	case idl.TypeOption:
+		w.P("if err = encoder.WriteBool(", target, " != nil); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("if ", target, " != nil {")
+		if isDefinedElems(t.Elem) {
+			g.writeEncodeElems(w, target, t.Elem)
+		} else {
+			w.P("if err = encoder.Encode(", target, "); err != nil {")
+			w.P("return err")
+			w.P("}")
+		}
+		w.P("}")
+	case idl.TypeCOption:
+		w.P("if err = encoder.WriteUint32(btou32(", target, " != nil), binary.LE); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("if ", target, " != nil {")
+		w.P("if err = encoder.Encode(", target, "); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("} else {")
+		w.P("var tmp ", g.goType(t.Elem))
+		w.P("if err = encoder.Encode(tmp); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("}")
+	case idl.TypeRemainder:
+		w.P("if err = encoder.WriteBytes(", target, ", false); err != nil {")
+		w.P("return err")
+		w.P("}")
+	default:
+		if isDefinedElems(t) {
+			g.writeEncodeElems(w, target, t)
+			return
+		}
+		w.P("if err = encoder.Encode(&", target, "); err != nil {")
+		w.P("return err")
+		w.P("}")
+	}
+}
+
+// isDefinedElems returns true for a vector or an array of defined types
+func isDefinedElems(t *idl.Type) bool {
+	return (t.Kind == idl.TypeVec || t.Kind == idl.TypeArray) && t.Elem.Kind == idl.TypeDefined
+}
+
+// writeEncodeElems writes the encoding of a vector or an array of defined types element by element,
+// the encoder does not find the MarshalWithEncoder of the elements which are not addressed
+func (g *generator) writeEncodeElems(w *writer, target string, t *idl.Type) {
+	if t.Kind == idl.TypeVec {
+		w.P("if err = encoder.WriteUint32(uint32(len(", target, ")), binary.LE); err != nil {")
+		w.P("return err")
+		w.P("}")
+	}
+	w.P("for i := range ", target, " {")
+	w.P("if err = encoder.Encode(&", target, "[i]); err != nil {")
+	w.P("return err")
+	w.P("}")
+	w.P("}")
+}
+
+// writeDecodeField writes the decoding of `target` (e.g. `obj.Amount`)
+func (g *generator) writeDecodeField(w *writer, target string, t *idl.Type) {
+	switch t.Kind {
+	case idl.TypeOption:
+		w.P("if ok, err := decoder.ReadBool(); err != nil {")
+		w.P("return err")
+		w.P("} else if ok {")
+		w.P("if err = decoder.Decode(&", target, "); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("}")
+	case idl.TypeCOption:
+		w.P("if v, err := decoder.ReadBytes(4); err != nil {")
+		w.P("return err")
+		w.P("} else {")
+		w.P("if err = decoder.Decode(&", target, "); err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("if v[0] == 0 {")
+		w.P(target, " = nil")
+		w.P("}")
+		w.P("}")
+	case idl.TypeRemainder:
+		w.P("if ", target, ", err = decoder.ReadBytes(decoder.Remaining()); err != nil {")
+		w.P("return err")
+		w.P("}")
+	default:
+		w.P("if err = decoder.Decode(&", target, "); err != nil {")
+		w.P("return err")
+		w.P("}")
+	}
+}
+
+// writeMarshal writes MarshalWithEncoder and UnmarshalWithDecoder of a struct,
+// discriminator is the name of the discriminator variable (accounts only)
+func (g *generator) writeMarshal(w *writer, name string, fields []idl.Field, discriminator string) {
+	w.P("func (obj *", name, ") MarshalWithEncoder(encoder *binary.Encoder) (err error) {")
+	if len(discriminator) > 0 {
+		w.P("// Write account discriminator:")
+		w.P("err = encoder.WriteBytes(", discriminator, "[:], false)")
+		w.P("if err != nil {")
+		w.P("return err")
+		w.P("}")
+	}
+	for i, f := range fields {
+		g.writeEncodeField(w, "obj."+fieldName(f.Name, i), f.Type)
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+	w.P("func (obj *", name, ") UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {")
+	if len(discriminator) > 0 {
+		w.P("// Read and check account discriminator:")
+		w.P("{")
+		w.P("discriminator, err := decoder.ReadNBytes(8)")
+		w.P("if err != nil {")
+		w.P("return err")
+		w.P("}")
+		w.P("if !bytes.Equal(discriminator, ", discriminator, "[:]) {")
+		w.P("return fmt.Errorf(\"wrong discriminator: wanted %s, got %s\", fmt.Sprint(", discriminator, "[:]), fmt.Sprint(discriminator[:]))")
+		w.P("}")
+		w.P("}")
+	}
+	for i, f := range fields {
+		g.writeDecodeField(w, "obj."+fieldName(f.Name, i), f.Type)
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+}
+
+// writeStruct writes a struct with its size and its serialization
+func (g *generator) writeStruct(w *writer, name string, docs []string, fields []idl.Field) {
+	w.P("// ", name, " Struct")
+	w.Docs(docs)
+	w.P("type ", name, " struct {")
+	g.writeFields(w, fields)
+	w.P("}")
+	w.P()
+	if size, ok := g.fieldsSize(fields, 0); ok {
+		w.P("const ", idl.ToScreamingSnake(name), "_SIZE = ", strconv.Itoa(size))
+		w.P()
+	}
+	g.writeMarshal(w, name, fields, "")
+}
+
+func (g *generator) genTypes() string {
+	// anchor >= 0.30 describes the layout of accounts and events in `types`
+	skip := make(map[string]bool)
+	for _, acc := range g.idl.Accounts {
+		skip[acc.Name] = true
+	}
+	var w writer
+	for i := range g.idl.Types {
+		def := &g.idl.Types[i]
+		if skip[def.Name] || isBuiltin(def.Name) {
+			continue
+		}
+		name := idl.ToPascal(def.Name)
+		switch def.Kind {
+		case idl.TypeStruct, idl.TypeTuple:
+			g.writeStruct(&w, name, def.Docs, def.Fields)
+		case idl.TypeDefAlias:
+			w.P("// ", name, " Alias")
+			w.Docs(def.Docs)
+			w.P("type ", name, " = ", g.goType(def.Alias))
+			w.P()
+		case idl.TypeDefEnum:
+			if def.IsScalarEnum() {
+				g.writeScalarEnum(&w, name, def)
+			} else {
+				g.writeComplexEnum(&w, name, def)
+			}
+		}
+	}
+	return w.String()
+}
+
+func (g *generator) writeScalarEnum(w *writer, name string, def *idl.TypeDef) {
+	w.P("// ", name, " Enum")
+	w.Docs(def.Docs)
+	w.P("type ", name, " binary.BorshEnum")
+	w.P()
+	w.P("const (")
+	for i, v := range def.Variants {
+		w.Docs(v.Docs)
+		if i == 0 {
+			w.P(name, idl.ToPascal(v.Name), " ", name, " = iota")
+		} else {
+			w.P(name, idl.ToPascal(v.Name))
+		}
+	}
+	w.P(")")
+	w.P()
+	w.P("func (value ", name, ") String() string {")
+	w.P("switch value {")
+	for _, v := range def.Variants {
+		w.P("case ", name, idl.ToPascal(v.Name), ":")
+		w.P("return \"", idl.ToPascal(v.Name), "\"")
+	}
+	w.P("default:")
+	w.P("return \"\"")
+	w.P("}")
+	w.P("}")
+	w.P()
+}
+
+func (g *generator) writeComplexEnum(w *writer, name string, def *idl.TypeDef) {
+	w.P("// ", name, " Enum")
+	w.Docs(def.Docs)
+	w.P("type ", name, " struct {")
+	for _, v := range def.Variants {
+		variant := idl.ToPascal(v.Name)
+		if len(v.Fields) == 0 {
+			w.P(variant, " *struct{} `bin:\"optional\"`")
+		} else {
+			w.P(variant, " *", name, variant, " `bin:\"optional\"`")
+		}
+	}
+	w.P("}")
+	w.P()
+
+	for _, v := range def.Variants {
+		if len(v.Fields) == 0 {
+			continue
+		}
+		variantType := name + idl.ToPascal(v.Name)
+		w.P("type ", variantType, " struct {")
+		g.writeFields(w, v.Fields)
+		w.P("}")
+		w.P()
+		g.writeMarshal(w, variantType, v.Fields, "")
+	}
+
+	w.P("func (obj *", name, ") String() string {")
+	for _, v := range def.Variants {
+		variant := idl.ToPascal(v.Name)
+		w.P("if obj.", variant, " != nil {")
+		w.P("return \"", variant, "\"")
+		w.P("}")
+	}
+	w.P("return \"", name, "(Unknown)\"")
+	w.P("}")
+	w.P()
+
+	w.P("func (obj *", name, ") MarshalWithEncoder(encoder *binary.Encoder) (err error) {")
+	for i, v := range def.Variants {
+		variant := idl.ToPascal(v.Name)
+		w.P("if obj.", variant, " != nil {")
+		w.P("if err = encoder.WriteUint8(uint8(", fmt.Sprintf("%#x", i), ")); err != nil {")
+		w.P("return err")
+		w.P("}")
+		if len(v.Fields) > 0 {
+			w.P("if err = encoder.Encode(obj.", variant, "); err != nil {")
+			w.P("return err")
+			w.P("}")
+		}
+		w.P("return nil")
+		w.P("}")
+	}
+	w.P("return nil")
+	w.P("}")
+	w.P()
+
+	w.P("func (obj *", name, ") UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {")
+	w.P("idx, err := decoder.ReadUint8()")
+	w.P("if err != nil {")
+	w.P("return err")
+	w.P("}")
+	for i, v := range def.Variants {
+		variant := idl.ToPascal(v.Name)
+		cond := "if idx == uint8(" + fmt.Sprintf("%#x", i) + ") {"
+		if i == 0 {
+			w.P(cond)
+		} else {
+			w.P("} else ", cond)
+		}
+		if len(v.Fields) == 0 {
+			w.P("obj.", variant, " = &struct{}{}")
+		} else {
+			w.P("var tmp ", name, variant)
+			for j, f := range v.Fields {
+				g.writeDecodeField(w, "tmp."+fieldName(f.Name, j), f.Type)
+			}
+			w.P("obj.", variant, " = &tmp")
+		}
+		w.P("return nil")
+	}
+	if len(def.Variants) > 0 {
+		w.P("}")
+	}
+	w.P("return errors.New(\"UnmarshalWithDecoder failed\")")
+	w.P("}")
+	w.P()
+
+	for _, v := range def.Variants {
+		variant := idl.ToPascal(v.Name)
+		w.P("func (obj *", name, ") Is", variant, "() bool {")
+		w.P("return obj.", variant, " != nil")
+		w.P("}")
+		w.P()
+		if len(v.Fields) == 0 {
+			w.P("func New", name, "_", variant, "() ", name, " {")
+			w.P("return ", name, "{")
+			w.P(variant, ": &struct{}{},")
+			w.P("}")
+			w.P("}")
+			w.P()
+			continue
+		}
+		w.P("func (obj *", name, ") As", variant, "() ", name, variant, " {")
+		w.P("return *obj.", variant)
+		w.P("}")
+		w.P()
+		var params string
+		for j, f := range v.Fields {
+			if j > 0 {
+				params += ", "
+			}
+			params += paramName(fieldName(f.Name, j)) + " " + g.goType(f.Type)
+		}
+		w.P("func New", name, "_", variant, "(", params, ") ", name, " {")
+		w.P("return ", name, "{")
+		w.P(variant, ": &", name, variant, "{")
+		for j, f := range v.Fields {
+			w.P(fieldName(f.Name, j), ": ", paramName(fieldName(f.Name, j)), ",")
+		}
+		w.P("},")
+		w.P("}")
+		w.P("}")
+		w.P()
+	}
+}
+
+func (g *generator) genAccounts() string {
+	var w writer
+	for _, acc := range g.idl.Accounts {
+		name := idl.ToPascal(acc.Name)
+		hasDiscriminator := len(acc.Discriminator) > 0
+		w.P("// ", name, " Struct")
+		w.Docs(acc.Docs)
+		if hasDiscriminator && len(acc.DiscriminatorSeed) > 0 {
+			w.P("// DETERMINANT: ", acc.DiscriminatorSeed)
+		}
+		w.P("type ", name, " struct {")
+		g.writeFields(&w, acc.Fields)
+		w.P("}")
+		w.P()
+		if size, ok := g.fieldsSize(acc.Fields, 0); ok {
+			w.P("const ", idl.ToScreamingSnake(name), "_SIZE = ", strconv.Itoa(size))
+			w.P()
+		}
+		discriminator := ""
+		if hasDiscriminator {
+			discriminator = name + "Discriminator"
+			if len(acc.DiscriminatorSeed) > 0 {
+				w.P("// ", discriminator, " DETERMINANT: ", acc.DiscriminatorSeed)
+			} else {
+				w.P("// ", discriminator, " the leading bytes of the account data")
+			}
+			w.P("var ", discriminator, " = [", strconv.Itoa(len(acc.Discriminator)), "]byte{", byteList(acc.Discriminator), "}")
+			w.P()
+		}
+		g.writeMarshal(&w, name, acc.Fields, discriminator)
+	}
+	return w.String()
+}
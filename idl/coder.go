@@ -0,0 +1,941 @@
+package idl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/binary"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// maxDepth guards against recursive type definitions
+const maxDepth = 64
+
+// Coder decodes and encodes instructions, accounts and types of a program at runtime, without generated bindings
+type Coder struct {
+	idl *IDL
+}
+
+func NewCoder(program *IDL) *Coder {
+	return &Coder{idl: program}
+}
+
+// DecodedAccountMeta an account of a decoded instruction,
+// Name is empty for the accounts which are not described by the IDL (remaining accounts)
+type DecodedAccountMeta struct {
+	Name       string           `json:"name,omitempty"`
+	Pubkey     common.PublicKey `json:"pubkey"`
+	IsSigner   bool             `json:"isSigner"`
+	IsWritable bool             `json:"isWritable"`
+}
+
+// DecodedInstruction an instruction decoded with the IDL
+type DecodedInstruction struct {
+	Name     string               `json:"name"`
+	Args     Struct               `json:"args"`
+	Accounts []DecodedAccountMeta `json:"accounts,omitempty"`
+}
+
+// DecodedAccount an account decoded with the IDL
+type DecodedAccount struct {
+	Name string `json:"name"`
+	Data Struct `json:"data"`
+}
+
+// matchDiscriminator returns the index of the longest discriminator which prefixes data
+func matchDiscriminator(data []byte, discriminators [][]byte) int {
+	found := -1
+	for i, disc := range discriminators {
+		if len(disc) == 0 || !bytes.HasPrefix(data, disc) {
+			continue
+		}
+		if found < 0 || len(disc) > len(discriminators[found]) {
+			found = i
+		}
+	}
+	return found
+}
+
+// DecodeInstruction decodes the instruction data, the instruction is resolved by its discriminator
+// (anchor sighash, u8 or u32 index)
+func (c *Coder) DecodeInstruction(data []byte) (*DecodedInstruction, error) {
+	discriminators := make([][]byte, len(c.idl.Instructions))
+	for i, ins := range c.idl.Instructions {
+		discriminators[i] = ins.Discriminator
+	}
+	idx := matchDiscriminator(data, discriminators)
+	if idx < 0 {
+		return nil, fmt.Errorf("unknown instruction discriminator %v", data[:min(len(data), 8)])
+	}
+	ins := &c.idl.Instructions[idx]
+	args, err := c.decodeFields(binary.NewBorshDecoder(data[len(ins.Discriminator):]), ins.Args, 0)
+	if err != nil {
+		return nil, fmt.Errorf("instruction %s: %w", ins.Name, err)
+	}
+	return &DecodedInstruction{Name: ins.Name, Args: args}, nil
+}
+
+// DecodeInstructionWithAccounts decodes the instruction data and names its accounts
+func (c *Coder) DecodeInstructionWithAccounts(accounts []*common.AccountMeta, data []byte) (*DecodedInstruction, error) {
+	out, err := c.DecodeInstruction(data)
+	if err != nil {
+		return nil, err
+	}
+	ins := c.idl.FindInstruction(out.Name)
+	for i, meta := range accounts {
+		item := DecodedAccountMeta{
+			Pubkey:     meta.Pubkey,
+			IsSigner:   meta.IsSigner,
+			IsWritable: meta.IsWritable,
+		}
+		if i < len(ins.Accounts) {
+			item.Name = ins.Accounts[i].Name
+		}
+		out.Accounts = append(out.Accounts, item)
+	}
+	return out, nil
+}
+
+// EncodeInstruction encodes the discriminator and the arguments of the instruction.
+// See EncodeValue for the accepted argument values
+func (c *Coder) EncodeInstruction(name string, args map[string]interface{}) ([]byte, error) {
+	ins := c.idl.FindInstruction(name)
+	if ins == nil {
+		return nil, fmt.Errorf("unknown instruction %q", name)
+	}
+	buf := new(bytes.Buffer)
+	buf.Write(ins.Discriminator)
+	encoder := binary.NewBorshEncoder(buf)
+	for _, arg := range ins.Args {
+		value, ok := lookupField(args, arg.Name)
+		if !ok && !isNullable(arg.Type) {
+			return nil, fmt.Errorf("instruction %s: missing argument %q", name, arg.Name)
+		}
+		if err := c.encode(encoder, arg.Type, value, 0); err != nil {
+			return nil, fmt.Errorf("instruction %s argument %s: %w", name, arg.Name, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeAccount decodes the account data, the account is resolved by its discriminator
+func (c *Coder) DecodeAccount(data []byte) (*DecodedAccount, error) {
+	discriminators := make([][]byte, len(c.idl.Accounts))
+	for i, acc := range c.idl.Accounts {
+		discriminators[i] = acc.Discriminator
+	}
+	idx := matchDiscriminator(data, discriminators)
+	if idx < 0 {
+		return nil, errors.New("unknown account discriminator")
+	}
+	acc := &c.idl.Accounts[idx]
+	fields, err := c.decodeFields(binary.NewBorshDecoder(data[len(acc.Discriminator):]), acc.Fields, 0)
+	if err != nil {
+		return nil, fmt.Errorf("account %s: %w", acc.Name, err)
+	}
+	return &DecodedAccount{Name: acc.Name, Data: fields}, nil
+}
+
+// DecodeAccountAs decodes the account data as the given account,
+// used for the accounts without discriminator (e.g. shank accounts)
+func (c *Coder) DecodeAccountAs(name string, data []byte) (Struct, error) {
+	acc := c.idl.FindAccount(name)
+	if acc == nil {
+		return nil, fmt.Errorf("unknown account %q", name)
+	}
+	if len(acc.Discriminator) > 0 {
+		if !bytes.HasPrefix(data, acc.Discriminator) {
+			return nil, fmt.Errorf("account %s: wrong discriminator", name)
+		}
+		data = data[len(acc.Discriminator):]
+	}
+	return c.decodeFields(binary.NewBorshDecoder(data), acc.Fields, 0)
+}
+
+// EncodeAccount encodes the discriminator and the fields of the account
+func (c *Coder) EncodeAccount(name string, fields map[string]interface{}) ([]byte, error) {
+	acc := c.idl.FindAccount(name)
+	if acc == nil {
+		return nil, fmt.Errorf("unknown account %q", name)
+	}
+	buf := new(bytes.Buffer)
+	buf.Write(acc.Discriminator)
+	if err := c.encodeFields(binary.NewBorshEncoder(buf), acc.Fields, fields, 0); err != nil {
+		return nil, fmt.Errorf("account %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeType decodes a defined type
+func (c *Coder) DecodeType(name string, data []byte) (interface{}, error) {
+	return c.DecodeValue(&Type{Kind: TypeDefined, Name: name}, data)
+}
+
+// DecodeValue decodes a value of the given type
+func (c *Coder) DecodeValue(t *Type, data []byte) (interface{}, error) {
+	return c.decode(binary.NewBorshDecoder(data), t, 0)
+}
+
+// EncodeValue encodes a value of the given type.
+//
+// Accepted values: go numbers, json.Number and decimal strings for integers (*big.Int for 128 bits),
+// common.PublicKey or base58 strings for public keys, []byte or base64 strings for bytes,
+// slices for vec/array/tuple, Struct or map[string]interface{} for structs,
+// Enum, the variant name or {"Variant": fields} for enums, nil for an empty option.
+func (c *Coder) EncodeValue(t *Type, value interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := c.encode(binary.NewBorshEncoder(buf), t, value, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *Coder) findType(name string) (*TypeDef, error) {
+	def := c.idl.FindType(name)
+	if def == nil {
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+	return def, nil
+}
+
+func (c *Coder) decodeFields(decoder *binary.Decoder, fields []Field, depth int) (Struct, error) {
+	out := make(Struct, 0, len(fields))
+	for i, f := range fields {
+		value, err := c.decode(decoder, f.Type, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", tupleFieldName(f.Name, i), err)
+		}
+		out = append(out, StructField{Name: tupleFieldName(f.Name, i), Value: value})
+	}
+	return out, nil
+}
+
+func (c *Coder) decode(decoder *binary.Decoder, t *Type, depth int) (interface{}, error) {
+	if depth > maxDepth {
+		return nil, errors.New("type is too deep")
+	}
+	switch t.Kind {
+	case TypeBool:
+		return decoder.ReadBool()
+	case TypeU8:
+		return decoder.ReadUint8()
+	case TypeI8:
+		return decoder.ReadInt8()
+	case TypeU16:
+		return decoder.ReadUint16(binary.LE)
+	case TypeI16:
+		return decoder.ReadInt16(binary.LE)
+	case TypeU32:
+		return decoder.ReadUint32(binary.LE)
+	case TypeI32:
+		return decoder.ReadInt32(binary.LE)
+	case TypeF32:
+		return decoder.ReadFloat32(binary.LE)
+	case TypeU64:
+		return decoder.ReadUint64(binary.LE)
+	case TypeI64:
+		return decoder.ReadInt64(binary.LE)
+	case TypeF64:
+		return decoder.ReadFloat64(binary.LE)
+	case TypeU128:
+		v, err := decoder.ReadUint128(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		return v.BigInt(), nil
+	case TypeI128:
+		v, err := decoder.ReadInt128(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		return v.BigInt(), nil
+	case TypeString:
+		return decoder.ReadString()
+	case TypeBytes:
+		length, err := decoder.ReadUint32(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.ReadBytes(int(length))
+	case TypeRemainder:
+		return decoder.ReadBytes(decoder.Remaining())
+	case TypePubkey:
+		data, err := decoder.ReadBytes(32)
+		if err != nil {
+			return nil, err
+		}
+		return web3.NewPublicKeyFromBs(data), nil
+	case TypeOption:
+		ok, err := decoder.ReadBool()
+		if err != nil || !ok {
+			return nil, err
+		}
+		return c.decode(decoder, t.Elem, depth+1)
+	case TypeCOption:
+		tag, err := decoder.ReadUint32(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		// the value is always serialized
+		value, err := c.decode(decoder, t.Elem, depth+1)
+		if err != nil || tag == 0 {
+			return nil, err
+		}
+		return value, nil
+	case TypeVec, TypeArray:
+		length := t.Len
+		if t.Kind == TypeVec {
+			n, err := decoder.ReadUint32(binary.LE)
+			if err != nil {
+				return nil, err
+			}
+			length = int(n)
+		}
+		if t.Elem.Kind == TypeU8 {
+			return decoder.ReadBytes(length)
+		}
+		if length > decoder.Remaining() {
+			return nil, fmt.Errorf("invalid length %d", length)
+		}
+		out := make([]interface{}, 0, length)
+		for i := 0; i < length; i++ {
+			value, err := c.decode(decoder, t.Elem, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, value)
+		}
+		return out, nil
+	case TypeMap:
+		length, err := decoder.ReadUint32(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		if int(length) > decoder.Remaining() {
+			return nil, fmt.Errorf("invalid length %d", length)
+		}
+		out := make(map[string]interface{}, length)
+		for i := 0; i < int(length); i++ {
+			key, err := c.decode(decoder, t.Key, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := c.decode(decoder, t.Elem, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			out[fmt.Sprint(key)] = value
+		}
+		return out, nil
+	case TypeStruct, TypeTuple:
+		return c.decodeFields(decoder, t.Fields, depth)
+	case TypeDefined:
+		def, err := c.findType(t.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch def.Kind {
+		case TypeStruct, TypeTuple:
+			return c.decodeFields(decoder, def.Fields, depth)
+		case TypeDefAlias:
+			return c.decode(decoder, def.Alias, depth+1)
+		case TypeDefEnum:
+			idx, err := decoder.ReadUint8()
+			if err != nil {
+				return nil, err
+			}
+			if int(idx) >= len(def.Variants) {
+				return nil, fmt.Errorf("%s: invalid variant index %d", def.Name, idx)
+			}
+			variant := def.Variants[idx]
+			if len(variant.Fields) == 0 {
+				return Enum{Variant: variant.Name}, nil
+			}
+			fields, err := c.decodeFields(decoder, variant.Fields, depth)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", def.Name, variant.Name, err)
+			}
+			return Enum{Variant: variant.Name, Fields: fields}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", errUnsupportedType, t.Kind)
+}
+
+// lookupField returns the value of the field by its name, or by its camel/snake case name
+func lookupField(values map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := values[name]; ok {
+		return value, true
+	}
+	if value, ok := values[ToCamel(name)]; ok {
+		return value, true
+	}
+	value, ok := values[ToSnake(name)]
+	return value, ok
+}
+
+func isNullable(t *Type) bool {
+	return t.Kind == TypeOption || t.Kind == TypeCOption
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// asFields converts a struct value to a map of fields
+func asFields(value interface{}, fields []Field) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case Struct:
+		return v.Map(), nil
+	case map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		if len(v) != len(fields) {
+			return nil, fmt.Errorf("expected %d items, got %d", len(fields), len(v))
+		}
+		out := make(map[string]interface{}, len(v))
+		for i, item := range v {
+			out[tupleFieldName(fields[i].Name, i)] = item
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("expected a struct, got %T", value)
+}
+
+func (c *Coder) encodeFields(encoder *binary.Encoder, fields []Field, values map[string]interface{}, depth int) error {
+	for i, f := range fields {
+		name := tupleFieldName(f.Name, i)
+		value, ok := lookupField(values, name)
+		if !ok && !isNullable(f.Type) {
+			return fmt.Errorf("missing field %q", name)
+		}
+		if err := c.encode(encoder, f.Type, value, depth+1); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Coder) encode(encoder *binary.Encoder, t *Type, value interface{}, depth int) error {
+	if depth > maxDepth {
+		return errors.New("type is too deep")
+	}
+	switch t.Kind {
+	case TypeBool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected a bool, got %T", value)
+		}
+		return encoder.WriteBool(v)
+	case TypeU8, TypeU16, TypeU32, TypeU64:
+		v, err := toUint(value, map[TypeKind]int{TypeU8: 8, TypeU16: 16, TypeU32: 32, TypeU64: 64}[t.Kind])
+		if err != nil {
+			return err
+		}
+		switch t.Kind {
+		case TypeU8:
+			return encoder.WriteUint8(uint8(v))
+		case TypeU16:
+			return encoder.WriteUint16(uint16(v), binary.LE)
+		case TypeU32:
+			return encoder.WriteUint32(uint32(v), binary.LE)
+		default:
+			return encoder.WriteUint64(v, binary.LE)
+		}
+	case TypeI8, TypeI16, TypeI32, TypeI64:
+		v, err := toInt(value, map[TypeKind]int{TypeI8: 8, TypeI16: 16, TypeI32: 32, TypeI64: 64}[t.Kind])
+		if err != nil {
+			return err
+		}
+		switch t.Kind {
+		case TypeI8:
+			return encoder.WriteInt8(int8(v))
+		case TypeI16:
+			return encoder.WriteInt16(int16(v), binary.LE)
+		case TypeI32:
+			return encoder.WriteInt32(int32(v), binary.LE)
+		default:
+			return encoder.WriteInt64(v, binary.LE)
+		}
+	case TypeF32, TypeF64:
+		v, err := toFloat(value)
+		if err != nil {
+			return err
+		}
+		if t.Kind == TypeF32 {
+			return encoder.WriteFloat32(float32(v), binary.LE)
+		}
+		return encoder.WriteFloat64(v, binary.LE)
+	case TypeU128, TypeI128:
+		v, err := toBigInt(value)
+		if err != nil {
+			return err
+		}
+		data, err := int128Bytes(v, t.Kind == TypeI128)
+		if err != nil {
+			return err
+		}
+		return encoder.WriteBytes(data, false)
+	case TypeString:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+		return encoder.WriteString(v)
+	case TypeBytes, TypeRemainder:
+		data, err := toBytes(value)
+		if err != nil {
+			return err
+		}
+		return encoder.WriteBytes(data, t.Kind == TypeBytes)
+	case TypePubkey:
+		v, err := toPublicKey(value)
+		if err != nil {
+			return err
+		}
+		return encoder.WriteBytes(v.Bytes(), false)
+	case TypeOption:
+		if isNil(value) {
+			return encoder.WriteBool(false)
+		}
+		if err := encoder.WriteBool(true); err != nil {
+			return err
+		}
+		return c.encode(encoder, t.Elem, value, depth+1)
+	case TypeCOption:
+		if isNil(value) {
+			if err := encoder.WriteUint32(0, binary.LE); err != nil {
+				return err
+			}
+			return c.encodeZero(encoder, t.Elem, depth+1)
+		}
+		if err := encoder.WriteUint32(1, binary.LE); err != nil {
+			return err
+		}
+		return c.encode(encoder, t.Elem, value, depth+1)
+	case TypeVec, TypeArray:
+		if t.Elem.Kind == TypeU8 {
+			if data, err := toBytes(value); err == nil {
+				if t.Kind == TypeArray && len(data) != t.Len {
+					return fmt.Errorf("expected %d bytes, got %d", t.Len, len(data))
+				}
+				return encoder.WriteBytes(data, t.Kind == TypeVec)
+			}
+		}
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		if t.Kind == TypeArray && len(items) != t.Len {
+			return fmt.Errorf("expected %d items, got %d", t.Len, len(items))
+		}
+		if t.Kind == TypeVec {
+			if err := encoder.WriteUint32(uint32(len(items)), binary.LE); err != nil {
+				return err
+			}
+		}
+		for _, item := range items {
+			if err := c.encode(encoder, t.Elem, item, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TypeMap:
+		values, ok := value.(map[string]interface{})
+		if !ok && !isNil(value) {
+			return fmt.Errorf("expected a map, got %T", value)
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		if err := encoder.WriteUint32(uint32(len(keys)), binary.LE); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := c.encode(encoder, t.Key, key, depth+1); err != nil {
+				return err
+			}
+			if err := c.encode(encoder, t.Elem, values[key], depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TypeStruct, TypeTuple:
+		values, err := asFields(value, t.Fields)
+		if err != nil {
+			return err
+		}
+		return c.encodeFields(encoder, t.Fields, values, depth)
+	case TypeDefined:
+		def, err := c.findType(t.Name)
+		if err != nil {
+			return err
+		}
+		switch def.Kind {
+		case TypeStruct, TypeTuple:
+			values, err := asFields(value, def.Fields)
+			if err != nil {
+				return fmt.Errorf("%s: %w", def.Name, err)
+			}
+			return c.encodeFields(encoder, def.Fields, values, depth)
+		case TypeDefAlias:
+			return c.encode(encoder, def.Alias, value, depth+1)
+		case TypeDefEnum:
+			return c.encodeEnum(encoder, def, value, depth)
+		}
+	}
+	return fmt.Errorf("%w: %q", errUnsupportedType, t.Kind)
+}
+
+func (c *Coder) encodeEnum(encoder *binary.Encoder, def *TypeDef, value interface{}, depth int) error {
+	var name string
+	var fields interface{}
+	switch v := value.(type) {
+	case Enum:
+		name, fields = v.Variant, v.Fields
+	case string:
+		name = v
+	case map[string]interface{}:
+		if len(v) != 1 {
+			return fmt.Errorf("%s: expected a single variant, got %d", def.Name, len(v))
+		}
+		for key, item := range v {
+			name, fields = key, item
+		}
+	case Struct:
+		if len(v) != 1 {
+			return fmt.Errorf("%s: expected a single variant, got %d", def.Name, len(v))
+		}
+		name, fields = v[0].Name, v[0].Value
+	default:
+		return fmt.Errorf("%s: expected an enum, got %T", def.Name, value)
+	}
+	for idx, variant := range def.Variants {
+		if variant.Name != name && ToPascal(variant.Name) != ToPascal(name) {
+			continue
+		}
+		if err := encoder.WriteUint8(uint8(idx)); err != nil {
+			return err
+		}
+		if len(variant.Fields) == 0 {
+			return nil
+		}
+		values, err := asFields(fields, variant.Fields)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", def.Name, variant.Name, err)
+		}
+		return c.encodeFields(encoder, variant.Fields, values, depth)
+	}
+	return fmt.Errorf("%s: unknown variant %q", def.Name, name)
+}
+
+// encodeZero writes the zero value of the type, used by the empty coption
+func (c *Coder) encodeZero(encoder *binary.Encoder, t *Type, depth int) error {
+	buf := new(bytes.Buffer)
+	if err := c.zero(binary.NewBorshEncoder(buf), t, depth); err != nil {
+		return err
+	}
+	return encoder.WriteBytes(buf.Bytes(), false)
+}
+
+func (c *Coder) zero(encoder *binary.Encoder, t *Type, depth int) error {
+	if depth > maxDepth {
+		return errors.New("type is too deep")
+	}
+	size, ok := c.fixedSize(t, depth)
+	if !ok {
+		return fmt.Errorf("unable to encode the zero value of %q", t.Kind)
+	}
+	return encoder.WriteBytes(make([]byte, size), false)
+}
+
+// fixedSize returns the serialized size of a type if it is fixed
+func (c *Coder) fixedSize(t *Type, depth int) (int, bool) {
+	if depth > maxDepth {
+		return 0, false
+	}
+	switch t.Kind {
+	case TypeBool, TypeU8, TypeI8:
+		return 1, true
+	case TypeU16, TypeI16:
+		return 2, true
+	case TypeU32, TypeI32, TypeF32:
+		return 4, true
+	case TypeU64, TypeI64, TypeF64:
+		return 8, true
+	case TypeU128, TypeI128:
+		return 16, true
+	case TypePubkey:
+		return 32, true
+	case TypeCOption:
+		size, ok := c.fixedSize(t.Elem, depth+1)
+		return 4 + size, ok
+	case TypeArray:
+		size, ok := c.fixedSize(t.Elem, depth+1)
+		return size * t.Len, ok
+	case TypeStruct, TypeTuple:
+		return c.fieldsFixedSize(t.Fields, depth)
+	case TypeDefined:
+		def := c.idl.FindType(t.Name)
+		if def == nil {
+			return 0, false
+		}
+		switch def.Kind {
+		case TypeStruct, TypeTuple:
+			return c.fieldsFixedSize(def.Fields, depth)
+		case TypeDefAlias:
+			return c.fixedSize(def.Alias, depth+1)
+		}
+	}
+	return 0, false
+}
+
+func (c *Coder) fieldsFixedSize(fields []Field, depth int) (int, bool) {
+	total := 0
+	for _, f := range fields {
+		size, ok := c.fixedSize(f.Type, depth+1)
+		if !ok {
+			return 0, false
+		}
+		total += size
+	}
+	return total, true
+}
+
+func toUint(value interface{}, bits int) (uint64, error) {
+	var out uint64
+	switch v := value.(type) {
+	case uint8:
+		out = uint64(v)
+	case uint16:
+		out = uint64(v)
+	case uint32:
+		out = uint64(v)
+	case uint64:
+		out = v
+	case uint:
+		out = uint64(v)
+	case int, int8, int16, int32, int64:
+		i := reflect.ValueOf(v).Int()
+		if i < 0 {
+			return 0, fmt.Errorf("negative value %d", i)
+		}
+		out = uint64(i)
+	case float64:
+		if v < 0 || v != math.Trunc(v) || v > math.MaxUint64 {
+			return 0, fmt.Errorf("invalid integer %v", v)
+		}
+		out = uint64(v)
+	case json.Number:
+		return toUint(string(v), bits)
+	case string:
+		i, err := strconv.ParseUint(v, 0, bits)
+		if err != nil {
+			return 0, err
+		}
+		out = i
+	default:
+		return 0, fmt.Errorf("expected an integer, got %T", value)
+	}
+	if bits < 64 && out >= 1<<bits {
+		return 0, fmt.Errorf("value %d overflows u%d", out, bits)
+	}
+	return out, nil
+}
+
+func toInt(value interface{}, bits int) (int64, error) {
+	var out int64
+	switch v := value.(type) {
+	case int8:
+		out = int64(v)
+	case int16:
+		out = int64(v)
+	case int32:
+		out = int64(v)
+	case int64:
+		out = v
+	case int:
+		out = int64(v)
+	case uint, uint8, uint16, uint32, uint64:
+		u := reflect.ValueOf(v).Uint()
+		if u > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows i64", u)
+		}
+		out = int64(u)
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return 0, fmt.Errorf("invalid integer %v", v)
+		}
+		out = int64(v)
+	case json.Number:
+		return toInt(string(v), bits)
+	case string:
+		i, err := strconv.ParseInt(v, 0, bits)
+		if err != nil {
+			return 0, err
+		}
+		out = i
+	default:
+		return 0, fmt.Errorf("expected an integer, got %T", value)
+	}
+	if bits < 64 && (out >= 1<<(bits-1) || out < -(1<<(bits-1))) {
+		return 0, fmt.Errorf("value %d overflows i%d", out, bits)
+	}
+	return out, nil
+}
+
+func toFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	}
+	return 0, fmt.Errorf("expected a number, got %T", value)
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case big.Int:
+		return &v, nil
+	case json.Number:
+		return toBigInt(string(v))
+	case string:
+		out, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", v)
+		}
+		return out, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		out, _ := big.NewFloat(v).Int(nil)
+		return out, nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	}
+	return nil, fmt.Errorf("expected an integer, got %T", value)
+}
+
+// int128Bytes the little endian two's complement representation of v
+func int128Bytes(v *big.Int, signed bool) ([]byte, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 128)
+	n := new(big.Int).Set(v)
+	if signed {
+		half := new(big.Int).Rsh(limit, 1)
+		if n.Cmp(half) >= 0 || n.Cmp(new(big.Int).Neg(half)) < 0 {
+			return nil, fmt.Errorf("value %s overflows i128", v)
+		}
+		if n.Sign() < 0 {
+			n.Add(n, limit)
+		}
+	} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("value %s overflows u128", v)
+	}
+	out := make([]byte, 16)
+	n.FillBytes(out)
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, nil
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return base64.StdEncoding.DecodeString(v)
+	case []interface{}:
+		out := make([]byte, len(v))
+		for i, item := range v {
+			b, err := toUint(item, 8)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = byte(b)
+		}
+		return out, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		out := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(out), rv)
+		return out, nil
+	}
+	return nil, fmt.Errorf("expected bytes, got %T", value)
+}
+
+func toPublicKey(value interface{}) (common.PublicKey, error) {
+	switch v := value.(type) {
+	case common.PublicKey:
+		return v, nil
+	case *common.PublicKey:
+		if v != nil {
+			return *v, nil
+		}
+	case string:
+		return web3.NewPublicKey(v)
+	case [32]byte:
+		return web3.NewPublicKeyFromBs(v[:]), nil
+	case []byte:
+		if len(v) == 32 {
+			return web3.NewPublicKeyFromBs(v), nil
+		}
+	}
+	return common.PublicKey{}, fmt.Errorf("expected a public key, got %T", value)
+}
+
+func toSlice(value interface{}) ([]interface{}, error) {
+	if v, ok := value.([]interface{}); ok {
+		return v, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		if isNil(value) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("expected a list, got %T", value)
+	}
+	out := make([]interface{}, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out, nil
+}
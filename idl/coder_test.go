@@ -0,0 +1,117 @@
+package idl
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/donutnomad/solana-web3/binary"
+	"github.com/donutnomad/solana-web3/common"
+	"testing"
+)
+
+func loadCounter(t *testing.T) *Coder {
+	program, err := ParseFile("codegen/testdata/counter.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewCoder(program)
+}
+
+func TestCoderInstruction(t *testing.T) {
+	coder := loadCounter(t)
+	data, err := coder.EncodeInstruction("initialize", map[string]interface{}{
+		"start": json.Number("5"),
+		"label": "hello",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(binary.Sighash([]byte("global:initialize")), 5, 0, 0, 0, 0, 0, 0, 0, 1, 5, 0, 0, 0)
+	want = append(want, "hello"...)
+	if !bytes.Equal(data, want) {
+		t.Fatalf("unexpected data %v", data)
+	}
+
+	payer := common.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	decoded, err := coder.DecodeInstructionWithAccounts([]*common.AccountMeta{
+		common.NewAccountMeta(payer, true, true),
+		common.NewAccountMeta(payer, true, true),
+		common.NewAccountMeta(payer, false, false),
+		common.NewAccountMeta(payer, false, false),
+	}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const wantJSON = `{"name":"initialize","args":{"start":5,"label":"hello"},"accounts":[` +
+		`{"name":"counter","pubkey":"9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM","isSigner":true,"isWritable":true},` +
+		`{"name":"payer","pubkey":"9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM","isSigner":true,"isWritable":true},` +
+		`{"name":"systemProgram","pubkey":"9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM","isSigner":false,"isWritable":false},` +
+		`{"pubkey":"9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM","isSigner":false,"isWritable":false}]}`
+	if string(out) != wantJSON {
+		t.Fatalf("unexpected json %s", out)
+	}
+}
+
+func TestCoderEnum(t *testing.T) {
+	coder := loadCounter(t)
+	for _, tt := range []struct {
+		mode interface{}
+		data []byte
+		json string
+	}{
+		{"One", []byte{0}, `"One"`},
+		{map[string]interface{}{"By": []interface{}{3}}, []byte{1, 3, 0, 0, 0, 0, 0, 0, 0}, `{"By":{"0":3}}`},
+		{Enum{Variant: "Reset", Fields: Struct{{Name: "value", Value: uint64(7)}}}, []byte{2, 7, 0, 0, 0, 0, 0, 0, 0}, `{"Reset":{"value":7}}`},
+	} {
+		data, err := coder.EncodeInstruction("increment", map[string]interface{}{"mode": tt.mode})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data[8:], tt.data) {
+			t.Fatalf("unexpected data %v", data[8:])
+		}
+		decoded, err := coder.DecodeInstruction(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mode, _ := decoded.Args.Get("mode")
+		out, _ := json.Marshal(mode)
+		if string(out) != tt.json {
+			t.Fatalf("unexpected json %s", out)
+		}
+	}
+}
+
+func TestCoderAccount(t *testing.T) {
+	coder := loadCounter(t)
+	authority := common.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	data, err := coder.EncodeAccount("Counter", map[string]interface{}{
+		"authority": authority.Base58(),
+		"count":     uint64(42),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data[:8], binary.Sighash([]byte("account:Counter"))) {
+		t.Fatalf("unexpected discriminator %v", data[:8])
+	}
+	decoded, err := coder.DecodeAccount(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Name != "Counter" {
+		t.Fatalf("unexpected account %s", decoded.Name)
+	}
+	if v, _ := decoded.Data.Get("authority"); v != authority {
+		t.Fatalf("unexpected authority %v", v)
+	}
+	if v, _ := decoded.Data.Get("count"); v != uint64(42) {
+		t.Fatalf("unexpected count %v", v)
+	}
+	if _, err := coder.DecodeAccount(data[1:]); err == nil {
+		t.Fatal("expected an error for an unknown discriminator")
+	}
+}
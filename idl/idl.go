@@ -0,0 +1,243 @@
+package idl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Format the dialect of the parsed IDL file
+type Format string
+
+const (
+	FormatAnchor       Format = "anchor"        // anchor >= 0.30 (spec 0.1.0)
+	FormatAnchorLegacy Format = "anchor_legacy" // anchor < 0.30
+	FormatShank        Format = "shank"
+	FormatCodama       Format = "codama"
+)
+
+// TypeKind the kind of Type
+type TypeKind string
+
+const (
+	TypeBool    TypeKind = "bool"
+	TypeU8      TypeKind = "u8"
+	TypeI8      TypeKind = "i8"
+	TypeU16     TypeKind = "u16"
+	TypeI16     TypeKind = "i16"
+	TypeU32     TypeKind = "u32"
+	TypeI32     TypeKind = "i32"
+	TypeF32     TypeKind = "f32"
+	TypeU64     TypeKind = "u64"
+	TypeI64     TypeKind = "i64"
+	TypeF64     TypeKind = "f64"
+	TypeU128    TypeKind = "u128"
+	TypeI128    TypeKind = "i128"
+	TypeString  TypeKind = "string"
+	TypeBytes   TypeKind = "bytes" // u32 length prefixed bytes
+	TypePubkey  TypeKind = "pubkey"
+	TypeOption  TypeKind = "option"  // 1 byte tag
+	TypeCOption TypeKind = "coption" // 4 bytes tag, the value is always present
+	TypeVec     TypeKind = "vec"
+	TypeArray   TypeKind = "array"
+	TypeMap     TypeKind = "map"
+	TypeTuple   TypeKind = "tuple"
+	TypeStruct  TypeKind = "struct" // anonymous struct
+	TypeDefined TypeKind = "defined"
+	// TypeRemainder all the remaining bytes of the input, without length prefix
+	TypeRemainder TypeKind = "remainder"
+)
+
+// Type a normalized IDL type
+type Type struct {
+	Kind TypeKind
+	// Elem element type of option/coption/vec/array, value type of map
+	Elem *Type
+	// Key key type of map
+	Key *Type
+	// Len length of array
+	Len int
+	// Name name of the defined type
+	Name string
+	// Fields fields of anonymous struct and tuple
+	Fields []Field
+}
+
+// Field a named field of a struct, an instruction argument or a tuple item (Name is empty)
+type Field struct {
+	Name string
+	Docs []string
+	Type *Type
+}
+
+// InstructionAccount an account of an instruction
+type InstructionAccount struct {
+	Name     string
+	Docs     []string
+	Writable bool
+	Signer   bool
+	Optional bool
+	// Address the fixed address of the account, if any
+	Address string
+}
+
+// Instruction an instruction of the program
+type Instruction struct {
+	Name string
+	Docs []string
+	// Discriminator the leading bytes of the instruction data
+	Discriminator []byte
+	// DiscriminatorSeed the preimage of the sighash discriminator (e.g. "global:initialize"), empty if unknown
+	DiscriminatorSeed string
+	Accounts          []InstructionAccount
+	Args              []Field
+}
+
+// Account an account type owned by the program
+type Account struct {
+	Name string
+	Docs []string
+	// Discriminator the leading bytes of the account data, empty if the account has none
+	Discriminator     []byte
+	DiscriminatorSeed string
+	Fields            []Field
+}
+
+// EnumVariant a variant of an enum, Fields is empty for unit variants
+type EnumVariant struct {
+	Name   string
+	Docs   []string
+	Fields []Field
+	// Tuple the fields are unnamed
+	Tuple bool
+}
+
+// TypeDef a type defined in the IDL.
+// Exactly one of Fields (struct), Variants (enum) or Alias is meaningful, depending on Kind
+type TypeDef struct {
+	Name     string
+	Docs     []string
+	Kind     TypeKind // TypeStruct, TypeTuple, "enum" or "alias"
+	Fields   []Field
+	Variants []EnumVariant
+	Alias    *Type
+}
+
+const (
+	TypeDefEnum  TypeKind = "enum"
+	TypeDefAlias TypeKind = "alias"
+)
+
+// IsScalarEnum reports whether the type is an enum without any fields
+func (t *TypeDef) IsScalarEnum() bool {
+	if t.Kind != TypeDefEnum {
+		return false
+	}
+	for _, v := range t.Variants {
+		if len(v.Fields) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Event an anchor event
+type Event struct {
+	Name              string
+	Discriminator     []byte
+	DiscriminatorSeed string
+	Fields            []Field
+}
+
+// ErrorCode a custom error of the program
+type ErrorCode struct {
+	Code int
+	Name string
+	Msg  string
+}
+
+// IDL a normalized representation of Anchor, Shank and Codama IDL files
+type IDL struct {
+	Format       Format
+	Name         string
+	Version      string
+	Address      string
+	Docs         []string
+	Instructions []Instruction
+	Accounts     []Account
+	Types        []TypeDef
+	Events       []Event
+	Errors       []ErrorCode
+}
+
+// FindType returns the defined type by name
+func (idl *IDL) FindType(name string) *TypeDef {
+	for i := range idl.Types {
+		if idl.Types[i].Name == name {
+			return &idl.Types[i]
+		}
+	}
+	return nil
+}
+
+// FindInstruction returns the instruction by name
+func (idl *IDL) FindInstruction(name string) *Instruction {
+	for i := range idl.Instructions {
+		if idl.Instructions[i].Name == name {
+			return &idl.Instructions[i]
+		}
+	}
+	return nil
+}
+
+// FindAccount returns the account by name
+func (idl *IDL) FindAccount(name string) *Account {
+	for i := range idl.Accounts {
+		if idl.Accounts[i].Name == name {
+			return &idl.Accounts[i]
+		}
+	}
+	return nil
+}
+
+// ParseFile reads and parses an IDL file
+func ParseFile(path string) (*IDL, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse parses an Anchor (legacy and >= 0.30), Shank or Codama IDL
+func Parse(data []byte) (*IDL, error) {
+	var probe struct {
+		Kind     string          `json:"kind"`
+		Standard string          `json:"standard"`
+		Address  string          `json:"address"`
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("invalid idl: %w", err)
+	}
+	if probe.Kind == "rootNode" || probe.Standard == "codama" {
+		return parseCodama(data)
+	}
+	var metadata struct {
+		Spec    string `json:"spec"`
+		Origin  string `json:"origin"`
+		Address string `json:"address"`
+	}
+	if len(probe.Metadata) > 0 {
+		if err := json.Unmarshal(probe.Metadata, &metadata); err != nil {
+			return nil, fmt.Errorf("invalid idl metadata: %w", err)
+		}
+	}
+	if len(metadata.Spec) > 0 {
+		return parseAnchor(data)
+	}
+	return parseAnchorLegacy(data, metadata.Origin == "shank")
+}
+
+var errUnsupportedType = errors.New("unsupported idl type")
@@ -0,0 +1,55 @@
+package idl
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ToSnake converts `updateRate`, `UpdateRate` and `update_rate` to `update_rate`.
+// Digits stick to the preceding word: `initializeAccount2` -> `initialize_account2`, `ListV2` -> `list_v2`
+func ToSnake(s string) string {
+	runes := []rune(s)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				sb.WriteByte('_')
+			} else if i > 0 && i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsLower(runes[i+1]) {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		if r == '-' || r == ' ' {
+			r = '_'
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// ToPascal converts `update_rate` and `updateRate` to `UpdateRate`
+func ToPascal(s string) string {
+	var sb strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
+
+// ToCamel converts `update_rate` and `UpdateRate` to `updateRate`
+func ToCamel(s string) string {
+	runes := []rune(ToPascal(s))
+	if len(runes) == 0 {
+		return ""
+	}
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// ToScreamingSnake converts `InterestBearingConfig` to `INTEREST_BEARING_CONFIG`
+func ToScreamingSnake(s string) string {
+	return strings.ToUpper(ToSnake(s))
+}
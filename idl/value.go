@@ -0,0 +1,77 @@
+package idl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// StructField a decoded field
+type StructField struct {
+	Name  string
+	Value interface{}
+}
+
+// Struct a decoded struct, the fields keep the order of the IDL.
+// It is encoded to a JSON object.
+type Struct []StructField
+
+// Get returns the value of the field
+func (s Struct) Get(name string) (interface{}, bool) {
+	for _, f := range s {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Map returns the fields as a map, the order is lost
+func (s Struct) Map() map[string]interface{} {
+	out := make(map[string]interface{}, len(s))
+	for _, f := range s {
+		out[f.Name] = f.Value
+	}
+	return out
+}
+
+func (s Struct) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range s {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(f.Name))
+		buf.WriteByte(':')
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Enum a decoded enum value, Fields is nil for unit variants.
+// Unit variants are encoded to a JSON string, the others to {"Variant": {...fields}}
+type Enum struct {
+	Variant string
+	Fields  Struct
+}
+
+func (e Enum) MarshalJSON() ([]byte, error) {
+	if e.Fields == nil {
+		return json.Marshal(e.Variant)
+	}
+	return json.Marshal(Struct{{Name: e.Variant, Value: e.Fields}})
+}
+
+// tupleFieldName the name of an unnamed field of a tuple or of a tuple variant
+func tupleFieldName(name string, idx int) string {
+	if len(name) == 0 {
+		return strconv.Itoa(idx)
+	}
+	return name
+}
@@ -2,10 +2,19 @@ package irys
 
 import (
 	"context"
+	"encoding/json"
+	offchain "github.com/donutnomad/solana-web3/offchain_metadata"
 	"github.com/donutnomad/solana-web3/web3"
+	"slices"
 	"strings"
 )
 
+// UploadLogoAndMetadata uploads the logo and the JSON metadata of a token, the additional keys are written along with the standard keys.
+// The additional standard keys (such as image, external_url or seller_fee_basis_points) fill the fields of the metadata,
+// the non-empty name, symbol and description and the uploaded logo prevail.
+// The attributes, properties and collection keys hold JSON, a value which does not fit is uploaded as a string.
+// The metadata is not validated, an empty name is uploaded as is, see UploadMetadata.
+// Returns the uri of the metadata
 func UploadLogoAndMetadata(
 	ctx context.Context,
 	connection *web3.Connection,
@@ -18,23 +27,93 @@ func UploadLogoAndMetadata(
 	additional map[string]string,
 	logo []byte,
 ) (string, error) {
-	var image string
-	if len(logo) > 0 {
-		response, err := node.UploadImage(ctx, connection, signer, logo)
+	metadata, err := logoMetadata(name, symbol, description, additional)
+	if err != nil {
+		return "", err
+	}
+	return uploadMetadata(ctx, connection, node, signer, gateway, *metadata, logo, false)
+}
+
+// UploadMetadata uploads the image and the JSON metadata, returns the uri of the metadata.
+// The uploaded image is set as the image of the metadata and added to its files,
+// the metadata is validated before the upload, see offchain_metadata.Metadata.Validate
+func UploadMetadata(
+	ctx context.Context,
+	connection *web3.Connection,
+	node *IrysNode,
+	signer web3.Signer,
+	gateway string,
+	metadata offchain.Metadata,
+	image []byte,
+) (string, error) {
+	return uploadMetadata(ctx, connection, node, signer, gateway, metadata, image, true)
+}
+
+// structuredKeys the standard keys whose additional value is read as JSON, an object or an array
+var structuredKeys = []string{"attributes", "properties", "collection"}
+
+// logoMetadata the metadata of UploadLogoAndMetadata, read as a JSON document of the additional keys and the standard keys
+func logoMetadata(name, symbol, description string, additional map[string]string) (*offchain.Metadata, error) {
+	var fields = make(map[string]json.RawMessage)
+	for key, value := range additional {
+		if slices.Contains(structuredKeys, key) && json.Valid([]byte(value)) {
+			fields[key] = json.RawMessage(value)
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = raw
+	}
+	for key, value := range map[string]string{"name": name, "symbol": symbol, "description": description} {
+		if _, ok := fields[key]; ok && value == "" {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = raw
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return offchain.Parse(data)
+}
+
+func uploadMetadata(
+	ctx context.Context,
+	connection *web3.Connection,
+	node *IrysNode,
+	signer web3.Signer,
+	gateway string,
+	metadata offchain.Metadata,
+	image []byte,
+	validate bool,
+) (string, error) {
+	if len(image) > 0 {
+		response, err := node.UploadImage(ctx, connection, signer, image)
 		if err != nil {
 			return "", err
 		}
-		image = joinPath(gateway, response.Id)
+		format, _ := sniffImageFormat(image)
+		metadata.Image = joinPath(gateway, response.Id)
+		var properties offchain.Properties
+		if metadata.Properties != nil {
+			properties = *metadata.Properties
+		}
+		properties.Files = append([]offchain.File{{Uri: metadata.Image, Type: "image/" + format}}, properties.Files...)
+		if properties.Category == "" {
+			properties.Category = offchain.CategoryImage
+		}
+		metadata.Properties = &properties
 	}
-	var metadata = make(map[string]string)
-	{
-		for key, value := range additional {
-			metadata[key] = value
+	if validate {
+		if err := metadata.Validate(); err != nil {
+			return "", err
 		}
-		metadata["name"] = name
-		metadata["symbol"] = symbol
-		metadata["description"] = description
-		metadata["image"] = image
 	}
 	response, err := node.UploadJson(ctx, connection, signer, metadata)
 	if err != nil {
@@ -68,3 +68,29 @@ func TestImage(t *testing.T) {
 	t.Logf("Result: %s", spew.Sdump(metadata))
 	t.Logf("Url: https://arweave.net/%s", metadata.Id)
 }
+
+func TestLogoMetadata(t *testing.T) {
+	metadata := Must1(logoMetadata("Token", "TKN", "", map[string]string{
+		"name":                    "ignored",
+		"description":             "the description",
+		"image":                   "https://example.com/logo.png",
+		"external_url":            "https://example.com",
+		"seller_fee_basis_points": "500",
+		"attributes":              `[{"trait_type":"level","value":7}]`,
+		"twitter":                 "@token",
+	}))
+	if metadata.Name != "Token" || metadata.Symbol != "TKN" || metadata.Description != "the description" ||
+		metadata.Image != "https://example.com/logo.png" || metadata.ExternalUrl != "https://example.com" ||
+		metadata.SellerFeeBasisPoints == nil || *metadata.SellerFeeBasisPoints != 500 ||
+		metadata.Attributes.Get("level") == nil {
+		t.Fatalf("unexpected metadata %+v", metadata)
+	}
+	if string(metadata.Extra["twitter"]) != `"@token"` {
+		t.Fatalf("unexpected extra %v", metadata.Extra)
+	}
+	// a collection which is not JSON is kept as a string
+	metadata = Must1(logoMetadata("Token", "TKN", "", map[string]string{"collection": "not json"}))
+	if metadata.Collection != nil || string(metadata.Extra["collection"]) != `"not json"` {
+		t.Fatalf("unexpected metadata %+v", metadata)
+	}
+}
@@ -0,0 +1,86 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_bubblegum
+
+import (
+	"bytes"
+	"fmt"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// TreeConfig Struct
+// DETERMINANT: account:TreeConfig
+type TreeConfig struct {
+	TreeCreator       common.PublicKey
+	TreeDelegate      common.PublicKey
+	TotalMintCapacity uint64
+	NumMinted         uint64
+	IsPublic          bool
+	IsDecompressible  DecompressibleState
+}
+
+const TREE_CONFIG_SIZE = 82
+
+// TreeConfigDiscriminator DETERMINANT: account:TreeConfig
+var TreeConfigDiscriminator = [8]byte{122, 245, 175, 248, 171, 34, 0, 207}
+
+func (obj *TreeConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	// Write account discriminator:
+	err = encoder.WriteBytes(TreeConfigDiscriminator[:], false)
+	if err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.TreeCreator); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.TreeDelegate); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.TotalMintCapacity); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NumMinted); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.IsPublic); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.IsDecompressible); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *TreeConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	// Read and check account discriminator:
+	{
+		discriminator, err := decoder.ReadNBytes(8)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(discriminator, TreeConfigDiscriminator[:]) {
+			return fmt.Errorf("wrong discriminator: wanted %s, got %s", fmt.Sprint(TreeConfigDiscriminator[:]), fmt.Sprint(discriminator[:]))
+		}
+	}
+	if err = decoder.Decode(&obj.TreeCreator); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.TreeDelegate); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.TotalMintCapacity); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NumMinted); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.IsPublic); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.IsDecompressible); err != nil {
+		return err
+	}
+	return nil
+}
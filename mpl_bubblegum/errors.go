@@ -0,0 +1,347 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_bubblegum
+
+type ProgramError interface {
+	Code() int
+	Error() string
+}
+
+var codeToErrorMap = make(map[int]ProgramError)
+var nameToErrorMap = make(map[string]ProgramError)
+
+func init() {
+	codeToErrorMap[6000] = new(AssetOwnerMismatchError)
+	nameToErrorMap["AssetOwnerMismatch"] = new(AssetOwnerMismatchError)
+	codeToErrorMap[6001] = new(PublicKeyMismatchError)
+	nameToErrorMap["PublicKeyMismatch"] = new(PublicKeyMismatchError)
+	codeToErrorMap[6002] = new(HashingMismatchError)
+	nameToErrorMap["HashingMismatch"] = new(HashingMismatchError)
+	codeToErrorMap[6003] = new(UnsupportedSchemaVersionError)
+	nameToErrorMap["UnsupportedSchemaVersion"] = new(UnsupportedSchemaVersionError)
+	codeToErrorMap[6004] = new(CreatorShareTotalMustBe100Error)
+	nameToErrorMap["CreatorShareTotalMustBe100"] = new(CreatorShareTotalMustBe100Error)
+	codeToErrorMap[6005] = new(DuplicateCreatorAddressError)
+	nameToErrorMap["DuplicateCreatorAddress"] = new(DuplicateCreatorAddressError)
+	codeToErrorMap[6006] = new(CreatorDidNotVerifyError)
+	nameToErrorMap["CreatorDidNotVerify"] = new(CreatorDidNotVerifyError)
+	codeToErrorMap[6007] = new(CreatorNotFoundError)
+	nameToErrorMap["CreatorNotFound"] = new(CreatorNotFoundError)
+	codeToErrorMap[6008] = new(NoCreatorsPresentError)
+	nameToErrorMap["NoCreatorsPresent"] = new(NoCreatorsPresentError)
+	codeToErrorMap[6009] = new(CreatorHashMismatchError)
+	nameToErrorMap["CreatorHashMismatch"] = new(CreatorHashMismatchError)
+	codeToErrorMap[6010] = new(DataHashMismatchError)
+	nameToErrorMap["DataHashMismatch"] = new(DataHashMismatchError)
+	codeToErrorMap[6011] = new(CreatorsTooLongError)
+	nameToErrorMap["CreatorsTooLong"] = new(CreatorsTooLongError)
+	codeToErrorMap[6012] = new(MetadataNameTooLongError)
+	nameToErrorMap["MetadataNameTooLong"] = new(MetadataNameTooLongError)
+	codeToErrorMap[6013] = new(MetadataSymbolTooLongError)
+	nameToErrorMap["MetadataSymbolTooLong"] = new(MetadataSymbolTooLongError)
+	codeToErrorMap[6014] = new(MetadataUriTooLongError)
+	nameToErrorMap["MetadataUriTooLong"] = new(MetadataUriTooLongError)
+	codeToErrorMap[6015] = new(MetadataBasisPointsTooHighError)
+	nameToErrorMap["MetadataBasisPointsTooHigh"] = new(MetadataBasisPointsTooHighError)
+	codeToErrorMap[6016] = new(TreeAuthorityIncorrectError)
+	nameToErrorMap["TreeAuthorityIncorrect"] = new(TreeAuthorityIncorrectError)
+	codeToErrorMap[6017] = new(InsufficientMintCapacityError)
+	nameToErrorMap["InsufficientMintCapacity"] = new(InsufficientMintCapacityError)
+	codeToErrorMap[6018] = new(NumericalOverflowErrorError)
+	nameToErrorMap["NumericalOverflowError"] = new(NumericalOverflowErrorError)
+	codeToErrorMap[6019] = new(IncorrectOwnerError)
+	nameToErrorMap["IncorrectOwner"] = new(IncorrectOwnerError)
+	codeToErrorMap[6020] = new(CollectionCannotBeVerifiedInThisInstructionError)
+	nameToErrorMap["CollectionCannotBeVerifiedInThisInstruction"] = new(CollectionCannotBeVerifiedInThisInstructionError)
+	codeToErrorMap[6021] = new(CollectionNotFoundError)
+	nameToErrorMap["CollectionNotFound"] = new(CollectionNotFoundError)
+	codeToErrorMap[6022] = new(AlreadyVerifiedError)
+	nameToErrorMap["AlreadyVerified"] = new(AlreadyVerifiedError)
+	codeToErrorMap[6023] = new(AlreadyUnverifiedError)
+	nameToErrorMap["AlreadyUnverified"] = new(AlreadyUnverifiedError)
+	codeToErrorMap[6024] = new(UpdateAuthorityIncorrectError)
+	nameToErrorMap["UpdateAuthorityIncorrect"] = new(UpdateAuthorityIncorrectError)
+	codeToErrorMap[6025] = new(LeafAuthorityMustSignError)
+	nameToErrorMap["LeafAuthorityMustSign"] = new(LeafAuthorityMustSignError)
+	codeToErrorMap[6026] = new(CollectionMustBeSizedError)
+	nameToErrorMap["CollectionMustBeSized"] = new(CollectionMustBeSizedError)
+}
+
+func GetMplBubblegumErrorFromCode(code int) ProgramError {
+	return codeToErrorMap[code]
+}
+
+func GetMplBubblegumErrorFromName(name string) ProgramError {
+	return nameToErrorMap[name]
+}
+
+// AssetOwnerMismatchError Error: 6000 `Asset Owner Does not match`
+type AssetOwnerMismatchError struct{}
+
+func (e AssetOwnerMismatchError) Code() int {
+	return 6000
+}
+func (e AssetOwnerMismatchError) Error() string {
+	return "Asset Owner Does not match"
+}
+
+// PublicKeyMismatchError Error: 6001 `PublicKeyMismatch`
+type PublicKeyMismatchError struct{}
+
+func (e PublicKeyMismatchError) Code() int {
+	return 6001
+}
+func (e PublicKeyMismatchError) Error() string {
+	return "PublicKeyMismatch"
+}
+
+// HashingMismatchError Error: 6002 `Hashing Mismatch Within Leaf Schema`
+type HashingMismatchError struct{}
+
+func (e HashingMismatchError) Code() int {
+	return 6002
+}
+func (e HashingMismatchError) Error() string {
+	return "Hashing Mismatch Within Leaf Schema"
+}
+
+// UnsupportedSchemaVersionError Error: 6003 `Unsupported Schema Version`
+type UnsupportedSchemaVersionError struct{}
+
+func (e UnsupportedSchemaVersionError) Code() int {
+	return 6003
+}
+func (e UnsupportedSchemaVersionError) Error() string {
+	return "Unsupported Schema Version"
+}
+
+// CreatorShareTotalMustBe100Error Error: 6004 `Creator shares must sum to 100`
+type CreatorShareTotalMustBe100Error struct{}
+
+func (e CreatorShareTotalMustBe100Error) Code() int {
+	return 6004
+}
+func (e CreatorShareTotalMustBe100Error) Error() string {
+	return "Creator shares must sum to 100"
+}
+
+// DuplicateCreatorAddressError Error: 6005 `No duplicate creator addresses in metadata`
+type DuplicateCreatorAddressError struct{}
+
+func (e DuplicateCreatorAddressError) Code() int {
+	return 6005
+}
+func (e DuplicateCreatorAddressError) Error() string {
+	return "No duplicate creator addresses in metadata"
+}
+
+// CreatorDidNotVerifyError Error: 6006 `Creator did not verify the metadata`
+type CreatorDidNotVerifyError struct{}
+
+func (e CreatorDidNotVerifyError) Code() int {
+	return 6006
+}
+func (e CreatorDidNotVerifyError) Error() string {
+	return "Creator did not verify the metadata"
+}
+
+// CreatorNotFoundError Error: 6007 `Creator not found in creator Vec`
+type CreatorNotFoundError struct{}
+
+func (e CreatorNotFoundError) Code() int {
+	return 6007
+}
+func (e CreatorNotFoundError) Error() string {
+	return "Creator not found in creator Vec"
+}
+
+// NoCreatorsPresentError Error: 6008 `No creators in creator Vec`
+type NoCreatorsPresentError struct{}
+
+func (e NoCreatorsPresentError) Code() int {
+	return 6008
+}
+func (e NoCreatorsPresentError) Error() string {
+	return "No creators in creator Vec"
+}
+
+// CreatorHashMismatchError Error: 6009 `User-provided creator Vec must result in same user-provided creator hash`
+type CreatorHashMismatchError struct{}
+
+func (e CreatorHashMismatchError) Code() int {
+	return 6009
+}
+func (e CreatorHashMismatchError) Error() string {
+	return "User-provided creator Vec must result in same user-provided creator hash"
+}
+
+// DataHashMismatchError Error: 6010 `User-provided metadata must result in same user-provided data hash`
+type DataHashMismatchError struct{}
+
+func (e DataHashMismatchError) Code() int {
+	return 6010
+}
+func (e DataHashMismatchError) Error() string {
+	return "User-provided metadata must result in same user-provided data hash"
+}
+
+// CreatorsTooLongError Error: 6011 `Creators list too long`
+type CreatorsTooLongError struct{}
+
+func (e CreatorsTooLongError) Code() int {
+	return 6011
+}
+func (e CreatorsTooLongError) Error() string {
+	return "Creators list too long"
+}
+
+// MetadataNameTooLongError Error: 6012 `Name in metadata is too long`
+type MetadataNameTooLongError struct{}
+
+func (e MetadataNameTooLongError) Code() int {
+	return 6012
+}
+func (e MetadataNameTooLongError) Error() string {
+	return "Name in metadata is too long"
+}
+
+// MetadataSymbolTooLongError Error: 6013 `Symbol in metadata is too long`
+type MetadataSymbolTooLongError struct{}
+
+func (e MetadataSymbolTooLongError) Code() int {
+	return 6013
+}
+func (e MetadataSymbolTooLongError) Error() string {
+	return "Symbol in metadata is too long"
+}
+
+// MetadataUriTooLongError Error: 6014 `Uri in metadata is too long`
+type MetadataUriTooLongError struct{}
+
+func (e MetadataUriTooLongError) Code() int {
+	return 6014
+}
+func (e MetadataUriTooLongError) Error() string {
+	return "Uri in metadata is too long"
+}
+
+// MetadataBasisPointsTooHighError Error: 6015 `Basis points in metadata cannot exceed 10000`
+type MetadataBasisPointsTooHighError struct{}
+
+func (e MetadataBasisPointsTooHighError) Code() int {
+	return 6015
+}
+func (e MetadataBasisPointsTooHighError) Error() string {
+	return "Basis points in metadata cannot exceed 10000"
+}
+
+// TreeAuthorityIncorrectError Error: 6016 `Tree creator or tree delegate must sign`
+type TreeAuthorityIncorrectError struct{}
+
+func (e TreeAuthorityIncorrectError) Code() int {
+	return 6016
+}
+func (e TreeAuthorityIncorrectError) Error() string {
+	return "Tree creator or tree delegate must sign"
+}
+
+// InsufficientMintCapacityError Error: 6017 `Not enough unapproved mints left`
+type InsufficientMintCapacityError struct{}
+
+func (e InsufficientMintCapacityError) Code() int {
+	return 6017
+}
+func (e InsufficientMintCapacityError) Error() string {
+	return "Not enough unapproved mints left"
+}
+
+// NumericalOverflowErrorError Error: 6018 `NumericalOverflowError`
+type NumericalOverflowErrorError struct{}
+
+func (e NumericalOverflowErrorError) Code() int {
+	return 6018
+}
+func (e NumericalOverflowErrorError) Error() string {
+	return "NumericalOverflowError"
+}
+
+// IncorrectOwnerError Error: 6019 `Incorrect account owner`
+type IncorrectOwnerError struct{}
+
+func (e IncorrectOwnerError) Code() int {
+	return 6019
+}
+func (e IncorrectOwnerError) Error() string {
+	return "Incorrect account owner"
+}
+
+// CollectionCannotBeVerifiedInThisInstructionError Error: 6020 `Cannot Verify Collection in this Instruction`
+type CollectionCannotBeVerifiedInThisInstructionError struct{}
+
+func (e CollectionCannotBeVerifiedInThisInstructionError) Code() int {
+	return 6020
+}
+func (e CollectionCannotBeVerifiedInThisInstructionError) Error() string {
+	return "Cannot Verify Collection in this Instruction"
+}
+
+// CollectionNotFoundError Error: 6021 `Collection Not Found on Metadata`
+type CollectionNotFoundError struct{}
+
+func (e CollectionNotFoundError) Code() int {
+	return 6021
+}
+func (e CollectionNotFoundError) Error() string {
+	return "Collection Not Found on Metadata"
+}
+
+// AlreadyVerifiedError Error: 6022 `Collection item is already verified.`
+type AlreadyVerifiedError struct{}
+
+func (e AlreadyVerifiedError) Code() int {
+	return 6022
+}
+func (e AlreadyVerifiedError) Error() string {
+	return "Collection item is already verified."
+}
+
+// AlreadyUnverifiedError Error: 6023 `Collection item is already unverified.`
+type AlreadyUnverifiedError struct{}
+
+func (e AlreadyUnverifiedError) Code() int {
+	return 6023
+}
+func (e AlreadyUnverifiedError) Error() string {
+	return "Collection item is already unverified."
+}
+
+// UpdateAuthorityIncorrectError Error: 6024 `Incorrect leaf metadata update authority.`
+type UpdateAuthorityIncorrectError struct{}
+
+func (e UpdateAuthorityIncorrectError) Code() int {
+	return 6024
+}
+func (e UpdateAuthorityIncorrectError) Error() string {
+	return "Incorrect leaf metadata update authority."
+}
+
+// LeafAuthorityMustSignError Error: 6025 `This transaction must be signed by either the leaf owner or leaf delegate`
+type LeafAuthorityMustSignError struct{}
+
+func (e LeafAuthorityMustSignError) Code() int {
+	return 6025
+}
+func (e LeafAuthorityMustSignError) Error() string {
+	return "This transaction must be signed by either the leaf owner or leaf delegate"
+}
+
+// CollectionMustBeSizedError Error: 6026 `Collection Not Compatable with Compression, Must be Sized`
+type CollectionMustBeSizedError struct{}
+
+func (e CollectionMustBeSizedError) Code() int {
+	return 6026
+}
+func (e CollectionMustBeSizedError) Error() string {
+	return "Collection Not Compatable with Compression, Must be Sized"
+}
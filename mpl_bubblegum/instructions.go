@@ -0,0 +1,2355 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_bubblegum
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// CreateTree Instruction
+// Creates the tree config of a new merkle tree and initializes the empty tree
+type CreateTree struct {
+	MaxDepth      *uint32
+	MaxBufferSize *uint32
+	Public        *bool `bin:"optional"`
+	// [0] = [WRITE] treeAuthority `Tree config PDA`
+	// [1] = [WRITE] merkleTree `Merkle tree account allocated by the caller`
+	// [2] = [WRITE, SIGNER] payer ``
+	// [3] = [SIGNER] treeCreator ``
+	// [4] = [] logWrapper `Noop program`
+	// [5] = [] compressionProgram ``
+	// [6] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewCreateTreeInstructionBuilder creates a new `CreateTree` instruction builder.
+func NewCreateTreeInstructionBuilder() *CreateTree {
+	return &CreateTree{
+		AccountMetaSlice: make(common.AccountMetaSlice, 7),
+	}
+}
+
+// NewCreateTreeInstruction
+//
+// Parameters:
+//
+//	maxDepth:
+//	maxBufferSize:
+//	public:
+//	treeAuthority: Tree config PDA
+//	merkleTree: Merkle tree account allocated by the caller
+//	payer:
+//	treeCreator:
+//	logWrapper: Noop program
+//	compressionProgram:
+//	systemProgram:
+func NewCreateTreeInstruction(
+	maxDepth uint32,
+	maxBufferSize uint32,
+	// optional,
+	public *bool,
+	treeAuthority common.PublicKey,
+	merkleTree common.PublicKey,
+	payer common.PublicKey,
+	treeCreator common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *CreateTree {
+	return NewCreateTreeInstructionBuilder().
+		SetMaxDepth(maxDepth).
+		SetMaxBufferSize(maxBufferSize).
+		SetPublic(public).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetMerkleTreeAccount(merkleTree).
+		SetPayerAccount(payer).
+		SetTreeCreatorAccount(treeCreator).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetMaxDepth sets the "maxDepth" parameter.
+func (obj *CreateTree) SetMaxDepth(maxDepth uint32) *CreateTree {
+	obj.MaxDepth = &maxDepth
+	return obj
+}
+
+// SetMaxBufferSize sets the "maxBufferSize" parameter.
+func (obj *CreateTree) SetMaxBufferSize(maxBufferSize uint32) *CreateTree {
+	obj.MaxBufferSize = &maxBufferSize
+	return obj
+}
+
+// SetPublic sets the "public" parameter.
+func (obj *CreateTree) SetPublic(public *bool) *CreateTree {
+	obj.Public = public
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+// Tree config PDA
+func (obj *CreateTree) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority).WRITE()
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+// Tree config PDA
+func (obj *CreateTree) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+// Merkle tree account allocated by the caller
+func (obj *CreateTree) SetMerkleTreeAccount(merkleTree common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[1] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+// Merkle tree account allocated by the caller
+func (obj *CreateTree) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+func (obj *CreateTree) SetPayerAccount(payer common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+func (obj *CreateTree) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetTreeCreatorAccount sets the "treeCreator" parameter.
+func (obj *CreateTree) SetTreeCreatorAccount(treeCreator common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[3] = common.Meta(treeCreator).SIGNER()
+	return obj
+}
+
+// GetTreeCreatorAccount gets the "treeCreator" parameter.
+func (obj *CreateTree) GetTreeCreatorAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// Noop program
+func (obj *CreateTree) SetLogWrapperAccount(logWrapper common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// Noop program
+func (obj *CreateTree) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *CreateTree) SetCompressionProgramAccount(compressionProgram common.PublicKey) *CreateTree {
+	obj.AccountMetaSlice[5] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *CreateTree) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *CreateTree) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *CreateTree {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[6] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[6] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *CreateTree) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+func (obj *CreateTree) SetProgramId(programId *common.PublicKey) *CreateTree {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *CreateTree) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_CreateTree[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *CreateTree) Validate() error {
+	if obj.MaxDepth == nil {
+		return errors.New("[CreateTree] maxDepth param is not set")
+	}
+	if obj.MaxBufferSize == nil {
+		return errors.New("[CreateTree] maxBufferSize param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[CreateTree] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[CreateTree] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[CreateTree] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[CreateTree] accounts.treeCreator is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[CreateTree] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[CreateTree] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[CreateTree] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *CreateTree) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *CreateTree) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.MaxDepth); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.MaxBufferSize); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.Public != nil); err != nil {
+		return err
+	}
+	if obj.Public != nil {
+		if err = encoder.Encode(obj.Public); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *CreateTree) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.MaxDepth); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.MaxBufferSize); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Public); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *CreateTree) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("CreateTree")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=3]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("     MaxDepth", *obj.MaxDepth))
+						paramsBranch.Child(format.Param("MaxBufferSize", *obj.MaxBufferSize))
+						paramsBranch.Child(format.Param("       Public (OPT)", obj.Public))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        merkleTree", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("             payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("       treeCreator", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("compressionProgram", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+// MintV1 Instruction
+// Mints a compressed NFT
+type MintV1 struct {
+	Message *MetadataArgs
+	// [0] = [WRITE] treeAuthority ``
+	// [1] = [] leafOwner ``
+	// [2] = [] leafDelegate ``
+	// [3] = [WRITE] merkleTree ``
+	// [4] = [SIGNER] payer ``
+	// [5] = [SIGNER] treeDelegate `Tree creator or tree delegate, any signer for a public tree`
+	// [6] = [] logWrapper ``
+	// [7] = [] compressionProgram ``
+	// [8] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewMintV1InstructionBuilder creates a new `MintV1` instruction builder.
+func NewMintV1InstructionBuilder() *MintV1 {
+	return &MintV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 9),
+	}
+}
+
+// NewMintV1Instruction
+//
+// Parameters:
+//
+//	message:
+//	treeAuthority:
+//	leafOwner:
+//	leafDelegate:
+//	merkleTree:
+//	payer:
+//	treeDelegate: Tree creator or tree delegate, any signer for a public tree
+//	logWrapper:
+//	compressionProgram:
+//	systemProgram:
+func NewMintV1Instruction(
+	message MetadataArgs,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	leafDelegate common.PublicKey,
+	merkleTree common.PublicKey,
+	payer common.PublicKey,
+	treeDelegate common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *MintV1 {
+	return NewMintV1InstructionBuilder().
+		SetMessage(message).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetLeafDelegateAccount(leafDelegate).
+		SetMerkleTreeAccount(merkleTree).
+		SetPayerAccount(payer).
+		SetTreeDelegateAccount(treeDelegate).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetMessage sets the "message" parameter.
+func (obj *MintV1) SetMessage(message MetadataArgs) *MintV1 {
+	obj.Message = &message
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *MintV1) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority).WRITE()
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *MintV1) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *MintV1) SetLeafOwnerAccount(leafOwner common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner)
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *MintV1) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetLeafDelegateAccount sets the "leafDelegate" parameter.
+func (obj *MintV1) SetLeafDelegateAccount(leafDelegate common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[2] = common.Meta(leafDelegate)
+	return obj
+}
+
+// GetLeafDelegateAccount gets the "leafDelegate" parameter.
+func (obj *MintV1) GetLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *MintV1) SetMerkleTreeAccount(merkleTree common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[3] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *MintV1) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+func (obj *MintV1) SetPayerAccount(payer common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[4] = common.Meta(payer).SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+func (obj *MintV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetTreeDelegateAccount sets the "treeDelegate" parameter.
+// Tree creator or tree delegate, any signer for a public tree
+func (obj *MintV1) SetTreeDelegateAccount(treeDelegate common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[5] = common.Meta(treeDelegate).SIGNER()
+	return obj
+}
+
+// GetTreeDelegateAccount gets the "treeDelegate" parameter.
+// Tree creator or tree delegate, any signer for a public tree
+func (obj *MintV1) GetTreeDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *MintV1) SetLogWrapperAccount(logWrapper common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[6] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *MintV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *MintV1) SetCompressionProgramAccount(compressionProgram common.PublicKey) *MintV1 {
+	obj.AccountMetaSlice[7] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *MintV1) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *MintV1) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *MintV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[8] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[8] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *MintV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(8)
+}
+
+func (obj *MintV1) SetProgramId(programId *common.PublicKey) *MintV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *MintV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_MintV1[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *MintV1) Validate() error {
+	if obj.Message == nil {
+		return errors.New("[MintV1] message param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[MintV1] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[MintV1] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[MintV1] accounts.leafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[MintV1] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[MintV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[MintV1] accounts.treeDelegate is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[MintV1] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[MintV1] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[8] == nil {
+		return errors.New("[MintV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *MintV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *MintV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *MintV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *MintV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("MintV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Message", *obj.Message))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=9]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("         leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      leafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("        merkleTree", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("             payer", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("      treeDelegate", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("compressionProgram", obj.AccountMetaSlice.Get(7)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(8)))
+					})
+				})
+		})
+}
+
+// MintToCollectionV1 Instruction
+// Mints a compressed NFT verified in a sized collection
+type MintToCollectionV1 struct {
+	MetadataArgs *MetadataArgs
+	// [0] = [WRITE] treeAuthority ``
+	// [1] = [] leafOwner ``
+	// [2] = [] leafDelegate ``
+	// [3] = [WRITE] merkleTree ``
+	// [4] = [SIGNER] payer ``
+	// [5] = [SIGNER] treeDelegate ``
+	// [6] = [SIGNER] collectionAuthority ``
+	// [7] = [] collectionAuthorityRecordPda `Collection authority record, or the bubblegum program if the authority is the update authority`
+	// [8] = [] collectionMint ``
+	// [9] = [WRITE] collectionMetadata ``
+	// [10] = [] editionAccount ``
+	// [11] = [] bubblegumSigner `PDA ["collection_cpi"]`
+	// [12] = [] logWrapper ``
+	// [13] = [] compressionProgram ``
+	// [14] = [] tokenMetadataProgram ``
+	// [15] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewMintToCollectionV1InstructionBuilder creates a new `MintToCollectionV1` instruction builder.
+func NewMintToCollectionV1InstructionBuilder() *MintToCollectionV1 {
+	return &MintToCollectionV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 16),
+	}
+}
+
+// NewMintToCollectionV1Instruction
+//
+// Parameters:
+//
+//	metadataArgs:
+//	treeAuthority:
+//	leafOwner:
+//	leafDelegate:
+//	merkleTree:
+//	payer:
+//	treeDelegate:
+//	collectionAuthority:
+//	collectionAuthorityRecordPda: Collection authority record, or the bubblegum program if the authority is the update authority
+//	collectionMint:
+//	collectionMetadata:
+//	editionAccount:
+//	bubblegumSigner: PDA ["collection_cpi"]
+//	logWrapper:
+//	compressionProgram:
+//	tokenMetadataProgram:
+//	systemProgram:
+func NewMintToCollectionV1Instruction(
+	metadataArgs MetadataArgs,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	leafDelegate common.PublicKey,
+	merkleTree common.PublicKey,
+	payer common.PublicKey,
+	treeDelegate common.PublicKey,
+	collectionAuthority common.PublicKey,
+	collectionAuthorityRecordPda common.PublicKey,
+	collectionMint common.PublicKey,
+	collectionMetadata common.PublicKey,
+	editionAccount common.PublicKey,
+	bubblegumSigner common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	tokenMetadataProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *MintToCollectionV1 {
+	return NewMintToCollectionV1InstructionBuilder().
+		SetMetadataArgs(metadataArgs).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetLeafDelegateAccount(leafDelegate).
+		SetMerkleTreeAccount(merkleTree).
+		SetPayerAccount(payer).
+		SetTreeDelegateAccount(treeDelegate).
+		SetCollectionAuthorityAccount(collectionAuthority).
+		SetCollectionAuthorityRecordPdaAccount(collectionAuthorityRecordPda).
+		SetCollectionMintAccount(collectionMint).
+		SetCollectionMetadataAccount(collectionMetadata).
+		SetEditionAccountAccount(editionAccount).
+		SetBubblegumSignerAccount(bubblegumSigner).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetTokenMetadataProgramAccount(tokenMetadataProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetMetadataArgs sets the "metadataArgs" parameter.
+func (obj *MintToCollectionV1) SetMetadataArgs(metadataArgs MetadataArgs) *MintToCollectionV1 {
+	obj.MetadataArgs = &metadataArgs
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *MintToCollectionV1) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority).WRITE()
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *MintToCollectionV1) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *MintToCollectionV1) SetLeafOwnerAccount(leafOwner common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner)
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *MintToCollectionV1) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetLeafDelegateAccount sets the "leafDelegate" parameter.
+func (obj *MintToCollectionV1) SetLeafDelegateAccount(leafDelegate common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[2] = common.Meta(leafDelegate)
+	return obj
+}
+
+// GetLeafDelegateAccount gets the "leafDelegate" parameter.
+func (obj *MintToCollectionV1) GetLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *MintToCollectionV1) SetMerkleTreeAccount(merkleTree common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[3] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *MintToCollectionV1) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+func (obj *MintToCollectionV1) SetPayerAccount(payer common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[4] = common.Meta(payer).SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+func (obj *MintToCollectionV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetTreeDelegateAccount sets the "treeDelegate" parameter.
+func (obj *MintToCollectionV1) SetTreeDelegateAccount(treeDelegate common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[5] = common.Meta(treeDelegate).SIGNER()
+	return obj
+}
+
+// GetTreeDelegateAccount gets the "treeDelegate" parameter.
+func (obj *MintToCollectionV1) GetTreeDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetCollectionAuthorityAccount sets the "collectionAuthority" parameter.
+func (obj *MintToCollectionV1) SetCollectionAuthorityAccount(collectionAuthority common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[6] = common.Meta(collectionAuthority).SIGNER()
+	return obj
+}
+
+// GetCollectionAuthorityAccount gets the "collectionAuthority" parameter.
+func (obj *MintToCollectionV1) GetCollectionAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetCollectionAuthorityRecordPdaAccount sets the "collectionAuthorityRecordPda" parameter.
+// Collection authority record, or the bubblegum program if the authority is the update authority
+func (obj *MintToCollectionV1) SetCollectionAuthorityRecordPdaAccount(collectionAuthorityRecordPda common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[7] = common.Meta(collectionAuthorityRecordPda)
+	return obj
+}
+
+// GetCollectionAuthorityRecordPdaAccount gets the "collectionAuthorityRecordPda" parameter.
+// Collection authority record, or the bubblegum program if the authority is the update authority
+func (obj *MintToCollectionV1) GetCollectionAuthorityRecordPdaAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+// SetCollectionMintAccount sets the "collectionMint" parameter.
+func (obj *MintToCollectionV1) SetCollectionMintAccount(collectionMint common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[8] = common.Meta(collectionMint)
+	return obj
+}
+
+// GetCollectionMintAccount gets the "collectionMint" parameter.
+func (obj *MintToCollectionV1) GetCollectionMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(8)
+}
+
+// SetCollectionMetadataAccount sets the "collectionMetadata" parameter.
+func (obj *MintToCollectionV1) SetCollectionMetadataAccount(collectionMetadata common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[9] = common.Meta(collectionMetadata).WRITE()
+	return obj
+}
+
+// GetCollectionMetadataAccount gets the "collectionMetadata" parameter.
+func (obj *MintToCollectionV1) GetCollectionMetadataAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(9)
+}
+
+// SetEditionAccountAccount sets the "editionAccount" parameter.
+func (obj *MintToCollectionV1) SetEditionAccountAccount(editionAccount common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[10] = common.Meta(editionAccount)
+	return obj
+}
+
+// GetEditionAccountAccount gets the "editionAccount" parameter.
+func (obj *MintToCollectionV1) GetEditionAccountAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(10)
+}
+
+// SetBubblegumSignerAccount sets the "bubblegumSigner" parameter.
+// PDA ["collection_cpi"]
+func (obj *MintToCollectionV1) SetBubblegumSignerAccount(bubblegumSigner common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[11] = common.Meta(bubblegumSigner)
+	return obj
+}
+
+// GetBubblegumSignerAccount gets the "bubblegumSigner" parameter.
+// PDA ["collection_cpi"]
+func (obj *MintToCollectionV1) GetBubblegumSignerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(11)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *MintToCollectionV1) SetLogWrapperAccount(logWrapper common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[12] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *MintToCollectionV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(12)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *MintToCollectionV1) SetCompressionProgramAccount(compressionProgram common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[13] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *MintToCollectionV1) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(13)
+}
+
+// SetTokenMetadataProgramAccount sets the "tokenMetadataProgram" parameter.
+func (obj *MintToCollectionV1) SetTokenMetadataProgramAccount(tokenMetadataProgram common.PublicKey) *MintToCollectionV1 {
+	obj.AccountMetaSlice[14] = common.Meta(tokenMetadataProgram)
+	return obj
+}
+
+// GetTokenMetadataProgramAccount gets the "tokenMetadataProgram" parameter.
+func (obj *MintToCollectionV1) GetTokenMetadataProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(14)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *MintToCollectionV1) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *MintToCollectionV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[15] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[15] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *MintToCollectionV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(15)
+}
+
+func (obj *MintToCollectionV1) SetProgramId(programId *common.PublicKey) *MintToCollectionV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *MintToCollectionV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_MintToCollectionV1[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *MintToCollectionV1) Validate() error {
+	if obj.MetadataArgs == nil {
+		return errors.New("[MintToCollectionV1] metadataArgs param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[MintToCollectionV1] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[MintToCollectionV1] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[MintToCollectionV1] accounts.leafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[MintToCollectionV1] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[MintToCollectionV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[MintToCollectionV1] accounts.treeDelegate is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[MintToCollectionV1] accounts.collectionAuthority is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[MintToCollectionV1] accounts.collectionAuthorityRecordPda is not set")
+	}
+	if obj.AccountMetaSlice[8] == nil {
+		return errors.New("[MintToCollectionV1] accounts.collectionMint is not set")
+	}
+	if obj.AccountMetaSlice[9] == nil {
+		return errors.New("[MintToCollectionV1] accounts.collectionMetadata is not set")
+	}
+	if obj.AccountMetaSlice[10] == nil {
+		return errors.New("[MintToCollectionV1] accounts.editionAccount is not set")
+	}
+	if obj.AccountMetaSlice[11] == nil {
+		return errors.New("[MintToCollectionV1] accounts.bubblegumSigner is not set")
+	}
+	if obj.AccountMetaSlice[12] == nil {
+		return errors.New("[MintToCollectionV1] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[13] == nil {
+		return errors.New("[MintToCollectionV1] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[14] == nil {
+		return errors.New("[MintToCollectionV1] accounts.tokenMetadataProgram is not set")
+	}
+	if obj.AccountMetaSlice[15] == nil {
+		return errors.New("[MintToCollectionV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *MintToCollectionV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *MintToCollectionV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.MetadataArgs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *MintToCollectionV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.MetadataArgs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *MintToCollectionV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("MintToCollectionV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("MetadataArgs", *obj.MetadataArgs))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=16]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("               treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                   leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("                leafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                  merkleTree", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("                       payer", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("                treeDelegate", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("         collectionAuthority", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("collectionAuthorityRecordPda", obj.AccountMetaSlice.Get(7)))
+						accountsBranch.Child(common.FormatMeta("              collectionMint", obj.AccountMetaSlice.Get(8)))
+						accountsBranch.Child(common.FormatMeta("          collectionMetadata", obj.AccountMetaSlice.Get(9)))
+						accountsBranch.Child(common.FormatMeta("              editionAccount", obj.AccountMetaSlice.Get(10)))
+						accountsBranch.Child(common.FormatMeta("             bubblegumSigner", obj.AccountMetaSlice.Get(11)))
+						accountsBranch.Child(common.FormatMeta("                  logWrapper", obj.AccountMetaSlice.Get(12)))
+						accountsBranch.Child(common.FormatMeta("          compressionProgram", obj.AccountMetaSlice.Get(13)))
+						accountsBranch.Child(common.FormatMeta("        tokenMetadataProgram", obj.AccountMetaSlice.Get(14)))
+						accountsBranch.Child(common.FormatMeta("               systemProgram", obj.AccountMetaSlice.Get(15)))
+					})
+				})
+		})
+}
+
+// Transfer Instruction
+// Transfers a compressed NFT, signed by the leaf owner or the leaf delegate; the proof is passed as remaining accounts
+type Transfer struct {
+	Root        *[32]uint8
+	DataHash    *[32]uint8
+	CreatorHash *[32]uint8
+	Nonce       *uint64
+	Index       *uint32
+	// [0] = [] treeAuthority ``
+	// [1] = [] leafOwner ``
+	// [2] = [] leafDelegate ``
+	// [3] = [] newLeafOwner ``
+	// [4] = [WRITE] merkleTree ``
+	// [5] = [] logWrapper ``
+	// [6] = [] compressionProgram ``
+	// [7] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewTransferInstructionBuilder creates a new `Transfer` instruction builder.
+func NewTransferInstructionBuilder() *Transfer {
+	return &Transfer{
+		AccountMetaSlice: make(common.AccountMetaSlice, 8),
+	}
+}
+
+// NewTransferInstruction
+//
+// Parameters:
+//
+//	root:
+//	dataHash:
+//	creatorHash:
+//	nonce:
+//	index:
+//	treeAuthority:
+//	leafOwner:
+//	leafDelegate:
+//	newLeafOwner:
+//	merkleTree:
+//	logWrapper:
+//	compressionProgram:
+//	systemProgram:
+func NewTransferInstruction(
+	root [32]uint8,
+	dataHash [32]uint8,
+	creatorHash [32]uint8,
+	nonce uint64,
+	index uint32,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	leafDelegate common.PublicKey,
+	newLeafOwner common.PublicKey,
+	merkleTree common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *Transfer {
+	return NewTransferInstructionBuilder().
+		SetRoot(root).
+		SetDataHash(dataHash).
+		SetCreatorHash(creatorHash).
+		SetNonce(nonce).
+		SetIndex(index).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetLeafDelegateAccount(leafDelegate).
+		SetNewLeafOwnerAccount(newLeafOwner).
+		SetMerkleTreeAccount(merkleTree).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetRoot sets the "root" parameter.
+func (obj *Transfer) SetRoot(root [32]uint8) *Transfer {
+	obj.Root = &root
+	return obj
+}
+
+// SetDataHash sets the "dataHash" parameter.
+func (obj *Transfer) SetDataHash(dataHash [32]uint8) *Transfer {
+	obj.DataHash = &dataHash
+	return obj
+}
+
+// SetCreatorHash sets the "creatorHash" parameter.
+func (obj *Transfer) SetCreatorHash(creatorHash [32]uint8) *Transfer {
+	obj.CreatorHash = &creatorHash
+	return obj
+}
+
+// SetNonce sets the "nonce" parameter.
+func (obj *Transfer) SetNonce(nonce uint64) *Transfer {
+	obj.Nonce = &nonce
+	return obj
+}
+
+// SetIndex sets the "index" parameter.
+func (obj *Transfer) SetIndex(index uint32) *Transfer {
+	obj.Index = &index
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *Transfer) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority)
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *Transfer) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *Transfer) SetLeafOwnerAccount(leafOwner common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner)
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *Transfer) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetLeafDelegateAccount sets the "leafDelegate" parameter.
+func (obj *Transfer) SetLeafDelegateAccount(leafDelegate common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[2] = common.Meta(leafDelegate)
+	return obj
+}
+
+// GetLeafDelegateAccount gets the "leafDelegate" parameter.
+func (obj *Transfer) GetLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetNewLeafOwnerAccount sets the "newLeafOwner" parameter.
+func (obj *Transfer) SetNewLeafOwnerAccount(newLeafOwner common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[3] = common.Meta(newLeafOwner)
+	return obj
+}
+
+// GetNewLeafOwnerAccount gets the "newLeafOwner" parameter.
+func (obj *Transfer) GetNewLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *Transfer) SetMerkleTreeAccount(merkleTree common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[4] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *Transfer) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *Transfer) SetLogWrapperAccount(logWrapper common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *Transfer) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *Transfer) SetCompressionProgramAccount(compressionProgram common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[6] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *Transfer) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *Transfer) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *Transfer {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[7] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[7] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *Transfer) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+func (obj *Transfer) SetProgramId(programId *common.PublicKey) *Transfer {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Transfer) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_Transfer[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *Transfer) Validate() error {
+	if obj.Root == nil {
+		return errors.New("[Transfer] root param is not set")
+	}
+	if obj.DataHash == nil {
+		return errors.New("[Transfer] dataHash param is not set")
+	}
+	if obj.CreatorHash == nil {
+		return errors.New("[Transfer] creatorHash param is not set")
+	}
+	if obj.Nonce == nil {
+		return errors.New("[Transfer] nonce param is not set")
+	}
+	if obj.Index == nil {
+		return errors.New("[Transfer] index param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Transfer] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Transfer] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Transfer] accounts.leafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[Transfer] accounts.newLeafOwner is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[Transfer] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[Transfer] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[Transfer] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[Transfer] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Transfer) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Transfer) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Root); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Transfer) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Root); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Transfer) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Transfer")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=5]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("       Root", *obj.Root))
+						paramsBranch.Child(format.Param("   DataHash", *obj.DataHash))
+						paramsBranch.Child(format.Param("CreatorHash", *obj.CreatorHash))
+						paramsBranch.Child(format.Param("      Nonce", *obj.Nonce))
+						paramsBranch.Child(format.Param("      Index", *obj.Index))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=8]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("         leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      leafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("      newLeafOwner", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("        merkleTree", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("compressionProgram", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(7)))
+					})
+				})
+		})
+}
+
+// Burn Instruction
+// Burns a compressed NFT, signed by the leaf owner or the leaf delegate; the proof is passed as remaining accounts
+type Burn struct {
+	Root        *[32]uint8
+	DataHash    *[32]uint8
+	CreatorHash *[32]uint8
+	Nonce       *uint64
+	Index       *uint32
+	// [0] = [] treeAuthority ``
+	// [1] = [] leafOwner ``
+	// [2] = [] leafDelegate ``
+	// [3] = [WRITE] merkleTree ``
+	// [4] = [] logWrapper ``
+	// [5] = [] compressionProgram ``
+	// [6] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnInstructionBuilder creates a new `Burn` instruction builder.
+func NewBurnInstructionBuilder() *Burn {
+	return &Burn{
+		AccountMetaSlice: make(common.AccountMetaSlice, 7),
+	}
+}
+
+// NewBurnInstruction
+//
+// Parameters:
+//
+//	root:
+//	dataHash:
+//	creatorHash:
+//	nonce:
+//	index:
+//	treeAuthority:
+//	leafOwner:
+//	leafDelegate:
+//	merkleTree:
+//	logWrapper:
+//	compressionProgram:
+//	systemProgram:
+func NewBurnInstruction(
+	root [32]uint8,
+	dataHash [32]uint8,
+	creatorHash [32]uint8,
+	nonce uint64,
+	index uint32,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	leafDelegate common.PublicKey,
+	merkleTree common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *Burn {
+	return NewBurnInstructionBuilder().
+		SetRoot(root).
+		SetDataHash(dataHash).
+		SetCreatorHash(creatorHash).
+		SetNonce(nonce).
+		SetIndex(index).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetLeafDelegateAccount(leafDelegate).
+		SetMerkleTreeAccount(merkleTree).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetRoot sets the "root" parameter.
+func (obj *Burn) SetRoot(root [32]uint8) *Burn {
+	obj.Root = &root
+	return obj
+}
+
+// SetDataHash sets the "dataHash" parameter.
+func (obj *Burn) SetDataHash(dataHash [32]uint8) *Burn {
+	obj.DataHash = &dataHash
+	return obj
+}
+
+// SetCreatorHash sets the "creatorHash" parameter.
+func (obj *Burn) SetCreatorHash(creatorHash [32]uint8) *Burn {
+	obj.CreatorHash = &creatorHash
+	return obj
+}
+
+// SetNonce sets the "nonce" parameter.
+func (obj *Burn) SetNonce(nonce uint64) *Burn {
+	obj.Nonce = &nonce
+	return obj
+}
+
+// SetIndex sets the "index" parameter.
+func (obj *Burn) SetIndex(index uint32) *Burn {
+	obj.Index = &index
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *Burn) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *Burn {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority)
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *Burn) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *Burn) SetLeafOwnerAccount(leafOwner common.PublicKey) *Burn {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner)
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *Burn) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetLeafDelegateAccount sets the "leafDelegate" parameter.
+func (obj *Burn) SetLeafDelegateAccount(leafDelegate common.PublicKey) *Burn {
+	obj.AccountMetaSlice[2] = common.Meta(leafDelegate)
+	return obj
+}
+
+// GetLeafDelegateAccount gets the "leafDelegate" parameter.
+func (obj *Burn) GetLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *Burn) SetMerkleTreeAccount(merkleTree common.PublicKey) *Burn {
+	obj.AccountMetaSlice[3] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *Burn) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *Burn) SetLogWrapperAccount(logWrapper common.PublicKey) *Burn {
+	obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *Burn) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *Burn) SetCompressionProgramAccount(compressionProgram common.PublicKey) *Burn {
+	obj.AccountMetaSlice[5] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *Burn) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *Burn) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *Burn {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[6] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[6] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *Burn) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+func (obj *Burn) SetProgramId(programId *common.PublicKey) *Burn {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Burn) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_Burn[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *Burn) Validate() error {
+	if obj.Root == nil {
+		return errors.New("[Burn] root param is not set")
+	}
+	if obj.DataHash == nil {
+		return errors.New("[Burn] dataHash param is not set")
+	}
+	if obj.CreatorHash == nil {
+		return errors.New("[Burn] creatorHash param is not set")
+	}
+	if obj.Nonce == nil {
+		return errors.New("[Burn] nonce param is not set")
+	}
+	if obj.Index == nil {
+		return errors.New("[Burn] index param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Burn] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Burn] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Burn] accounts.leafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[Burn] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[Burn] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[Burn] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[Burn] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Burn) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Burn) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Root); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Root); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Burn")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=5]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("       Root", *obj.Root))
+						paramsBranch.Child(format.Param("   DataHash", *obj.DataHash))
+						paramsBranch.Child(format.Param("CreatorHash", *obj.CreatorHash))
+						paramsBranch.Child(format.Param("      Nonce", *obj.Nonce))
+						paramsBranch.Child(format.Param("      Index", *obj.Index))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("         leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      leafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("        merkleTree", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("compressionProgram", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+// Delegate Instruction
+// Sets the leaf delegate of a compressed NFT; the proof is passed as remaining accounts
+type Delegate struct {
+	Root        *[32]uint8
+	DataHash    *[32]uint8
+	CreatorHash *[32]uint8
+	Nonce       *uint64
+	Index       *uint32
+	// [0] = [] treeAuthority ``
+	// [1] = [SIGNER] leafOwner ``
+	// [2] = [] previousLeafDelegate ``
+	// [3] = [] newLeafDelegate ``
+	// [4] = [WRITE] merkleTree ``
+	// [5] = [] logWrapper ``
+	// [6] = [] compressionProgram ``
+	// [7] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewDelegateInstructionBuilder creates a new `Delegate` instruction builder.
+func NewDelegateInstructionBuilder() *Delegate {
+	return &Delegate{
+		AccountMetaSlice: make(common.AccountMetaSlice, 8),
+	}
+}
+
+// NewDelegateInstruction
+//
+// Parameters:
+//
+//	root:
+//	dataHash:
+//	creatorHash:
+//	nonce:
+//	index:
+//	treeAuthority:
+//	leafOwner:
+//	previousLeafDelegate:
+//	newLeafDelegate:
+//	merkleTree:
+//	logWrapper:
+//	compressionProgram:
+//	systemProgram:
+func NewDelegateInstruction(
+	root [32]uint8,
+	dataHash [32]uint8,
+	creatorHash [32]uint8,
+	nonce uint64,
+	index uint32,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	previousLeafDelegate common.PublicKey,
+	newLeafDelegate common.PublicKey,
+	merkleTree common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *Delegate {
+	return NewDelegateInstructionBuilder().
+		SetRoot(root).
+		SetDataHash(dataHash).
+		SetCreatorHash(creatorHash).
+		SetNonce(nonce).
+		SetIndex(index).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetPreviousLeafDelegateAccount(previousLeafDelegate).
+		SetNewLeafDelegateAccount(newLeafDelegate).
+		SetMerkleTreeAccount(merkleTree).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetRoot sets the "root" parameter.
+func (obj *Delegate) SetRoot(root [32]uint8) *Delegate {
+	obj.Root = &root
+	return obj
+}
+
+// SetDataHash sets the "dataHash" parameter.
+func (obj *Delegate) SetDataHash(dataHash [32]uint8) *Delegate {
+	obj.DataHash = &dataHash
+	return obj
+}
+
+// SetCreatorHash sets the "creatorHash" parameter.
+func (obj *Delegate) SetCreatorHash(creatorHash [32]uint8) *Delegate {
+	obj.CreatorHash = &creatorHash
+	return obj
+}
+
+// SetNonce sets the "nonce" parameter.
+func (obj *Delegate) SetNonce(nonce uint64) *Delegate {
+	obj.Nonce = &nonce
+	return obj
+}
+
+// SetIndex sets the "index" parameter.
+func (obj *Delegate) SetIndex(index uint32) *Delegate {
+	obj.Index = &index
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *Delegate) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority)
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *Delegate) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *Delegate) SetLeafOwnerAccount(leafOwner common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner).SIGNER()
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *Delegate) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPreviousLeafDelegateAccount sets the "previousLeafDelegate" parameter.
+func (obj *Delegate) SetPreviousLeafDelegateAccount(previousLeafDelegate common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[2] = common.Meta(previousLeafDelegate)
+	return obj
+}
+
+// GetPreviousLeafDelegateAccount gets the "previousLeafDelegate" parameter.
+func (obj *Delegate) GetPreviousLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetNewLeafDelegateAccount sets the "newLeafDelegate" parameter.
+func (obj *Delegate) SetNewLeafDelegateAccount(newLeafDelegate common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[3] = common.Meta(newLeafDelegate)
+	return obj
+}
+
+// GetNewLeafDelegateAccount gets the "newLeafDelegate" parameter.
+func (obj *Delegate) GetNewLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *Delegate) SetMerkleTreeAccount(merkleTree common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[4] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *Delegate) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *Delegate) SetLogWrapperAccount(logWrapper common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *Delegate) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *Delegate) SetCompressionProgramAccount(compressionProgram common.PublicKey) *Delegate {
+	obj.AccountMetaSlice[6] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *Delegate) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *Delegate) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *Delegate {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[7] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[7] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *Delegate) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+func (obj *Delegate) SetProgramId(programId *common.PublicKey) *Delegate {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Delegate) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_Delegate[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *Delegate) Validate() error {
+	if obj.Root == nil {
+		return errors.New("[Delegate] root param is not set")
+	}
+	if obj.DataHash == nil {
+		return errors.New("[Delegate] dataHash param is not set")
+	}
+	if obj.CreatorHash == nil {
+		return errors.New("[Delegate] creatorHash param is not set")
+	}
+	if obj.Nonce == nil {
+		return errors.New("[Delegate] nonce param is not set")
+	}
+	if obj.Index == nil {
+		return errors.New("[Delegate] index param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Delegate] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Delegate] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Delegate] accounts.previousLeafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[Delegate] accounts.newLeafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[Delegate] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[Delegate] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[Delegate] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[Delegate] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Delegate) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Delegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Root); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Delegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Root); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Index); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Delegate) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Delegate")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=5]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("       Root", *obj.Root))
+						paramsBranch.Child(format.Param("   DataHash", *obj.DataHash))
+						paramsBranch.Child(format.Param("CreatorHash", *obj.CreatorHash))
+						paramsBranch.Child(format.Param("      Nonce", *obj.Nonce))
+						paramsBranch.Child(format.Param("      Index", *obj.Index))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=8]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("       treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("           leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("previousLeafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("     newLeafDelegate", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("          merkleTree", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("          logWrapper", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("  compressionProgram", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("       systemProgram", obj.AccountMetaSlice.Get(7)))
+					})
+				})
+		})
+}
+
+// VerifyCreator Instruction
+// Verifies a creator of a compressed NFT; the proof is passed as remaining accounts
+type VerifyCreator struct {
+	Root        *[32]uint8
+	DataHash    *[32]uint8
+	CreatorHash *[32]uint8
+	Nonce       *uint64
+	Index       *uint32
+	Message     *MetadataArgs
+	// [0] = [] treeAuthority ``
+	// [1] = [] leafOwner ``
+	// [2] = [] leafDelegate ``
+	// [3] = [WRITE] merkleTree ``
+	// [4] = [SIGNER] payer ``
+	// [5] = [SIGNER] creator ``
+	// [6] = [] logWrapper ``
+	// [7] = [] compressionProgram ``
+	// [8] = [] systemProgram ``
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewVerifyCreatorInstructionBuilder creates a new `VerifyCreator` instruction builder.
+func NewVerifyCreatorInstructionBuilder() *VerifyCreator {
+	return &VerifyCreator{
+		AccountMetaSlice: make(common.AccountMetaSlice, 9),
+	}
+}
+
+// NewVerifyCreatorInstruction
+//
+// Parameters:
+//
+//	root:
+//	dataHash:
+//	creatorHash:
+//	nonce:
+//	index:
+//	message:
+//	treeAuthority:
+//	leafOwner:
+//	leafDelegate:
+//	merkleTree:
+//	payer:
+//	creator:
+//	logWrapper:
+//	compressionProgram:
+//	systemProgram:
+func NewVerifyCreatorInstruction(
+	root [32]uint8,
+	dataHash [32]uint8,
+	creatorHash [32]uint8,
+	nonce uint64,
+	index uint32,
+	message MetadataArgs,
+	treeAuthority common.PublicKey,
+	leafOwner common.PublicKey,
+	leafDelegate common.PublicKey,
+	merkleTree common.PublicKey,
+	payer common.PublicKey,
+	creator common.PublicKey,
+	logWrapper common.PublicKey,
+	compressionProgram common.PublicKey,
+	systemProgram common.PublicKey,
+) *VerifyCreator {
+	return NewVerifyCreatorInstructionBuilder().
+		SetRoot(root).
+		SetDataHash(dataHash).
+		SetCreatorHash(creatorHash).
+		SetNonce(nonce).
+		SetIndex(index).
+		SetMessage(message).
+		SetTreeAuthorityAccount(treeAuthority).
+		SetLeafOwnerAccount(leafOwner).
+		SetLeafDelegateAccount(leafDelegate).
+		SetMerkleTreeAccount(merkleTree).
+		SetPayerAccount(payer).
+		SetCreatorAccount(creator).
+		SetLogWrapperAccount(logWrapper).
+		SetCompressionProgramAccount(compressionProgram).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetRoot sets the "root" parameter.
+func (obj *VerifyCreator) SetRoot(root [32]uint8) *VerifyCreator {
+	obj.Root = &root
+	return obj
+}
+
+// SetDataHash sets the "dataHash" parameter.
+func (obj *VerifyCreator) SetDataHash(dataHash [32]uint8) *VerifyCreator {
+	obj.DataHash = &dataHash
+	return obj
+}
+
+// SetCreatorHash sets the "creatorHash" parameter.
+func (obj *VerifyCreator) SetCreatorHash(creatorHash [32]uint8) *VerifyCreator {
+	obj.CreatorHash = &creatorHash
+	return obj
+}
+
+// SetNonce sets the "nonce" parameter.
+func (obj *VerifyCreator) SetNonce(nonce uint64) *VerifyCreator {
+	obj.Nonce = &nonce
+	return obj
+}
+
+// SetIndex sets the "index" parameter.
+func (obj *VerifyCreator) SetIndex(index uint32) *VerifyCreator {
+	obj.Index = &index
+	return obj
+}
+
+// SetMessage sets the "message" parameter.
+func (obj *VerifyCreator) SetMessage(message MetadataArgs) *VerifyCreator {
+	obj.Message = &message
+	return obj
+}
+
+// SetTreeAuthorityAccount sets the "treeAuthority" parameter.
+func (obj *VerifyCreator) SetTreeAuthorityAccount(treeAuthority common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[0] = common.Meta(treeAuthority)
+	return obj
+}
+
+// GetTreeAuthorityAccount gets the "treeAuthority" parameter.
+func (obj *VerifyCreator) GetTreeAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetLeafOwnerAccount sets the "leafOwner" parameter.
+func (obj *VerifyCreator) SetLeafOwnerAccount(leafOwner common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[1] = common.Meta(leafOwner)
+	return obj
+}
+
+// GetLeafOwnerAccount gets the "leafOwner" parameter.
+func (obj *VerifyCreator) GetLeafOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetLeafDelegateAccount sets the "leafDelegate" parameter.
+func (obj *VerifyCreator) SetLeafDelegateAccount(leafDelegate common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[2] = common.Meta(leafDelegate)
+	return obj
+}
+
+// GetLeafDelegateAccount gets the "leafDelegate" parameter.
+func (obj *VerifyCreator) GetLeafDelegateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetMerkleTreeAccount sets the "merkleTree" parameter.
+func (obj *VerifyCreator) SetMerkleTreeAccount(merkleTree common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[3] = common.Meta(merkleTree).WRITE()
+	return obj
+}
+
+// GetMerkleTreeAccount gets the "merkleTree" parameter.
+func (obj *VerifyCreator) GetMerkleTreeAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+func (obj *VerifyCreator) SetPayerAccount(payer common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[4] = common.Meta(payer).SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+func (obj *VerifyCreator) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetCreatorAccount sets the "creator" parameter.
+func (obj *VerifyCreator) SetCreatorAccount(creator common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[5] = common.Meta(creator).SIGNER()
+	return obj
+}
+
+// GetCreatorAccount gets the "creator" parameter.
+func (obj *VerifyCreator) GetCreatorAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+func (obj *VerifyCreator) SetLogWrapperAccount(logWrapper common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[6] = common.Meta(logWrapper)
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+func (obj *VerifyCreator) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetCompressionProgramAccount sets the "compressionProgram" parameter.
+func (obj *VerifyCreator) SetCompressionProgramAccount(compressionProgram common.PublicKey) *VerifyCreator {
+	obj.AccountMetaSlice[7] = common.Meta(compressionProgram)
+	return obj
+}
+
+// GetCompressionProgramAccount gets the "compressionProgram" parameter.
+func (obj *VerifyCreator) GetCompressionProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+func (obj *VerifyCreator) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *VerifyCreator {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[8] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[8] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+func (obj *VerifyCreator) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(8)
+}
+
+func (obj *VerifyCreator) SetProgramId(programId *common.PublicKey) *VerifyCreator {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *VerifyCreator) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_VerifyCreator[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *VerifyCreator) Validate() error {
+	if obj.Root == nil {
+		return errors.New("[VerifyCreator] root param is not set")
+	}
+	if obj.DataHash == nil {
+		return errors.New("[VerifyCreator] dataHash param is not set")
+	}
+	if obj.CreatorHash == nil {
+		return errors.New("[VerifyCreator] creatorHash param is not set")
+	}
+	if obj.Nonce == nil {
+		return errors.New("[VerifyCreator] nonce param is not set")
+	}
+	if obj.Index == nil {
+		return errors.New("[VerifyCreator] index param is not set")
+	}
+	if obj.Message == nil {
+		return errors.New("[VerifyCreator] message param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[VerifyCreator] accounts.treeAuthority is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[VerifyCreator] accounts.leafOwner is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[VerifyCreator] accounts.leafDelegate is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[VerifyCreator] accounts.merkleTree is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[VerifyCreator] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[VerifyCreator] accounts.creator is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[VerifyCreator] accounts.logWrapper is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[VerifyCreator] accounts.compressionProgram is not set")
+	}
+	if obj.AccountMetaSlice[8] == nil {
+		return errors.New("[VerifyCreator] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *VerifyCreator) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *VerifyCreator) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Root); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Index); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *VerifyCreator) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Root); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DataHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CreatorHash); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Nonce); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Index); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *VerifyCreator) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("VerifyCreator")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=6]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("       Root", *obj.Root))
+						paramsBranch.Child(format.Param("   DataHash", *obj.DataHash))
+						paramsBranch.Child(format.Param("CreatorHash", *obj.CreatorHash))
+						paramsBranch.Child(format.Param("      Nonce", *obj.Nonce))
+						paramsBranch.Child(format.Param("      Index", *obj.Index))
+						paramsBranch.Child(format.Param("    Message", *obj.Message))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=9]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     treeAuthority", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("         leafOwner", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      leafDelegate", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("        merkleTree", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("             payer", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("           creator", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("compressionProgram", obj.AccountMetaSlice.Get(7)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(8)))
+					})
+				})
+		})
+}
@@ -0,0 +1,89 @@
+package mpl_bubblegum
+
+import (
+	"encoding/binary"
+	"github.com/donutnomad/solana-web3/common"
+	bin "github.com/gagliardetto/binary"
+	"golang.org/x/crypto/sha3"
+)
+
+// LeafSchemaVersionV1 the version prefixing the hash of a LeafSchema V1
+const LeafSchemaVersionV1 uint8 = 1
+
+// LeafSchema the leaf of a compressed NFT in the merkle tree
+type LeafSchema struct {
+	// the asset id, see FindAssetIdAddress
+	Id          common.PublicKey
+	Owner       common.PublicKey
+	Delegate    common.PublicKey
+	Nonce       uint64
+	DataHash    [32]byte
+	CreatorHash [32]byte
+}
+
+// Hash the leaf node in the merkle tree:
+// keccak256(version || id || owner || delegate || nonce || data_hash || creator_hash)
+func (obj *LeafSchema) Hash() [32]byte {
+	return keccak(
+		[]byte{LeafSchemaVersionV1},
+		obj.Id[:],
+		obj.Owner[:],
+		obj.Delegate[:],
+		binary.LittleEndian.AppendUint64(nil, obj.Nonce),
+		obj.DataHash[:],
+		obj.CreatorHash[:],
+	)
+}
+
+// NewLeafSchema the leaf of the metadata minted to the owner with the nonce of the merkle tree
+func NewLeafSchema(merkleTree common.PublicKey, nonce uint64, owner, delegate common.PublicKey, metadata *MetadataArgs) (*LeafSchema, error) {
+	id, err := FindAssetIdAddress(merkleTree, nonce)
+	if err != nil {
+		return nil, err
+	}
+	dataHash, err := HashMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &LeafSchema{
+		Id:          id,
+		Owner:       owner,
+		Delegate:    delegate,
+		Nonce:       nonce,
+		DataHash:    dataHash,
+		CreatorHash: HashCreators(metadata.Creators),
+	}, nil
+}
+
+// HashMetadata the data hash of the metadata: keccak256(keccak256(borsh(metadata)) || seller_fee_basis_points)
+func HashMetadata(metadata *MetadataArgs) ([32]byte, error) {
+	data, err := bin.MarshalBorsh(metadata)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	hash := keccak(data)
+	return keccak(hash[:], binary.LittleEndian.AppendUint16(nil, metadata.SellerFeeBasisPoints)), nil
+}
+
+// HashCreators the creator hash of the creators: keccak256(address || verified || share, ...)
+func HashCreators(creators []Creator) [32]byte {
+	var data []byte
+	for _, creator := range creators {
+		verified := byte(0)
+		if creator.Verified {
+			verified = 1
+		}
+		data = append(data, creator.Address[:]...)
+		data = append(data, verified, creator.Share)
+	}
+	return keccak(data)
+}
+
+func keccak(values ...[]byte) (ret [32]byte) {
+	h := sha3.NewLegacyKeccak256()
+	for _, value := range values {
+		h.Write(value)
+	}
+	h.Sum(ret[:0])
+	return ret
+}
@@ -0,0 +1,91 @@
+package mpl_bubblegum
+
+import (
+	"github.com/donutnomad/solana-web3/common"
+	cmp "github.com/donutnomad/solana-web3/spl_account_compression"
+	"github.com/donutnomad/solana-web3/web3"
+	bin "github.com/gagliardetto/binary"
+	"testing"
+)
+
+func TestLeafSchema(t *testing.T) {
+	merkleTree := web3.Keypair.Generate().PublicKey()
+	owner := web3.Keypair.Generate().PublicKey()
+	creator := web3.Keypair.Generate().PublicKey()
+	metadata := MetadataArgs{
+		Name:                 "leaf",
+		Symbol:               "LF",
+		Uri:                  "https://example.com/leaf.json",
+		SellerFeeBasisPoints: 500,
+		IsMutable:            true,
+		Creators:             []Creator{{Address: creator, Share: 100}},
+	}
+	data, err := bin.MarshalBorsh(&metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// strings, basis points, bools, 4 none options, token program version, creators
+	if len(data) != (4+4)+(4+2)+(4+29)+2+2+4+1+(4+34) {
+		t.Fatalf("unexpected metadata size %d", len(data))
+	}
+	if HashCreators(nil) != keccak() {
+		t.Fatal("unexpected creator hash")
+	}
+
+	// leaves of the nonces 0 to 3, the leaf 2 is delegated
+	var leaves []*LeafSchema
+	var nodes [][32]byte
+	for nonce := uint64(0); nonce < 4; nonce++ {
+		leaf, err := NewLeafSchema(merkleTree, nonce, owner, owner, &metadata)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaves = append(leaves, leaf)
+		nodes = append(nodes, leaf.Hash())
+	}
+	if leaves[0].Id == leaves[1].Id || leaves[0].DataHash != leaves[1].DataHash {
+		t.Fatal("unexpected leaves")
+	}
+	delegate := web3.Keypair.Generate().PublicKey()
+	delegated := *leaves[2]
+	delegated.Delegate = delegate
+	if delegated.Hash() == leaves[2].Hash() {
+		t.Fatal("unexpected delegated leaf")
+	}
+
+	tree, err := cmp.NewMerkleTree(5, nodes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := tree.Proof(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.VerifyProof(tree.Root(), leaves[2].Hash(), proof, 2) {
+		t.Fatal("unexpected proof")
+	}
+	newOwner := web3.Keypair.Generate().PublicKey()
+	treeConfig, _ := FindTreeConfigAddress(merkleTree)
+	transfer := NewTransferInstruction(
+		tree.Root(), leaves[2].DataHash, leaves[2].CreatorHash, 2, 2,
+		treeConfig, owner, owner, newOwner, merkleTree, cmp.NoopProgramID, cmp.ProgramID, web3.SystemProgramID,
+	)
+	transfer.GetLeafOwnerAccount().SIGNER()
+	accounts := ProofAccounts(proof, 2)
+	if len(accounts) != 3 || accounts[0].Pubkey != common.PublicKey(proof[0]) || accounts[2].IsWritable {
+		t.Fatalf("unexpected proof accounts %v", accounts)
+	}
+	for _, account := range accounts {
+		transfer.AccountMetaSlice.Append(account)
+	}
+	ins, err := transfer.ValidateAndBuild()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys := ins.Accounts(); len(keys) != 11 || !keys[1].IsSigner || keys[10].Pubkey != common.PublicKey(proof[2]) {
+		t.Fatalf("unexpected accounts %v", keys)
+	}
+	if len(ProofAccounts(proof, 10)) != 0 {
+		t.Fatal("unexpected proof accounts")
+	}
+}
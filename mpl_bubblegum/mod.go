@@ -0,0 +1,166 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_bubblegum
+
+import (
+	"bytes"
+	"fmt"
+	spew "github.com/davecgh/go-spew/spew"
+	binary "github.com/donutnomad/solana-web3/binary"
+	common "github.com/donutnomad/solana-web3/common"
+	solanago "github.com/gagliardetto/solana-go"
+	text "github.com/gagliardetto/solana-go/text"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+var ProgramID common.PublicKey = common.MustPublicKeyFromBase58("BGUMAp9Gq7iTEuizy4pqaxsTyUCBK68MDfK752saRPUY")
+
+func SetProgramID(pubkey common.PublicKey) {
+	ProgramID = pubkey
+	if !common.IsZero(ProgramID) {
+		solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)
+	}
+}
+
+const ProgramName = "mpl_bubblegum"
+
+func init() {
+	if !common.IsZero(ProgramID) {
+		solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)
+	}
+}
+
+func btou32(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var (
+	Instruction_CreateTree         = binary.TypeID([8]byte{165, 83, 136, 142, 89, 202, 47, 220})
+	Instruction_MintV1             = binary.TypeID([8]byte{145, 98, 192, 118, 184, 147, 118, 104})
+	Instruction_MintToCollectionV1 = binary.TypeID([8]byte{153, 18, 178, 47, 197, 158, 86, 15})
+	Instruction_Transfer           = binary.TypeID([8]byte{163, 52, 200, 231, 140, 3, 69, 186})
+	Instruction_Burn               = binary.TypeID([8]byte{116, 110, 29, 56, 107, 219, 42, 93})
+	Instruction_Delegate           = binary.TypeID([8]byte{90, 147, 75, 178, 85, 88, 4, 137})
+	Instruction_VerifyCreator      = binary.TypeID([8]byte{52, 17, 96, 132, 71, 4, 85, 194})
+)
+
+var InstructionImplDef = binary.NewVariantDefinitionAnchorType([]binary.VariantTypeHash{
+	{
+		"create_tree", "global:create_tree", (*CreateTree)(nil),
+	},
+	{
+		"mint_v1", "global:mint_v1", (*MintV1)(nil),
+	},
+	{
+		"mint_to_collection_v1", "global:mint_to_collection_v1", (*MintToCollectionV1)(nil),
+	},
+	{
+		"transfer", "global:transfer", (*Transfer)(nil),
+	},
+	{
+		"burn", "global:burn", (*Burn)(nil),
+	},
+	{
+		"delegate", "global:delegate", (*Delegate)(nil),
+	},
+	{
+		"verify_creator", "global:verify_creator", (*VerifyCreator)(nil),
+	},
+})
+
+// InstructionIDToName returns the name of the instruction given its ID.
+func InstructionIDToName(id binary.TypeID) string {
+	switch id {
+	case Instruction_CreateTree:
+		return "CreateTree"
+	case Instruction_MintV1:
+		return "MintV1"
+	case Instruction_MintToCollectionV1:
+		return "MintToCollectionV1"
+	case Instruction_Transfer:
+		return "Transfer"
+	case Instruction_Burn:
+		return "Burn"
+	case Instruction_Delegate:
+		return "Delegate"
+	case Instruction_VerifyCreator:
+		return "VerifyCreator"
+	default:
+		return ""
+	}
+}
+
+func registryDecodeInstruction(accounts []*solanago.AccountMeta, data []byte) (interface{}, error) {
+	obj, err := DecodeInstruction(common.ConvertMeta(accounts), data)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func DecodeInstruction(accounts []*common.AccountMeta, data []byte) (*Instruction, error) {
+	obj := new(Instruction)
+	if err := binary.NewBorshDecoder(data).Decode(obj); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	if v, ok := obj.Impl.(common.AccountsSettable); ok {
+		err := v.SetAccounts(accounts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set accounts for instruction: %w", err)
+		}
+	}
+	return obj, nil
+}
+
+type Instruction struct {
+	binary.BaseVariant
+	programId *common.PublicKey
+	typeIdLen uint8
+}
+
+func (obj *Instruction) EncodeToTree(parent treeout.Branches) {
+	if enToTree, ok := obj.Impl.(text.EncodableToTree); ok {
+		enToTree.EncodeToTree(parent)
+	} else {
+		parent.Child(spew.Sdump(obj))
+	}
+}
+
+func (obj *Instruction) ProgramID() common.PublicKey {
+	if obj.programId != nil {
+		return *obj.programId
+	}
+	return ProgramID
+}
+
+func (obj *Instruction) Accounts() (out []*common.AccountMeta) {
+	return obj.Impl.(common.AccountsGettable).GetAccounts()
+}
+
+func (obj *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := binary.NewBorshEncoder(buf).Encode(obj); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (obj *Instruction) TextEncode(encoder *text.Encoder, option *text.Option) error {
+	return encoder.Encode(obj.Impl, option)
+}
+
+func (obj *Instruction) UnmarshalWithDecoder(decoder *binary.Decoder) error {
+	return InstructionImplDef.UnmarshalBinaryVariant(decoder, &obj.BaseVariant)
+}
+
+func (obj *Instruction) MarshalWithEncoder(encoder *binary.Encoder) error {
+	err := encoder.WriteBytes(obj.TypeID.Bytes()[:obj.typeIdLen], false)
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(obj.Impl)
+}
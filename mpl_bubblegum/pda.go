@@ -0,0 +1,61 @@
+package mpl_bubblegum
+
+import (
+	"encoding/binary"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+const (
+	// AssetSeed the seed of the asset id PDA: ["asset", tree, nonce]
+	AssetSeed = "asset"
+	// CollectionCpiSeed the seed of the bubblegum signer PDA verifying the collections
+	CollectionCpiSeed = "collection_cpi"
+)
+
+// FindTreeConfigAddress the TreeConfig of the merkle tree, the authority of the tree
+func FindTreeConfigAddress(
+	merkleTree web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindTreeConfigAddressAndBumpSeed(merkleTree)
+	return k, err
+}
+
+func FindTreeConfigAddressAndBumpSeed(
+	merkleTree web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		merkleTree[:],
+	}, ProgramID)
+}
+
+// FindAssetIdAddress the id of the compressed NFT of the nonce in the merkle tree
+func FindAssetIdAddress(
+	merkleTree web3.PublicKey,
+	nonce uint64,
+) (web3.PublicKey, error) {
+	k, _, err := FindAssetIdAddressAndBumpSeed(merkleTree, nonce)
+	return k, err
+}
+
+func FindAssetIdAddressAndBumpSeed(
+	merkleTree web3.PublicKey,
+	nonce uint64,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte(AssetSeed),
+		merkleTree[:],
+		binary.LittleEndian.AppendUint64(nil, nonce),
+	}, ProgramID)
+}
+
+// FindBubblegumSignerAddress the PDA signing the collection verifications of Token Metadata
+func FindBubblegumSignerAddress() (web3.PublicKey, error) {
+	k, _, err := FindBubblegumSignerAddressAndBumpSeed()
+	return k, err
+}
+
+func FindBubblegumSignerAddressAndBumpSeed() (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte(CollectionCpiSeed),
+	}, ProgramID)
+}
@@ -0,0 +1,16 @@
+package mpl_bubblegum
+
+import (
+	"github.com/donutnomad/solana-web3/common"
+)
+
+// ProofAccounts the remaining accounts of the instructions modifying a leaf (Transfer, Burn, Delegate, VerifyCreator),
+// the proof nodes from the leaf, without the nodes cached by the canopy of the tree
+func ProofAccounts(proof [][32]byte, canopyDepth uint32) []*common.AccountMeta {
+	length := len(proof) - min(int(canopyDepth), len(proof))
+	var ret = make([]*common.AccountMeta, 0, length)
+	for _, node := range proof[:length] {
+		ret = append(ret, common.Meta(common.PublicKey(node)))
+	}
+	return ret
+}
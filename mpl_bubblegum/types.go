@@ -0,0 +1,329 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_bubblegum
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// MetadataArgs Struct
+type MetadataArgs struct {
+	Name                 string
+	Symbol               string
+	Uri                  string
+	SellerFeeBasisPoints uint16
+	PrimarySaleHappened  bool
+	IsMutable            bool
+	EditionNonce         *uint8         `bin:"optional"`
+	TokenStandard        *TokenStandard `bin:"optional"`
+	Collection           *Collection    `bin:"optional"`
+	Uses                 *Uses          `bin:"optional"`
+	TokenProgramVersion  TokenProgramVersion
+	Creators             []Creator
+}
+
+func (obj *MetadataArgs) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Symbol); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.SellerFeeBasisPoints); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PrimarySaleHappened); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.IsMutable); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.EditionNonce != nil); err != nil {
+		return err
+	}
+	if obj.EditionNonce != nil {
+		if err = encoder.Encode(obj.EditionNonce); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.TokenStandard != nil); err != nil {
+		return err
+	}
+	if obj.TokenStandard != nil {
+		if err = encoder.Encode(obj.TokenStandard); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.Collection != nil); err != nil {
+		return err
+	}
+	if obj.Collection != nil {
+		if err = encoder.Encode(obj.Collection); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.Uses != nil); err != nil {
+		return err
+	}
+	if obj.Uses != nil {
+		if err = encoder.Encode(obj.Uses); err != nil {
+			return err
+		}
+	}
+	if err = encoder.Encode(&obj.TokenProgramVersion); err != nil {
+		return err
+	}
+	if err = encoder.WriteUint32(uint32(len(obj.Creators)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Creators {
+		if err = encoder.Encode(&obj.Creators[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *MetadataArgs) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Symbol); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.SellerFeeBasisPoints); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PrimarySaleHappened); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.IsMutable); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.EditionNonce); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.TokenStandard); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Collection); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Uses); err != nil {
+			return err
+		}
+	}
+	if err = decoder.Decode(&obj.TokenProgramVersion); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Creators); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Creator Struct
+type Creator struct {
+	Address  common.PublicKey
+	Verified bool
+	Share    uint8
+}
+
+const CREATOR_SIZE = 34
+
+func (obj *Creator) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Address); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Verified); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Share); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Creator) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Address); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Verified); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Share); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Collection Struct
+type Collection struct {
+	Verified bool
+	Key      common.PublicKey
+}
+
+const COLLECTION_SIZE = 33
+
+func (obj *Collection) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Verified); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Collection) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Verified); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Uses Struct
+type Uses struct {
+	UseMethod UseMethod
+	Remaining uint64
+	Total     uint64
+}
+
+const USES_SIZE = 17
+
+func (obj *Uses) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.UseMethod); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Remaining); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Total); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Uses) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.UseMethod); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Remaining); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Total); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UseMethod Enum
+type UseMethod binary.BorshEnum
+
+const (
+	UseMethodBurn UseMethod = iota
+	UseMethodMultiple
+	UseMethodSingle
+)
+
+func (value UseMethod) String() string {
+	switch value {
+	case UseMethodBurn:
+		return "Burn"
+	case UseMethodMultiple:
+		return "Multiple"
+	case UseMethodSingle:
+		return "Single"
+	default:
+		return ""
+	}
+}
+
+// TokenStandard Enum
+type TokenStandard binary.BorshEnum
+
+const (
+	TokenStandardNonFungible TokenStandard = iota
+	TokenStandardFungibleAsset
+	TokenStandardFungible
+	TokenStandardNonFungibleEdition
+)
+
+func (value TokenStandard) String() string {
+	switch value {
+	case TokenStandardNonFungible:
+		return "NonFungible"
+	case TokenStandardFungibleAsset:
+		return "FungibleAsset"
+	case TokenStandardFungible:
+		return "Fungible"
+	case TokenStandardNonFungibleEdition:
+		return "NonFungibleEdition"
+	default:
+		return ""
+	}
+}
+
+// TokenProgramVersion Enum
+type TokenProgramVersion binary.BorshEnum
+
+const (
+	TokenProgramVersionOriginal TokenProgramVersion = iota
+	TokenProgramVersionToken2022
+)
+
+func (value TokenProgramVersion) String() string {
+	switch value {
+	case TokenProgramVersionOriginal:
+		return "Original"
+	case TokenProgramVersionToken2022:
+		return "Token2022"
+	default:
+		return ""
+	}
+}
+
+// DecompressibleState Enum
+type DecompressibleState binary.BorshEnum
+
+const (
+	DecompressibleStateEnabled DecompressibleState = iota
+	DecompressibleStateDisabled
+)
+
+func (value DecompressibleState) String() string {
+	switch value {
+	case DecompressibleStateEnabled:
+		return "Enabled"
+	case DecompressibleStateDisabled:
+		return "Disabled"
+	default:
+		return ""
+	}
+}
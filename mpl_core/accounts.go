@@ -0,0 +1,197 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_core
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// BaseAssetV1 Struct
+type BaseAssetV1 struct {
+	Key             Key
+	Owner           common.PublicKey
+	UpdateAuthority UpdateAuthority
+	Name            string
+	Uri             string
+	Seq             *uint64 `bin:"optional"`
+}
+
+func (obj *BaseAssetV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Owner); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.Seq != nil); err != nil {
+		return err
+	}
+	if obj.Seq != nil {
+		if err = encoder.Encode(obj.Seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *BaseAssetV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Owner); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BaseCollectionV1 Struct
+type BaseCollectionV1 struct {
+	Key             Key
+	UpdateAuthority common.PublicKey
+	Name            string
+	Uri             string
+	NumMinted       uint32
+	CurrentSize     uint32
+}
+
+func (obj *BaseCollectionV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NumMinted); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CurrentSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BaseCollectionV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NumMinted); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CurrentSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PluginHeaderV1 Struct
+type PluginHeaderV1 struct {
+	Key                  Key
+	PluginRegistryOffset uint64
+}
+
+const PLUGIN_HEADER_V1_SIZE = 9
+
+func (obj *PluginHeaderV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PluginRegistryOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginHeaderV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PluginRegistryOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PluginRegistryV1 Struct
+type PluginRegistryV1 struct {
+	Key              Key
+	Registry         []RegistryRecord
+	ExternalRegistry []ExternalRegistryRecord
+}
+
+func (obj *PluginRegistryV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	if err = encoder.WriteUint32(uint32(len(obj.Registry)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Registry {
+		if err = encoder.Encode(&obj.Registry[i]); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteUint32(uint32(len(obj.ExternalRegistry)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.ExternalRegistry {
+		if err = encoder.Encode(&obj.ExternalRegistry[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *PluginRegistryV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Registry); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ExternalRegistry); err != nil {
+		return err
+	}
+	return nil
+}
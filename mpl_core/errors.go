@@ -0,0 +1,359 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_core
+
+type ProgramError interface {
+	Code() int
+	Error() string
+}
+
+var codeToErrorMap = make(map[int]ProgramError)
+var nameToErrorMap = make(map[string]ProgramError)
+
+func init() {
+	codeToErrorMap[0] = new(InvalidSystemProgramError)
+	nameToErrorMap["InvalidSystemProgram"] = new(InvalidSystemProgramError)
+	codeToErrorMap[1] = new(DeserializationErrorError)
+	nameToErrorMap["DeserializationError"] = new(DeserializationErrorError)
+	codeToErrorMap[2] = new(SerializationErrorError)
+	nameToErrorMap["SerializationError"] = new(SerializationErrorError)
+	codeToErrorMap[3] = new(PluginsNotInitializedError)
+	nameToErrorMap["PluginsNotInitialized"] = new(PluginsNotInitializedError)
+	codeToErrorMap[4] = new(PluginNotFoundError)
+	nameToErrorMap["PluginNotFound"] = new(PluginNotFoundError)
+	codeToErrorMap[5] = new(NumericalOverflowError)
+	nameToErrorMap["NumericalOverflow"] = new(NumericalOverflowError)
+	codeToErrorMap[6] = new(IncorrectAccountError)
+	nameToErrorMap["IncorrectAccount"] = new(IncorrectAccountError)
+	codeToErrorMap[7] = new(IncorrectAssetHashError)
+	nameToErrorMap["IncorrectAssetHash"] = new(IncorrectAssetHashError)
+	codeToErrorMap[8] = new(InvalidPluginError)
+	nameToErrorMap["InvalidPlugin"] = new(InvalidPluginError)
+	codeToErrorMap[9] = new(InvalidAuthorityError)
+	nameToErrorMap["InvalidAuthority"] = new(InvalidAuthorityError)
+	codeToErrorMap[10] = new(AssetIsFrozenError)
+	nameToErrorMap["AssetIsFrozen"] = new(AssetIsFrozenError)
+	codeToErrorMap[11] = new(MissingCompressionProofError)
+	nameToErrorMap["MissingCompressionProof"] = new(MissingCompressionProofError)
+	codeToErrorMap[12] = new(CannotMigrateMasterWithSupplyError)
+	nameToErrorMap["CannotMigrateMasterWithSupply"] = new(CannotMigrateMasterWithSupplyError)
+	codeToErrorMap[13] = new(CannotMigratePrintsError)
+	nameToErrorMap["CannotMigratePrints"] = new(CannotMigratePrintsError)
+	codeToErrorMap[14] = new(CannotBurnCollectionError)
+	nameToErrorMap["CannotBurnCollection"] = new(CannotBurnCollectionError)
+	codeToErrorMap[15] = new(PluginAlreadyExistsError)
+	nameToErrorMap["PluginAlreadyExists"] = new(PluginAlreadyExistsError)
+	codeToErrorMap[16] = new(NumericalOverflowErrorError)
+	nameToErrorMap["NumericalOverflowError"] = new(NumericalOverflowErrorError)
+	codeToErrorMap[17] = new(AlreadyCompressedError)
+	nameToErrorMap["AlreadyCompressed"] = new(AlreadyCompressedError)
+	codeToErrorMap[18] = new(AlreadyDecompressedError)
+	nameToErrorMap["AlreadyDecompressed"] = new(AlreadyDecompressedError)
+	codeToErrorMap[19] = new(InvalidCollectionError)
+	nameToErrorMap["InvalidCollection"] = new(InvalidCollectionError)
+	codeToErrorMap[20] = new(MissingUpdateAuthorityError)
+	nameToErrorMap["MissingUpdateAuthority"] = new(MissingUpdateAuthorityError)
+	codeToErrorMap[21] = new(MissingNewOwnerError)
+	nameToErrorMap["MissingNewOwner"] = new(MissingNewOwnerError)
+	codeToErrorMap[22] = new(MissingSystemProgramError)
+	nameToErrorMap["MissingSystemProgram"] = new(MissingSystemProgramError)
+	codeToErrorMap[23] = new(NotAvailableError)
+	nameToErrorMap["NotAvailable"] = new(NotAvailableError)
+	codeToErrorMap[24] = new(InvalidAssetError)
+	nameToErrorMap["InvalidAsset"] = new(InvalidAssetError)
+	codeToErrorMap[25] = new(MissingCollectionError)
+	nameToErrorMap["MissingCollection"] = new(MissingCollectionError)
+	codeToErrorMap[26] = new(NoApprovalsError)
+	nameToErrorMap["NoApprovals"] = new(NoApprovalsError)
+	codeToErrorMap[27] = new(CannotRedelegateError)
+	nameToErrorMap["CannotRedelegate"] = new(CannotRedelegateError)
+}
+
+func GetMplCoreErrorFromCode(code int) ProgramError {
+	return codeToErrorMap[code]
+}
+
+func GetMplCoreErrorFromName(name string) ProgramError {
+	return nameToErrorMap[name]
+}
+
+// InvalidSystemProgramError Error: 0 `Invalid System Program`
+type InvalidSystemProgramError struct{}
+
+func (e InvalidSystemProgramError) Code() int {
+	return 0
+}
+func (e InvalidSystemProgramError) Error() string {
+	return "Invalid System Program"
+}
+
+// DeserializationErrorError Error: 1 `Error deserializing account`
+type DeserializationErrorError struct{}
+
+func (e DeserializationErrorError) Code() int {
+	return 1
+}
+func (e DeserializationErrorError) Error() string {
+	return "Error deserializing account"
+}
+
+// SerializationErrorError Error: 2 `Error serializing account`
+type SerializationErrorError struct{}
+
+func (e SerializationErrorError) Code() int {
+	return 2
+}
+func (e SerializationErrorError) Error() string {
+	return "Error serializing account"
+}
+
+// PluginsNotInitializedError Error: 3 `Plugins not initialized`
+type PluginsNotInitializedError struct{}
+
+func (e PluginsNotInitializedError) Code() int {
+	return 3
+}
+func (e PluginsNotInitializedError) Error() string {
+	return "Plugins not initialized"
+}
+
+// PluginNotFoundError Error: 4 `Plugin not found`
+type PluginNotFoundError struct{}
+
+func (e PluginNotFoundError) Code() int {
+	return 4
+}
+func (e PluginNotFoundError) Error() string {
+	return "Plugin not found"
+}
+
+// NumericalOverflowError Error: 5 `Numerical Overflow`
+type NumericalOverflowError struct{}
+
+func (e NumericalOverflowError) Code() int {
+	return 5
+}
+func (e NumericalOverflowError) Error() string {
+	return "Numerical Overflow"
+}
+
+// IncorrectAccountError Error: 6 `Incorrect account`
+type IncorrectAccountError struct{}
+
+func (e IncorrectAccountError) Code() int {
+	return 6
+}
+func (e IncorrectAccountError) Error() string {
+	return "Incorrect account"
+}
+
+// IncorrectAssetHashError Error: 7 `Incorrect asset hash`
+type IncorrectAssetHashError struct{}
+
+func (e IncorrectAssetHashError) Code() int {
+	return 7
+}
+func (e IncorrectAssetHashError) Error() string {
+	return "Incorrect asset hash"
+}
+
+// InvalidPluginError Error: 8 `Invalid Plugin`
+type InvalidPluginError struct{}
+
+func (e InvalidPluginError) Code() int {
+	return 8
+}
+func (e InvalidPluginError) Error() string {
+	return "Invalid Plugin"
+}
+
+// InvalidAuthorityError Error: 9 `Invalid Authority`
+type InvalidAuthorityError struct{}
+
+func (e InvalidAuthorityError) Code() int {
+	return 9
+}
+func (e InvalidAuthorityError) Error() string {
+	return "Invalid Authority"
+}
+
+// AssetIsFrozenError Error: 10 `Cannot transfer a frozen asset`
+type AssetIsFrozenError struct{}
+
+func (e AssetIsFrozenError) Code() int {
+	return 10
+}
+func (e AssetIsFrozenError) Error() string {
+	return "Cannot transfer a frozen asset"
+}
+
+// MissingCompressionProofError Error: 11 `Missing compression proof`
+type MissingCompressionProofError struct{}
+
+func (e MissingCompressionProofError) Code() int {
+	return 11
+}
+func (e MissingCompressionProofError) Error() string {
+	return "Missing compression proof"
+}
+
+// CannotMigrateMasterWithSupplyError Error: 12 `Cannot migrate a master edition used for prints`
+type CannotMigrateMasterWithSupplyError struct{}
+
+func (e CannotMigrateMasterWithSupplyError) Code() int {
+	return 12
+}
+func (e CannotMigrateMasterWithSupplyError) Error() string {
+	return "Cannot migrate a master edition used for prints"
+}
+
+// CannotMigratePrintsError Error: 13 `Cannot migrate a print edition`
+type CannotMigratePrintsError struct{}
+
+func (e CannotMigratePrintsError) Code() int {
+	return 13
+}
+func (e CannotMigratePrintsError) Error() string {
+	return "Cannot migrate a print edition"
+}
+
+// CannotBurnCollectionError Error: 14 `Cannot burn a collection NFT`
+type CannotBurnCollectionError struct{}
+
+func (e CannotBurnCollectionError) Code() int {
+	return 14
+}
+func (e CannotBurnCollectionError) Error() string {
+	return "Cannot burn a collection NFT"
+}
+
+// PluginAlreadyExistsError Error: 15 `Plugin already exists`
+type PluginAlreadyExistsError struct{}
+
+func (e PluginAlreadyExistsError) Code() int {
+	return 15
+}
+func (e PluginAlreadyExistsError) Error() string {
+	return "Plugin already exists"
+}
+
+// NumericalOverflowErrorError Error: 16 `Numerical overflow`
+type NumericalOverflowErrorError struct{}
+
+func (e NumericalOverflowErrorError) Code() int {
+	return 16
+}
+func (e NumericalOverflowErrorError) Error() string {
+	return "Numerical overflow"
+}
+
+// AlreadyCompressedError Error: 17 `Already compressed account`
+type AlreadyCompressedError struct{}
+
+func (e AlreadyCompressedError) Code() int {
+	return 17
+}
+func (e AlreadyCompressedError) Error() string {
+	return "Already compressed account"
+}
+
+// AlreadyDecompressedError Error: 18 `Already decompressed account`
+type AlreadyDecompressedError struct{}
+
+func (e AlreadyDecompressedError) Code() int {
+	return 18
+}
+func (e AlreadyDecompressedError) Error() string {
+	return "Already decompressed account"
+}
+
+// InvalidCollectionError Error: 19 `Invalid Collection passed in`
+type InvalidCollectionError struct{}
+
+func (e InvalidCollectionError) Code() int {
+	return 19
+}
+func (e InvalidCollectionError) Error() string {
+	return "Invalid Collection passed in"
+}
+
+// MissingUpdateAuthorityError Error: 20 `Missing update authority`
+type MissingUpdateAuthorityError struct{}
+
+func (e MissingUpdateAuthorityError) Code() int {
+	return 20
+}
+func (e MissingUpdateAuthorityError) Error() string {
+	return "Missing update authority"
+}
+
+// MissingNewOwnerError Error: 21 `Missing new owner`
+type MissingNewOwnerError struct{}
+
+func (e MissingNewOwnerError) Code() int {
+	return 21
+}
+func (e MissingNewOwnerError) Error() string {
+	return "Missing new owner"
+}
+
+// MissingSystemProgramError Error: 22 `Missing system program`
+type MissingSystemProgramError struct{}
+
+func (e MissingSystemProgramError) Code() int {
+	return 22
+}
+func (e MissingSystemProgramError) Error() string {
+	return "Missing system program"
+}
+
+// NotAvailableError Error: 23 `Feature not available`
+type NotAvailableError struct{}
+
+func (e NotAvailableError) Code() int {
+	return 23
+}
+func (e NotAvailableError) Error() string {
+	return "Feature not available"
+}
+
+// InvalidAssetError Error: 24 `Invalid Asset passed in`
+type InvalidAssetError struct{}
+
+func (e InvalidAssetError) Code() int {
+	return 24
+}
+func (e InvalidAssetError) Error() string {
+	return "Invalid Asset passed in"
+}
+
+// MissingCollectionError Error: 25 `Missing collection`
+type MissingCollectionError struct{}
+
+func (e MissingCollectionError) Code() int {
+	return 25
+}
+func (e MissingCollectionError) Error() string {
+	return "Missing collection"
+}
+
+// NoApprovalsError Error: 26 `Neither the asset or any plugins have approved this operation`
+type NoApprovalsError struct{}
+
+func (e NoApprovalsError) Code() int {
+	return 26
+}
+func (e NoApprovalsError) Error() string {
+	return "Neither the asset or any plugins have approved this operation"
+}
+
+// CannotRedelegateError Error: 27 `Plugin Manager cannot redelegate a delegated plugin without revoking first`
+type CannotRedelegateError struct{}
+
+func (e CannotRedelegateError) Code() int {
+	return 27
+}
+func (e CannotRedelegateError) Error() string {
+	return "Plugin Manager cannot redelegate a delegated plugin without revoking first"
+}
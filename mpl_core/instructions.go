@@ -0,0 +1,3655 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_core
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// CreateV1 Instruction
+// Creates an asset
+type CreateV1 struct {
+	CreateV1Args *CreateV1Args
+	// [0] = [WRITE, SIGNER] asset `The address of the new asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [SIGNER] authority `The authority signing for creation`
+	// [3] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [4] = [] owner `The owner of the new asset. Defaults to the authority if not present.`
+	// [5] = [] updateAuthority `The authority on the new asset`
+	// [6] = [] systemProgram `The system program`
+	// [7] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewCreateV1InstructionBuilder creates a new `CreateV1` instruction builder.
+func NewCreateV1InstructionBuilder() *CreateV1 {
+	return &CreateV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 8),
+	}
+}
+
+// NewCreateV1Instruction
+//
+// Parameters:
+//
+//	createV1Args:
+//	asset: The address of the new asset
+//	collection: The collection to which the asset belongs
+//	authority: The authority signing for creation
+//	payer: The account paying for the storage fees
+//	owner: The owner of the new asset. Defaults to the authority if not present.
+//	updateAuthority: The authority on the new asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewCreateV1Instruction(
+	createV1Args CreateV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	authority common.PublicKey,
+	payer common.PublicKey,
+	owner common.PublicKey,
+	updateAuthority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *CreateV1 {
+	return NewCreateV1InstructionBuilder().
+		SetCreateV1Args(createV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetAuthorityAccount(authority).
+		SetPayerAccount(payer).
+		SetOwnerAccount(owner).
+		SetUpdateAuthorityAccount(updateAuthority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetCreateV1Args sets the "createV1Args" parameter.
+func (obj *CreateV1) SetCreateV1Args(createV1Args CreateV1Args) *CreateV1 {
+	obj.CreateV1Args = &createV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the new asset
+func (obj *CreateV1) SetAssetAccount(asset common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE().SIGNER()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the new asset
+func (obj *CreateV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *CreateV1) SetCollectionAccount(collection common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *CreateV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The authority signing for creation
+func (obj *CreateV1) SetAuthorityAccount(authority common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The authority signing for creation
+func (obj *CreateV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *CreateV1) SetPayerAccount(payer common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[3] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *CreateV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetOwnerAccount sets the "owner" parameter.
+// The owner of the new asset. Defaults to the authority if not present.
+func (obj *CreateV1) SetOwnerAccount(owner common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[4] = common.Meta(owner)
+	return obj
+}
+
+// GetOwnerAccount gets the "owner" parameter.
+// The owner of the new asset. Defaults to the authority if not present.
+func (obj *CreateV1) GetOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetUpdateAuthorityAccount sets the "updateAuthority" parameter.
+// The authority on the new asset
+func (obj *CreateV1) SetUpdateAuthorityAccount(updateAuthority common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[5] = common.Meta(updateAuthority)
+	return obj
+}
+
+// GetUpdateAuthorityAccount gets the "updateAuthority" parameter.
+// The authority on the new asset
+func (obj *CreateV1) GetUpdateAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *CreateV1) SetSystemProgramAccount(systemProgram common.PublicKey) *CreateV1 {
+	obj.AccountMetaSlice[6] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *CreateV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *CreateV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *CreateV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[7] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[7] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *CreateV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+func (obj *CreateV1) SetProgramId(programId *common.PublicKey) *CreateV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *CreateV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_CreateV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *CreateV1) Validate() error {
+	if obj.CreateV1Args == nil {
+		return errors.New("[CreateV1] createV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[CreateV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[CreateV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[CreateV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *CreateV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *CreateV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.CreateV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *CreateV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.CreateV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *CreateV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("CreateV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("CreateV1Args", *obj.CreateV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=8]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("          asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("     collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("          payer", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("          owner", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("updateAuthority", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("  systemProgram", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("     logWrapper", obj.AccountMetaSlice.Get(7)))
+					})
+				})
+		})
+}
+
+// CreateCollectionV1 Instruction
+// Creates a collection
+type CreateCollectionV1 struct {
+	CreateCollectionV1Args *CreateCollectionV1Args
+	// [0] = [WRITE, SIGNER] collection `The address of the new collection`
+	// [1] = [] updateAuthority `The authority of the new collection`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [] systemProgram `The system program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewCreateCollectionV1InstructionBuilder creates a new `CreateCollectionV1` instruction builder.
+func NewCreateCollectionV1InstructionBuilder() *CreateCollectionV1 {
+	return &CreateCollectionV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewCreateCollectionV1Instruction
+//
+// Parameters:
+//
+//	createCollectionV1Args:
+//	collection: The address of the new collection
+//	updateAuthority: The authority of the new collection
+//	payer: The account paying for the storage fees
+//	systemProgram: The system program
+func NewCreateCollectionV1Instruction(
+	createCollectionV1Args CreateCollectionV1Args,
+	collection common.PublicKey,
+	updateAuthority common.PublicKey,
+	payer common.PublicKey,
+	systemProgram common.PublicKey,
+) *CreateCollectionV1 {
+	return NewCreateCollectionV1InstructionBuilder().
+		SetCreateCollectionV1Args(createCollectionV1Args).
+		SetCollectionAccount(collection).
+		SetUpdateAuthorityAccount(updateAuthority).
+		SetPayerAccount(payer).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetCreateCollectionV1Args sets the "createCollectionV1Args" parameter.
+func (obj *CreateCollectionV1) SetCreateCollectionV1Args(createCollectionV1Args CreateCollectionV1Args) *CreateCollectionV1 {
+	obj.CreateCollectionV1Args = &createCollectionV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the new collection
+func (obj *CreateCollectionV1) SetCollectionAccount(collection common.PublicKey) *CreateCollectionV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE().SIGNER()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the new collection
+func (obj *CreateCollectionV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetUpdateAuthorityAccount sets the "updateAuthority" parameter.
+// The authority of the new collection
+func (obj *CreateCollectionV1) SetUpdateAuthorityAccount(updateAuthority common.PublicKey) *CreateCollectionV1 {
+	obj.AccountMetaSlice[1] = common.Meta(updateAuthority)
+	return obj
+}
+
+// GetUpdateAuthorityAccount gets the "updateAuthority" parameter.
+// The authority of the new collection
+func (obj *CreateCollectionV1) GetUpdateAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *CreateCollectionV1) SetPayerAccount(payer common.PublicKey) *CreateCollectionV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *CreateCollectionV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *CreateCollectionV1) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *CreateCollectionV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *CreateCollectionV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *CreateCollectionV1) SetProgramId(programId *common.PublicKey) *CreateCollectionV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *CreateCollectionV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_CreateCollectionV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *CreateCollectionV1) Validate() error {
+	if obj.CreateCollectionV1Args == nil {
+		return errors.New("[CreateCollectionV1] createCollectionV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[CreateCollectionV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[CreateCollectionV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[CreateCollectionV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *CreateCollectionV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *CreateCollectionV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.CreateCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *CreateCollectionV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.CreateCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *CreateCollectionV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("CreateCollectionV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("CreateCollectionV1Args", *obj.CreateCollectionV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("updateAuthority", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("          payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("  systemProgram", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// AddPluginV1 Instruction
+// Adds a plugin to an asset
+type AddPluginV1 struct {
+	AddPluginV1Args *AddPluginV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewAddPluginV1InstructionBuilder creates a new `AddPluginV1` instruction builder.
+func NewAddPluginV1InstructionBuilder() *AddPluginV1 {
+	return &AddPluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewAddPluginV1Instruction
+//
+// Parameters:
+//
+//	addPluginV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewAddPluginV1Instruction(
+	addPluginV1Args AddPluginV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *AddPluginV1 {
+	return NewAddPluginV1InstructionBuilder().
+		SetAddPluginV1Args(addPluginV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetAddPluginV1Args sets the "addPluginV1Args" parameter.
+func (obj *AddPluginV1) SetAddPluginV1Args(addPluginV1Args AddPluginV1Args) *AddPluginV1 {
+	obj.AddPluginV1Args = &addPluginV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *AddPluginV1) SetAssetAccount(asset common.PublicKey) *AddPluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *AddPluginV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *AddPluginV1) SetCollectionAccount(collection common.PublicKey) *AddPluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *AddPluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *AddPluginV1) SetPayerAccount(payer common.PublicKey) *AddPluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *AddPluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *AddPluginV1) SetAuthorityAccount(authority common.PublicKey) *AddPluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *AddPluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *AddPluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *AddPluginV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *AddPluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *AddPluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *AddPluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *AddPluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *AddPluginV1) SetProgramId(programId *common.PublicKey) *AddPluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *AddPluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_AddPluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *AddPluginV1) Validate() error {
+	if obj.AddPluginV1Args == nil {
+		return errors.New("[AddPluginV1] addPluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[AddPluginV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[AddPluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[AddPluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *AddPluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *AddPluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.AddPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AddPluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.AddPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AddPluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("AddPluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("AddPluginV1Args", *obj.AddPluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// AddCollectionPluginV1 Instruction
+// Adds a plugin to a collection
+type AddCollectionPluginV1 struct {
+	AddCollectionPluginV1Args *AddCollectionPluginV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] systemProgram `The system program`
+	// [4] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewAddCollectionPluginV1InstructionBuilder creates a new `AddCollectionPluginV1` instruction builder.
+func NewAddCollectionPluginV1InstructionBuilder() *AddCollectionPluginV1 {
+	return &AddCollectionPluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewAddCollectionPluginV1Instruction
+//
+// Parameters:
+//
+//	addCollectionPluginV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewAddCollectionPluginV1Instruction(
+	addCollectionPluginV1Args AddCollectionPluginV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *AddCollectionPluginV1 {
+	return NewAddCollectionPluginV1InstructionBuilder().
+		SetAddCollectionPluginV1Args(addCollectionPluginV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetAddCollectionPluginV1Args sets the "addCollectionPluginV1Args" parameter.
+func (obj *AddCollectionPluginV1) SetAddCollectionPluginV1Args(addCollectionPluginV1Args AddCollectionPluginV1Args) *AddCollectionPluginV1 {
+	obj.AddCollectionPluginV1Args = &addCollectionPluginV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *AddCollectionPluginV1) SetCollectionAccount(collection common.PublicKey) *AddCollectionPluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *AddCollectionPluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *AddCollectionPluginV1) SetPayerAccount(payer common.PublicKey) *AddCollectionPluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *AddCollectionPluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *AddCollectionPluginV1) SetAuthorityAccount(authority common.PublicKey) *AddCollectionPluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *AddCollectionPluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *AddCollectionPluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *AddCollectionPluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *AddCollectionPluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *AddCollectionPluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *AddCollectionPluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *AddCollectionPluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *AddCollectionPluginV1) SetProgramId(programId *common.PublicKey) *AddCollectionPluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *AddCollectionPluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_AddCollectionPluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *AddCollectionPluginV1) Validate() error {
+	if obj.AddCollectionPluginV1Args == nil {
+		return errors.New("[AddCollectionPluginV1] addCollectionPluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[AddCollectionPluginV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[AddCollectionPluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[AddCollectionPluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *AddCollectionPluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *AddCollectionPluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.AddCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AddCollectionPluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.AddCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AddCollectionPluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("AddCollectionPluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("AddCollectionPluginV1Args", *obj.AddCollectionPluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// RemovePluginV1 Instruction
+// Removes a plugin from an asset
+type RemovePluginV1 struct {
+	RemovePluginV1Args *RemovePluginV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewRemovePluginV1InstructionBuilder creates a new `RemovePluginV1` instruction builder.
+func NewRemovePluginV1InstructionBuilder() *RemovePluginV1 {
+	return &RemovePluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewRemovePluginV1Instruction
+//
+// Parameters:
+//
+//	removePluginV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewRemovePluginV1Instruction(
+	removePluginV1Args RemovePluginV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *RemovePluginV1 {
+	return NewRemovePluginV1InstructionBuilder().
+		SetRemovePluginV1Args(removePluginV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetRemovePluginV1Args sets the "removePluginV1Args" parameter.
+func (obj *RemovePluginV1) SetRemovePluginV1Args(removePluginV1Args RemovePluginV1Args) *RemovePluginV1 {
+	obj.RemovePluginV1Args = &removePluginV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *RemovePluginV1) SetAssetAccount(asset common.PublicKey) *RemovePluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *RemovePluginV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *RemovePluginV1) SetCollectionAccount(collection common.PublicKey) *RemovePluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *RemovePluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RemovePluginV1) SetPayerAccount(payer common.PublicKey) *RemovePluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RemovePluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *RemovePluginV1) SetAuthorityAccount(authority common.PublicKey) *RemovePluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *RemovePluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *RemovePluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *RemovePluginV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *RemovePluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RemovePluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *RemovePluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RemovePluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *RemovePluginV1) SetProgramId(programId *common.PublicKey) *RemovePluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *RemovePluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_RemovePluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *RemovePluginV1) Validate() error {
+	if obj.RemovePluginV1Args == nil {
+		return errors.New("[RemovePluginV1] removePluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[RemovePluginV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[RemovePluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[RemovePluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *RemovePluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *RemovePluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.RemovePluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemovePluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.RemovePluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemovePluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RemovePluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("RemovePluginV1Args", *obj.RemovePluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// RemoveCollectionPluginV1 Instruction
+// Removes a plugin from a collection
+type RemoveCollectionPluginV1 struct {
+	RemoveCollectionPluginV1Args *RemoveCollectionPluginV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] systemProgram `The system program`
+	// [4] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewRemoveCollectionPluginV1InstructionBuilder creates a new `RemoveCollectionPluginV1` instruction builder.
+func NewRemoveCollectionPluginV1InstructionBuilder() *RemoveCollectionPluginV1 {
+	return &RemoveCollectionPluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewRemoveCollectionPluginV1Instruction
+//
+// Parameters:
+//
+//	removeCollectionPluginV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewRemoveCollectionPluginV1Instruction(
+	removeCollectionPluginV1Args RemoveCollectionPluginV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *RemoveCollectionPluginV1 {
+	return NewRemoveCollectionPluginV1InstructionBuilder().
+		SetRemoveCollectionPluginV1Args(removeCollectionPluginV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetRemoveCollectionPluginV1Args sets the "removeCollectionPluginV1Args" parameter.
+func (obj *RemoveCollectionPluginV1) SetRemoveCollectionPluginV1Args(removeCollectionPluginV1Args RemoveCollectionPluginV1Args) *RemoveCollectionPluginV1 {
+	obj.RemoveCollectionPluginV1Args = &removeCollectionPluginV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *RemoveCollectionPluginV1) SetCollectionAccount(collection common.PublicKey) *RemoveCollectionPluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *RemoveCollectionPluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RemoveCollectionPluginV1) SetPayerAccount(payer common.PublicKey) *RemoveCollectionPluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RemoveCollectionPluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *RemoveCollectionPluginV1) SetAuthorityAccount(authority common.PublicKey) *RemoveCollectionPluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *RemoveCollectionPluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *RemoveCollectionPluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *RemoveCollectionPluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *RemoveCollectionPluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RemoveCollectionPluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *RemoveCollectionPluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RemoveCollectionPluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *RemoveCollectionPluginV1) SetProgramId(programId *common.PublicKey) *RemoveCollectionPluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *RemoveCollectionPluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_RemoveCollectionPluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *RemoveCollectionPluginV1) Validate() error {
+	if obj.RemoveCollectionPluginV1Args == nil {
+		return errors.New("[RemoveCollectionPluginV1] removeCollectionPluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[RemoveCollectionPluginV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[RemoveCollectionPluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[RemoveCollectionPluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *RemoveCollectionPluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *RemoveCollectionPluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.RemoveCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemoveCollectionPluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.RemoveCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemoveCollectionPluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RemoveCollectionPluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("RemoveCollectionPluginV1Args", *obj.RemoveCollectionPluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// UpdatePluginV1 Instruction
+// Updates a plugin of an asset
+type UpdatePluginV1 struct {
+	UpdatePluginV1Args *UpdatePluginV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdatePluginV1InstructionBuilder creates a new `UpdatePluginV1` instruction builder.
+func NewUpdatePluginV1InstructionBuilder() *UpdatePluginV1 {
+	return &UpdatePluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewUpdatePluginV1Instruction
+//
+// Parameters:
+//
+//	updatePluginV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewUpdatePluginV1Instruction(
+	updatePluginV1Args UpdatePluginV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *UpdatePluginV1 {
+	return NewUpdatePluginV1InstructionBuilder().
+		SetUpdatePluginV1Args(updatePluginV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetUpdatePluginV1Args sets the "updatePluginV1Args" parameter.
+func (obj *UpdatePluginV1) SetUpdatePluginV1Args(updatePluginV1Args UpdatePluginV1Args) *UpdatePluginV1 {
+	obj.UpdatePluginV1Args = &updatePluginV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *UpdatePluginV1) SetAssetAccount(asset common.PublicKey) *UpdatePluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *UpdatePluginV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *UpdatePluginV1) SetCollectionAccount(collection common.PublicKey) *UpdatePluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *UpdatePluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdatePluginV1) SetPayerAccount(payer common.PublicKey) *UpdatePluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdatePluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *UpdatePluginV1) SetAuthorityAccount(authority common.PublicKey) *UpdatePluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *UpdatePluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *UpdatePluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *UpdatePluginV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *UpdatePluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdatePluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *UpdatePluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdatePluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *UpdatePluginV1) SetProgramId(programId *common.PublicKey) *UpdatePluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdatePluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdatePluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdatePluginV1) Validate() error {
+	if obj.UpdatePluginV1Args == nil {
+		return errors.New("[UpdatePluginV1] updatePluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdatePluginV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[UpdatePluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[UpdatePluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdatePluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdatePluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.UpdatePluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdatePluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.UpdatePluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdatePluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdatePluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("UpdatePluginV1Args", *obj.UpdatePluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// UpdateCollectionPluginV1 Instruction
+// Updates a plugin of a collection
+type UpdateCollectionPluginV1 struct {
+	UpdateCollectionPluginV1Args *UpdateCollectionPluginV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] systemProgram `The system program`
+	// [4] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateCollectionPluginV1InstructionBuilder creates a new `UpdateCollectionPluginV1` instruction builder.
+func NewUpdateCollectionPluginV1InstructionBuilder() *UpdateCollectionPluginV1 {
+	return &UpdateCollectionPluginV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewUpdateCollectionPluginV1Instruction
+//
+// Parameters:
+//
+//	updateCollectionPluginV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewUpdateCollectionPluginV1Instruction(
+	updateCollectionPluginV1Args UpdateCollectionPluginV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *UpdateCollectionPluginV1 {
+	return NewUpdateCollectionPluginV1InstructionBuilder().
+		SetUpdateCollectionPluginV1Args(updateCollectionPluginV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetUpdateCollectionPluginV1Args sets the "updateCollectionPluginV1Args" parameter.
+func (obj *UpdateCollectionPluginV1) SetUpdateCollectionPluginV1Args(updateCollectionPluginV1Args UpdateCollectionPluginV1Args) *UpdateCollectionPluginV1 {
+	obj.UpdateCollectionPluginV1Args = &updateCollectionPluginV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *UpdateCollectionPluginV1) SetCollectionAccount(collection common.PublicKey) *UpdateCollectionPluginV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *UpdateCollectionPluginV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateCollectionPluginV1) SetPayerAccount(payer common.PublicKey) *UpdateCollectionPluginV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateCollectionPluginV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *UpdateCollectionPluginV1) SetAuthorityAccount(authority common.PublicKey) *UpdateCollectionPluginV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *UpdateCollectionPluginV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateCollectionPluginV1) SetSystemProgramAccount(systemProgram common.PublicKey) *UpdateCollectionPluginV1 {
+	obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateCollectionPluginV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateCollectionPluginV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *UpdateCollectionPluginV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateCollectionPluginV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *UpdateCollectionPluginV1) SetProgramId(programId *common.PublicKey) *UpdateCollectionPluginV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateCollectionPluginV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateCollectionPluginV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateCollectionPluginV1) Validate() error {
+	if obj.UpdateCollectionPluginV1Args == nil {
+		return errors.New("[UpdateCollectionPluginV1] updateCollectionPluginV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateCollectionPluginV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateCollectionPluginV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[UpdateCollectionPluginV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateCollectionPluginV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateCollectionPluginV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.UpdateCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionPluginV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.UpdateCollectionPluginV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionPluginV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateCollectionPluginV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("UpdateCollectionPluginV1Args", *obj.UpdateCollectionPluginV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// ApprovePluginAuthorityV1 Instruction
+// Approves the authority of a plugin of an asset
+type ApprovePluginAuthorityV1 struct {
+	ApprovePluginAuthorityV1Args *ApprovePluginAuthorityV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewApprovePluginAuthorityV1InstructionBuilder creates a new `ApprovePluginAuthorityV1` instruction builder.
+func NewApprovePluginAuthorityV1InstructionBuilder() *ApprovePluginAuthorityV1 {
+	return &ApprovePluginAuthorityV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewApprovePluginAuthorityV1Instruction
+//
+// Parameters:
+//
+//	approvePluginAuthorityV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewApprovePluginAuthorityV1Instruction(
+	approvePluginAuthorityV1Args ApprovePluginAuthorityV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *ApprovePluginAuthorityV1 {
+	return NewApprovePluginAuthorityV1InstructionBuilder().
+		SetApprovePluginAuthorityV1Args(approvePluginAuthorityV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetApprovePluginAuthorityV1Args sets the "approvePluginAuthorityV1Args" parameter.
+func (obj *ApprovePluginAuthorityV1) SetApprovePluginAuthorityV1Args(approvePluginAuthorityV1Args ApprovePluginAuthorityV1Args) *ApprovePluginAuthorityV1 {
+	obj.ApprovePluginAuthorityV1Args = &approvePluginAuthorityV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *ApprovePluginAuthorityV1) SetAssetAccount(asset common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *ApprovePluginAuthorityV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *ApprovePluginAuthorityV1) SetCollectionAccount(collection common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *ApprovePluginAuthorityV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *ApprovePluginAuthorityV1) SetPayerAccount(payer common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *ApprovePluginAuthorityV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *ApprovePluginAuthorityV1) SetAuthorityAccount(authority common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *ApprovePluginAuthorityV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *ApprovePluginAuthorityV1) SetSystemProgramAccount(systemProgram common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *ApprovePluginAuthorityV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *ApprovePluginAuthorityV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *ApprovePluginAuthorityV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *ApprovePluginAuthorityV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *ApprovePluginAuthorityV1) SetProgramId(programId *common.PublicKey) *ApprovePluginAuthorityV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ApprovePluginAuthorityV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ApprovePluginAuthorityV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ApprovePluginAuthorityV1) Validate() error {
+	if obj.ApprovePluginAuthorityV1Args == nil {
+		return errors.New("[ApprovePluginAuthorityV1] approvePluginAuthorityV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ApprovePluginAuthorityV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[ApprovePluginAuthorityV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[ApprovePluginAuthorityV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ApprovePluginAuthorityV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ApprovePluginAuthorityV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ApprovePluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApprovePluginAuthorityV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ApprovePluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApprovePluginAuthorityV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ApprovePluginAuthorityV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ApprovePluginAuthorityV1Args", *obj.ApprovePluginAuthorityV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// ApproveCollectionPluginAuthorityV1 Instruction
+// Approves the authority of a plugin of a collection
+type ApproveCollectionPluginAuthorityV1 struct {
+	ApproveCollectionPluginAuthorityV1Args *ApproveCollectionPluginAuthorityV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] systemProgram `The system program`
+	// [4] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewApproveCollectionPluginAuthorityV1InstructionBuilder creates a new `ApproveCollectionPluginAuthorityV1` instruction builder.
+func NewApproveCollectionPluginAuthorityV1InstructionBuilder() *ApproveCollectionPluginAuthorityV1 {
+	return &ApproveCollectionPluginAuthorityV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewApproveCollectionPluginAuthorityV1Instruction
+//
+// Parameters:
+//
+//	approveCollectionPluginAuthorityV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewApproveCollectionPluginAuthorityV1Instruction(
+	approveCollectionPluginAuthorityV1Args ApproveCollectionPluginAuthorityV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *ApproveCollectionPluginAuthorityV1 {
+	return NewApproveCollectionPluginAuthorityV1InstructionBuilder().
+		SetApproveCollectionPluginAuthorityV1Args(approveCollectionPluginAuthorityV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetApproveCollectionPluginAuthorityV1Args sets the "approveCollectionPluginAuthorityV1Args" parameter.
+func (obj *ApproveCollectionPluginAuthorityV1) SetApproveCollectionPluginAuthorityV1Args(approveCollectionPluginAuthorityV1Args ApproveCollectionPluginAuthorityV1Args) *ApproveCollectionPluginAuthorityV1 {
+	obj.ApproveCollectionPluginAuthorityV1Args = &approveCollectionPluginAuthorityV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *ApproveCollectionPluginAuthorityV1) SetCollectionAccount(collection common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *ApproveCollectionPluginAuthorityV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *ApproveCollectionPluginAuthorityV1) SetPayerAccount(payer common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *ApproveCollectionPluginAuthorityV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *ApproveCollectionPluginAuthorityV1) SetAuthorityAccount(authority common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *ApproveCollectionPluginAuthorityV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *ApproveCollectionPluginAuthorityV1) SetSystemProgramAccount(systemProgram common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *ApproveCollectionPluginAuthorityV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *ApproveCollectionPluginAuthorityV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *ApproveCollectionPluginAuthorityV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) SetProgramId(programId *common.PublicKey) *ApproveCollectionPluginAuthorityV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ApproveCollectionPluginAuthorityV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) Validate() error {
+	if obj.ApproveCollectionPluginAuthorityV1Args == nil {
+		return errors.New("[ApproveCollectionPluginAuthorityV1] approveCollectionPluginAuthorityV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ApproveCollectionPluginAuthorityV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[ApproveCollectionPluginAuthorityV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[ApproveCollectionPluginAuthorityV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ApproveCollectionPluginAuthorityV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ApproveCollectionPluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ApproveCollectionPluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ApproveCollectionPluginAuthorityV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ApproveCollectionPluginAuthorityV1Args", *obj.ApproveCollectionPluginAuthorityV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// RevokePluginAuthorityV1 Instruction
+// Revokes the authority of a plugin of an asset
+type RevokePluginAuthorityV1 struct {
+	RevokePluginAuthorityV1Args *RevokePluginAuthorityV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewRevokePluginAuthorityV1InstructionBuilder creates a new `RevokePluginAuthorityV1` instruction builder.
+func NewRevokePluginAuthorityV1InstructionBuilder() *RevokePluginAuthorityV1 {
+	return &RevokePluginAuthorityV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewRevokePluginAuthorityV1Instruction
+//
+// Parameters:
+//
+//	revokePluginAuthorityV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewRevokePluginAuthorityV1Instruction(
+	revokePluginAuthorityV1Args RevokePluginAuthorityV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *RevokePluginAuthorityV1 {
+	return NewRevokePluginAuthorityV1InstructionBuilder().
+		SetRevokePluginAuthorityV1Args(revokePluginAuthorityV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetRevokePluginAuthorityV1Args sets the "revokePluginAuthorityV1Args" parameter.
+func (obj *RevokePluginAuthorityV1) SetRevokePluginAuthorityV1Args(revokePluginAuthorityV1Args RevokePluginAuthorityV1Args) *RevokePluginAuthorityV1 {
+	obj.RevokePluginAuthorityV1Args = &revokePluginAuthorityV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *RevokePluginAuthorityV1) SetAssetAccount(asset common.PublicKey) *RevokePluginAuthorityV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *RevokePluginAuthorityV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *RevokePluginAuthorityV1) SetCollectionAccount(collection common.PublicKey) *RevokePluginAuthorityV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *RevokePluginAuthorityV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RevokePluginAuthorityV1) SetPayerAccount(payer common.PublicKey) *RevokePluginAuthorityV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RevokePluginAuthorityV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *RevokePluginAuthorityV1) SetAuthorityAccount(authority common.PublicKey) *RevokePluginAuthorityV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *RevokePluginAuthorityV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *RevokePluginAuthorityV1) SetSystemProgramAccount(systemProgram common.PublicKey) *RevokePluginAuthorityV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *RevokePluginAuthorityV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RevokePluginAuthorityV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *RevokePluginAuthorityV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RevokePluginAuthorityV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *RevokePluginAuthorityV1) SetProgramId(programId *common.PublicKey) *RevokePluginAuthorityV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *RevokePluginAuthorityV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_RevokePluginAuthorityV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *RevokePluginAuthorityV1) Validate() error {
+	if obj.RevokePluginAuthorityV1Args == nil {
+		return errors.New("[RevokePluginAuthorityV1] revokePluginAuthorityV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[RevokePluginAuthorityV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[RevokePluginAuthorityV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[RevokePluginAuthorityV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *RevokePluginAuthorityV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *RevokePluginAuthorityV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.RevokePluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokePluginAuthorityV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.RevokePluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokePluginAuthorityV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RevokePluginAuthorityV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("RevokePluginAuthorityV1Args", *obj.RevokePluginAuthorityV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// RevokeCollectionPluginAuthorityV1 Instruction
+// Revokes the authority of a plugin of a collection
+type RevokeCollectionPluginAuthorityV1 struct {
+	RevokeCollectionPluginAuthorityV1Args *RevokeCollectionPluginAuthorityV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] systemProgram `The system program`
+	// [4] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewRevokeCollectionPluginAuthorityV1InstructionBuilder creates a new `RevokeCollectionPluginAuthorityV1` instruction builder.
+func NewRevokeCollectionPluginAuthorityV1InstructionBuilder() *RevokeCollectionPluginAuthorityV1 {
+	return &RevokeCollectionPluginAuthorityV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewRevokeCollectionPluginAuthorityV1Instruction
+//
+// Parameters:
+//
+//	revokeCollectionPluginAuthorityV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewRevokeCollectionPluginAuthorityV1Instruction(
+	revokeCollectionPluginAuthorityV1Args RevokeCollectionPluginAuthorityV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *RevokeCollectionPluginAuthorityV1 {
+	return NewRevokeCollectionPluginAuthorityV1InstructionBuilder().
+		SetRevokeCollectionPluginAuthorityV1Args(revokeCollectionPluginAuthorityV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetRevokeCollectionPluginAuthorityV1Args sets the "revokeCollectionPluginAuthorityV1Args" parameter.
+func (obj *RevokeCollectionPluginAuthorityV1) SetRevokeCollectionPluginAuthorityV1Args(revokeCollectionPluginAuthorityV1Args RevokeCollectionPluginAuthorityV1Args) *RevokeCollectionPluginAuthorityV1 {
+	obj.RevokeCollectionPluginAuthorityV1Args = &revokeCollectionPluginAuthorityV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *RevokeCollectionPluginAuthorityV1) SetCollectionAccount(collection common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *RevokeCollectionPluginAuthorityV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RevokeCollectionPluginAuthorityV1) SetPayerAccount(payer common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *RevokeCollectionPluginAuthorityV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *RevokeCollectionPluginAuthorityV1) SetAuthorityAccount(authority common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *RevokeCollectionPluginAuthorityV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *RevokeCollectionPluginAuthorityV1) SetSystemProgramAccount(systemProgram common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *RevokeCollectionPluginAuthorityV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RevokeCollectionPluginAuthorityV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *RevokeCollectionPluginAuthorityV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) SetProgramId(programId *common.PublicKey) *RevokeCollectionPluginAuthorityV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_RevokeCollectionPluginAuthorityV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) Validate() error {
+	if obj.RevokeCollectionPluginAuthorityV1Args == nil {
+		return errors.New("[RevokeCollectionPluginAuthorityV1] revokeCollectionPluginAuthorityV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[RevokeCollectionPluginAuthorityV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[RevokeCollectionPluginAuthorityV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[RevokeCollectionPluginAuthorityV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *RevokeCollectionPluginAuthorityV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.RevokeCollectionPluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.RevokeCollectionPluginAuthorityV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RevokeCollectionPluginAuthorityV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("RevokeCollectionPluginAuthorityV1Args", *obj.RevokeCollectionPluginAuthorityV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// BurnV1 Instruction
+// Burns an asset
+type BurnV1 struct {
+	BurnV1Args *BurnV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnV1InstructionBuilder creates a new `BurnV1` instruction builder.
+func NewBurnV1InstructionBuilder() *BurnV1 {
+	return &BurnV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewBurnV1Instruction
+//
+// Parameters:
+//
+//	burnV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewBurnV1Instruction(
+	burnV1Args BurnV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *BurnV1 {
+	return NewBurnV1InstructionBuilder().
+		SetBurnV1Args(burnV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetBurnV1Args sets the "burnV1Args" parameter.
+func (obj *BurnV1) SetBurnV1Args(burnV1Args BurnV1Args) *BurnV1 {
+	obj.BurnV1Args = &burnV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *BurnV1) SetAssetAccount(asset common.PublicKey) *BurnV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *BurnV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *BurnV1) SetCollectionAccount(collection common.PublicKey) *BurnV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *BurnV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *BurnV1) SetPayerAccount(payer common.PublicKey) *BurnV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *BurnV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *BurnV1) SetAuthorityAccount(authority common.PublicKey) *BurnV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *BurnV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *BurnV1) SetSystemProgramAccount(systemProgram common.PublicKey) *BurnV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *BurnV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *BurnV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *BurnV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *BurnV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *BurnV1) SetProgramId(programId *common.PublicKey) *BurnV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *BurnV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_BurnV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *BurnV1) Validate() error {
+	if obj.BurnV1Args == nil {
+		return errors.New("[BurnV1] burnV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[BurnV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[BurnV1] accounts.payer is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *BurnV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *BurnV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.BurnV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.BurnV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("BurnV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("BurnV1Args", *obj.BurnV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// BurnCollectionV1 Instruction
+// Burns an empty collection
+type BurnCollectionV1 struct {
+	BurnCollectionV1Args *BurnCollectionV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The owner or delegate of the collection`
+	// [3] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnCollectionV1InstructionBuilder creates a new `BurnCollectionV1` instruction builder.
+func NewBurnCollectionV1InstructionBuilder() *BurnCollectionV1 {
+	return &BurnCollectionV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewBurnCollectionV1Instruction
+//
+// Parameters:
+//
+//	burnCollectionV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the collection
+//	logWrapper: The SPL Noop Program
+func NewBurnCollectionV1Instruction(
+	burnCollectionV1Args BurnCollectionV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	logWrapper common.PublicKey,
+) *BurnCollectionV1 {
+	return NewBurnCollectionV1InstructionBuilder().
+		SetBurnCollectionV1Args(burnCollectionV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetBurnCollectionV1Args sets the "burnCollectionV1Args" parameter.
+func (obj *BurnCollectionV1) SetBurnCollectionV1Args(burnCollectionV1Args BurnCollectionV1Args) *BurnCollectionV1 {
+	obj.BurnCollectionV1Args = &burnCollectionV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *BurnCollectionV1) SetCollectionAccount(collection common.PublicKey) *BurnCollectionV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *BurnCollectionV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *BurnCollectionV1) SetPayerAccount(payer common.PublicKey) *BurnCollectionV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *BurnCollectionV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *BurnCollectionV1) SetAuthorityAccount(authority common.PublicKey) *BurnCollectionV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the collection
+func (obj *BurnCollectionV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *BurnCollectionV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *BurnCollectionV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *BurnCollectionV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *BurnCollectionV1) SetProgramId(programId *common.PublicKey) *BurnCollectionV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *BurnCollectionV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_BurnCollectionV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *BurnCollectionV1) Validate() error {
+	if obj.BurnCollectionV1Args == nil {
+		return errors.New("[BurnCollectionV1] burnCollectionV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[BurnCollectionV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[BurnCollectionV1] accounts.payer is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *BurnCollectionV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *BurnCollectionV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.BurnCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnCollectionV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.BurnCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnCollectionV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("BurnCollectionV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("BurnCollectionV1Args", *obj.BurnCollectionV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("     payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta(" authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("logWrapper", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// TransferV1 Instruction
+// Transfers an asset
+type TransferV1 struct {
+	TransferV1Args *TransferV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] newOwner `The new owner to which to transfer the asset`
+	// [5] = [] systemProgram `The system program`
+	// [6] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewTransferV1InstructionBuilder creates a new `TransferV1` instruction builder.
+func NewTransferV1InstructionBuilder() *TransferV1 {
+	return &TransferV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 7),
+	}
+}
+
+// NewTransferV1Instruction
+//
+// Parameters:
+//
+//	transferV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	newOwner: The new owner to which to transfer the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewTransferV1Instruction(
+	transferV1Args TransferV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	newOwner common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *TransferV1 {
+	return NewTransferV1InstructionBuilder().
+		SetTransferV1Args(transferV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetNewOwnerAccount(newOwner).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetTransferV1Args sets the "transferV1Args" parameter.
+func (obj *TransferV1) SetTransferV1Args(transferV1Args TransferV1Args) *TransferV1 {
+	obj.TransferV1Args = &transferV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *TransferV1) SetAssetAccount(asset common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *TransferV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *TransferV1) SetCollectionAccount(collection common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection)
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *TransferV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *TransferV1) SetPayerAccount(payer common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *TransferV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *TransferV1) SetAuthorityAccount(authority common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *TransferV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetNewOwnerAccount sets the "newOwner" parameter.
+// The new owner to which to transfer the asset
+func (obj *TransferV1) SetNewOwnerAccount(newOwner common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[4] = common.Meta(newOwner)
+	return obj
+}
+
+// GetNewOwnerAccount gets the "newOwner" parameter.
+// The new owner to which to transfer the asset
+func (obj *TransferV1) GetNewOwnerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *TransferV1) SetSystemProgramAccount(systemProgram common.PublicKey) *TransferV1 {
+	obj.AccountMetaSlice[5] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *TransferV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *TransferV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *TransferV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[6] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[6] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *TransferV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+func (obj *TransferV1) SetProgramId(programId *common.PublicKey) *TransferV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *TransferV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_TransferV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *TransferV1) Validate() error {
+	if obj.TransferV1Args == nil {
+		return errors.New("[TransferV1] transferV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[TransferV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[TransferV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[TransferV1] accounts.newOwner is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *TransferV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *TransferV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.TransferV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *TransferV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.TransferV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *TransferV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("TransferV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("TransferV1Args", *obj.TransferV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("     newOwner", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+// UpdateV1 Instruction
+// Updates the name, the uri or the update authority of an asset
+type UpdateV1 struct {
+	UpdateV1Args *UpdateV1Args
+	// [0] = [WRITE] asset `The address of the asset`
+	// [1] = [WRITE] collection `The collection to which the asset belongs`
+	// [2] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [3] = [SIGNER] authority `The owner or delegate of the asset`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateV1InstructionBuilder creates a new `UpdateV1` instruction builder.
+func NewUpdateV1InstructionBuilder() *UpdateV1 {
+	return &UpdateV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewUpdateV1Instruction
+//
+// Parameters:
+//
+//	updateV1Args:
+//	asset: The address of the asset
+//	collection: The collection to which the asset belongs
+//	payer: The account paying for the storage fees
+//	authority: The owner or delegate of the asset
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewUpdateV1Instruction(
+	updateV1Args UpdateV1Args,
+	asset common.PublicKey,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *UpdateV1 {
+	return NewUpdateV1InstructionBuilder().
+		SetUpdateV1Args(updateV1Args).
+		SetAssetAccount(asset).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetUpdateV1Args sets the "updateV1Args" parameter.
+func (obj *UpdateV1) SetUpdateV1Args(updateV1Args UpdateV1Args) *UpdateV1 {
+	obj.UpdateV1Args = &updateV1Args
+	return obj
+}
+
+// SetAssetAccount sets the "asset" parameter.
+// The address of the asset
+func (obj *UpdateV1) SetAssetAccount(asset common.PublicKey) *UpdateV1 {
+	obj.AccountMetaSlice[0] = common.Meta(asset).WRITE()
+	return obj
+}
+
+// GetAssetAccount gets the "asset" parameter.
+// The address of the asset
+func (obj *UpdateV1) GetAssetAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *UpdateV1) SetCollectionAccount(collection common.PublicKey) *UpdateV1 {
+	obj.AccountMetaSlice[1] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The collection to which the asset belongs
+func (obj *UpdateV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateV1) SetPayerAccount(payer common.PublicKey) *UpdateV1 {
+	obj.AccountMetaSlice[2] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *UpdateV1) SetAuthorityAccount(authority common.PublicKey) *UpdateV1 {
+	obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The owner or delegate of the asset
+func (obj *UpdateV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateV1) SetSystemProgramAccount(systemProgram common.PublicKey) *UpdateV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *UpdateV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *UpdateV1) SetProgramId(programId *common.PublicKey) *UpdateV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateV1) Validate() error {
+	if obj.UpdateV1Args == nil {
+		return errors.New("[UpdateV1] updateV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateV1] accounts.asset is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[UpdateV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[UpdateV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.UpdateV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.UpdateV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("UpdateV1Args", *obj.UpdateV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        asset", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("   collection", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        payer", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("   logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// UpdateCollectionV1 Instruction
+// Updates the name, the uri or the update authority of a collection
+type UpdateCollectionV1 struct {
+	UpdateCollectionV1Args *UpdateCollectionV1Args
+	// [0] = [WRITE] collection `The address of the collection`
+	// [1] = [WRITE, SIGNER] payer `The account paying for the storage fees`
+	// [2] = [SIGNER] authority `The update authority or update authority delegate of the collection`
+	// [3] = [] newUpdateAuthority `The new update authority of the collection`
+	// [4] = [] systemProgram `The system program`
+	// [5] = [] logWrapper `The SPL Noop Program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateCollectionV1InstructionBuilder creates a new `UpdateCollectionV1` instruction builder.
+func NewUpdateCollectionV1InstructionBuilder() *UpdateCollectionV1 {
+	return &UpdateCollectionV1{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewUpdateCollectionV1Instruction
+//
+// Parameters:
+//
+//	updateCollectionV1Args:
+//	collection: The address of the collection
+//	payer: The account paying for the storage fees
+//	authority: The update authority or update authority delegate of the collection
+//	newUpdateAuthority: The new update authority of the collection
+//	systemProgram: The system program
+//	logWrapper: The SPL Noop Program
+func NewUpdateCollectionV1Instruction(
+	updateCollectionV1Args UpdateCollectionV1Args,
+	collection common.PublicKey,
+	payer common.PublicKey,
+	authority common.PublicKey,
+	newUpdateAuthority common.PublicKey,
+	systemProgram common.PublicKey,
+	logWrapper common.PublicKey,
+) *UpdateCollectionV1 {
+	return NewUpdateCollectionV1InstructionBuilder().
+		SetUpdateCollectionV1Args(updateCollectionV1Args).
+		SetCollectionAccount(collection).
+		SetPayerAccount(payer).
+		SetAuthorityAccount(authority).
+		SetNewUpdateAuthorityAccount(newUpdateAuthority).
+		SetSystemProgramAccount(systemProgram).
+		SetLogWrapperAccount(logWrapper)
+}
+
+// SetUpdateCollectionV1Args sets the "updateCollectionV1Args" parameter.
+func (obj *UpdateCollectionV1) SetUpdateCollectionV1Args(updateCollectionV1Args UpdateCollectionV1Args) *UpdateCollectionV1 {
+	obj.UpdateCollectionV1Args = &updateCollectionV1Args
+	return obj
+}
+
+// SetCollectionAccount sets the "collection" parameter.
+// The address of the collection
+func (obj *UpdateCollectionV1) SetCollectionAccount(collection common.PublicKey) *UpdateCollectionV1 {
+	obj.AccountMetaSlice[0] = common.Meta(collection).WRITE()
+	return obj
+}
+
+// GetCollectionAccount gets the "collection" parameter.
+// The address of the collection
+func (obj *UpdateCollectionV1) GetCollectionAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetPayerAccount sets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateCollectionV1) SetPayerAccount(payer common.PublicKey) *UpdateCollectionV1 {
+	obj.AccountMetaSlice[1] = common.Meta(payer).WRITE().SIGNER()
+	return obj
+}
+
+// GetPayerAccount gets the "payer" parameter.
+// The account paying for the storage fees
+func (obj *UpdateCollectionV1) GetPayerAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The update authority or update authority delegate of the collection
+func (obj *UpdateCollectionV1) SetAuthorityAccount(authority common.PublicKey) *UpdateCollectionV1 {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The update authority or update authority delegate of the collection
+func (obj *UpdateCollectionV1) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetNewUpdateAuthorityAccount sets the "newUpdateAuthority" parameter.
+// The new update authority of the collection
+func (obj *UpdateCollectionV1) SetNewUpdateAuthorityAccount(newUpdateAuthority common.PublicKey) *UpdateCollectionV1 {
+	obj.AccountMetaSlice[3] = common.Meta(newUpdateAuthority)
+	return obj
+}
+
+// GetNewUpdateAuthorityAccount gets the "newUpdateAuthority" parameter.
+// The new update authority of the collection
+func (obj *UpdateCollectionV1) GetNewUpdateAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateCollectionV1) SetSystemProgramAccount(systemProgram common.PublicKey) *UpdateCollectionV1 {
+	obj.AccountMetaSlice[4] = common.Meta(systemProgram)
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// The system program
+func (obj *UpdateCollectionV1) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetLogWrapperAccount sets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateCollectionV1) SetLogWrapperAccount(logWrapper common.PublicKey, multiSigners ...common.PublicKey) *UpdateCollectionV1 {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(logWrapper)
+	}
+	return obj
+}
+
+// GetLogWrapperAccount gets the "logWrapper" parameter.
+// The SPL Noop Program
+func (obj *UpdateCollectionV1) GetLogWrapperAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *UpdateCollectionV1) SetProgramId(programId *common.PublicKey) *UpdateCollectionV1 {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateCollectionV1) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateCollectionV1}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateCollectionV1) Validate() error {
+	if obj.UpdateCollectionV1Args == nil {
+		return errors.New("[UpdateCollectionV1] updateCollectionV1Args param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateCollectionV1] accounts.collection is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateCollectionV1] accounts.payer is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[UpdateCollectionV1] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateCollectionV1) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateCollectionV1) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.UpdateCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionV1) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.UpdateCollectionV1Args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionV1) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateCollectionV1")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("UpdateCollectionV1Args", *obj.UpdateCollectionV1Args))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("        collection", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("             payer", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("         authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("newUpdateAuthority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("     systemProgram", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("        logWrapper", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
@@ -0,0 +1,239 @@
+package mpl_core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	binary "github.com/gagliardetto/binary"
+)
+
+var (
+	ErrInvalidAccountOwner = errors.New("mpl_core: the account is not owned by the program")
+	ErrUnexpectedAccount   = errors.New("mpl_core: unexpected account key")
+	ErrInvalidPluginHeader = errors.New("mpl_core: invalid plugin header")
+)
+
+// PluginRecord a plugin of an asset or a collection, with its authority and its offset in the account
+type PluginRecord struct {
+	Type      PluginType
+	Authority Authority
+	Offset    uint64
+	Plugin    Plugin
+}
+
+// Plugins the plugins of an asset or a collection, decoded from the plugin registry
+type Plugins struct {
+	Header   *PluginHeaderV1
+	Registry *PluginRegistryV1
+	Records  []PluginRecord
+}
+
+// Get the plugin of the type, nil if absent
+func (p *Plugins) Get(pluginType PluginType) *PluginRecord {
+	if p == nil {
+		return nil
+	}
+	for i := range p.Records {
+		if p.Records[i].Type == pluginType {
+			return &p.Records[i]
+		}
+	}
+	return nil
+}
+
+// AssetV1 a Core asset with its plugins
+type AssetV1 struct {
+	BaseAssetV1
+	Plugins *Plugins
+}
+
+// Collection the collection of the asset, nil if the asset is not in a collection
+func (a *AssetV1) Collection() *common.PublicKey {
+	if a.UpdateAuthority.IsCollection() {
+		key := a.UpdateAuthority.AsCollection().Field0
+		return &key
+	}
+	return nil
+}
+
+// IsFrozen returns true if the asset is frozen by the FreezeDelegate or the PermanentFreezeDelegate plugin.
+// The PermanentFreezeDelegate of the collection also freezes the asset, see CollectionV1.IsFrozen
+func (a *AssetV1) IsFrozen() bool {
+	return isFrozen(a.Plugins)
+}
+
+// CollectionV1 a Core collection with its plugins
+type CollectionV1 struct {
+	BaseCollectionV1
+	Plugins *Plugins
+}
+
+// IsFrozen returns true if the assets of the collection are frozen by the PermanentFreezeDelegate plugin
+func (c *CollectionV1) IsFrozen() bool {
+	if record := c.Plugins.Get(PluginTypePermanentFreezeDelegate); record != nil {
+		return record.Plugin.AsPermanentFreezeDelegate().Field0.Frozen
+	}
+	return false
+}
+
+// IsPermanentTransferDelegate returns true if the address is the authority of the PermanentTransferDelegate plugin of the asset,
+// or of the collection of the asset (nil if the asset is not in a collection). The permanent delegate transfers a frozen asset
+func (a *AssetV1) IsPermanentTransferDelegate(address common.PublicKey, collection *CollectionV1) bool {
+	var updateAuthority *common.PublicKey
+	if a.UpdateAuthority.IsAddress() {
+		key := a.UpdateAuthority.AsAddress().Field0
+		updateAuthority = &key
+	} else if a.UpdateAuthority.IsCollection() && collection != nil {
+		updateAuthority = &collection.UpdateAuthority
+	}
+	isAuthority := func(plugins *Plugins) bool {
+		record := plugins.Get(PluginTypePermanentTransferDelegate)
+		if record == nil {
+			return false
+		}
+		switch {
+		case record.Authority.IsAddress():
+			return record.Authority.AsAddress().Address == address
+		case record.Authority.IsOwner():
+			return a.Owner == address
+		case record.Authority.IsUpdateAuthority():
+			return updateAuthority != nil && *updateAuthority == address
+		}
+		return false
+	}
+	if isAuthority(a.Plugins) {
+		return true
+	}
+	return collection != nil && isAuthority(collection.Plugins)
+}
+
+func isFrozen(plugins *Plugins) bool {
+	if record := plugins.Get(PluginTypeFreezeDelegate); record != nil && record.Plugin.AsFreezeDelegate().Field0.Frozen {
+		return true
+	}
+	if record := plugins.Get(PluginTypePermanentFreezeDelegate); record != nil && record.Plugin.AsPermanentFreezeDelegate().Field0.Frozen {
+		return true
+	}
+	return false
+}
+
+// decodePlugins decodes the plugin header following the base of the account, its registry and the plugins, nil if the account has no plugin
+func decodePlugins(data []byte, offset uint) (*Plugins, error) {
+	if offset >= uint(len(data)) {
+		return nil, nil
+	}
+	decoder := binary.NewBorshDecoder(data)
+	if err := decoder.SetPosition(offset); err != nil {
+		return nil, err
+	}
+	var ret Plugins
+	if err := decoder.Decode(&ret.Header); err != nil {
+		return nil, err
+	}
+	if ret.Header.Key != KeyPluginHeaderV1 || ret.Header.PluginRegistryOffset >= uint64(len(data)) {
+		return nil, ErrInvalidPluginHeader
+	}
+	if err := decoder.SetPosition(uint(ret.Header.PluginRegistryOffset)); err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(&ret.Registry); err != nil {
+		return nil, err
+	}
+	if ret.Registry.Key != KeyPluginRegistryV1 {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedAccount, ret.Registry.Key)
+	}
+	for _, record := range ret.Registry.Registry {
+		var plugin Plugin
+		if err := decoder.SetPosition(uint(record.Offset)); err != nil {
+			return nil, err
+		}
+		if err := decoder.Decode(&plugin); err != nil {
+			return nil, fmt.Errorf("mpl_core: decode the plugin %s: %w", record.PluginType, err)
+		}
+		ret.Records = append(ret.Records, PluginRecord{
+			Type:      record.PluginType,
+			Authority: record.Authority,
+			Offset:    record.Offset,
+			Plugin:    plugin,
+		})
+	}
+	return &ret, nil
+}
+
+// DecodeAsset decodes an AssetV1 account and its plugins
+func DecodeAsset(data []byte) (*AssetV1, error) {
+	if len(data) == 0 {
+		return nil, ErrUnexpectedAccount
+	}
+	if Key(data[0]) != KeyAssetV1 {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedAccount, Key(data[0]))
+	}
+	decoder := binary.NewBorshDecoder(data)
+	var ret AssetV1
+	if err := decoder.Decode(&ret.BaseAssetV1); err != nil {
+		return nil, err
+	}
+	plugins, err := decodePlugins(data, decoder.Position())
+	if err != nil {
+		return nil, err
+	}
+	ret.Plugins = plugins
+	return &ret, nil
+}
+
+// DecodeCollection decodes a CollectionV1 account and its plugins
+func DecodeCollection(data []byte) (*CollectionV1, error) {
+	if len(data) == 0 {
+		return nil, ErrUnexpectedAccount
+	}
+	if Key(data[0]) != KeyCollectionV1 {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedAccount, Key(data[0]))
+	}
+	decoder := binary.NewBorshDecoder(data)
+	var ret CollectionV1
+	if err := decoder.Decode(&ret.BaseCollectionV1); err != nil {
+		return nil, err
+	}
+	plugins, err := decodePlugins(data, decoder.Position())
+	if err != nil {
+		return nil, err
+	}
+	ret.Plugins = plugins
+	return &ret, nil
+}
+
+func fetchAccountData(connection *web3.Connection, address web3.PublicKey, commitment *web3.Commitment) ([]byte, error) {
+	info, err := connection.GetAccountInfo(address, web3.GetAccountInfoConfig{Commitment: commitment})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if info.Owner != ProgramID {
+		return nil, ErrInvalidAccountOwner
+	}
+	return info.Data.Content, nil
+}
+
+// FetchAsset fetches and decodes the asset, nil if the account does not exist
+func FetchAsset(ctx context.Context, connection *web3.Connection, address web3.PublicKey, commitment *web3.Commitment) (*AssetV1, error) {
+	_ = ctx
+	data, err := fetchAccountData(connection, address, commitment)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return DecodeAsset(data)
+}
+
+// FetchCollection fetches and decodes the collection, nil if the account does not exist
+func FetchCollection(ctx context.Context, connection *web3.Connection, address web3.PublicKey, commitment *web3.Commitment) (*CollectionV1, error) {
+	_ = ctx
+	data, err := fetchAccountData(connection, address, commitment)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return DecodeCollection(data)
+}
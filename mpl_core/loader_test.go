@@ -0,0 +1,114 @@
+package mpl_core
+
+import (
+	"errors"
+	"github.com/donutnomad/solana-web3/web3"
+	binary "github.com/gagliardetto/binary"
+	"testing"
+)
+
+func mustMarshal(t *testing.T, v any) []byte {
+	data, err := binary.MarshalBorsh(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDecodeAsset(t *testing.T) {
+	owner := web3.Keypair.Generate().PublicKey()
+	collection := web3.Keypair.Generate().PublicKey()
+	base := BaseAssetV1{
+		Key:             KeyAssetV1,
+		Owner:           owner,
+		UpdateAuthority: NewUpdateAuthority_Collection(collection),
+		Name:            "Core #1",
+		Uri:             "https://example.com/1.json",
+	}
+	data := mustMarshal(t, &base)
+
+	asset, err := DecodeAsset(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Plugins != nil || asset.Owner != owner || *asset.Collection() != collection || asset.IsFrozen() {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+
+	// the plugin header, the plugins and the registry follow the base
+	headerOffset := len(data)
+	pluginsOffset := headerOffset + PLUGIN_HEADER_V1_SIZE
+	freeze := NewPlugin_FreezeDelegate(FreezeDelegate{Frozen: true})
+	attributes := NewPlugin_Attributes(Attributes{AttributeList: []Attribute{{Key: "level", Value: "7"}}})
+	plugins := append(mustMarshal(t, &freeze), mustMarshal(t, &attributes)...)
+	registry := PluginRegistryV1{
+		Key: KeyPluginRegistryV1,
+		Registry: []RegistryRecord{
+			{PluginType: PluginTypeFreezeDelegate, Authority: NewAuthority_Owner(), Offset: uint64(pluginsOffset)},
+			{PluginType: PluginTypeAttributes, Authority: NewAuthority_UpdateAuthority(), Offset: uint64(pluginsOffset + len(mustMarshal(t, &freeze)))},
+		},
+	}
+	header := PluginHeaderV1{Key: KeyPluginHeaderV1, PluginRegistryOffset: uint64(pluginsOffset + len(plugins))}
+	data = append(data, mustMarshal(t, &header)...)
+	data = append(data, plugins...)
+	data = append(data, mustMarshal(t, &registry)...)
+
+	asset, err = DecodeAsset(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asset.Name != base.Name || len(asset.Plugins.Records) != 2 || !asset.IsFrozen() {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	record := asset.Plugins.Get(PluginTypeAttributes)
+	if record == nil || !record.Authority.IsUpdateAuthority() || record.Plugin.AsAttributes().Field0.AttributeList[0].Value != "7" {
+		t.Fatalf("unexpected attributes %+v", record)
+	}
+	if asset.Plugins.Get(PluginTypeRoyalties) != nil {
+		t.Fatal("unexpected royalties")
+	}
+
+	if _, err := DecodeCollection(data); !errors.Is(err, ErrUnexpectedAccount) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	header.PluginRegistryOffset = uint64(len(data))
+	copy(data[headerOffset:], mustMarshal(t, &header))
+	if _, err := DecodeAsset(data); !errors.Is(err, ErrInvalidPluginHeader) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestIsPermanentTransferDelegate(t *testing.T) {
+	owner := web3.Keypair.Generate().PublicKey()
+	delegate := web3.Keypair.Generate().PublicKey()
+	updateAuthority := web3.Keypair.Generate().PublicKey()
+	permanent := func(authority Authority) *Plugins {
+		return &Plugins{Records: []PluginRecord{{
+			Type:      PluginTypePermanentTransferDelegate,
+			Authority: authority,
+			Plugin:    NewPlugin_PermanentTransferDelegate(PermanentTransferDelegate{}),
+		}}}
+	}
+	asset := AssetV1{BaseAssetV1: BaseAssetV1{Key: KeyAssetV1, Owner: owner, UpdateAuthority: NewUpdateAuthority_Address(updateAuthority)}}
+	if asset.IsPermanentTransferDelegate(owner, nil) {
+		t.Fatal("unexpected delegate without the plugin")
+	}
+	asset.Plugins = permanent(NewAuthority_Address(delegate))
+	if !asset.IsPermanentTransferDelegate(delegate, nil) || asset.IsPermanentTransferDelegate(owner, nil) {
+		t.Fatal("unexpected address delegate")
+	}
+	asset.Plugins = permanent(NewAuthority_UpdateAuthority())
+	if !asset.IsPermanentTransferDelegate(updateAuthority, nil) || asset.IsPermanentTransferDelegate(delegate, nil) {
+		t.Fatal("unexpected update authority delegate")
+	}
+
+	// the update authority of an asset in a collection is the update authority of the collection
+	collectionKey := web3.Keypair.Generate().PublicKey()
+	collection := CollectionV1{BaseCollectionV1: BaseCollectionV1{Key: KeyCollectionV1, UpdateAuthority: updateAuthority}}
+	asset.UpdateAuthority = NewUpdateAuthority_Collection(collectionKey)
+	asset.Plugins = nil
+	collection.Plugins = permanent(NewAuthority_UpdateAuthority())
+	if !asset.IsPermanentTransferDelegate(updateAuthority, &collection) || asset.IsPermanentTransferDelegate(updateAuthority, nil) {
+		t.Fatal("unexpected collection delegate")
+	}
+}
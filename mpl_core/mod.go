@@ -0,0 +1,226 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_core
+
+import (
+	"bytes"
+	"fmt"
+	spew "github.com/davecgh/go-spew/spew"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+	text "github.com/gagliardetto/solana-go/text"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+var ProgramID common.PublicKey = common.MustPublicKeyFromBase58("CoREENxT6tW1HoK8ypY1SxRMZTcVPm7R94rH4PZNhX7d")
+
+func SetProgramID(pubkey common.PublicKey) {
+	ProgramID = pubkey
+	if !common.IsZero(ProgramID) {
+		solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)
+	}
+}
+
+const ProgramName = "mpl_core"
+
+func init() {
+	if !common.IsZero(ProgramID) {
+		solanago.RegisterInstructionDecoder(common.As(ProgramID), registryDecodeInstruction)
+	}
+}
+
+func btou32(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var (
+	Instruction_CreateV1                           uint8 = 0
+	Instruction_CreateCollectionV1                 uint8 = 1
+	Instruction_AddPluginV1                        uint8 = 2
+	Instruction_AddCollectionPluginV1              uint8 = 3
+	Instruction_RemovePluginV1                     uint8 = 4
+	Instruction_RemoveCollectionPluginV1           uint8 = 5
+	Instruction_UpdatePluginV1                     uint8 = 6
+	Instruction_UpdateCollectionPluginV1           uint8 = 7
+	Instruction_ApprovePluginAuthorityV1           uint8 = 8
+	Instruction_ApproveCollectionPluginAuthorityV1 uint8 = 9
+	Instruction_RevokePluginAuthorityV1            uint8 = 10
+	Instruction_RevokeCollectionPluginAuthorityV1  uint8 = 11
+	Instruction_BurnV1                             uint8 = 12
+	Instruction_BurnCollectionV1                   uint8 = 13
+	Instruction_TransferV1                         uint8 = 14
+	Instruction_UpdateV1                           uint8 = 15
+	Instruction_UpdateCollectionV1                 uint8 = 16
+)
+
+var InstructionImplDef = binary.NewVariantDefinition(binary.Uint8TypeIDEncoding, []binary.VariantType{
+	{
+		"create_v1", (*CreateV1)(nil),
+	},
+	{
+		"create_collection_v1", (*CreateCollectionV1)(nil),
+	},
+	{
+		"add_plugin_v1", (*AddPluginV1)(nil),
+	},
+	{
+		"add_collection_plugin_v1", (*AddCollectionPluginV1)(nil),
+	},
+	{
+		"remove_plugin_v1", (*RemovePluginV1)(nil),
+	},
+	{
+		"remove_collection_plugin_v1", (*RemoveCollectionPluginV1)(nil),
+	},
+	{
+		"update_plugin_v1", (*UpdatePluginV1)(nil),
+	},
+	{
+		"update_collection_plugin_v1", (*UpdateCollectionPluginV1)(nil),
+	},
+	{
+		"approve_plugin_authority_v1", (*ApprovePluginAuthorityV1)(nil),
+	},
+	{
+		"approve_collection_plugin_authority_v1", (*ApproveCollectionPluginAuthorityV1)(nil),
+	},
+	{
+		"revoke_plugin_authority_v1", (*RevokePluginAuthorityV1)(nil),
+	},
+	{
+		"revoke_collection_plugin_authority_v1", (*RevokeCollectionPluginAuthorityV1)(nil),
+	},
+	{
+		"burn_v1", (*BurnV1)(nil),
+	},
+	{
+		"burn_collection_v1", (*BurnCollectionV1)(nil),
+	},
+	{
+		"transfer_v1", (*TransferV1)(nil),
+	},
+	{
+		"update_v1", (*UpdateV1)(nil),
+	},
+	{
+		"update_collection_v1", (*UpdateCollectionV1)(nil),
+	},
+})
+
+// InstructionIDToName returns the name of the instruction given its ID.
+func InstructionIDToName(id uint8) string {
+	switch id {
+	case Instruction_CreateV1:
+		return "CreateV1"
+	case Instruction_CreateCollectionV1:
+		return "CreateCollectionV1"
+	case Instruction_AddPluginV1:
+		return "AddPluginV1"
+	case Instruction_AddCollectionPluginV1:
+		return "AddCollectionPluginV1"
+	case Instruction_RemovePluginV1:
+		return "RemovePluginV1"
+	case Instruction_RemoveCollectionPluginV1:
+		return "RemoveCollectionPluginV1"
+	case Instruction_UpdatePluginV1:
+		return "UpdatePluginV1"
+	case Instruction_UpdateCollectionPluginV1:
+		return "UpdateCollectionPluginV1"
+	case Instruction_ApprovePluginAuthorityV1:
+		return "ApprovePluginAuthorityV1"
+	case Instruction_ApproveCollectionPluginAuthorityV1:
+		return "ApproveCollectionPluginAuthorityV1"
+	case Instruction_RevokePluginAuthorityV1:
+		return "RevokePluginAuthorityV1"
+	case Instruction_RevokeCollectionPluginAuthorityV1:
+		return "RevokeCollectionPluginAuthorityV1"
+	case Instruction_BurnV1:
+		return "BurnV1"
+	case Instruction_BurnCollectionV1:
+		return "BurnCollectionV1"
+	case Instruction_TransferV1:
+		return "TransferV1"
+	case Instruction_UpdateV1:
+		return "UpdateV1"
+	case Instruction_UpdateCollectionV1:
+		return "UpdateCollectionV1"
+	default:
+		return ""
+	}
+}
+
+func registryDecodeInstruction(accounts []*solanago.AccountMeta, data []byte) (interface{}, error) {
+	obj, err := DecodeInstruction(common.ConvertMeta(accounts), data)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func DecodeInstruction(accounts []*common.AccountMeta, data []byte) (*Instruction, error) {
+	obj := new(Instruction)
+	if err := binary.NewBorshDecoder(data).Decode(obj); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	if v, ok := obj.Impl.(common.AccountsSettable); ok {
+		err := v.SetAccounts(accounts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set accounts for instruction: %w", err)
+		}
+	}
+	return obj, nil
+}
+
+type Instruction struct {
+	binary.BaseVariant
+	programId *common.PublicKey
+	typeIdLen uint8
+}
+
+func (obj *Instruction) EncodeToTree(parent treeout.Branches) {
+	if enToTree, ok := obj.Impl.(text.EncodableToTree); ok {
+		enToTree.EncodeToTree(parent)
+	} else {
+		parent.Child(spew.Sdump(obj))
+	}
+}
+
+func (obj *Instruction) ProgramID() common.PublicKey {
+	if obj.programId != nil {
+		return *obj.programId
+	}
+	return ProgramID
+}
+
+func (obj *Instruction) Accounts() (out []*common.AccountMeta) {
+	return obj.Impl.(common.AccountsGettable).GetAccounts()
+}
+
+func (obj *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := binary.NewBorshEncoder(buf).Encode(obj); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (obj *Instruction) TextEncode(encoder *text.Encoder, option *text.Option) error {
+	return encoder.Encode(obj.Impl, option)
+}
+
+func (obj *Instruction) UnmarshalWithDecoder(decoder *binary.Decoder) error {
+	return obj.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionImplDef)
+}
+
+func (obj *Instruction) MarshalWithEncoder(encoder *binary.Encoder) error {
+	err := encoder.WriteBytes(obj.TypeID.Bytes()[:obj.typeIdLen], false)
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(obj.Impl)
+}
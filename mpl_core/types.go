@@ -0,0 +1,2630 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package mpl_core
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// CreateV1Args Struct
+type CreateV1Args struct {
+	DataState DataState
+	Name      string
+	Uri       string
+	Plugins   []PluginAuthorityPair `bin:"optional"`
+}
+
+func (obj *CreateV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.DataState); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.Plugins != nil); err != nil {
+		return err
+	}
+	if obj.Plugins != nil {
+		if err = encoder.WriteUint32(uint32(len(obj.Plugins)), binary.LE); err != nil {
+			return err
+		}
+		for i := range obj.Plugins {
+			if err = encoder.Encode(&obj.Plugins[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (obj *CreateV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.DataState); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Plugins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateCollectionV1Args Struct
+type CreateCollectionV1Args struct {
+	Name    string
+	Uri     string
+	Plugins []PluginAuthorityPair `bin:"optional"`
+}
+
+func (obj *CreateCollectionV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.Plugins != nil); err != nil {
+		return err
+	}
+	if obj.Plugins != nil {
+		if err = encoder.WriteUint32(uint32(len(obj.Plugins)), binary.LE); err != nil {
+			return err
+		}
+		for i := range obj.Plugins {
+			if err = encoder.Encode(&obj.Plugins[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (obj *CreateCollectionV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Plugins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddPluginV1Args Struct
+type AddPluginV1Args struct {
+	Plugin        Plugin
+	InitAuthority *Authority `bin:"optional"`
+}
+
+func (obj *AddPluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.InitAuthority != nil); err != nil {
+		return err
+	}
+	if obj.InitAuthority != nil {
+		if err = encoder.Encode(obj.InitAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *AddPluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.InitAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddCollectionPluginV1Args Struct
+type AddCollectionPluginV1Args struct {
+	Plugin        Plugin
+	InitAuthority *Authority `bin:"optional"`
+}
+
+func (obj *AddCollectionPluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.InitAuthority != nil); err != nil {
+		return err
+	}
+	if obj.InitAuthority != nil {
+		if err = encoder.Encode(obj.InitAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *AddCollectionPluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.InitAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemovePluginV1Args Struct
+type RemovePluginV1Args struct {
+	PluginType PluginType
+}
+
+const REMOVE_PLUGIN_V1_ARGS_SIZE = 1
+
+func (obj *RemovePluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemovePluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoveCollectionPluginV1Args Struct
+type RemoveCollectionPluginV1Args struct {
+	PluginType PluginType
+}
+
+const REMOVE_COLLECTION_PLUGIN_V1_ARGS_SIZE = 1
+
+func (obj *RemoveCollectionPluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RemoveCollectionPluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdatePluginV1Args Struct
+type UpdatePluginV1Args struct {
+	Plugin Plugin
+}
+
+func (obj *UpdatePluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdatePluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateCollectionPluginV1Args Struct
+type UpdateCollectionPluginV1Args struct {
+	Plugin Plugin
+}
+
+func (obj *UpdateCollectionPluginV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionPluginV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ApprovePluginAuthorityV1Args Struct
+type ApprovePluginAuthorityV1Args struct {
+	PluginType   PluginType
+	NewAuthority Authority
+}
+
+func (obj *ApprovePluginAuthorityV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewAuthority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApprovePluginAuthorityV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewAuthority); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ApproveCollectionPluginAuthorityV1Args Struct
+type ApproveCollectionPluginAuthorityV1Args struct {
+	PluginType   PluginType
+	NewAuthority Authority
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewAuthority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApproveCollectionPluginAuthorityV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewAuthority); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RevokePluginAuthorityV1Args Struct
+type RevokePluginAuthorityV1Args struct {
+	PluginType PluginType
+}
+
+const REVOKE_PLUGIN_AUTHORITY_V1_ARGS_SIZE = 1
+
+func (obj *RevokePluginAuthorityV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokePluginAuthorityV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RevokeCollectionPluginAuthorityV1Args Struct
+type RevokeCollectionPluginAuthorityV1Args struct {
+	PluginType PluginType
+}
+
+const REVOKE_COLLECTION_PLUGIN_AUTHORITY_V1_ARGS_SIZE = 1
+
+func (obj *RevokeCollectionPluginAuthorityV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RevokeCollectionPluginAuthorityV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BurnV1Args Struct
+type BurnV1Args struct {
+	CompressionProof *CompressionProof `bin:"optional"`
+}
+
+func (obj *BurnV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.CompressionProof != nil); err != nil {
+		return err
+	}
+	if obj.CompressionProof != nil {
+		if err = encoder.Encode(obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *BurnV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BurnCollectionV1Args Struct
+type BurnCollectionV1Args struct {
+	CompressionProof *CompressionProof `bin:"optional"`
+}
+
+func (obj *BurnCollectionV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.CompressionProof != nil); err != nil {
+		return err
+	}
+	if obj.CompressionProof != nil {
+		if err = encoder.Encode(obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *BurnCollectionV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TransferV1Args Struct
+type TransferV1Args struct {
+	CompressionProof *CompressionProof `bin:"optional"`
+}
+
+func (obj *TransferV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.CompressionProof != nil); err != nil {
+		return err
+	}
+	if obj.CompressionProof != nil {
+		if err = encoder.Encode(obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *TransferV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.CompressionProof); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateV1Args Struct
+type UpdateV1Args struct {
+	NewName            *string          `bin:"optional"`
+	NewUri             *string          `bin:"optional"`
+	NewUpdateAuthority *UpdateAuthority `bin:"optional"`
+}
+
+func (obj *UpdateV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.NewName != nil); err != nil {
+		return err
+	}
+	if obj.NewName != nil {
+		if err = encoder.Encode(obj.NewName); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.NewUri != nil); err != nil {
+		return err
+	}
+	if obj.NewUri != nil {
+		if err = encoder.Encode(obj.NewUri); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.NewUpdateAuthority != nil); err != nil {
+		return err
+	}
+	if obj.NewUpdateAuthority != nil {
+		if err = encoder.Encode(obj.NewUpdateAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *UpdateV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.NewName); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.NewUri); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.NewUpdateAuthority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateCollectionV1Args Struct
+type UpdateCollectionV1Args struct {
+	NewName *string `bin:"optional"`
+	NewUri  *string `bin:"optional"`
+}
+
+func (obj *UpdateCollectionV1Args) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.NewName != nil); err != nil {
+		return err
+	}
+	if obj.NewName != nil {
+		if err = encoder.Encode(obj.NewName); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.NewUri != nil); err != nil {
+		return err
+	}
+	if obj.NewUri != nil {
+		if err = encoder.Encode(obj.NewUri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *UpdateCollectionV1Args) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.NewName); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.NewUri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompressionProof Struct
+type CompressionProof struct {
+	Owner           common.PublicKey
+	UpdateAuthority UpdateAuthority
+	Name            string
+	Uri             string
+	Seq             uint64
+	Plugins         []HashablePluginSchema
+}
+
+func (obj *CompressionProof) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Owner); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Name); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Seq); err != nil {
+		return err
+	}
+	if err = encoder.WriteUint32(uint32(len(obj.Plugins)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Plugins {
+		if err = encoder.Encode(&obj.Plugins[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *CompressionProof) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Owner); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.UpdateAuthority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Name); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Uri); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Seq); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Plugins); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HashablePluginSchema Struct
+type HashablePluginSchema struct {
+	Index     uint64
+	Authority Authority
+	Plugin    Plugin
+}
+
+func (obj *HashablePluginSchema) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Index); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *HashablePluginSchema) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Index); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PluginAuthorityPair Struct
+type PluginAuthorityPair struct {
+	Plugin    Plugin
+	Authority *Authority `bin:"optional"`
+}
+
+func (obj *PluginAuthorityPair) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Plugin); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.Authority != nil); err != nil {
+		return err
+	}
+	if obj.Authority != nil {
+		if err = encoder.Encode(obj.Authority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *PluginAuthorityPair) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Plugin); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Authority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegistryRecord Struct
+type RegistryRecord struct {
+	PluginType PluginType
+	Authority  Authority
+	Offset     uint64
+}
+
+func (obj *RegistryRecord) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Offset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RegistryRecord) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Offset); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExternalRegistryRecord Struct
+type ExternalRegistryRecord struct {
+	PluginType ExternalPluginAdapterType
+	Authority  Authority
+	Offset     uint64
+	DataOffset *uint64 `bin:"optional"`
+	DataLen    *uint64 `bin:"optional"`
+}
+
+func (obj *ExternalRegistryRecord) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Offset); err != nil {
+		return err
+	}
+	if err = encoder.WriteBool(obj.DataOffset != nil); err != nil {
+		return err
+	}
+	if obj.DataOffset != nil {
+		if err = encoder.Encode(obj.DataOffset); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.DataLen != nil); err != nil {
+		return err
+	}
+	if obj.DataLen != nil {
+		if err = encoder.Encode(obj.DataLen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *ExternalRegistryRecord) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.PluginType); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Offset); err != nil {
+		return err
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.DataOffset); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.DataLen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Royalties Struct
+type Royalties struct {
+	BasisPoints uint16
+	Creators    []Creator
+	RuleSet     RuleSet
+}
+
+func (obj *Royalties) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.BasisPoints); err != nil {
+		return err
+	}
+	if err = encoder.WriteUint32(uint32(len(obj.Creators)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Creators {
+		if err = encoder.Encode(&obj.Creators[i]); err != nil {
+			return err
+		}
+	}
+	if err = encoder.Encode(&obj.RuleSet); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Royalties) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.BasisPoints); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Creators); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.RuleSet); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Creator Struct
+type Creator struct {
+	Address    common.PublicKey
+	Percentage uint8
+}
+
+const CREATOR_SIZE = 33
+
+func (obj *Creator) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Address); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Percentage); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Creator) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Address); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Percentage); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FreezeDelegate Struct
+type FreezeDelegate struct {
+	Frozen bool
+}
+
+const FREEZE_DELEGATE_SIZE = 1
+
+func (obj *FreezeDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Frozen); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *FreezeDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Frozen); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BurnDelegate Struct
+type BurnDelegate struct {
+}
+
+const BURN_DELEGATE_SIZE = 0
+
+func (obj *BurnDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *BurnDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// TransferDelegate Struct
+type TransferDelegate struct {
+}
+
+const TRANSFER_DELEGATE_SIZE = 0
+
+func (obj *TransferDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *TransferDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// UpdateDelegate Struct
+type UpdateDelegate struct {
+	AdditionalDelegates []common.PublicKey
+}
+
+func (obj *UpdateDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.AdditionalDelegates); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.AdditionalDelegates); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PermanentFreezeDelegate Struct
+type PermanentFreezeDelegate struct {
+	Frozen bool
+}
+
+const PERMANENT_FREEZE_DELEGATE_SIZE = 1
+
+func (obj *PermanentFreezeDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Frozen); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PermanentFreezeDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Frozen); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Attributes Struct
+type Attributes struct {
+	AttributeList []Attribute
+}
+
+func (obj *Attributes) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteUint32(uint32(len(obj.AttributeList)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.AttributeList {
+		if err = encoder.Encode(&obj.AttributeList[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *Attributes) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.AttributeList); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Attribute Struct
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+func (obj *Attribute) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Key); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Attribute) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Key); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PermanentTransferDelegate Struct
+type PermanentTransferDelegate struct {
+}
+
+const PERMANENT_TRANSFER_DELEGATE_SIZE = 0
+
+func (obj *PermanentTransferDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *PermanentTransferDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// PermanentBurnDelegate Struct
+type PermanentBurnDelegate struct {
+}
+
+const PERMANENT_BURN_DELEGATE_SIZE = 0
+
+func (obj *PermanentBurnDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *PermanentBurnDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// Edition Struct
+type Edition struct {
+	Number uint32
+}
+
+const EDITION_SIZE = 4
+
+func (obj *Edition) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Number); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Edition) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Number); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MasterEdition Struct
+type MasterEdition struct {
+	MaxSupply *uint32 `bin:"optional"`
+	Name      *string `bin:"optional"`
+	Uri       *string `bin:"optional"`
+}
+
+func (obj *MasterEdition) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBool(obj.MaxSupply != nil); err != nil {
+		return err
+	}
+	if obj.MaxSupply != nil {
+		if err = encoder.Encode(obj.MaxSupply); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.Name != nil); err != nil {
+		return err
+	}
+	if obj.Name != nil {
+		if err = encoder.Encode(obj.Name); err != nil {
+			return err
+		}
+	}
+	if err = encoder.WriteBool(obj.Uri != nil); err != nil {
+		return err
+	}
+	if obj.Uri != nil {
+		if err = encoder.Encode(obj.Uri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *MasterEdition) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.MaxSupply); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Name); err != nil {
+			return err
+		}
+	}
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.Uri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddBlocker Struct
+type AddBlocker struct {
+}
+
+const ADD_BLOCKER_SIZE = 0
+
+func (obj *AddBlocker) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *AddBlocker) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// ImmutableMetadata Struct
+type ImmutableMetadata struct {
+}
+
+const IMMUTABLE_METADATA_SIZE = 0
+
+func (obj *ImmutableMetadata) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *ImmutableMetadata) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+// VerifiedCreators Struct
+type VerifiedCreators struct {
+	Signatures []VerifiedCreatorsSignature
+}
+
+func (obj *VerifiedCreators) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteUint32(uint32(len(obj.Signatures)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Signatures {
+		if err = encoder.Encode(&obj.Signatures[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *VerifiedCreators) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Signatures); err != nil {
+		return err
+	}
+	return nil
+}
+
+// VerifiedCreatorsSignature Struct
+type VerifiedCreatorsSignature struct {
+	Address  common.PublicKey
+	Verified bool
+}
+
+const VERIFIED_CREATORS_SIGNATURE_SIZE = 33
+
+func (obj *VerifiedCreatorsSignature) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Address); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Verified); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *VerifiedCreatorsSignature) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Address); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Verified); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Autograph Struct
+type Autograph struct {
+	Signatures []AutographSignature
+}
+
+func (obj *Autograph) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteUint32(uint32(len(obj.Signatures)), binary.LE); err != nil {
+		return err
+	}
+	for i := range obj.Signatures {
+		if err = encoder.Encode(&obj.Signatures[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (obj *Autograph) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Signatures); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AutographSignature Struct
+type AutographSignature struct {
+	Address common.PublicKey
+	Message string
+}
+
+func (obj *AutographSignature) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Address); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AutographSignature) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Address); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Key Enum
+type Key binary.BorshEnum
+
+const (
+	KeyUninitialized Key = iota
+	KeyAssetV1
+	KeyHashedAssetV1
+	KeyPluginHeaderV1
+	KeyPluginRegistryV1
+	KeyCollectionV1
+)
+
+func (value Key) String() string {
+	switch value {
+	case KeyUninitialized:
+		return "Uninitialized"
+	case KeyAssetV1:
+		return "AssetV1"
+	case KeyHashedAssetV1:
+		return "HashedAssetV1"
+	case KeyPluginHeaderV1:
+		return "PluginHeaderV1"
+	case KeyPluginRegistryV1:
+		return "PluginRegistryV1"
+	case KeyCollectionV1:
+		return "CollectionV1"
+	default:
+		return ""
+	}
+}
+
+// DataState Enum
+type DataState binary.BorshEnum
+
+const (
+	DataStateAccountState DataState = iota
+	DataStateLedgerState
+)
+
+func (value DataState) String() string {
+	switch value {
+	case DataStateAccountState:
+		return "AccountState"
+	case DataStateLedgerState:
+		return "LedgerState"
+	default:
+		return ""
+	}
+}
+
+// UpdateAuthority Enum
+type UpdateAuthority struct {
+	None       *struct{}                  `bin:"optional"`
+	Address    *UpdateAuthorityAddress    `bin:"optional"`
+	Collection *UpdateAuthorityCollection `bin:"optional"`
+}
+
+type UpdateAuthorityAddress struct {
+	Field0 common.PublicKey
+}
+
+func (obj *UpdateAuthorityAddress) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateAuthorityAddress) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type UpdateAuthorityCollection struct {
+	Field0 common.PublicKey
+}
+
+func (obj *UpdateAuthorityCollection) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateAuthorityCollection) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateAuthority) String() string {
+	if obj.None != nil {
+		return "None"
+	}
+	if obj.Address != nil {
+		return "Address"
+	}
+	if obj.Collection != nil {
+		return "Collection"
+	}
+	return "UpdateAuthority(Unknown)"
+}
+
+func (obj *UpdateAuthority) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if obj.None != nil {
+		if err = encoder.WriteUint8(uint8(0x0)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Address != nil {
+		if err = encoder.WriteUint8(uint8(0x1)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Address); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Collection != nil {
+		if err = encoder.WriteUint8(uint8(0x2)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Collection); err != nil {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func (obj *UpdateAuthority) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	idx, err := decoder.ReadUint8()
+	if err != nil {
+		return err
+	}
+	if idx == uint8(0x0) {
+		obj.None = &struct{}{}
+		return nil
+	} else if idx == uint8(0x1) {
+		var tmp UpdateAuthorityAddress
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Address = &tmp
+		return nil
+	} else if idx == uint8(0x2) {
+		var tmp UpdateAuthorityCollection
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Collection = &tmp
+		return nil
+	}
+	return errors.New("UnmarshalWithDecoder failed")
+}
+
+func (obj *UpdateAuthority) IsNone() bool {
+	return obj.None != nil
+}
+
+func NewUpdateAuthority_None() UpdateAuthority {
+	return UpdateAuthority{
+		None: &struct{}{},
+	}
+}
+
+func (obj *UpdateAuthority) IsAddress() bool {
+	return obj.Address != nil
+}
+
+func (obj *UpdateAuthority) AsAddress() UpdateAuthorityAddress {
+	return *obj.Address
+}
+
+func NewUpdateAuthority_Address(field0 common.PublicKey) UpdateAuthority {
+	return UpdateAuthority{
+		Address: &UpdateAuthorityAddress{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *UpdateAuthority) IsCollection() bool {
+	return obj.Collection != nil
+}
+
+func (obj *UpdateAuthority) AsCollection() UpdateAuthorityCollection {
+	return *obj.Collection
+}
+
+func NewUpdateAuthority_Collection(field0 common.PublicKey) UpdateAuthority {
+	return UpdateAuthority{
+		Collection: &UpdateAuthorityCollection{
+			Field0: field0,
+		},
+	}
+}
+
+// Authority Enum
+type Authority struct {
+	None            *struct{}         `bin:"optional"`
+	Owner           *struct{}         `bin:"optional"`
+	UpdateAuthority *struct{}         `bin:"optional"`
+	Address         *AuthorityAddress `bin:"optional"`
+}
+
+type AuthorityAddress struct {
+	Address common.PublicKey
+}
+
+func (obj *AuthorityAddress) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Address); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *AuthorityAddress) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Address); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Authority) String() string {
+	if obj.None != nil {
+		return "None"
+	}
+	if obj.Owner != nil {
+		return "Owner"
+	}
+	if obj.UpdateAuthority != nil {
+		return "UpdateAuthority"
+	}
+	if obj.Address != nil {
+		return "Address"
+	}
+	return "Authority(Unknown)"
+}
+
+func (obj *Authority) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if obj.None != nil {
+		if err = encoder.WriteUint8(uint8(0x0)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Owner != nil {
+		if err = encoder.WriteUint8(uint8(0x1)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.UpdateAuthority != nil {
+		if err = encoder.WriteUint8(uint8(0x2)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Address != nil {
+		if err = encoder.WriteUint8(uint8(0x3)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Address); err != nil {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func (obj *Authority) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	idx, err := decoder.ReadUint8()
+	if err != nil {
+		return err
+	}
+	if idx == uint8(0x0) {
+		obj.None = &struct{}{}
+		return nil
+	} else if idx == uint8(0x1) {
+		obj.Owner = &struct{}{}
+		return nil
+	} else if idx == uint8(0x2) {
+		obj.UpdateAuthority = &struct{}{}
+		return nil
+	} else if idx == uint8(0x3) {
+		var tmp AuthorityAddress
+		if err = decoder.Decode(&tmp.Address); err != nil {
+			return err
+		}
+		obj.Address = &tmp
+		return nil
+	}
+	return errors.New("UnmarshalWithDecoder failed")
+}
+
+func (obj *Authority) IsNone() bool {
+	return obj.None != nil
+}
+
+func NewAuthority_None() Authority {
+	return Authority{
+		None: &struct{}{},
+	}
+}
+
+func (obj *Authority) IsOwner() bool {
+	return obj.Owner != nil
+}
+
+func NewAuthority_Owner() Authority {
+	return Authority{
+		Owner: &struct{}{},
+	}
+}
+
+func (obj *Authority) IsUpdateAuthority() bool {
+	return obj.UpdateAuthority != nil
+}
+
+func NewAuthority_UpdateAuthority() Authority {
+	return Authority{
+		UpdateAuthority: &struct{}{},
+	}
+}
+
+func (obj *Authority) IsAddress() bool {
+	return obj.Address != nil
+}
+
+func (obj *Authority) AsAddress() AuthorityAddress {
+	return *obj.Address
+}
+
+func NewAuthority_Address(address common.PublicKey) Authority {
+	return Authority{
+		Address: &AuthorityAddress{
+			Address: address,
+		},
+	}
+}
+
+// RuleSet Enum
+type RuleSet struct {
+	None             *struct{}                `bin:"optional"`
+	ProgramAllowList *RuleSetProgramAllowList `bin:"optional"`
+	ProgramDenyList  *RuleSetProgramDenyList  `bin:"optional"`
+}
+
+type RuleSetProgramAllowList struct {
+	Field0 []common.PublicKey
+}
+
+func (obj *RuleSetProgramAllowList) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RuleSetProgramAllowList) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type RuleSetProgramDenyList struct {
+	Field0 []common.PublicKey
+}
+
+func (obj *RuleSetProgramDenyList) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RuleSetProgramDenyList) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RuleSet) String() string {
+	if obj.None != nil {
+		return "None"
+	}
+	if obj.ProgramAllowList != nil {
+		return "ProgramAllowList"
+	}
+	if obj.ProgramDenyList != nil {
+		return "ProgramDenyList"
+	}
+	return "RuleSet(Unknown)"
+}
+
+func (obj *RuleSet) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if obj.None != nil {
+		if err = encoder.WriteUint8(uint8(0x0)); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.ProgramAllowList != nil {
+		if err = encoder.WriteUint8(uint8(0x1)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.ProgramAllowList); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.ProgramDenyList != nil {
+		if err = encoder.WriteUint8(uint8(0x2)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.ProgramDenyList); err != nil {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func (obj *RuleSet) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	idx, err := decoder.ReadUint8()
+	if err != nil {
+		return err
+	}
+	if idx == uint8(0x0) {
+		obj.None = &struct{}{}
+		return nil
+	} else if idx == uint8(0x1) {
+		var tmp RuleSetProgramAllowList
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.ProgramAllowList = &tmp
+		return nil
+	} else if idx == uint8(0x2) {
+		var tmp RuleSetProgramDenyList
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.ProgramDenyList = &tmp
+		return nil
+	}
+	return errors.New("UnmarshalWithDecoder failed")
+}
+
+func (obj *RuleSet) IsNone() bool {
+	return obj.None != nil
+}
+
+func NewRuleSet_None() RuleSet {
+	return RuleSet{
+		None: &struct{}{},
+	}
+}
+
+func (obj *RuleSet) IsProgramAllowList() bool {
+	return obj.ProgramAllowList != nil
+}
+
+func (obj *RuleSet) AsProgramAllowList() RuleSetProgramAllowList {
+	return *obj.ProgramAllowList
+}
+
+func NewRuleSet_ProgramAllowList(field0 []common.PublicKey) RuleSet {
+	return RuleSet{
+		ProgramAllowList: &RuleSetProgramAllowList{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *RuleSet) IsProgramDenyList() bool {
+	return obj.ProgramDenyList != nil
+}
+
+func (obj *RuleSet) AsProgramDenyList() RuleSetProgramDenyList {
+	return *obj.ProgramDenyList
+}
+
+func NewRuleSet_ProgramDenyList(field0 []common.PublicKey) RuleSet {
+	return RuleSet{
+		ProgramDenyList: &RuleSetProgramDenyList{
+			Field0: field0,
+		},
+	}
+}
+
+// PluginType Enum
+type PluginType binary.BorshEnum
+
+const (
+	PluginTypeRoyalties PluginType = iota
+	PluginTypeFreezeDelegate
+	PluginTypeBurnDelegate
+	PluginTypeTransferDelegate
+	PluginTypeUpdateDelegate
+	PluginTypePermanentFreezeDelegate
+	PluginTypeAttributes
+	PluginTypePermanentTransferDelegate
+	PluginTypePermanentBurnDelegate
+	PluginTypeEdition
+	PluginTypeMasterEdition
+	PluginTypeAddBlocker
+	PluginTypeImmutableMetadata
+	PluginTypeVerifiedCreators
+	PluginTypeAutograph
+)
+
+func (value PluginType) String() string {
+	switch value {
+	case PluginTypeRoyalties:
+		return "Royalties"
+	case PluginTypeFreezeDelegate:
+		return "FreezeDelegate"
+	case PluginTypeBurnDelegate:
+		return "BurnDelegate"
+	case PluginTypeTransferDelegate:
+		return "TransferDelegate"
+	case PluginTypeUpdateDelegate:
+		return "UpdateDelegate"
+	case PluginTypePermanentFreezeDelegate:
+		return "PermanentFreezeDelegate"
+	case PluginTypeAttributes:
+		return "Attributes"
+	case PluginTypePermanentTransferDelegate:
+		return "PermanentTransferDelegate"
+	case PluginTypePermanentBurnDelegate:
+		return "PermanentBurnDelegate"
+	case PluginTypeEdition:
+		return "Edition"
+	case PluginTypeMasterEdition:
+		return "MasterEdition"
+	case PluginTypeAddBlocker:
+		return "AddBlocker"
+	case PluginTypeImmutableMetadata:
+		return "ImmutableMetadata"
+	case PluginTypeVerifiedCreators:
+		return "VerifiedCreators"
+	case PluginTypeAutograph:
+		return "Autograph"
+	default:
+		return ""
+	}
+}
+
+// Plugin Enum
+type Plugin struct {
+	Royalties                 *PluginRoyalties                 `bin:"optional"`
+	FreezeDelegate            *PluginFreezeDelegate            `bin:"optional"`
+	BurnDelegate              *PluginBurnDelegate              `bin:"optional"`
+	TransferDelegate          *PluginTransferDelegate          `bin:"optional"`
+	UpdateDelegate            *PluginUpdateDelegate            `bin:"optional"`
+	PermanentFreezeDelegate   *PluginPermanentFreezeDelegate   `bin:"optional"`
+	Attributes                *PluginAttributes                `bin:"optional"`
+	PermanentTransferDelegate *PluginPermanentTransferDelegate `bin:"optional"`
+	PermanentBurnDelegate     *PluginPermanentBurnDelegate     `bin:"optional"`
+	Edition                   *PluginEdition                   `bin:"optional"`
+	MasterEdition             *PluginMasterEdition             `bin:"optional"`
+	AddBlocker                *PluginAddBlocker                `bin:"optional"`
+	ImmutableMetadata         *PluginImmutableMetadata         `bin:"optional"`
+	VerifiedCreators          *PluginVerifiedCreators          `bin:"optional"`
+	Autograph                 *PluginAutograph                 `bin:"optional"`
+}
+
+type PluginRoyalties struct {
+	Field0 Royalties
+}
+
+func (obj *PluginRoyalties) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginRoyalties) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginFreezeDelegate struct {
+	Field0 FreezeDelegate
+}
+
+func (obj *PluginFreezeDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginFreezeDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginBurnDelegate struct {
+	Field0 BurnDelegate
+}
+
+func (obj *PluginBurnDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginBurnDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginTransferDelegate struct {
+	Field0 TransferDelegate
+}
+
+func (obj *PluginTransferDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginTransferDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginUpdateDelegate struct {
+	Field0 UpdateDelegate
+}
+
+func (obj *PluginUpdateDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginUpdateDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginPermanentFreezeDelegate struct {
+	Field0 PermanentFreezeDelegate
+}
+
+func (obj *PluginPermanentFreezeDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginPermanentFreezeDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginAttributes struct {
+	Field0 Attributes
+}
+
+func (obj *PluginAttributes) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginAttributes) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginPermanentTransferDelegate struct {
+	Field0 PermanentTransferDelegate
+}
+
+func (obj *PluginPermanentTransferDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginPermanentTransferDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginPermanentBurnDelegate struct {
+	Field0 PermanentBurnDelegate
+}
+
+func (obj *PluginPermanentBurnDelegate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginPermanentBurnDelegate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginEdition struct {
+	Field0 Edition
+}
+
+func (obj *PluginEdition) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginEdition) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginMasterEdition struct {
+	Field0 MasterEdition
+}
+
+func (obj *PluginMasterEdition) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginMasterEdition) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginAddBlocker struct {
+	Field0 AddBlocker
+}
+
+func (obj *PluginAddBlocker) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginAddBlocker) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginImmutableMetadata struct {
+	Field0 ImmutableMetadata
+}
+
+func (obj *PluginImmutableMetadata) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginImmutableMetadata) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginVerifiedCreators struct {
+	Field0 VerifiedCreators
+}
+
+func (obj *PluginVerifiedCreators) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginVerifiedCreators) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PluginAutograph struct {
+	Field0 Autograph
+}
+
+func (obj *PluginAutograph) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PluginAutograph) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Field0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Plugin) String() string {
+	if obj.Royalties != nil {
+		return "Royalties"
+	}
+	if obj.FreezeDelegate != nil {
+		return "FreezeDelegate"
+	}
+	if obj.BurnDelegate != nil {
+		return "BurnDelegate"
+	}
+	if obj.TransferDelegate != nil {
+		return "TransferDelegate"
+	}
+	if obj.UpdateDelegate != nil {
+		return "UpdateDelegate"
+	}
+	if obj.PermanentFreezeDelegate != nil {
+		return "PermanentFreezeDelegate"
+	}
+	if obj.Attributes != nil {
+		return "Attributes"
+	}
+	if obj.PermanentTransferDelegate != nil {
+		return "PermanentTransferDelegate"
+	}
+	if obj.PermanentBurnDelegate != nil {
+		return "PermanentBurnDelegate"
+	}
+	if obj.Edition != nil {
+		return "Edition"
+	}
+	if obj.MasterEdition != nil {
+		return "MasterEdition"
+	}
+	if obj.AddBlocker != nil {
+		return "AddBlocker"
+	}
+	if obj.ImmutableMetadata != nil {
+		return "ImmutableMetadata"
+	}
+	if obj.VerifiedCreators != nil {
+		return "VerifiedCreators"
+	}
+	if obj.Autograph != nil {
+		return "Autograph"
+	}
+	return "Plugin(Unknown)"
+}
+
+func (obj *Plugin) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if obj.Royalties != nil {
+		if err = encoder.WriteUint8(uint8(0x0)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Royalties); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.FreezeDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x1)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.FreezeDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.BurnDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x2)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.BurnDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.TransferDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x3)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.TransferDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.UpdateDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x4)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.UpdateDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.PermanentFreezeDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x5)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.PermanentFreezeDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Attributes != nil {
+		if err = encoder.WriteUint8(uint8(0x6)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Attributes); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.PermanentTransferDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x7)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.PermanentTransferDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.PermanentBurnDelegate != nil {
+		if err = encoder.WriteUint8(uint8(0x8)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.PermanentBurnDelegate); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Edition != nil {
+		if err = encoder.WriteUint8(uint8(0x9)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Edition); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.MasterEdition != nil {
+		if err = encoder.WriteUint8(uint8(0xa)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.MasterEdition); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.AddBlocker != nil {
+		if err = encoder.WriteUint8(uint8(0xb)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.AddBlocker); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.ImmutableMetadata != nil {
+		if err = encoder.WriteUint8(uint8(0xc)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.ImmutableMetadata); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.VerifiedCreators != nil {
+		if err = encoder.WriteUint8(uint8(0xd)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.VerifiedCreators); err != nil {
+			return err
+		}
+		return nil
+	}
+	if obj.Autograph != nil {
+		if err = encoder.WriteUint8(uint8(0xe)); err != nil {
+			return err
+		}
+		if err = encoder.Encode(obj.Autograph); err != nil {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+func (obj *Plugin) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	idx, err := decoder.ReadUint8()
+	if err != nil {
+		return err
+	}
+	if idx == uint8(0x0) {
+		var tmp PluginRoyalties
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Royalties = &tmp
+		return nil
+	} else if idx == uint8(0x1) {
+		var tmp PluginFreezeDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.FreezeDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x2) {
+		var tmp PluginBurnDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.BurnDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x3) {
+		var tmp PluginTransferDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.TransferDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x4) {
+		var tmp PluginUpdateDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.UpdateDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x5) {
+		var tmp PluginPermanentFreezeDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.PermanentFreezeDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x6) {
+		var tmp PluginAttributes
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Attributes = &tmp
+		return nil
+	} else if idx == uint8(0x7) {
+		var tmp PluginPermanentTransferDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.PermanentTransferDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x8) {
+		var tmp PluginPermanentBurnDelegate
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.PermanentBurnDelegate = &tmp
+		return nil
+	} else if idx == uint8(0x9) {
+		var tmp PluginEdition
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Edition = &tmp
+		return nil
+	} else if idx == uint8(0xa) {
+		var tmp PluginMasterEdition
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.MasterEdition = &tmp
+		return nil
+	} else if idx == uint8(0xb) {
+		var tmp PluginAddBlocker
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.AddBlocker = &tmp
+		return nil
+	} else if idx == uint8(0xc) {
+		var tmp PluginImmutableMetadata
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.ImmutableMetadata = &tmp
+		return nil
+	} else if idx == uint8(0xd) {
+		var tmp PluginVerifiedCreators
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.VerifiedCreators = &tmp
+		return nil
+	} else if idx == uint8(0xe) {
+		var tmp PluginAutograph
+		if err = decoder.Decode(&tmp.Field0); err != nil {
+			return err
+		}
+		obj.Autograph = &tmp
+		return nil
+	}
+	return errors.New("UnmarshalWithDecoder failed")
+}
+
+func (obj *Plugin) IsRoyalties() bool {
+	return obj.Royalties != nil
+}
+
+func (obj *Plugin) AsRoyalties() PluginRoyalties {
+	return *obj.Royalties
+}
+
+func NewPlugin_Royalties(field0 Royalties) Plugin {
+	return Plugin{
+		Royalties: &PluginRoyalties{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsFreezeDelegate() bool {
+	return obj.FreezeDelegate != nil
+}
+
+func (obj *Plugin) AsFreezeDelegate() PluginFreezeDelegate {
+	return *obj.FreezeDelegate
+}
+
+func NewPlugin_FreezeDelegate(field0 FreezeDelegate) Plugin {
+	return Plugin{
+		FreezeDelegate: &PluginFreezeDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsBurnDelegate() bool {
+	return obj.BurnDelegate != nil
+}
+
+func (obj *Plugin) AsBurnDelegate() PluginBurnDelegate {
+	return *obj.BurnDelegate
+}
+
+func NewPlugin_BurnDelegate(field0 BurnDelegate) Plugin {
+	return Plugin{
+		BurnDelegate: &PluginBurnDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsTransferDelegate() bool {
+	return obj.TransferDelegate != nil
+}
+
+func (obj *Plugin) AsTransferDelegate() PluginTransferDelegate {
+	return *obj.TransferDelegate
+}
+
+func NewPlugin_TransferDelegate(field0 TransferDelegate) Plugin {
+	return Plugin{
+		TransferDelegate: &PluginTransferDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsUpdateDelegate() bool {
+	return obj.UpdateDelegate != nil
+}
+
+func (obj *Plugin) AsUpdateDelegate() PluginUpdateDelegate {
+	return *obj.UpdateDelegate
+}
+
+func NewPlugin_UpdateDelegate(field0 UpdateDelegate) Plugin {
+	return Plugin{
+		UpdateDelegate: &PluginUpdateDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsPermanentFreezeDelegate() bool {
+	return obj.PermanentFreezeDelegate != nil
+}
+
+func (obj *Plugin) AsPermanentFreezeDelegate() PluginPermanentFreezeDelegate {
+	return *obj.PermanentFreezeDelegate
+}
+
+func NewPlugin_PermanentFreezeDelegate(field0 PermanentFreezeDelegate) Plugin {
+	return Plugin{
+		PermanentFreezeDelegate: &PluginPermanentFreezeDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsAttributes() bool {
+	return obj.Attributes != nil
+}
+
+func (obj *Plugin) AsAttributes() PluginAttributes {
+	return *obj.Attributes
+}
+
+func NewPlugin_Attributes(field0 Attributes) Plugin {
+	return Plugin{
+		Attributes: &PluginAttributes{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsPermanentTransferDelegate() bool {
+	return obj.PermanentTransferDelegate != nil
+}
+
+func (obj *Plugin) AsPermanentTransferDelegate() PluginPermanentTransferDelegate {
+	return *obj.PermanentTransferDelegate
+}
+
+func NewPlugin_PermanentTransferDelegate(field0 PermanentTransferDelegate) Plugin {
+	return Plugin{
+		PermanentTransferDelegate: &PluginPermanentTransferDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsPermanentBurnDelegate() bool {
+	return obj.PermanentBurnDelegate != nil
+}
+
+func (obj *Plugin) AsPermanentBurnDelegate() PluginPermanentBurnDelegate {
+	return *obj.PermanentBurnDelegate
+}
+
+func NewPlugin_PermanentBurnDelegate(field0 PermanentBurnDelegate) Plugin {
+	return Plugin{
+		PermanentBurnDelegate: &PluginPermanentBurnDelegate{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsEdition() bool {
+	return obj.Edition != nil
+}
+
+func (obj *Plugin) AsEdition() PluginEdition {
+	return *obj.Edition
+}
+
+func NewPlugin_Edition(field0 Edition) Plugin {
+	return Plugin{
+		Edition: &PluginEdition{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsMasterEdition() bool {
+	return obj.MasterEdition != nil
+}
+
+func (obj *Plugin) AsMasterEdition() PluginMasterEdition {
+	return *obj.MasterEdition
+}
+
+func NewPlugin_MasterEdition(field0 MasterEdition) Plugin {
+	return Plugin{
+		MasterEdition: &PluginMasterEdition{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsAddBlocker() bool {
+	return obj.AddBlocker != nil
+}
+
+func (obj *Plugin) AsAddBlocker() PluginAddBlocker {
+	return *obj.AddBlocker
+}
+
+func NewPlugin_AddBlocker(field0 AddBlocker) Plugin {
+	return Plugin{
+		AddBlocker: &PluginAddBlocker{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsImmutableMetadata() bool {
+	return obj.ImmutableMetadata != nil
+}
+
+func (obj *Plugin) AsImmutableMetadata() PluginImmutableMetadata {
+	return *obj.ImmutableMetadata
+}
+
+func NewPlugin_ImmutableMetadata(field0 ImmutableMetadata) Plugin {
+	return Plugin{
+		ImmutableMetadata: &PluginImmutableMetadata{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsVerifiedCreators() bool {
+	return obj.VerifiedCreators != nil
+}
+
+func (obj *Plugin) AsVerifiedCreators() PluginVerifiedCreators {
+	return *obj.VerifiedCreators
+}
+
+func NewPlugin_VerifiedCreators(field0 VerifiedCreators) Plugin {
+	return Plugin{
+		VerifiedCreators: &PluginVerifiedCreators{
+			Field0: field0,
+		},
+	}
+}
+
+func (obj *Plugin) IsAutograph() bool {
+	return obj.Autograph != nil
+}
+
+func (obj *Plugin) AsAutograph() PluginAutograph {
+	return *obj.Autograph
+}
+
+func NewPlugin_Autograph(field0 Autograph) Plugin {
+	return Plugin{
+		Autograph: &PluginAutograph{
+			Field0: field0,
+		},
+	}
+}
+
+// ExternalPluginAdapterType Enum
+type ExternalPluginAdapterType binary.BorshEnum
+
+const (
+	ExternalPluginAdapterTypeLifecycleHook ExternalPluginAdapterType = iota
+	ExternalPluginAdapterTypeOracle
+	ExternalPluginAdapterTypeAppData
+	ExternalPluginAdapterTypeLinkedLifecycleHook
+	ExternalPluginAdapterTypeLinkedAppData
+	ExternalPluginAdapterTypeDataSection
+)
+
+func (value ExternalPluginAdapterType) String() string {
+	switch value {
+	case ExternalPluginAdapterTypeLifecycleHook:
+		return "LifecycleHook"
+	case ExternalPluginAdapterTypeOracle:
+		return "Oracle"
+	case ExternalPluginAdapterTypeAppData:
+		return "AppData"
+	case ExternalPluginAdapterTypeLinkedLifecycleHook:
+		return "LinkedLifecycleHook"
+	case ExternalPluginAdapterTypeLinkedAppData:
+		return "LinkedAppData"
+	case ExternalPluginAdapterTypeDataSection:
+		return "DataSection"
+	default:
+		return ""
+	}
+}
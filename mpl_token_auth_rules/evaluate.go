@@ -0,0 +1,330 @@
+package mpl_token_auth_rules
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"golang.org/x/crypto/sha3"
+	"slices"
+)
+
+// PayloadValue a value of the payload, one of Pubkey, Seeds, Proof and Number is set
+type PayloadValue struct {
+	Pubkey *common.PublicKey
+	Seeds  [][]byte
+	Proof  [][32]byte
+	Number *uint64
+}
+
+// Payload the payload of an operation, keyed by the field, see PayloadAmount, PayloadDestination...
+type Payload map[string]PayloadValue
+
+func (p Payload) SetPubkey(field string, pubkey common.PublicKey) Payload {
+	p[field] = PayloadValue{Pubkey: &pubkey}
+	return p
+}
+
+func (p Payload) SetSeeds(field string, seeds [][]byte) Payload {
+	p[field] = PayloadValue{Seeds: seeds}
+	return p
+}
+
+func (p Payload) SetProof(field string, proof [][32]byte) Payload {
+	p[field] = PayloadValue{Proof: proof}
+	return p
+}
+
+func (p Payload) SetNumber(field string, number uint64) Payload {
+	p[field] = PayloadValue{Number: &number}
+	return p
+}
+
+// Environment the state an operation is evaluated in
+type Environment struct {
+	Payload Payload
+	// the signers of the transaction
+	Signers []common.PublicKey
+	// the owner programs of the accounts in the payload, an account absent is unknown
+	Owners map[common.PublicKey]common.PublicKey
+}
+
+// Evaluation the result of the evaluation of an operation
+type Evaluation struct {
+	// the operation, or its namespace, the rule belongs to
+	Operation string
+	Rule      *Rule
+	Pass      bool
+	// the leaf rules failed, in evaluation order
+	Failures []string
+	// the payload fields required but absent
+	MissingFields []string
+	// the accounts whose owner is required but unknown
+	MissingOwners []common.PublicKey
+}
+
+// Evaluate evaluates the operation against the rule set locally, the same as the program does during Validate.
+// A Frequency rule always fails, the program does not implement it
+func (s *RuleSet) Evaluate(operation string, env Environment) (*Evaluation, error) {
+	name, rule, err := s.Rule(operation)
+	if err != nil {
+		return nil, err
+	}
+	return EvaluateRule(name, rule, env), nil
+}
+
+// EvaluateRule evaluates the rule in the environment
+func EvaluateRule(operation string, rule *Rule, env Environment) *Evaluation {
+	e := &evaluator{env: env, ret: &Evaluation{Operation: operation, Rule: rule}}
+	e.ret.Pass = e.evaluate(rule)
+	return e.ret
+}
+
+type evaluator struct {
+	env Environment
+	ret *Evaluation
+}
+
+func (e *evaluator) fail(rule *Rule, format string, args ...any) bool {
+	e.ret.Failures = append(e.ret.Failures, fmt.Sprintf("%s: %s", rule.describe(), fmt.Sprintf(format, args...)))
+	return false
+}
+
+func (e *evaluator) field(field string) (PayloadValue, bool) {
+	value, ok := e.env.Payload[field]
+	if !ok && !slices.Contains(e.ret.MissingFields, field) {
+		e.ret.MissingFields = append(e.ret.MissingFields, field)
+	}
+	return value, ok
+}
+
+func (e *evaluator) pubkey(rule *Rule, field string) (common.PublicKey, bool) {
+	value, ok := e.field(field)
+	if !ok {
+		return common.PublicKey{}, e.fail(rule, "missing field %s", field)
+	}
+	if value.Pubkey == nil {
+		return common.PublicKey{}, e.fail(rule, "field %s is not a pubkey", field)
+	}
+	return *value.Pubkey, true
+}
+
+func (e *evaluator) owner(rule *Rule, account common.PublicKey) (common.PublicKey, bool) {
+	owner, ok := e.env.Owners[account]
+	if !ok {
+		if !slices.Contains(e.ret.MissingOwners, account) {
+			e.ret.MissingOwners = append(e.ret.MissingOwners, account)
+		}
+		return common.PublicKey{}, e.fail(rule, "unknown owner of %s", account)
+	}
+	return owner, true
+}
+
+func (e *evaluator) proof(rule *Rule, field string) ([][32]byte, bool) {
+	value, ok := e.field(field)
+	if !ok {
+		return nil, e.fail(rule, "missing field %s", field)
+	}
+	if value.Proof == nil {
+		return nil, e.fail(rule, "field %s is not a merkle proof", field)
+	}
+	return value.Proof, true
+}
+
+func (e *evaluator) evaluate(rule *Rule) bool {
+	switch rule.Kind {
+	case RuleAll:
+		pass := true
+		for _, item := range rule.Rules {
+			pass = e.evaluate(item) && pass
+		}
+		return pass
+	case RuleAny:
+		failures := len(e.ret.Failures)
+		for _, item := range rule.Rules {
+			if e.evaluate(item) {
+				// the failed branches do not matter
+				e.ret.Failures = e.ret.Failures[:failures]
+				return true
+			}
+		}
+		return false
+	case RuleNot:
+		failures := len(e.ret.Failures)
+		pass := e.evaluate(rule.Rule)
+		e.ret.Failures = e.ret.Failures[:failures]
+		if pass {
+			return e.fail(rule, "the negated rule %s passed", rule.Rule)
+		}
+		return true
+	case RuleAdditionalSigner:
+		if !slices.Contains(e.env.Signers, rule.Pubkey) {
+			return e.fail(rule, "not a signer")
+		}
+		return true
+	case RulePubkeyMatch:
+		key, ok := e.pubkey(rule, rule.Field)
+		if ok && key != rule.Pubkey {
+			return e.fail(rule, "got %s", key)
+		}
+		return ok
+	case RulePubkeyListMatch:
+		key, ok := e.pubkey(rule, rule.Field)
+		if ok && !slices.Contains(rule.Pubkeys, key) {
+			return e.fail(rule, "got %s", key)
+		}
+		return ok
+	case RulePubkeyTreeMatch:
+		key, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		proof, ok := e.proof(rule, rule.ProofField)
+		if ok && ComputeMerkleRoot(key, proof) != rule.Root {
+			return e.fail(rule, "%s is not in the tree", key)
+		}
+		return ok
+	case RulePDAMatch:
+		pda, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		seeds, ok := e.field(rule.SeedsField)
+		if !ok {
+			return e.fail(rule, "missing field %s", rule.SeedsField)
+		}
+		var program common.PublicKey
+		if rule.Program != nil {
+			program = *rule.Program
+		} else if program, ok = e.owner(rule, pda); !ok {
+			return false
+		}
+		derived, _, err := web3.FindProgramAddress(seeds.Seeds, program)
+		if err != nil || derived != pda {
+			return e.fail(rule, "%s is not derived from the seeds", pda)
+		}
+		return true
+	case RuleProgramOwned:
+		key, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		owner, ok := e.owner(rule, key)
+		if ok && owner != rule.Pubkey {
+			return e.fail(rule, "%s is owned by %s", key, owner)
+		}
+		return ok
+	case RuleProgramOwnedList:
+		key, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		owner, ok := e.owner(rule, key)
+		if ok && !slices.Contains(rule.Pubkeys, owner) {
+			return e.fail(rule, "%s is owned by %s", key, owner)
+		}
+		return ok
+	case RuleProgramOwnedTree:
+		key, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		owner, ok := e.owner(rule, key)
+		if !ok {
+			return false
+		}
+		proof, ok := e.proof(rule, rule.ProofField)
+		if ok && ComputeMerkleRoot(owner, proof) != rule.Root {
+			return e.fail(rule, "the owner %s is not in the tree", owner)
+		}
+		return ok
+	case RuleAmount:
+		value, ok := e.field(rule.Field)
+		if !ok {
+			return e.fail(rule, "missing field %s", rule.Field)
+		}
+		if value.Number == nil {
+			return e.fail(rule, "field %s is not a number", rule.Field)
+		}
+		if !rule.Operator.Compare(*value.Number, rule.Amount) {
+			return e.fail(rule, "got %d", *value.Number)
+		}
+		return true
+	case RuleIsWallet:
+		key, ok := e.pubkey(rule, rule.Field)
+		if !ok {
+			return false
+		}
+		owner, ok := e.owner(rule, key)
+		if ok && (owner != web3.SystemProgramID || !web3.IsOnCurve(key)) {
+			return e.fail(rule, "%s is not a wallet", key)
+		}
+		return ok
+	case RulePass:
+		return true
+	case RuleFrequency:
+		return e.fail(rule, "not implemented by the program")
+	default:
+		return e.fail(rule, "unexpected rule")
+	}
+}
+
+// ComputeMerkleRoot the root of the tree of the leaf with the proof,
+// the leaf is keccak(0x00, key) and the nodes are keccak(0x01, min, max)
+func ComputeMerkleRoot(leaf common.PublicKey, proof [][32]byte) [32]byte {
+	var computed = keccak([]byte{0x00}, leaf[:])
+	for _, node := range proof {
+		if bytes.Compare(computed[:], node[:]) <= 0 {
+			computed = keccak([]byte{0x01}, computed[:], node[:])
+		} else {
+			computed = keccak([]byte{0x01}, node[:], computed[:])
+		}
+	}
+	return computed
+}
+
+func keccak(values ...[]byte) (ret [32]byte) {
+	h := sha3.NewLegacyKeccak256()
+	for _, value := range values {
+		h.Write(value)
+	}
+	h.Sum(ret[:0])
+	return ret
+}
+
+// RequiredFields the payload fields referenced by the rule of the operation, in order of appearance
+func (s *RuleSet) RequiredFields(operation string) ([]string, error) {
+	_, rule, err := s.Rule(operation)
+	if err != nil {
+		return nil, err
+	}
+	return rule.RequiredFields(), nil
+}
+
+// RequiredFields the payload fields referenced by the rule, in order of appearance.
+// The fields of the branches of an Any rule are all listed, though only one branch needs to pass
+func (r *Rule) RequiredFields() []string {
+	var ret []string
+	add := func(fields ...string) {
+		for _, field := range fields {
+			if field != "" && !slices.Contains(ret, field) {
+				ret = append(ret, field)
+			}
+		}
+	}
+	var walk func(rule *Rule)
+	walk = func(rule *Rule) {
+		switch rule.Kind {
+		case RuleAll, RuleAny:
+			for _, item := range rule.Rules {
+				walk(item)
+			}
+		case RuleNot:
+			walk(rule.Rule)
+		default:
+			add(rule.Field, rule.ProofField, rule.SeedsField)
+		}
+	}
+	walk(r)
+	return ret
+}
@@ -0,0 +1,210 @@
+package mpl_token_auth_rules
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// pack encodes the test values: string, uint64, []byte, []any and msgMap
+func pack(v any) []byte {
+	switch x := v.(type) {
+	case nil:
+		return []byte{0xc0}
+	case string:
+		return append([]byte{0xd9, byte(len(x))}, x...)
+	case uint64:
+		out := []byte{0xcf, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(out[1:], x)
+		return out
+	case []byte:
+		return append([]byte{0xc4, byte(len(x))}, x...)
+	case []any:
+		out := []byte{0xdc, 0, byte(len(x))}
+		for _, item := range x {
+			out = append(out, pack(item)...)
+		}
+		return out
+	case msgMap:
+		out := []byte{0xde, 0, byte(len(x))}
+		for _, entry := range x {
+			out = append(out, pack(entry.Key)...)
+			out = append(out, pack(entry.Value)...)
+		}
+		return out
+	}
+	panic("unsupported value")
+}
+
+func variant(name string, value any) msgMap {
+	return msgMap{{Key: name, Value: value}}
+}
+
+func buildRuleSetAccount(revisions ...[]byte) []byte {
+	data := make([]byte, RuleSetHeaderSize)
+	data[0] = byte(KeyRuleSet)
+	var offsets []uint64
+	for _, revision := range revisions {
+		offsets = append(offsets, uint64(len(data)))
+		data = append(data, RuleSetLibVersion)
+		data = append(data, revision...)
+	}
+	binary.LittleEndian.PutUint64(data[1:], uint64(len(data)))
+	data = append(data, RuleSetRevMapVersion)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(offsets)))
+	for _, offset := range offsets {
+		data = binary.LittleEndian.AppendUint64(data, offset)
+	}
+	return data
+}
+
+func TestRuleSet(t *testing.T) {
+	owner := web3.Keypair.Generate().PublicKey()
+	marketplace := web3.Keypair.Generate().PublicKey()
+	allowed := web3.Keypair.Generate().PublicKey()
+	wallet := web3.Keypair.Generate().PublicKey()
+
+	// compact structs as arrays, pubkeys as base58 strings, the first revision names its fields
+	first := pack(msgMap{
+		{Key: "libVersion", Value: uint64(1)},
+		{Key: "owner", Value: owner.Bytes()},
+		{Key: "ruleSetName", Value: "old"},
+		{Key: "operations", Value: msgMap{{Key: "Transfer", Value: "Pass"}}},
+	})
+	second := pack([]any{
+		uint64(1),
+		owner.String(),
+		"marketplace",
+		msgMap{
+			{Key: OperationTransferWalletToWallet, Value: "Pass"},
+			{Key: OperationTransferOwner, Value: "Namespace"},
+			{Key: "Transfer", Value: variant("All", []any{[]any{
+				variant("Amount", []any{uint64(1), "Eq", PayloadAmount}),
+				variant("Any", []any{[]any{
+					variant("ProgramOwnedList", []any{[]any{marketplace.String()}, PayloadDestination}),
+					variant("PubkeyListMatch", []any{[]any{allowed.String()}, PayloadDestination}),
+					variant("IsWallet", []any{PayloadDestination}),
+				}}),
+			}})},
+			{Key: OperationDelegateSale, Value: variant("Not", []any{variant("AdditionalSigner", []any{owner.String()})})},
+		},
+	})
+
+	account, err := DecodeRuleSetAccount(buildRuleSetAccount(first, second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(account.Revisions) != 2 {
+		t.Fatalf("unexpected revisions %d", len(account.Revisions))
+	}
+	revision := 0
+	old, err := account.Revision(&revision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old.Name != "old" || old.Owner != owner || old.Operations["Transfer"].Kind != RulePass {
+		t.Fatalf("unexpected first revision %v", old)
+	}
+	ruleSet, err := account.Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ruleSet.Name != "marketplace" || len(ruleSet.Operations) != 4 {
+		t.Fatalf("unexpected rule set %v", ruleSet)
+	}
+	if s := ruleSet.String(); !strings.Contains(s, "ProgramOwnedList(Destination owned by ["+marketplace.String()+"])") {
+		t.Fatalf("unexpected string %s", s)
+	}
+
+	// Transfer:Owner is a Namespace, Delegate:Standard is absent,
+	// Transfer:TransferDelegate is absent and not looked up in the Transfer namespace
+	name, _, err := ruleSet.Rule(OperationTransferOwner)
+	if err != nil || name != "Transfer" {
+		t.Fatalf("unexpected namespace %s %v", name, err)
+	}
+	for _, operation := range []string{"Delegate:Standard", OperationTransferTransferDelegate} {
+		if _, _, err := ruleSet.Rule(operation); !errors.Is(err, ErrOperationNotFound) {
+			t.Fatalf("%s: unexpected error %v", operation, err)
+		}
+		if _, err := ruleSet.Evaluate(operation, Environment{Payload: Payload{}}); !errors.Is(err, ErrOperationNotFound) {
+			t.Fatalf("%s: unexpected evaluation error %v", operation, err)
+		}
+	}
+	fields, err := ruleSet.RequiredFields(OperationTransferOwner)
+	if err != nil || !slices.Equal(fields, []string{PayloadAmount, PayloadDestination}) {
+		t.Fatalf("unexpected required fields %v %v", fields, err)
+	}
+
+	evaluate := func(operation string, env Environment) *Evaluation {
+		t.Helper()
+		ret, err := ruleSet.Evaluate(operation, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ret
+	}
+
+	// missing payload
+	ret := evaluate(OperationTransferOwner, Environment{Payload: Payload{}})
+	if ret.Pass || !slices.Equal(ret.MissingFields, []string{PayloadAmount, PayloadDestination}) {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+	// a marketplace escrow
+	escrow := web3.Keypair.Generate().PublicKey()
+	ret = evaluate(OperationTransferOwner, Environment{
+		Payload: Payload{}.SetNumber(PayloadAmount, 1).SetPubkey(PayloadDestination, escrow),
+		Owners:  map[common.PublicKey]common.PublicKey{escrow: marketplace},
+	})
+	if !ret.Pass || len(ret.Failures) != 0 {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+	// a wallet
+	ret = evaluate(OperationTransferOwner, Environment{
+		Payload: Payload{}.SetNumber(PayloadAmount, 1).SetPubkey(PayloadDestination, wallet),
+		Owners:  map[common.PublicKey]common.PublicKey{wallet: web3.SystemProgramID},
+	})
+	if !ret.Pass {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+	// another program, with an amount of 2
+	other := web3.Keypair.Generate().PublicKey()
+	ret = evaluate(OperationTransferOwner, Environment{
+		Payload: Payload{}.SetNumber(PayloadAmount, 2).SetPubkey(PayloadDestination, other),
+		Owners:  map[common.PublicKey]common.PublicKey{other: web3.TokenProgramID},
+	})
+	if ret.Pass || len(ret.Failures) != 4 || !strings.HasPrefix(ret.Failures[0], "Amount(Amount == 1)") {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+	// Not(AdditionalSigner)
+	if ret = evaluate(OperationDelegateSale, Environment{Signers: []common.PublicKey{owner}}); ret.Pass {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+	if ret = evaluate(OperationDelegateSale, Environment{}); !ret.Pass {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+}
+
+func TestComputeMerkleRoot(t *testing.T) {
+	a := web3.Keypair.Generate().PublicKey()
+	b := web3.Keypair.Generate().PublicKey()
+	leafA := keccak([]byte{0x00}, a[:])
+	leafB := keccak([]byte{0x00}, b[:])
+	var root [32]byte
+	if string(leafA[:]) <= string(leafB[:]) {
+		root = keccak([]byte{0x01}, leafA[:], leafB[:])
+	} else {
+		root = keccak([]byte{0x01}, leafB[:], leafA[:])
+	}
+	if ComputeMerkleRoot(a, [][32]byte{leafB}) != root || ComputeMerkleRoot(b, [][32]byte{leafA}) != root {
+		t.Fatal("unexpected root")
+	}
+	rule := &Rule{Kind: RulePubkeyTreeMatch, Root: root, Field: PayloadDestination, ProofField: "DestinationProof"}
+	ret := EvaluateRule("Transfer", rule, Environment{Payload: Payload{}.SetPubkey(PayloadDestination, a).SetProof("DestinationProof", [][32]byte{leafB})})
+	if !ret.Pass {
+		t.Fatalf("unexpected evaluation %+v", ret)
+	}
+}
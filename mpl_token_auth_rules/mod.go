@@ -0,0 +1,84 @@
+package mpl_token_auth_rules
+
+import (
+	"errors"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+var ProgramID common.PublicKey = common.MustPublicKeyFromBase58("auth9SigNpDKz4sJJ1DfCTuZrZNSAgh9sFD3rboVmgg")
+
+const ProgramName = "mpl_token_auth_rules"
+
+// RuleSetSeed the seed of the RuleSet PDA: ["rule_set", owner, name]
+const RuleSetSeed = "rule_set"
+
+// Key the first byte of the accounts of the program
+type Key uint8
+
+const (
+	KeyUninitialized Key = iota
+	KeyRuleSet
+	KeyFrequency
+)
+
+// Versions of the serialized rule sets and of the revision map
+const (
+	RuleSetLibVersion    uint8 = 1 // RuleSetV1, MessagePack
+	RuleSetV2LibVersion  uint8 = 2 // RuleSetV2, not supported
+	RuleSetRevMapVersion uint8 = 1
+	RuleSetHeaderSize          = 9
+)
+
+// Operations of Token Metadata, the rule of an operation falls back to the rule of its namespace ("Transfer", "Delegate")
+const (
+	OperationTransferOwner                  = "Transfer:Owner"
+	OperationTransferWalletToWallet         = "Transfer:WalletToWallet"
+	OperationTransferMigrationDelegate      = "Transfer:MigrationDelegate"
+	OperationTransferSaleDelegate           = "Transfer:SaleDelegate"
+	OperationTransferTransferDelegate       = "Transfer:TransferDelegate"
+	OperationTransferLockedTransferDelegate = "Transfer:LockedTransferDelegate"
+	OperationDelegateSale                   = "Delegate:Sale"
+	OperationDelegateTransfer               = "Delegate:Transfer"
+	OperationDelegateLockedTransfer         = "Delegate:LockedTransfer"
+	OperationDelegateUtility                = "Delegate:Utility"
+	OperationDelegateStaking                = "Delegate:Staking"
+)
+
+// Payload fields filled by Token Metadata
+const (
+	PayloadAmount           = "Amount"
+	PayloadAuthority        = "Authority"
+	PayloadAuthoritySeeds   = "AuthoritySeeds"
+	PayloadDelegate         = "Delegate"
+	PayloadDelegateSeeds    = "DelegateSeeds"
+	PayloadDestination      = "Destination"
+	PayloadDestinationSeeds = "DestinationSeeds"
+	PayloadHolder           = "Holder"
+	PayloadSource           = "Source"
+	PayloadSourceSeeds      = "SourceSeeds"
+)
+
+var (
+	ErrInvalidAccountOwner = errors.New("token auth rules: the account is not owned by the program")
+	ErrInvalidAccountKey   = errors.New("token auth rules: the account is not a rule set")
+	ErrInvalidRuleSet      = errors.New("token auth rules: invalid rule set data")
+	ErrUnsupportedVersion  = errors.New("token auth rules: unsupported rule set version")
+	ErrRevisionNotFound    = errors.New("token auth rules: rule set revision not found")
+	ErrOperationNotFound   = errors.New("token auth rules: operation not found")
+	ErrInvalidMessagePack  = errors.New("token auth rules: invalid message pack data")
+)
+
+// FindRuleSetAddress the RuleSet of the owner with the name
+func FindRuleSetAddress(owner common.PublicKey, name string) (common.PublicKey, error) {
+	k, _, err := FindRuleSetAddressAndBumpSeed(owner, name)
+	return k, err
+}
+
+func FindRuleSetAddressAndBumpSeed(owner common.PublicKey, name string) (common.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte(RuleSetSeed),
+		owner[:],
+		[]byte(name),
+	}, ProgramID)
+}
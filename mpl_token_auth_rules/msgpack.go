@@ -0,0 +1,341 @@
+package mpl_token_auth_rules
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// msgMap a MessagePack map, the order of the entries is kept
+type msgMap []msgEntry
+
+type msgEntry struct {
+	Key   any
+	Value any
+}
+
+// decodeMsgPack decodes one MessagePack value into nil, bool, uint64, int64, float64, string, []byte, []any or msgMap.
+// The extension types are returned as []byte
+func decodeMsgPack(data []byte) (any, error) {
+	d := &msgDecoder{data: data}
+	return d.decode(0)
+}
+
+const maxMsgPackDepth = 64
+
+type msgDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *msgDecoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("%w: unexpected end at %d", ErrInvalidMessagePack, d.pos)
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *msgDecoder) uint(n int) (uint64, error) {
+	b, err := d.read(n)
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	default:
+		return binary.BigEndian.Uint64(b), nil
+	}
+}
+
+func (d *msgDecoder) decode(depth int) (any, error) {
+	if depth > maxMsgPackDepth {
+		return nil, fmt.Errorf("%w: nested too deep", ErrInvalidMessagePack)
+	}
+	b, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	c := b[0]
+	switch {
+	case c <= 0x7f:
+		return uint64(c), nil
+	case c >= 0xe0:
+		return int64(int8(c)), nil
+	case c&0xf0 == 0x80:
+		return d.decodeMap(int(c&0x0f), depth)
+	case c&0xf0 == 0x90:
+		return d.decodeArray(int(c&0x0f), depth)
+	case c&0xe0 == 0xa0:
+		return d.decodeString(int(c & 0x1f))
+	}
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.uint(1 << (c - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		return d.read(int(n))
+	case 0xc7, 0xc8, 0xc9:
+		n, err := d.uint(1 << (c - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return d.read(int(n) + 1)
+	case 0xca:
+		n, err := d.uint(4)
+		return float64(math.Float32frombits(uint32(n))), err
+	case 0xcb:
+		n, err := d.uint(8)
+		return math.Float64frombits(n), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.uint(1 << (c - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (c - 0xd0)
+		n, err := d.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		// sign extend
+		shift := 64 - 8*size
+		return int64(n<<shift) >> shift, nil
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return d.read(1<<(c-0xd4) + 1)
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeString(int(n))
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (c - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeArray(int(n), depth)
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (c - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeMap(int(n), depth)
+	}
+	return nil, fmt.Errorf("%w: unknown format 0x%x", ErrInvalidMessagePack, c)
+}
+
+func (d *msgDecoder) decodeString(n int) (any, error) {
+	b, err := d.read(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (d *msgDecoder) decodeArray(n int, depth int) (any, error) {
+	if n > len(d.data)-d.pos {
+		return nil, fmt.Errorf("%w: array of %d items exceeds the data", ErrInvalidMessagePack, n)
+	}
+	ret := make([]any, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func (d *msgDecoder) decodeMap(n int, depth int) (any, error) {
+	if 2*n > len(d.data)-d.pos {
+		return nil, fmt.Errorf("%w: map of %d entries exceeds the data", ErrInvalidMessagePack, n)
+	}
+	ret := make(msgMap, 0, n)
+	for i := 0; i < n; i++ {
+		k, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, msgEntry{Key: k, Value: v})
+	}
+	return ret, nil
+}
+
+// the serde values, structs are encoded as arrays (compact) or maps (named)
+
+func msgUint(v any) (uint64, error) {
+	switch n := v.(type) {
+	case uint64:
+		return n, nil
+	case int64:
+		if n >= 0 {
+			return uint64(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: expected an unsigned integer, got %T", ErrInvalidRuleSet, v)
+}
+
+func msgString(v any) (string, error) {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case []byte:
+		return string(s), nil
+	}
+	return "", fmt.Errorf("%w: expected a string, got %T", ErrInvalidRuleSet, v)
+}
+
+func msgArray(v any) ([]any, error) {
+	if a, ok := v.([]any); ok {
+		return a, nil
+	}
+	return nil, fmt.Errorf("%w: expected an array, got %T", ErrInvalidRuleSet, v)
+}
+
+// msgBytes32 a [u8; 32] encoded as binary, as an array of bytes, or a Pubkey encoded as base58 (DisplayFromStr)
+func msgBytes32(v any) ([32]byte, error) {
+	var ret [32]byte
+	switch b := v.(type) {
+	case []byte:
+		if len(b) == 32 {
+			copy(ret[:], b)
+			return ret, nil
+		}
+	case string:
+		key, err := web3.NewPublicKey(b)
+		if err != nil {
+			return ret, fmt.Errorf("%w: %v", ErrInvalidRuleSet, err)
+		}
+		return key, nil
+	case []any:
+		if len(b) == 32 {
+			for i, item := range b {
+				n, err := msgUint(item)
+				if err != nil || n > math.MaxUint8 {
+					return ret, fmt.Errorf("%w: invalid byte %v", ErrInvalidRuleSet, item)
+				}
+				ret[i] = uint8(n)
+			}
+			return ret, nil
+		}
+	}
+	return ret, fmt.Errorf("%w: expected 32 bytes, got %T", ErrInvalidRuleSet, v)
+}
+
+func msgPubkey(v any) (common.PublicKey, error) {
+	b, err := msgBytes32(v)
+	return common.PublicKey(b), err
+}
+
+func msgPubkeys(v any) ([]common.PublicKey, error) {
+	items, err := msgArray(v)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]common.PublicKey, 0, len(items))
+	for _, item := range items {
+		key, err := msgPubkey(item)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, key)
+	}
+	return ret, nil
+}
+
+// msgFields the fields of a struct by position, or by name (snake_case or camelCase)
+func msgFields(v any, names ...string) ([]any, error) {
+	switch s := v.(type) {
+	case []any:
+		if len(s) < len(names) {
+			return nil, fmt.Errorf("%w: expected %d fields, got %d", ErrInvalidRuleSet, len(names), len(s))
+		}
+		return s[:len(names)], nil
+	case msgMap:
+		ret := make([]any, len(names))
+		for i, name := range names {
+			value, ok := s.get(name)
+			if !ok {
+				value, ok = s.get(camelCase(name))
+			}
+			if !ok {
+				return nil, fmt.Errorf("%w: missing field %s", ErrInvalidRuleSet, name)
+			}
+			ret[i] = value
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("%w: expected a struct, got %T", ErrInvalidRuleSet, v)
+}
+
+// msgVariant the variant of an enum: a unit variant is encoded as its name,
+// the others as a map of a single entry keyed by the name or the index of the variant
+func msgVariant(v any, names []string) (index int, value any, err error) {
+	var key = v
+	if m, ok := v.(msgMap); ok {
+		if len(m) != 1 {
+			return 0, nil, fmt.Errorf("%w: expected an enum, got a map of %d entries", ErrInvalidRuleSet, len(m))
+		}
+		key, value = m[0].Key, m[0].Value
+	}
+	switch k := key.(type) {
+	case string:
+		for i, name := range names {
+			if name == k {
+				return i, value, nil
+			}
+		}
+		return 0, nil, fmt.Errorf("%w: unknown variant %s", ErrInvalidRuleSet, k)
+	case uint64:
+		if k < uint64(len(names)) {
+			return int(k), value, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("%w: unknown variant %v", ErrInvalidRuleSet, key)
+}
+
+func (m msgMap) get(key string) (any, bool) {
+	for _, entry := range m {
+		if k, ok := entry.Key.(string); ok && k == key {
+			return entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+func camelCase(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,351 @@
+package mpl_token_auth_rules
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"strings"
+)
+
+// RuleKind the variant of RuleV1
+type RuleKind uint8
+
+const (
+	RuleAll RuleKind = iota
+	RuleAny
+	RuleNot
+	RuleAdditionalSigner
+	RulePubkeyMatch
+	RulePubkeyListMatch
+	RulePubkeyTreeMatch
+	RulePDAMatch
+	RuleProgramOwned
+	RuleProgramOwnedList
+	RuleProgramOwnedTree
+	RuleAmount
+	RuleFrequency
+	RuleIsWallet
+	RulePass
+	RuleNamespace
+)
+
+var ruleKindNames = []string{
+	"All",
+	"Any",
+	"Not",
+	"AdditionalSigner",
+	"PubkeyMatch",
+	"PubkeyListMatch",
+	"PubkeyTreeMatch",
+	"PDAMatch",
+	"ProgramOwned",
+	"ProgramOwnedList",
+	"ProgramOwnedTree",
+	"Amount",
+	"Frequency",
+	"IsWallet",
+	"Pass",
+	"Namespace",
+}
+
+func (k RuleKind) String() string {
+	if int(k) < len(ruleKindNames) {
+		return ruleKindNames[k]
+	}
+	return fmt.Sprintf("RuleKind(%d)", k)
+}
+
+// CompareOp the operator of an Amount rule, the payload amount is compared to the rule amount
+type CompareOp uint8
+
+const (
+	CompareLt CompareOp = iota
+	CompareLtEq
+	CompareEq
+	CompareGtEq
+	CompareGt
+)
+
+var compareOpNames = []string{"Lt", "LtEq", "Eq", "GtEq", "Gt"}
+
+func (op CompareOp) String() string {
+	switch op {
+	case CompareLt:
+		return "<"
+	case CompareLtEq:
+		return "<="
+	case CompareEq:
+		return "=="
+	case CompareGtEq:
+		return ">="
+	case CompareGt:
+		return ">"
+	}
+	return fmt.Sprintf("CompareOp(%d)", op)
+}
+
+// Compare returns the result of "value op amount"
+func (op CompareOp) Compare(value, amount uint64) bool {
+	switch op {
+	case CompareLt:
+		return value < amount
+	case CompareLtEq:
+		return value <= amount
+	case CompareEq:
+		return value == amount
+	case CompareGtEq:
+		return value >= amount
+	case CompareGt:
+		return value > amount
+	}
+	return false
+}
+
+// Rule a RuleV1, only the fields of its kind are set:
+//
+//	All, Any:                      Rules
+//	Not:                           Rule
+//	AdditionalSigner:              Pubkey (the account)
+//	PubkeyMatch:                   Pubkey, Field
+//	PubkeyListMatch:               Pubkeys, Field
+//	PubkeyTreeMatch:               Root, Field (pubkey), ProofField
+//	PDAMatch:                      Program (optional), Field (pda), SeedsField
+//	ProgramOwned:                  Pubkey (the program), Field
+//	ProgramOwnedList:              Pubkeys (the programs), Field
+//	ProgramOwnedTree:              Root, Field (pubkey), ProofField
+//	Amount:                        Amount, Operator, Field
+//	Frequency:                     Pubkey (the authority)
+//	IsWallet:                      Field
+//	Pass, Namespace:               none
+type Rule struct {
+	Kind       RuleKind
+	Rules      []*Rule
+	Rule       *Rule
+	Pubkey     common.PublicKey
+	Pubkeys    []common.PublicKey
+	Program    *common.PublicKey
+	Root       [32]byte
+	Field      string
+	ProofField string
+	SeedsField string
+	Amount     uint64
+	Operator   CompareOp
+}
+
+// decodeRule decodes a RuleV1 from its MessagePack value
+func decodeRule(v any, depth int) (*Rule, error) {
+	if depth > maxMsgPackDepth {
+		return nil, fmt.Errorf("%w: rules nested too deep", ErrInvalidRuleSet)
+	}
+	index, value, err := msgVariant(v, ruleKindNames)
+	if err != nil {
+		return nil, err
+	}
+	var rule = &Rule{Kind: RuleKind(index)}
+	var fields []any
+	fieldsOf := func(names ...string) error {
+		fields, err = msgFields(value, names...)
+		return err
+	}
+	switch rule.Kind {
+	case RuleAll, RuleAny:
+		if err = fieldsOf("rules"); err != nil {
+			return nil, err
+		}
+		items, err := msgArray(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			child, err := decodeRule(item, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			rule.Rules = append(rule.Rules, child)
+		}
+	case RuleNot:
+		if err = fieldsOf("rule"); err != nil {
+			return nil, err
+		}
+		if rule.Rule, err = decodeRule(fields[0], depth+1); err != nil {
+			return nil, err
+		}
+	case RuleAdditionalSigner:
+		if err = fieldsOf("account"); err != nil {
+			return nil, err
+		}
+		if rule.Pubkey, err = msgPubkey(fields[0]); err != nil {
+			return nil, err
+		}
+	case RulePubkeyMatch, RuleProgramOwned:
+		name := "pubkey"
+		if rule.Kind == RuleProgramOwned {
+			name = "program"
+		}
+		if err = fieldsOf(name, "field"); err != nil {
+			return nil, err
+		}
+		if rule.Pubkey, err = msgPubkey(fields[0]); err != nil {
+			return nil, err
+		}
+		if rule.Field, err = msgString(fields[1]); err != nil {
+			return nil, err
+		}
+	case RulePubkeyListMatch, RuleProgramOwnedList:
+		name := "pubkeys"
+		if rule.Kind == RuleProgramOwnedList {
+			name = "programs"
+		}
+		if err = fieldsOf(name, "field"); err != nil {
+			return nil, err
+		}
+		if rule.Pubkeys, err = msgPubkeys(fields[0]); err != nil {
+			return nil, err
+		}
+		if rule.Field, err = msgString(fields[1]); err != nil {
+			return nil, err
+		}
+	case RulePubkeyTreeMatch, RuleProgramOwnedTree:
+		if err = fieldsOf("root", "pubkey_field", "proof_field"); err != nil {
+			return nil, err
+		}
+		if rule.Root, err = msgBytes32(fields[0]); err != nil {
+			return nil, err
+		}
+		if rule.Field, err = msgString(fields[1]); err != nil {
+			return nil, err
+		}
+		if rule.ProofField, err = msgString(fields[2]); err != nil {
+			return nil, err
+		}
+	case RulePDAMatch:
+		if err = fieldsOf("program", "pda_field", "seeds_field"); err != nil {
+			return nil, err
+		}
+		if fields[0] != nil {
+			program, err := msgPubkey(fields[0])
+			if err != nil {
+				return nil, err
+			}
+			rule.Program = &program
+		}
+		if rule.Field, err = msgString(fields[1]); err != nil {
+			return nil, err
+		}
+		if rule.SeedsField, err = msgString(fields[2]); err != nil {
+			return nil, err
+		}
+	case RuleAmount:
+		if err = fieldsOf("amount", "operator", "field"); err != nil {
+			return nil, err
+		}
+		if rule.Amount, err = msgUint(fields[0]); err != nil {
+			return nil, err
+		}
+		operator, _, err := msgVariant(fields[1], compareOpNames)
+		if err != nil {
+			return nil, err
+		}
+		rule.Operator = CompareOp(operator)
+		if rule.Field, err = msgString(fields[2]); err != nil {
+			return nil, err
+		}
+	case RuleFrequency:
+		if err = fieldsOf("authority"); err != nil {
+			return nil, err
+		}
+		if rule.Pubkey, err = msgPubkey(fields[0]); err != nil {
+			return nil, err
+		}
+	case RuleIsWallet:
+		if err = fieldsOf("field"); err != nil {
+			return nil, err
+		}
+		if rule.Field, err = msgString(fields[0]); err != nil {
+			return nil, err
+		}
+	}
+	return rule, nil
+}
+
+// String the rule on one line
+func (r *Rule) String() string {
+	switch r.Kind {
+	case RuleAll, RuleAny:
+		items := make([]string, 0, len(r.Rules))
+		for _, item := range r.Rules {
+			items = append(items, item.String())
+		}
+		return fmt.Sprintf("%s(%s)", r.Kind, strings.Join(items, ", "))
+	case RuleNot:
+		return fmt.Sprintf("Not(%s)", r.Rule)
+	default:
+		return r.describe()
+	}
+}
+
+// Format the rule as an indented tree
+func (r *Rule) Format(indent string) string {
+	var b strings.Builder
+	r.format(&b, indent, 0)
+	return b.String()
+}
+
+func (r *Rule) format(b *strings.Builder, indent string, level int) {
+	b.WriteString(strings.Repeat(indent, level))
+	switch r.Kind {
+	case RuleAll, RuleAny:
+		b.WriteString(r.Kind.String())
+		b.WriteString("\n")
+		for _, item := range r.Rules {
+			item.format(b, indent, level+1)
+		}
+	case RuleNot:
+		b.WriteString("Not\n")
+		r.Rule.format(b, indent, level+1)
+	default:
+		b.WriteString(r.describe())
+		b.WriteString("\n")
+	}
+}
+
+// describe a leaf rule
+func (r *Rule) describe() string {
+	switch r.Kind {
+	case RuleAdditionalSigner:
+		return fmt.Sprintf("AdditionalSigner(%s)", r.Pubkey)
+	case RulePubkeyMatch:
+		return fmt.Sprintf("PubkeyMatch(%s == %s)", r.Field, r.Pubkey)
+	case RulePubkeyListMatch:
+		return fmt.Sprintf("PubkeyListMatch(%s in %s)", r.Field, formatPubkeys(r.Pubkeys))
+	case RulePubkeyTreeMatch:
+		return fmt.Sprintf("PubkeyTreeMatch(%s in tree %s, proof %s)", r.Field, hex.EncodeToString(r.Root[:]), r.ProofField)
+	case RulePDAMatch:
+		program := "owner"
+		if r.Program != nil {
+			program = r.Program.String()
+		}
+		return fmt.Sprintf("PDAMatch(%s derived from %s by %s)", r.Field, r.SeedsField, program)
+	case RuleProgramOwned:
+		return fmt.Sprintf("ProgramOwned(%s owned by %s)", r.Field, r.Pubkey)
+	case RuleProgramOwnedList:
+		return fmt.Sprintf("ProgramOwnedList(%s owned by %s)", r.Field, formatPubkeys(r.Pubkeys))
+	case RuleProgramOwnedTree:
+		return fmt.Sprintf("ProgramOwnedTree(%s owned by tree %s, proof %s)", r.Field, hex.EncodeToString(r.Root[:]), r.ProofField)
+	case RuleAmount:
+		return fmt.Sprintf("Amount(%s %s %d)", r.Field, r.Operator, r.Amount)
+	case RuleFrequency:
+		return fmt.Sprintf("Frequency(%s)", r.Pubkey)
+	case RuleIsWallet:
+		return fmt.Sprintf("IsWallet(%s)", r.Field)
+	}
+	return r.Kind.String()
+}
+
+func formatPubkeys(keys []common.PublicKey) string {
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, key.String())
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
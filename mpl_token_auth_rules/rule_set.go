@@ -0,0 +1,208 @@
+package mpl_token_auth_rules
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"sort"
+	"strings"
+)
+
+// RuleSet a RuleSetV1, the rules of the operations
+type RuleSet struct {
+	LibVersion uint8
+	Owner      common.PublicKey
+	Name       string
+	Operations map[string]*Rule
+}
+
+// DecodeRuleSet decodes a MessagePack encoded RuleSetV1
+func DecodeRuleSet(data []byte) (*RuleSet, error) {
+	value, err := decodeMsgPack(data)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := msgFields(value, "lib_version", "owner", "rule_set_name", "operations")
+	if err != nil {
+		return nil, err
+	}
+	var ret = &RuleSet{Operations: make(map[string]*Rule)}
+	libVersion, err := msgUint(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	if libVersion != uint64(RuleSetLibVersion) {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, libVersion)
+	}
+	ret.LibVersion = uint8(libVersion)
+	if ret.Owner, err = msgPubkey(fields[1]); err != nil {
+		return nil, err
+	}
+	if ret.Name, err = msgString(fields[2]); err != nil {
+		return nil, err
+	}
+	operations, ok := fields[3].(msgMap)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected the operations map, got %T", ErrInvalidRuleSet, fields[3])
+	}
+	for _, entry := range operations {
+		operation, err := msgString(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		if ret.Operations[operation], err = decodeRule(entry.Value, 0); err != nil {
+			return nil, fmt.Errorf("operation %s: %w", operation, err)
+		}
+	}
+	return ret, nil
+}
+
+// SortedOperations the operations sorted by name
+func (s *RuleSet) SortedOperations() []string {
+	ret := make([]string, 0, len(s.Operations))
+	for operation := range s.Operations {
+		ret = append(ret, operation)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Rule the rule of the operation, the rule of its namespace (the part before ':') if the operation is a Namespace rule.
+// As the program, an absent operation is not found, its namespace is not looked up.
+// Returns the operation or the namespace the rule belongs to
+func (s *RuleSet) Rule(operation string) (string, *Rule, error) {
+	rule, ok := s.Operations[operation]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s", ErrOperationNotFound, operation)
+	}
+	if rule.Kind != RuleNamespace {
+		return operation, rule, nil
+	}
+	if namespace, _, found := strings.Cut(operation, ":"); found {
+		if rule, ok := s.Operations[namespace]; ok && rule.Kind != RuleNamespace {
+			return namespace, rule, nil
+		}
+	}
+	return "", nil, fmt.Errorf("%w: %s", ErrOperationNotFound, operation)
+}
+
+// String the rule set as an indented tree of the rules of each operation
+func (s *RuleSet) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "RuleSet %q (owner %s, lib version %d)\n", s.Name, s.Owner, s.LibVersion)
+	for _, operation := range s.SortedOperations() {
+		fmt.Fprintf(&b, "  %s:\n", operation)
+		s.Operations[operation].format(&b, "  ", 2)
+	}
+	return b.String()
+}
+
+// RuleSetAccount a RuleSet account, holding all the revisions of the rule set
+type RuleSetAccount struct {
+	Key Key
+	// the offsets of the revisions in the account data
+	RevisionOffsets []uint64
+	// the raw revisions, the first byte is the lib version
+	Revisions [][]byte
+}
+
+// DecodeRuleSetAccount decodes the header, the revision map and the revisions of a RuleSet account
+func DecodeRuleSetAccount(data []byte) (*RuleSetAccount, error) {
+	if len(data) < RuleSetHeaderSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidRuleSet, len(data))
+	}
+	if Key(data[0]) != KeyRuleSet {
+		return nil, ErrInvalidAccountKey
+	}
+	revMapLocation := binary.LittleEndian.Uint64(data[1:RuleSetHeaderSize])
+	if revMapLocation >= uint64(len(data)) || revMapLocation < RuleSetHeaderSize {
+		return nil, fmt.Errorf("%w: revision map at %d", ErrInvalidRuleSet, revMapLocation)
+	}
+	revMap := data[revMapLocation:]
+	if revMap[0] != RuleSetRevMapVersion {
+		return nil, fmt.Errorf("%w: revision map version %d", ErrUnsupportedVersion, revMap[0])
+	}
+	if len(revMap) < 5 {
+		return nil, fmt.Errorf("%w: revision map too short", ErrInvalidRuleSet)
+	}
+	count := uint64(binary.LittleEndian.Uint32(revMap[1:5]))
+	if uint64(len(revMap)-5) < count*8 {
+		return nil, fmt.Errorf("%w: revision map of %d revisions too short", ErrInvalidRuleSet, count)
+	}
+	var ret = &RuleSetAccount{Key: KeyRuleSet}
+	for i := uint64(0); i < count; i++ {
+		ret.RevisionOffsets = append(ret.RevisionOffsets, binary.LittleEndian.Uint64(revMap[5+i*8:]))
+	}
+	for i, start := range ret.RevisionOffsets {
+		end := revMapLocation
+		if i+1 < len(ret.RevisionOffsets) {
+			end = ret.RevisionOffsets[i+1]
+		}
+		if start < RuleSetHeaderSize || start >= end || end > revMapLocation {
+			return nil, fmt.Errorf("%w: revision %d at [%d, %d)", ErrInvalidRuleSet, i, start, end)
+		}
+		ret.Revisions = append(ret.Revisions, data[start:end])
+	}
+	return ret, nil
+}
+
+// Revision decodes the revision of the rule set, the latest if revision is nil
+func (a *RuleSetAccount) Revision(revision *int) (*RuleSet, error) {
+	if len(a.Revisions) == 0 {
+		return nil, ErrRevisionNotFound
+	}
+	index := len(a.Revisions) - 1
+	if revision != nil {
+		index = *revision
+	}
+	if index < 0 || index >= len(a.Revisions) {
+		return nil, fmt.Errorf("%w: %d of %d", ErrRevisionNotFound, index, len(a.Revisions))
+	}
+	data := a.Revisions[index]
+	if data[0] != RuleSetLibVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+	}
+	return DecodeRuleSet(data[1:])
+}
+
+// Latest decodes the latest revision of the rule set
+func (a *RuleSetAccount) Latest() (*RuleSet, error) {
+	return a.Revision(nil)
+}
+
+// FetchRuleSetAccount fetches and decodes the RuleSet account, nil if the account does not exist
+func FetchRuleSetAccount(
+	ctx context.Context,
+	connection *web3.Connection,
+	address common.PublicKey,
+	commitment *web3.Commitment,
+) (*RuleSetAccount, error) {
+	_ = ctx
+	info, err := connection.GetAccountInfo(address, web3.GetAccountInfoConfig{Commitment: commitment})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if info.Owner != ProgramID {
+		return nil, ErrInvalidAccountOwner
+	}
+	return DecodeRuleSetAccount(info.Data.Content)
+}
+
+// FetchRuleSet fetches the latest revision of the rule set, nil if the account does not exist
+func FetchRuleSet(
+	ctx context.Context,
+	connection *web3.Connection,
+	address common.PublicKey,
+	commitment *web3.Commitment,
+) (*RuleSet, error) {
+	account, err := FetchRuleSetAccount(ctx, connection, address, commitment)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	return account.Latest()
+}
@@ -1,6 +1,15 @@
 package mpl_token_metadata
 
-import "github.com/donutnomad/solana-web3/web3"
+import (
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"strconv"
+)
+
+const (
+	// EditionMarkerBitSize the editions tracked by an EditionMarker account
+	EditionMarkerBitSize = 248
+)
 
 func FindAssociatedAddress(
 	mint web3.PublicKey,
@@ -18,3 +27,247 @@ func FindAssociatedAddressAndBumpSeed(
 		mint[:],
 	}, ProgramID)
 }
+
+// FindMasterEditionAddress the MasterEditionV2 of the mint, or the Edition if the mint is a print
+func FindMasterEditionAddress(
+	mint web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindMasterEditionAddressAndBumpSeed(mint)
+	return k, err
+}
+
+func FindMasterEditionAddressAndBumpSeed(
+	mint web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte("edition"),
+	}, ProgramID)
+}
+
+// FindEditionMarkerAddress the EditionMarker of the master edition tracking the edition number
+func FindEditionMarkerAddress(
+	masterMint web3.PublicKey,
+	edition uint64,
+) (web3.PublicKey, error) {
+	k, _, err := FindEditionMarkerAddressAndBumpSeed(masterMint, edition)
+	return k, err
+}
+
+func FindEditionMarkerAddressAndBumpSeed(
+	masterMint web3.PublicKey,
+	edition uint64,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		masterMint[:],
+		[]byte("edition"),
+		[]byte(strconv.FormatUint(edition/EditionMarkerBitSize, 10)),
+	}, ProgramID)
+}
+
+// FindEditionMarkerV2Address the EditionMarkerV2 of the master edition, tracking all its editions
+func FindEditionMarkerV2Address(
+	masterMint web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindEditionMarkerV2AddressAndBumpSeed(masterMint)
+	return k, err
+}
+
+func FindEditionMarkerV2AddressAndBumpSeed(
+	masterMint web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		masterMint[:],
+		[]byte("edition"),
+		[]byte("marker"),
+	}, ProgramID)
+}
+
+// FindTokenRecordAddress the TokenRecord of the token account of a programmable NFT
+func FindTokenRecordAddress(
+	mint web3.PublicKey,
+	token web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindTokenRecordAddressAndBumpSeed(mint, token)
+	return k, err
+}
+
+func FindTokenRecordAddressAndBumpSeed(
+	mint web3.PublicKey,
+	token web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte("token_record"),
+		token[:],
+	}, ProgramID)
+}
+
+// MetadataDelegateRoleSeed the seed of the MetadataDelegateRecord of the role
+func MetadataDelegateRoleSeed(role MetadataDelegateRole) (string, error) {
+	switch role {
+	case MetadataDelegateRoleAuthorityItem:
+		return "authority_item_delegate", nil
+	case MetadataDelegateRoleCollection:
+		return "collection_delegate", nil
+	case MetadataDelegateRoleUse:
+		return "use_delegate", nil
+	case MetadataDelegateRoleData:
+		return "data_delegate", nil
+	case MetadataDelegateRoleProgrammableConfig:
+		return "programmable_config_delegate", nil
+	case MetadataDelegateRoleDataItem:
+		return "data_item_delegate", nil
+	case MetadataDelegateRoleCollectionItem:
+		return "collection_item_delegate", nil
+	case MetadataDelegateRoleProgrammableConfigItem:
+		return "prog_config_item_delegate", nil
+	default:
+		return "", fmt.Errorf("unknown metadata delegate role %d", role)
+	}
+}
+
+// FindMetadataDelegateRecordAddress the MetadataDelegateRecord of the delegate approved by the update authority for the role
+func FindMetadataDelegateRecordAddress(
+	mint web3.PublicKey,
+	role MetadataDelegateRole,
+	updateAuthority web3.PublicKey,
+	delegate web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindMetadataDelegateRecordAddressAndBumpSeed(mint, role, updateAuthority, delegate)
+	return k, err
+}
+
+func FindMetadataDelegateRecordAddressAndBumpSeed(
+	mint web3.PublicKey,
+	role MetadataDelegateRole,
+	updateAuthority web3.PublicKey,
+	delegate web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	seed, err := MetadataDelegateRoleSeed(role)
+	if err != nil {
+		return web3.PublicKey{}, 0, err
+	}
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte(seed),
+		updateAuthority[:],
+		delegate[:],
+	}, ProgramID)
+}
+
+// FindHolderDelegateRecordAddress the record of the print delegate approved by the owner of the master edition
+func FindHolderDelegateRecordAddress(
+	mint web3.PublicKey,
+	owner web3.PublicKey,
+	delegate web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindHolderDelegateRecordAddressAndBumpSeed(mint, owner, delegate)
+	return k, err
+}
+
+func FindHolderDelegateRecordAddressAndBumpSeed(
+	mint web3.PublicKey,
+	owner web3.PublicKey,
+	delegate web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte("print_delegate"),
+		owner[:],
+		delegate[:],
+	}, ProgramID)
+}
+
+// FindCollectionAuthorityRecordAddress the CollectionAuthorityRecord of the authority approved for the collection mint
+func FindCollectionAuthorityRecordAddress(
+	mint web3.PublicKey,
+	authority web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindCollectionAuthorityRecordAddressAndBumpSeed(mint, authority)
+	return k, err
+}
+
+func FindCollectionAuthorityRecordAddressAndBumpSeed(
+	mint web3.PublicKey,
+	authority web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte("collection_authority"),
+		authority[:],
+	}, ProgramID)
+}
+
+// FindUseAuthorityRecordAddress the UseAuthorityRecord of the use authority approved for the mint
+func FindUseAuthorityRecordAddress(
+	mint web3.PublicKey,
+	useAuthority web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindUseAuthorityRecordAddressAndBumpSeed(mint, useAuthority)
+	return k, err
+}
+
+func FindUseAuthorityRecordAddressAndBumpSeed(
+	mint web3.PublicKey,
+	useAuthority web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+		[]byte("user"),
+		useAuthority[:],
+	}, ProgramID)
+}
+
+// FindProgramAsBurnerAddress the burner signing the burns of the use authorities
+func FindProgramAsBurnerAddress() (web3.PublicKey, error) {
+	k, _, err := web3.FindProgramAddress([][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		[]byte("burn"),
+	}, ProgramID)
+	return k, err
+}
+
+// FindEscrowAddress the TokenOwnedEscrow of the mint, owned by the token owner if creator is nil, otherwise by the creator
+func FindEscrowAddress(
+	mint web3.PublicKey,
+	creator *web3.PublicKey,
+) (web3.PublicKey, error) {
+	k, _, err := FindEscrowAddressAndBumpSeed(mint, creator)
+	return k, err
+}
+
+func FindEscrowAddressAndBumpSeed(
+	mint web3.PublicKey,
+	creator *web3.PublicKey,
+) (web3.PublicKey, uint8, error) {
+	// the seeds of the escrow authority: [0] for the token owner, [1] and the creator for a creator
+	var seeds = [][]byte{
+		[]byte("metadata"),
+		ProgramID[:],
+		mint[:],
+	}
+	if creator != nil {
+		seeds = append(seeds, []byte{1}, creator[:])
+	} else {
+		seeds = append(seeds, []byte{0})
+	}
+	return web3.FindProgramAddress(append(seeds, []byte("escrow")), ProgramID)
+}
@@ -0,0 +1,48 @@
+package mpl_token_metadata
+
+import (
+	"github.com/donutnomad/solana-web3/web3"
+	"testing"
+)
+
+// the addresses are derived outside of this package from the seeds of the program
+func TestFindAddresses(t *testing.T) {
+	mint := web3.MustPublicKey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	token := web3.MustPublicKey("So11111111111111111111111111111111111111112")
+	authority := web3.MustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+
+	find := func(k web3.PublicKey, bump uint8, err error) func() (web3.PublicKey, uint8, error) {
+		return func() (web3.PublicKey, uint8, error) { return k, bump, err }
+	}
+	tests := []struct {
+		name string
+		find func() (web3.PublicKey, uint8, error)
+		want string
+		bump uint8
+	}{
+		{"metadata", find(FindAssociatedAddressAndBumpSeed(mint)), "5x38Kp4hvdomTCnCrAny4UtMUt5rQBdB6px2K1Ui45Wq", 255},
+		{"edition", find(FindMasterEditionAddressAndBumpSeed(mint)), "A7FGB2kzjpDPRLMeqRLgW9XZ3JQ2RYRL4w5kUZv64ZB", 252},
+		{"edition marker", find(FindEditionMarkerAddressAndBumpSeed(mint, 500)), "5WDrstw1S121yZJbJTMfkzAzRtViywVdpeYfaknvF5Lx", 255},
+		{"edition marker v2", find(FindEditionMarkerV2AddressAndBumpSeed(mint)), "Hzm5ZxraVN5HyQVGGYga3t3hys9wz5GeRjucJ65jFtJW", 255},
+		{"token record", find(FindTokenRecordAddressAndBumpSeed(mint, token)), "4Y8v5bmnsGARqr2dGZbkmqFBGJB5ymLQCSjkGWqkzXHM", 255},
+		{"authority item delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleAuthorityItem, authority, token)), "8EDbgTLf2sb9sAM3S5xxbv7NSDGaF2xmKzdwfqboxzMA", 254},
+		{"collection delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleCollection, authority, token)), "SupUv4FA6NSxhgR19SJVh5WSqDxjgUWksoPfc1scUAp", 254},
+		{"use delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleUse, authority, token)), "2Rt1KymdThJE18A1aTVFqGGgckmfjDp266syh7pdUusq", 254},
+		{"data delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleData, authority, token)), "5ewXEiVcf7zkwM1MkcKrwNCESLCB9EVLnQr32S34CDnu", 254},
+		{"programmable config delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleProgrammableConfig, authority, token)), "Gp9H3ZfDWU3v1mtVmvBAocZhgqXSukpLqin2Ab6N6KFw", 254},
+		{"data item delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleDataItem, authority, token)), "EYGoiAgnEbRnrZNzsg2RH2tNgu26Pzxo6GjX8xYVv9gs", 254},
+		{"collection item delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleCollectionItem, authority, token)), "6nGVoELpnnK6U5ADt8xDdfBgyzeMAj4Eac5VXEDXAtP9", 255},
+		{"programmable config item delegate", find(FindMetadataDelegateRecordAddressAndBumpSeed(mint, MetadataDelegateRoleProgrammableConfigItem, authority, token)), "BJ5i4GzbtHZDbCYP5seKAtnQfXj2z4cgLiSB3kTHeF1C", 254},
+		{"escrow of the token owner", find(FindEscrowAddressAndBumpSeed(mint, nil)), "GVKHEME4PNFn2wCaRZJczhbTUwpXuk8VU5q5MyCfUWWd", 254},
+		{"escrow of a creator", find(FindEscrowAddressAndBumpSeed(mint, &authority)), "Ci5EXeomujMNeJA3AR9HmaSAt2HHuREvn9Zp7MPRDyTv", 253},
+	}
+	for _, tt := range tests {
+		k, bump, err := tt.find()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if k.String() != tt.want || bump != tt.bump {
+			t.Errorf("%s: got %s %d, want %s %d", tt.name, k, bump, tt.want, tt.bump)
+		}
+	}
+}
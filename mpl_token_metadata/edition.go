@@ -0,0 +1,47 @@
+package mpl_token_metadata
+
+// EditionMarkerIndex the EditionMarker tracking the edition, see FindEditionMarkerAddress
+func EditionMarkerIndex(edition uint64) uint64 {
+	return edition / EditionMarkerBitSize
+}
+
+// editionBit the byte and the mask of the edition in a ledger, the most significant bit of a byte is the first edition
+func editionBit(offset uint64) (uint64, uint8) {
+	return offset / 8, 1 << (7 - offset%8)
+}
+
+// IsPrinted returns true if the edition tracked by the marker is printed
+func (obj *EditionMarker) IsPrinted(edition uint64) bool {
+	index, mask := editionBit(edition % EditionMarkerBitSize)
+	return obj.Ledger[index]&mask != 0
+}
+
+// Printed the printed editions tracked by the marker
+// @param markerIndex The index of the marker, see EditionMarkerIndex
+func (obj *EditionMarker) Printed(markerIndex uint64) []uint64 {
+	var ret []uint64
+	for offset := uint64(0); offset < EditionMarkerBitSize; offset++ {
+		index, mask := editionBit(offset)
+		if obj.Ledger[index]&mask != 0 {
+			ret = append(ret, markerIndex*EditionMarkerBitSize+offset)
+		}
+	}
+	return ret
+}
+
+// IsPrinted returns true if the edition is printed
+func (obj *EditionMarkerV2) IsPrinted(edition uint64) bool {
+	index, mask := editionBit(edition)
+	return index < uint64(len(obj.Ledger)) && obj.Ledger[index]&mask != 0
+}
+
+// Printed the printed editions
+func (obj *EditionMarkerV2) Printed() []uint64 {
+	var ret []uint64
+	for edition := uint64(0); edition < uint64(len(obj.Ledger))*8; edition++ {
+		if obj.IsPrinted(edition) {
+			ret = append(ret, edition)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+package mpl_token_metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	binary "github.com/gagliardetto/binary"
+)
+
+var (
+	ErrInvalidAccountOwner = errors.New("mpl_token_metadata: the account is not owned by the program")
+	ErrUnknownAccountKey   = errors.New("mpl_token_metadata: unknown account key")
+	ErrUnexpectedAccount   = errors.New("mpl_token_metadata: unexpected account type")
+)
+
+// DecodeAccount decodes the account of the program by its key,
+// returns one of *Metadata, *MasterEditionV1, *MasterEditionV2, *Edition, *EditionMarker, *EditionMarkerV2,
+// *TokenRecord, *MetadataDelegateRecord, *CollectionAuthorityRecord, *UseAuthorityRecord, *TokenOwnedEscrow,
+// *ReservationListV1 and *ReservationListV2
+func DecodeAccount(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, ErrUnknownAccountKey
+	}
+	var account binary.BinaryUnmarshaler
+	switch Key(data[0]) {
+	case KeyEditionV1:
+		account = new(Edition)
+	case KeyMasterEditionV1:
+		account = new(MasterEditionV1)
+	case KeyReservationListV1:
+		account = new(ReservationListV1)
+	case KeyMetadataV1:
+		account = new(Metadata)
+	case KeyReservationListV2:
+		account = new(ReservationListV2)
+	case KeyMasterEditionV2:
+		account = new(MasterEditionV2)
+	case KeyEditionMarker:
+		account = new(EditionMarker)
+	case KeyUseAuthorityRecord:
+		account = new(UseAuthorityRecord)
+	case KeyCollectionAuthorityRecord:
+		account = new(CollectionAuthorityRecord)
+	case KeyTokenOwnedEscrow:
+		account = new(TokenOwnedEscrow)
+	case KeyTokenRecord:
+		account = new(TokenRecord)
+	case KeyMetadataDelegate:
+		account = new(MetadataDelegateRecord)
+	case KeyEditionMarkerV2:
+		account = new(EditionMarkerV2)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownAccountKey, data[0])
+	}
+	if err := account.UnmarshalWithDecoder(binary.NewBorshDecoder(data)); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// FetchAccount fetches and decodes the account of the program, nil if the account does not exist.
+// T is the type of the account, such as Metadata or TokenRecord
+func FetchAccount[T any](
+	ctx context.Context,
+	connection *web3.Connection,
+	address web3.PublicKey,
+	commitment *web3.Commitment,
+) (*T, error) {
+	_ = ctx
+	info, err := connection.GetAccountInfo(address, web3.GetAccountInfoConfig{Commitment: commitment})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if info.Owner != ProgramID {
+		return nil, ErrInvalidAccountOwner
+	}
+	account, err := DecodeAccount(info.Data.Content)
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := account.(*T)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedAccount, Key(info.Data.Content[0]))
+	}
+	return ret, nil
+}
+
+// FetchMetadata fetches the metadata of the mint, nil if the mint has no metadata
+func FetchMetadata(ctx context.Context, connection *web3.Connection, mint web3.PublicKey, commitment *web3.Commitment) (*Metadata, error) {
+	address, err := FindAssociatedAddress(mint)
+	if err != nil {
+		return nil, err
+	}
+	return FetchAccount[Metadata](ctx, connection, address, commitment)
+}
+
+// FetchMasterEdition fetches the master edition of the mint, nil if the mint has no master edition
+func FetchMasterEdition(ctx context.Context, connection *web3.Connection, mint web3.PublicKey, commitment *web3.Commitment) (*MasterEditionV2, error) {
+	address, err := FindMasterEditionAddress(mint)
+	if err != nil {
+		return nil, err
+	}
+	return FetchAccount[MasterEditionV2](ctx, connection, address, commitment)
+}
+
+// FetchEdition fetches the print edition of the mint, nil if the mint is not a print
+func FetchEdition(ctx context.Context, connection *web3.Connection, mint web3.PublicKey, commitment *web3.Commitment) (*Edition, error) {
+	address, err := FindMasterEditionAddress(mint)
+	if err != nil {
+		return nil, err
+	}
+	return FetchAccount[Edition](ctx, connection, address, commitment)
+}
+
+// FetchTokenRecord fetches the token record of the token account of a programmable NFT, nil if absent
+func FetchTokenRecord(ctx context.Context, connection *web3.Connection, mint, token web3.PublicKey, commitment *web3.Commitment) (*TokenRecord, error) {
+	address, err := FindTokenRecordAddress(mint, token)
+	if err != nil {
+		return nil, err
+	}
+	return FetchAccount[TokenRecord](ctx, connection, address, commitment)
+}
@@ -0,0 +1,214 @@
+package offchain_metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultArweaveGateway = "https://arweave.net/"
+	DefaultIpfsGateway    = "https://ipfs.io/ipfs/"
+	// DefaultMaxSize the maximum size of the JSON metadata
+	DefaultMaxSize = 1 << 20
+	// DefaultCacheSize the maximum number of cached metadata
+	DefaultCacheSize = 1024
+	DefaultCacheTTL  = 10 * time.Minute
+)
+
+var (
+	ErrUnsupportedUri   = errors.New("offchain_metadata: unsupported uri")
+	ErrTooLarge         = errors.New("offchain_metadata: the metadata exceeds the maximum size")
+	ErrUnexpectedStatus = errors.New("offchain_metadata: unexpected http status")
+)
+
+// FetcherConfig the configuration of a Fetcher, the zero values are replaced by the defaults
+type FetcherConfig struct {
+	HttpClient     *http.Client
+	ArweaveGateway string
+	IpfsGateway    string
+	// RewriteHttpGateways rewrites the http uris of other ipfs gateways to IpfsGateway, such as https://gateway.pinata.cloud/ipfs/<cid>
+	RewriteHttpGateways bool
+	MaxSize             int64
+	// CacheSize a negative size disables the cache
+	CacheSize int
+	CacheTTL  time.Duration
+}
+
+type cacheEntry struct {
+	metadata *Metadata
+	expires  time.Time
+}
+
+// Fetcher fetches the JSON metadata of the uris of the on-chain metadata, the parsed metadata are cached by the resolved uri
+type Fetcher struct {
+	config FetcherConfig
+	mu     sync.Mutex
+	cache  map[string]cacheEntry
+}
+
+func NewFetcher(config *FetcherConfig) *Fetcher {
+	var ret = Fetcher{cache: make(map[string]cacheEntry)}
+	if config != nil {
+		ret.config = *config
+	}
+	if ret.config.HttpClient == nil {
+		ret.config.HttpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	if ret.config.ArweaveGateway == "" {
+		ret.config.ArweaveGateway = DefaultArweaveGateway
+	}
+	if ret.config.IpfsGateway == "" {
+		ret.config.IpfsGateway = DefaultIpfsGateway
+	}
+	if ret.config.MaxSize <= 0 {
+		ret.config.MaxSize = DefaultMaxSize
+	}
+	if ret.config.CacheSize == 0 {
+		ret.config.CacheSize = DefaultCacheSize
+	}
+	if ret.config.CacheTTL <= 0 {
+		ret.config.CacheTTL = DefaultCacheTTL
+	}
+	return &ret
+}
+
+func joinGateway(gateway, path string) string {
+	return strings.TrimSuffix(gateway, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// ResolveUri the http uri of the uri of an on-chain metadata: the null padding is trimmed,
+// ar://<id> and ipfs://<cid> are rewritten to the gateways
+func (f *Fetcher) ResolveUri(uri string) (string, error) {
+	uri = TrimOnChain(uri)
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedUri, uri)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "ar":
+		return joinGateway(f.config.ArweaveGateway, u.Host+u.Path), nil
+	case "ipfs":
+		// ipfs://<cid>/<path> or ipfs://ipfs/<cid>/<path>
+		path := strings.TrimPrefix(u.Host+u.Path, "ipfs/")
+		return joinGateway(f.config.IpfsGateway, path), nil
+	case "http", "https":
+		if f.config.RewriteHttpGateways {
+			if _, path, ok := strings.Cut(u.Path, "/ipfs/"); ok && path != "" {
+				return joinGateway(f.config.IpfsGateway, path), nil
+			}
+		}
+		return uri, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedUri, uri)
+}
+
+// FetchBytes fetches the content of the uri, at most MaxSize bytes
+func (f *Fetcher) FetchBytes(ctx context.Context, uri string) ([]byte, error) {
+	resolved, err := f.ResolveUri(uri)
+	if err != nil {
+		return nil, err
+	}
+	return f.fetchBytes(ctx, resolved)
+}
+
+func (f *Fetcher) fetchBytes(ctx context.Context, resolved string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resolved, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := f.config.HttpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnexpectedStatus, response.Status, resolved)
+	}
+	if response.ContentLength > f.config.MaxSize {
+		return nil, fmt.Errorf("%w: %d", ErrTooLarge, response.ContentLength)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, f.config.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > f.config.MaxSize {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// Fetch fetches and parses the JSON metadata of the uri. The cached metadata are shared, they must not be modified
+func (f *Fetcher) Fetch(ctx context.Context, uri string) (*Metadata, error) {
+	resolved, err := f.ResolveUri(uri)
+	if err != nil {
+		return nil, err
+	}
+	if ret := f.cached(resolved); ret != nil {
+		return ret, nil
+	}
+	data, err := f.fetchBytes(ctx, resolved)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	f.store(resolved, ret)
+	return ret, nil
+}
+
+// FetchAndValidate fetches the JSON metadata of the uri and validates it against the standard and the on-chain name and symbol.
+// The metadata is returned along with the validation error, the caller decides to show it or not
+func (f *Fetcher) FetchAndValidate(ctx context.Context, uri, name, symbol string) (*Metadata, error) {
+	ret, err := f.Fetch(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	return ret, errors.Join(ret.Validate(), ret.ValidateOnChain(name, symbol))
+}
+
+func (f *Fetcher) cached(resolved string) *Metadata {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	entry, ok := f.cache[resolved]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expires) {
+		delete(f.cache, resolved)
+		return nil
+	}
+	return entry.metadata
+}
+
+func (f *Fetcher) store(resolved string, metadata *Metadata) {
+	if f.config.CacheSize < 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	if _, ok := f.cache[resolved]; !ok && len(f.cache) >= f.config.CacheSize {
+		// evict the expired entries, or the entry expiring first
+		var oldest string
+		for key, entry := range f.cache {
+			if now.After(entry.expires) {
+				delete(f.cache, key)
+			} else if oldest == "" || entry.expires.Before(f.cache[oldest].expires) {
+				oldest = key
+			}
+		}
+		if len(f.cache) >= f.config.CacheSize {
+			delete(f.cache, oldest)
+		}
+	}
+	f.cache[resolved] = cacheEntry{metadata: metadata, expires: now.Add(f.config.CacheTTL)}
+}
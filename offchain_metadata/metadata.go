@@ -0,0 +1,254 @@
+package offchain_metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Categories of the properties
+const (
+	CategoryImage = "image"
+	CategoryVideo = "video"
+	CategoryAudio = "audio"
+	CategoryVr    = "vr"
+	CategoryHtml  = "html"
+)
+
+// Metadata the JSON metadata of an NFT or a token, the Metaplex JSON standard.
+// The keys which are not part of the standard are kept in Extra
+type Metadata struct {
+	Name                 string                     `json:"name"`
+	Symbol               string                     `json:"symbol"`
+	Description          string                     `json:"description,omitempty"`
+	SellerFeeBasisPoints *uint16                    `json:"seller_fee_basis_points,omitempty"`
+	Image                string                     `json:"image,omitempty"`
+	AnimationUrl         string                     `json:"animation_url,omitempty"`
+	ExternalUrl          string                     `json:"external_url,omitempty"`
+	Attributes           Attributes                 `json:"attributes,omitempty"`
+	Properties           *Properties                `json:"properties,omitempty"`
+	Collection           *Collection                `json:"collection,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+// Properties the files and the creators of the metadata
+type Properties struct {
+	Files    []File    `json:"files,omitempty"`
+	Category string    `json:"category,omitempty"`
+	Creators []Creator `json:"creators,omitempty"`
+}
+
+// File a file of the metadata, the image or the animation in several formats
+type File struct {
+	Uri  string `json:"uri"`
+	Type string `json:"type,omitempty"`
+	Cdn  bool   `json:"cdn,omitempty"`
+}
+
+// UnmarshalJSON accepts a file given by its uri only
+func (f *File) UnmarshalJSON(data []byte) error {
+	var uri string
+	if err := json.Unmarshal(data, &uri); err == nil {
+		*f = File{Uri: uri}
+		return nil
+	}
+	type file File
+	return json.Unmarshal(data, (*file)(f))
+}
+
+// Creator a creator of the metadata and its share of the royalties, the address is checked by Validate
+type Creator struct {
+	Address string `json:"address"`
+	Share   uint8  `json:"share"`
+}
+
+// UnmarshalJSON accepts a share given as a string
+func (c *Creator) UnmarshalJSON(data []byte) error {
+	var ret struct {
+		Address string          `json:"address"`
+		Share   json.RawMessage `json:"share"`
+	}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return err
+	}
+	*c = Creator{Address: ret.Address}
+	if len(ret.Share) == 0 || string(ret.Share) == "null" {
+		return nil
+	}
+	share, ok := parseUint(ret.Share, 8)
+	if !ok {
+		return fmt.Errorf("%w: share %s", ErrInvalidJson, ret.Share)
+	}
+	c.Share = uint8(share)
+	return nil
+}
+
+// PublicKey the address of the creator
+func (c Creator) PublicKey() (web3.PublicKey, error) {
+	return web3.NewPublicKey(c.Address)
+}
+
+// Collection the off-chain collection of the metadata, the on-chain collection of the Metadata account prevails
+type Collection struct {
+	Name   string `json:"name,omitempty"`
+	Family string `json:"family,omitempty"`
+}
+
+// Attribute a trait of the metadata, the value is a string or a number
+type Attribute struct {
+	TraitType string `json:"trait_type"`
+	Value     any    `json:"value"`
+	// the display type of a numeric value, such as "number" or "date"
+	DisplayType string `json:"display_type,omitempty"`
+}
+
+// String the value of the attribute as a string
+func (a Attribute) String() string {
+	switch value := a.Value.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// Attributes the attributes of the metadata
+type Attributes []Attribute
+
+// Get the attribute of the trait type, nil if absent
+func (a Attributes) Get(traitType string) *Attribute {
+	for i := range a {
+		if a[i].TraitType == traitType {
+			return &a[i]
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON accepts the attributes given as an object of the trait types to the values, sorted by the trait type
+func (a *Attributes) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var values map[string]any
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		*a = nil
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			*a = append(*a, Attribute{TraitType: key, Value: values[key]})
+		}
+		return nil
+	}
+	var ret []Attribute
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+// standardKeys the keys of the fields of Metadata
+var standardKeys = []string{"name", "symbol", "description", "seller_fee_basis_points", "image", "animation_url", "external_url", "attributes", "properties", "collection"}
+
+type metadata Metadata
+
+// MarshalJSON writes the extra keys along with the fields, the fields prevail
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(metadata(m))
+	if err != nil || len(m.Extra) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range m.Extra {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON reads the metadata key by key, the seller fee basis points and the shares of the creators may be strings.
+// The keys out of the standard, and the standard keys whose value does not fit the field, are kept in Extra,
+// the latter are reported by Validate
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var ret Metadata
+	decoders := map[string]func(json.RawMessage) bool{
+		"name":          func(raw json.RawMessage) bool { return decodeField(raw, &ret.Name) },
+		"symbol":        func(raw json.RawMessage) bool { return decodeField(raw, &ret.Symbol) },
+		"description":   func(raw json.RawMessage) bool { return decodeField(raw, &ret.Description) },
+		"image":         func(raw json.RawMessage) bool { return decodeField(raw, &ret.Image) },
+		"animation_url": func(raw json.RawMessage) bool { return decodeField(raw, &ret.AnimationUrl) },
+		"external_url":  func(raw json.RawMessage) bool { return decodeField(raw, &ret.ExternalUrl) },
+		"attributes":    func(raw json.RawMessage) bool { return decodeField(raw, &ret.Attributes) },
+		"properties":    func(raw json.RawMessage) bool { return decodeField(raw, &ret.Properties) },
+		"collection":    func(raw json.RawMessage) bool { return decodeField(raw, &ret.Collection) },
+		"seller_fee_basis_points": func(raw json.RawMessage) bool {
+			fee, ok := parseUint(raw, 16)
+			if ok {
+				ret.SellerFeeBasisPoints = new(uint16)
+				*ret.SellerFeeBasisPoints = uint16(fee)
+			}
+			return ok
+		},
+	}
+	for key, value := range fields {
+		if decode, ok := decoders[key]; ok && decode(value) {
+			delete(fields, key)
+		}
+	}
+	if len(fields) > 0 {
+		ret.Extra = fields
+	}
+	*m = ret
+	return nil
+}
+
+// decodeField decodes the value into the field, the field is unchanged if the value does not fit
+func decodeField[T any](raw json.RawMessage, field *T) bool {
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false
+	}
+	*field = value
+	return true
+}
+
+// parseUint parses an unsigned integer of the size given as a number, such as 500 or 500.0, or as a string
+func parseUint(raw json.RawMessage, bitSize int) (uint64, bool) {
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		s = string(bytes.TrimSpace(raw))
+	}
+	if value, err := strconv.ParseUint(s, 10, bitSize); err == nil {
+		return value, true
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || value < 0 || value != math.Trunc(value) || value >= float64(uint64(1)<<bitSize) {
+		return 0, false
+	}
+	return uint64(value), true
+}
+
+// Parse parses the JSON metadata
+func Parse(data []byte) (*Metadata, error) {
+	var ret Metadata
+	if err := json.Unmarshal(data, &ret); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJson, err)
+	}
+	return &ret, nil
+}
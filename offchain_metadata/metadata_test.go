@@ -0,0 +1,151 @@
+package offchain_metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const sloppyJson = `{
+	"name": "Item #1",
+	"symbol": "ITM",
+	"seller_fee_basis_points": "500",
+	"image": "ipfs://QmImage/1.png",
+	"attributes": {"level": 7, "background": "blue"},
+	"properties": {
+		"files": ["ipfs://QmImage/1.png", {"uri": "https://example.com/1.mp4", "type": "video/mp4"}],
+		"creators": [{"address": "not a key", "share": 90}]
+	},
+	"twitter": "https://x.com/item"
+}`
+
+func TestParse(t *testing.T) {
+	metadata, err := Parse([]byte(sloppyJson))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *metadata.SellerFeeBasisPoints != 500 || len(metadata.Attributes) != 2 || metadata.Attributes.Get("level").String() != "7" {
+		t.Fatalf("unexpected metadata %+v", metadata)
+	}
+	if files := metadata.Properties.Files; len(files) != 2 || files[0].Uri != metadata.Image || files[1].Type != "video/mp4" {
+		t.Fatalf("unexpected files %+v", files)
+	}
+	// the extra keys are written back
+	data, err := json.Marshal(metadata)
+	if err != nil || !strings.Contains(string(data), `"twitter":"https://x.com/item"`) || !strings.Contains(string(data), `"seller_fee_basis_points":500`) {
+		t.Fatalf("unexpected json %s %v", data, err)
+	}
+
+	err = metadata.Validate()
+	if !errors.Is(err, ErrInvalidCreator) || !errors.Is(err, ErrInvalidCreatorShares) || errors.Is(err, ErrInvalidUri) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := metadata.ValidateOnChain("Item #1\x00\x00\x00", "ITM\x00"); err != nil {
+		t.Fatal(err)
+	}
+	if err := metadata.ValidateOnChain("Item #2", "ITM"); !errors.Is(err, ErrNameMismatch) || errors.Is(err, ErrSymbolMismatch) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := Parse([]byte("<html>")); !errors.Is(err, ErrInvalidJson) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+// an off-type standard key does not fail the parse, it is kept in Extra and reported by Validate
+func TestParseOffType(t *testing.T) {
+	const creator = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	tests := []struct {
+		name    string
+		json    string
+		check   func(m *Metadata) bool
+		invalid bool
+	}{
+		{"collection string", `{"name": "Item", "collection": "My Collection"}`, func(m *Metadata) bool {
+			return m.Collection == nil && string(m.Extra["collection"]) == `"My Collection"`
+		}, true},
+		{"float seller fee", `{"name": "Item", "seller_fee_basis_points": 500.0}`, func(m *Metadata) bool {
+			return m.SellerFeeBasisPoints != nil && *m.SellerFeeBasisPoints == 500 && m.Extra == nil
+		}, false},
+		{"string share", `{"name": "Item", "properties": {"creators": [{"address": "` + creator + `", "share": "50"}, {"address": "` + creator + `", "share": 50}]}}`, func(m *Metadata) bool {
+			return m.Properties != nil && len(m.Properties.Creators) == 2 && m.Properties.Creators[0].Share == 50 && m.Extra == nil
+		}, false},
+		{"fractional seller fee", `{"name": "Item", "seller_fee_basis_points": 500.5}`, func(m *Metadata) bool {
+			return m.SellerFeeBasisPoints == nil && string(m.Extra["seller_fee_basis_points"]) == "500.5"
+		}, true},
+	}
+	for _, tt := range tests {
+		metadata, err := Parse([]byte(tt.json))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if metadata.Name != "Item" || !tt.check(metadata) {
+			t.Fatalf("%s: unexpected metadata %+v", tt.name, metadata)
+		}
+		if err := metadata.Validate(); errors.Is(err, ErrInvalidField) != tt.invalid {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+
+	// the kept value is written back
+	metadata, _ := Parse([]byte(tests[0].json))
+	if data, err := json.Marshal(metadata); err != nil || !strings.Contains(string(data), `"collection":"My Collection"`) {
+		t.Fatalf("unexpected json %s %v", data, err)
+	}
+}
+
+func TestFetcher(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch r.URL.Path {
+		case "/ipfs/QmMeta/1.json", "/ar/tx":
+			_, _ = w.Write([]byte(sloppyJson))
+		case "/large.json":
+			_, _ = w.Write([]byte(`{"name": "` + strings.Repeat("a", 2048) + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	fetcher := NewFetcher(&FetcherConfig{
+		ArweaveGateway:      server.URL + "/ar",
+		IpfsGateway:         server.URL + "/ipfs/",
+		RewriteHttpGateways: true,
+		MaxSize:             1024,
+	})
+	ctx := context.Background()
+
+	for uri, want := range map[string]string{
+		"ipfs://QmMeta/1.json\x00\x00":                    server.URL + "/ipfs/QmMeta/1.json",
+		"ipfs://ipfs/QmMeta/1.json":                       server.URL + "/ipfs/QmMeta/1.json",
+		"https://gateway.pinata.cloud/ipfs/QmMeta/1.json": server.URL + "/ipfs/QmMeta/1.json",
+		"ar://tx":                    server.URL + "/ar/tx",
+		"https://example.com/1.json": "https://example.com/1.json",
+	} {
+		if got, err := fetcher.ResolveUri(uri); err != nil || got != want {
+			t.Fatalf("unexpected uri %s %v, want %s", got, err, want)
+		}
+	}
+	if _, err := fetcher.ResolveUri("ftp://example.com/1.json"); !errors.Is(err, ErrUnsupportedUri) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	metadata, err := fetcher.FetchAndValidate(ctx, "ipfs://QmMeta/1.json", "Item #1", "ITM")
+	if metadata == nil || !errors.Is(err, ErrInvalidCreatorShares) {
+		t.Fatalf("unexpected metadata %v %v", metadata, err)
+	}
+	// cached by the resolved uri
+	if _, err := fetcher.Fetch(ctx, "https://gateway.pinata.cloud/ipfs/QmMeta/1.json"); err != nil || len(requests) != 1 {
+		t.Fatalf("unexpected requests %v %v", requests, err)
+	}
+	if _, err := fetcher.Fetch(ctx, server.URL+"/large.json"); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := fetcher.Fetch(ctx, server.URL+"/missing.json"); !errors.Is(err, ErrUnexpectedStatus) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
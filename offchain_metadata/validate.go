@@ -0,0 +1,115 @@
+package offchain_metadata
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	ErrInvalidJson          = errors.New("offchain_metadata: invalid json")
+	ErrEmptyName            = errors.New("offchain_metadata: empty name")
+	ErrNameMismatch         = errors.New("offchain_metadata: the name does not match the on-chain name")
+	ErrSymbolMismatch       = errors.New("offchain_metadata: the symbol does not match the on-chain symbol")
+	ErrInvalidUri           = errors.New("offchain_metadata: invalid uri")
+	ErrInvalidAttribute     = errors.New("offchain_metadata: invalid attribute")
+	ErrInvalidSellerFee     = errors.New("offchain_metadata: the seller fee basis points exceed 10000")
+	ErrInvalidCreator       = errors.New("offchain_metadata: invalid creator address")
+	ErrInvalidCreatorShares = errors.New("offchain_metadata: the shares of the creators do not add up to 100")
+	ErrInvalidField         = errors.New("offchain_metadata: the value of the standard key does not fit its field")
+)
+
+// validSchemes the schemes of the uris of the metadata
+var validSchemes = []string{"https", "http", "ar", "ipfs", "data"}
+
+func validateUri(field, uri string) error {
+	if uri == "" {
+		return nil
+	}
+	u, err := url.Parse(uri)
+	if err != nil || !containsFold(validSchemes, u.Scheme) || (u.Host == "" && u.Opaque == "" && u.Path == "") {
+		return fmt.Errorf("%w: %s %q", ErrInvalidUri, field, uri)
+	}
+	return nil
+}
+
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the metadata against the Metaplex JSON standard, returns all the issues joined by errors.Join
+func (m *Metadata) Validate() error {
+	var errs []error
+	// the standard keys kept in Extra did not fit their field
+	for _, key := range standardKeys {
+		if value, ok := m.Extra[key]; ok {
+			errs = append(errs, fmt.Errorf("%w: %s %s", ErrInvalidField, key, value))
+		}
+	}
+	if strings.TrimSpace(m.Name) == "" {
+		errs = append(errs, ErrEmptyName)
+	}
+	if m.SellerFeeBasisPoints != nil && *m.SellerFeeBasisPoints > 10000 {
+		errs = append(errs, ErrInvalidSellerFee)
+	}
+	errs = append(errs,
+		validateUri("image", m.Image),
+		validateUri("animation_url", m.AnimationUrl),
+		validateUri("external_url", m.ExternalUrl),
+	)
+	for i, attribute := range m.Attributes {
+		if strings.TrimSpace(attribute.TraitType) == "" {
+			errs = append(errs, fmt.Errorf("%w: empty trait type of the attribute %d", ErrInvalidAttribute, i))
+		}
+		switch attribute.Value.(type) {
+		case string, float64, bool:
+		default:
+			errs = append(errs, fmt.Errorf("%w: unexpected value of the attribute %q", ErrInvalidAttribute, attribute.TraitType))
+		}
+	}
+	if m.Properties != nil {
+		for i, file := range m.Properties.Files {
+			if file.Uri == "" {
+				errs = append(errs, fmt.Errorf("%w: empty uri of the file %d", ErrInvalidUri, i))
+			}
+			errs = append(errs, validateUri("properties.files", file.Uri))
+		}
+		if len(m.Properties.Creators) > 0 {
+			var shares int
+			for _, creator := range m.Properties.Creators {
+				if _, err := creator.PublicKey(); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidCreator, creator.Address))
+				}
+				shares += int(creator.Share)
+			}
+			if shares != 100 {
+				errs = append(errs, fmt.Errorf("%w: %d", ErrInvalidCreatorShares, shares))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// TrimOnChain trims the null padding and the spaces of a string of an on-chain metadata account
+func TrimOnChain(s string) string {
+	return strings.TrimSpace(strings.TrimRight(s, "\x00"))
+}
+
+// ValidateOnChain checks the name and the symbol of the metadata against the on-chain name and symbol,
+// such as Metadata.Data of Metaplex or the TokenMetadata extension of Token-2022
+func (m *Metadata) ValidateOnChain(name, symbol string) error {
+	var errs []error
+	if got, want := strings.TrimSpace(m.Name), TrimOnChain(name); got != want {
+		errs = append(errs, fmt.Errorf("%w: %q, %q", ErrNameMismatch, got, want))
+	}
+	if got, want := strings.TrimSpace(m.Symbol), TrimOnChain(symbol); got != want {
+		errs = append(errs, fmt.Errorf("%w: %q, %q", ErrSymbolMismatch, got, want))
+	}
+	return errors.Join(errs...)
+}
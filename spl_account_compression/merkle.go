@@ -0,0 +1,142 @@
+package spl_account_compression
+
+import (
+	"fmt"
+	"golang.org/x/crypto/sha3"
+)
+
+// emptyNodes the root of an empty subtree by its level, the level of a leaf is 0
+var emptyNodes = func() (ret [MaxDepth + 1][32]byte) {
+	for level := 1; level <= MaxDepth; level++ {
+		ret[level] = Hash(ret[level-1], ret[level-1])
+	}
+	return ret
+}()
+
+// Hash the parent node of the left and the right nodes: keccak256(left || right)
+func Hash(left, right [32]byte) (ret [32]byte) {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(left[:])
+	h.Write(right[:])
+	h.Sum(ret[:0])
+	return ret
+}
+
+// EmptyNode the root of an empty subtree of the level, the empty leaf is 32 zero bytes
+func EmptyNode(level uint32) [32]byte {
+	if level > MaxDepth {
+		panic(fmt.Sprintf("spl_account_compression: level %d exceeds the max depth", level))
+	}
+	return emptyNodes[level]
+}
+
+// ComputeRoot computes the root of the tree from the leaf at the index and its proof,
+// the proof is the siblings of the path from the leaf to the root
+func ComputeRoot(leaf [32]byte, proof [][32]byte, index uint32) [32]byte {
+	node := leaf
+	for i, sibling := range proof {
+		if (index>>i)&1 == 0 {
+			node = Hash(node, sibling)
+		} else {
+			node = Hash(sibling, node)
+		}
+	}
+	return node
+}
+
+// VerifyProof returns true if the leaf at the index belongs to the tree of the root
+func VerifyProof(root, leaf [32]byte, proof [][32]byte, index uint32) bool {
+	return ComputeRoot(leaf, proof, index) == root
+}
+
+// MerkleTree an in-memory merkle tree hashed like a ConcurrentMerkleTree.
+// Only the nodes of the appended leaves are stored, the other nodes are empty nodes
+type MerkleTree struct {
+	depth uint32
+	// levels[0] the leaves, levels[depth] the root
+	levels [][][32]byte
+}
+
+// NewMerkleTree creates a tree of the depth with the leaves appended in order
+func NewMerkleTree(depth uint32, leaves ...[32]byte) (*MerkleTree, error) {
+	if depth == 0 || depth > MaxDepth {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidDepth, depth)
+	}
+	if uint64(len(leaves)) > 1<<depth {
+		return nil, fmt.Errorf("%w: %d leaves", ErrInvalidIndex, len(leaves))
+	}
+	tree := &MerkleTree{depth: depth, levels: make([][][32]byte, depth+1)}
+	tree.levels[0] = append([][32]byte(nil), leaves...)
+	for level := uint32(1); level <= depth; level++ {
+		children := tree.levels[level-1]
+		nodes := make([][32]byte, (len(children)+1)/2)
+		for i := range nodes {
+			nodes[i] = Hash(tree.Node(level-1, uint32(2*i)), tree.Node(level-1, uint32(2*i+1)))
+		}
+		tree.levels[level] = nodes
+	}
+	return tree, nil
+}
+
+// Depth the depth of the tree
+func (t *MerkleTree) Depth() uint32 {
+	return t.depth
+}
+
+// Len the number of the appended leaves
+func (t *MerkleTree) Len() uint32 {
+	return uint32(len(t.levels[0]))
+}
+
+// Root the root of the tree
+func (t *MerkleTree) Root() [32]byte {
+	return t.Node(t.depth, 0)
+}
+
+// Node the node at the index of the level, the leaves are at the level 0
+func (t *MerkleTree) Node(level, index uint32) [32]byte {
+	if nodes := t.levels[level]; index < uint32(len(nodes)) {
+		return nodes[index]
+	}
+	return EmptyNode(level)
+}
+
+// Proof the siblings of the path from the leaf at the index to the root
+func (t *MerkleTree) Proof(index uint32) ([][32]byte, error) {
+	if uint64(index) >= 1<<t.depth {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidIndex, index)
+	}
+	proof := make([][32]byte, t.depth)
+	for level := uint32(0); level < t.depth; level++ {
+		proof[level] = t.Node(level, (index>>level)^1)
+	}
+	return proof, nil
+}
+
+// Append appends the leaf, returns its index
+func (t *MerkleTree) Append(leaf [32]byte) (uint32, error) {
+	index := t.Len()
+	if uint64(index) >= 1<<t.depth {
+		return 0, fmt.Errorf("%w: the tree is full", ErrInvalidIndex)
+	}
+	for level := uint32(0); level <= t.depth; level++ {
+		if uint32(len(t.levels[level])) <= index>>level {
+			t.levels[level] = append(t.levels[level], EmptyNode(level))
+		}
+	}
+	return index, t.Update(index, leaf)
+}
+
+// Update replaces the leaf at the index, the leaf must have been appended.
+// Replace a leaf by the empty leaf to remove it
+func (t *MerkleTree) Update(index uint32, leaf [32]byte) error {
+	if index >= t.Len() {
+		return fmt.Errorf("%w: %d", ErrInvalidIndex, index)
+	}
+	t.levels[0][index] = leaf
+	for level := uint32(1); level <= t.depth; level++ {
+		index >>= 1
+		t.levels[level][index] = Hash(t.Node(level-1, 2*index), t.Node(level-1, 2*index+1))
+	}
+	return nil
+}
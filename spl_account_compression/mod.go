@@ -0,0 +1,47 @@
+package spl_account_compression
+
+import (
+	"errors"
+	"github.com/donutnomad/solana-web3/common"
+)
+
+var ProgramID common.PublicKey = common.MustPublicKeyFromBase58("cmtDvXumGCrqC1Age74AVPhSRVXJMd8PJS91L8KbNCK")
+
+// NoopProgramID the log wrapper of the compression programs, the changelogs are logged by its instruction data
+var NoopProgramID common.PublicKey = common.MustPublicKeyFromBase58("noopb9bkMVfRPU8AsbpTUg8AQkHtKwMYZiFUjNRtMmV")
+
+const ProgramName = "spl_account_compression"
+
+// AccountType the first byte of the accounts of the program
+type AccountType uint8
+
+const (
+	AccountTypeUninitialized AccountType = iota
+	AccountTypeConcurrentMerkleTree
+)
+
+// HeaderVersion the version of the header of a ConcurrentMerkleTree account
+type HeaderVersion uint8
+
+const (
+	HeaderVersionV1 HeaderVersion = iota
+)
+
+const (
+	// HeaderSize the size of the header of a ConcurrentMerkleTree account
+	HeaderSize = 56
+	// treeMetadataSize the sequence number, the active index and the buffer size of the tree
+	treeMetadataSize = 24
+	// MaxDepth the maximum depth of a tree supported by the program
+	MaxDepth = 30
+)
+
+var (
+	ErrInvalidAccountOwner = errors.New("spl_account_compression: the account is not owned by the program")
+	ErrInvalidAccountType  = errors.New("spl_account_compression: the account is not a concurrent merkle tree")
+	ErrUnsupportedVersion  = errors.New("spl_account_compression: unsupported header version")
+	ErrInvalidAccountSize  = errors.New("spl_account_compression: unexpected account size")
+	ErrInvalidDepth        = errors.New("spl_account_compression: invalid tree depth")
+	ErrInvalidIndex        = errors.New("spl_account_compression: the leaf index is out of the tree")
+	ErrInvalidProof        = errors.New("spl_account_compression: unexpected proof length")
+)
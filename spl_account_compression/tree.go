@@ -0,0 +1,246 @@
+package spl_account_compression
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"math/bits"
+)
+
+// ValidDepthSizePairs the (max depth, max buffer size) pairs accepted by the program
+var ValidDepthSizePairs = [][2]uint32{
+	{3, 8}, {5, 8},
+	{14, 64}, {14, 256}, {14, 1024}, {14, 2048},
+	{15, 64}, {16, 64}, {17, 64}, {18, 64}, {19, 64},
+	{20, 64}, {20, 256}, {20, 1024}, {20, 2048},
+	{24, 64}, {24, 256}, {24, 512}, {24, 1024}, {24, 2048},
+	{26, 512}, {26, 1024}, {26, 2048},
+	{30, 512}, {30, 1024}, {30, 2048},
+}
+
+// IsValidDepthSizePair returns true if the program accepts the max depth and the max buffer size
+func IsValidDepthSizePair(maxDepth, maxBufferSize uint32) bool {
+	for _, pair := range ValidDepthSizePairs {
+		if pair[0] == maxDepth && pair[1] == maxBufferSize {
+			return true
+		}
+	}
+	return false
+}
+
+// GetConcurrentMerkleTreeAccountSize the size of a ConcurrentMerkleTree account,
+// the canopy caches the nodes of the top canopyDepth levels below the root
+func GetConcurrentMerkleTreeAccountSize(maxDepth, maxBufferSize, canopyDepth uint32) uint64 {
+	changeLogSize := uint64(32 + 32*maxDepth + 8)
+	rightmostProofSize := uint64(32*maxDepth + 32 + 8)
+	canopySize := uint64(0)
+	if canopyDepth > 0 {
+		canopySize = ((1 << (canopyDepth + 1)) - 2) * 32
+	}
+	return HeaderSize + treeMetadataSize + uint64(maxBufferSize)*changeLogSize + rightmostProofSize + canopySize
+}
+
+// ConcurrentMerkleTreeHeader the header of a ConcurrentMerkleTree account
+type ConcurrentMerkleTreeHeader struct {
+	AccountType   AccountType
+	Version       HeaderVersion
+	MaxBufferSize uint32
+	MaxDepth      uint32
+	// the authority allowed to modify the tree, the TreeConfig of Bubblegum
+	Authority    common.PublicKey
+	CreationSlot uint64
+}
+
+// ChangeLog a change of the tree: the new root and the new nodes of the path of the changed leaf
+type ChangeLog struct {
+	Root [32]byte
+	// the nodes from the leaf to the root, excluded
+	Path  [][32]byte
+	Index uint32
+}
+
+// Path the proof of the rightmost leaf, used to append leaves
+type Path struct {
+	Proof [][32]byte
+	Leaf  [32]byte
+	// the number of the appended leaves
+	Index uint32
+}
+
+// ConcurrentMerkleTree a ConcurrentMerkleTree account of the program
+type ConcurrentMerkleTree struct {
+	Header         ConcurrentMerkleTreeHeader
+	SequenceNumber uint64
+	// the index of the latest changelog
+	ActiveIndex uint64
+	// the number of the valid changelogs
+	BufferSize     uint64
+	ChangeLogs     []ChangeLog
+	RightmostProof Path
+	// the cached nodes of the top levels, without the root, in breadth-first order
+	Canopy [][32]byte
+}
+
+type treeDecoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *treeDecoder) next(n int) []byte {
+	ret := d.data[d.offset : d.offset+n]
+	d.offset += n
+	return ret
+}
+
+func (d *treeDecoder) node() (ret [32]byte) {
+	copy(ret[:], d.next(32))
+	return ret
+}
+
+func (d *treeDecoder) nodes(n uint32) [][32]byte {
+	ret := make([][32]byte, n)
+	for i := range ret {
+		ret[i] = d.node()
+	}
+	return ret
+}
+
+func (d *treeDecoder) uint32() uint32 {
+	return binary.LittleEndian.Uint32(d.next(4))
+}
+
+func (d *treeDecoder) uint64() uint64 {
+	return binary.LittleEndian.Uint64(d.next(8))
+}
+
+// DecodeConcurrentMerkleTree decodes the data of a ConcurrentMerkleTree account
+func DecodeConcurrentMerkleTree(data []byte) (*ConcurrentMerkleTree, error) {
+	if len(data) < HeaderSize {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidAccountSize, len(data))
+	}
+	d := &treeDecoder{data: data}
+	var ret ConcurrentMerkleTree
+	header := &ret.Header
+	header.AccountType = AccountType(d.next(1)[0])
+	if header.AccountType != AccountTypeConcurrentMerkleTree {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidAccountType, header.AccountType)
+	}
+	header.Version = HeaderVersion(d.next(1)[0])
+	if header.Version != HeaderVersionV1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+	header.MaxBufferSize = d.uint32()
+	header.MaxDepth = d.uint32()
+	header.Authority = web3.NewPublicKeyFromBs(d.next(32))
+	header.CreationSlot = d.uint64()
+	d.offset = HeaderSize
+	if header.MaxDepth == 0 || header.MaxDepth > MaxDepth {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidDepth, header.MaxDepth)
+	}
+
+	treeSize := GetConcurrentMerkleTreeAccountSize(header.MaxDepth, header.MaxBufferSize, 0)
+	if uint64(len(data)) < treeSize || (uint64(len(data))-treeSize)%32 != 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidAccountSize, len(data))
+	}
+	ret.SequenceNumber = d.uint64()
+	ret.ActiveIndex = d.uint64()
+	ret.BufferSize = d.uint64()
+	ret.ChangeLogs = make([]ChangeLog, header.MaxBufferSize)
+	for i := range ret.ChangeLogs {
+		ret.ChangeLogs[i] = ChangeLog{Root: d.node(), Path: d.nodes(header.MaxDepth), Index: d.uint32()}
+		d.uint32() // padding
+	}
+	ret.RightmostProof = Path{Proof: d.nodes(header.MaxDepth), Leaf: d.node(), Index: d.uint32()}
+	d.uint32() // padding
+	ret.Canopy = d.nodes(uint32(len(data)-d.offset) / 32)
+	return &ret, nil
+}
+
+// FetchConcurrentMerkleTree fetches and decodes the ConcurrentMerkleTree account, nil if the account does not exist
+func FetchConcurrentMerkleTree(
+	ctx context.Context,
+	connection *web3.Connection,
+	address web3.PublicKey,
+	commitment *web3.Commitment,
+) (*ConcurrentMerkleTree, error) {
+	_ = ctx
+	info, err := connection.GetAccountInfo(address, web3.GetAccountInfoConfig{Commitment: commitment})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if info.Owner != ProgramID {
+		return nil, ErrInvalidAccountOwner
+	}
+	return DecodeConcurrentMerkleTree(info.Data.Content)
+}
+
+// Root the current root of the tree
+func (t *ConcurrentMerkleTree) Root() [32]byte {
+	return t.ChangeLogs[t.ActiveIndex].Root
+}
+
+// IsRecentRoot returns true if the root is in the changelog buffer,
+// a proof against a recent root is still accepted by the program
+func (t *ConcurrentMerkleTree) IsRecentRoot(root [32]byte) bool {
+	size := uint64(len(t.ChangeLogs))
+	for i := uint64(0); i < t.BufferSize && i < size; i++ {
+		if t.ChangeLogs[(t.ActiveIndex+size-i)%size].Root == root {
+			return true
+		}
+	}
+	return false
+}
+
+// LeafCount the number of the appended leaves, also the index of the next leaf
+func (t *ConcurrentMerkleTree) LeafCount() uint32 {
+	return t.RightmostProof.Index
+}
+
+// CanopyDepth the number of levels cached by the canopy
+func (t *ConcurrentMerkleTree) CanopyDepth() uint32 {
+	if len(t.Canopy) == 0 {
+		return 0
+	}
+	return uint32(bits.Len(uint(len(t.Canopy)+2))) - 2
+}
+
+// ProofLength the number of proof nodes to pass to the program, the upper nodes are read from the canopy
+func (t *ConcurrentMerkleTree) ProofLength() uint32 {
+	return t.Header.MaxDepth - min(t.CanopyDepth(), t.Header.MaxDepth)
+}
+
+// CompleteProof completes a proof truncated by the canopy with the nodes of the canopy
+func (t *ConcurrentMerkleTree) CompleteProof(proof [][32]byte, index uint32) ([][32]byte, error) {
+	depth := t.Header.MaxDepth
+	if uint32(len(proof)) > depth || uint32(len(proof)) < t.ProofLength() {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidProof, len(proof))
+	}
+	if uint64(index) >= 1<<depth {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidIndex, index)
+	}
+	ret := append([][32]byte(nil), proof...)
+	// the heap index of the node of the path, the root is 1
+	for node := ((uint64(1) << depth) + uint64(index)) >> len(proof); node > 1; node >>= 1 {
+		cached := t.Canopy[(node^1)-2]
+		if cached == ([32]byte{}) {
+			cached = EmptyNode(depth + 1 - uint32(bits.Len64(node)))
+		}
+		ret = append(ret, cached)
+	}
+	return ret, nil
+}
+
+// VerifyLeaf returns true if the leaf at the index belongs to the tree of the current root or of a recent root,
+// the proof may be truncated by the canopy
+func (t *ConcurrentMerkleTree) VerifyLeaf(leaf [32]byte, proof [][32]byte, index uint32) (bool, error) {
+	proof, err := t.CompleteProof(proof, index)
+	if err != nil {
+		return false, err
+	}
+	return t.IsRecentRoot(ComputeRoot(leaf, proof, index)), nil
+}
@@ -0,0 +1,126 @@
+package spl_account_compression
+
+import (
+	"encoding/binary"
+	"github.com/donutnomad/solana-web3/web3"
+	"testing"
+)
+
+// encodeTree encodes the account of the tree with a single changelog of the current root
+func encodeTree(t *testing.T, tree *MerkleTree, maxBufferSize, canopyDepth uint32) []byte {
+	depth := tree.Depth()
+	data := make([]byte, 0, GetConcurrentMerkleTreeAccountSize(depth, maxBufferSize, canopyDepth))
+	data = append(data, byte(AccountTypeConcurrentMerkleTree), byte(HeaderVersionV1))
+	data = binary.LittleEndian.AppendUint32(data, maxBufferSize)
+	data = binary.LittleEndian.AppendUint32(data, depth)
+	data = append(data, web3.Keypair.Generate().PublicKey().Bytes()...)
+	data = binary.LittleEndian.AppendUint64(data, 100)
+	data = append(data, make([]byte, 6)...)
+	// sequence number, active index, buffer size
+	data = binary.LittleEndian.AppendUint64(data, uint64(tree.Len()))
+	data = binary.LittleEndian.AppendUint64(data, 0)
+	data = binary.LittleEndian.AppendUint64(data, 1)
+	for i := uint32(0); i < maxBufferSize; i++ {
+		var root [32]byte
+		if i == 0 {
+			root = tree.Root()
+		}
+		data = append(data, root[:]...)
+		data = append(data, make([]byte, 32*depth+8)...)
+	}
+	last := tree.Len() - 1
+	proof, err := tree.Proof(last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range proof {
+		data = append(data, node[:]...)
+	}
+	leaf := tree.Node(0, last)
+	data = append(data, leaf[:]...)
+	data = binary.LittleEndian.AppendUint32(data, tree.Len())
+	data = binary.LittleEndian.AppendUint32(data, 0)
+	for level := depth - 1; level >= depth-canopyDepth; level-- {
+		for index := uint32(0); index < 1<<(depth-level); index++ {
+			node := tree.Node(level, index)
+			if node == EmptyNode(level) {
+				// the program leaves the empty nodes of the canopy zeroed
+				node = [32]byte{}
+			}
+			data = append(data, node[:]...)
+		}
+	}
+	return data
+}
+
+func TestConcurrentMerkleTree(t *testing.T) {
+	if GetConcurrentMerkleTreeAccountSize(14, 64, 0) != 31800 || GetConcurrentMerkleTreeAccountSize(3, 8, 1) != 1304+64 {
+		t.Fatal("unexpected account size")
+	}
+	if !IsValidDepthSizePair(14, 64) || IsValidDepthSizePair(14, 32) {
+		t.Fatal("unexpected depth size pair")
+	}
+
+	var leaves [][32]byte
+	for i := 0; i < 5; i++ {
+		leaves = append(leaves, web3.Keypair.Generate().PublicKey())
+	}
+	tree, err := NewMerkleTree(5, leaves[:3]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaf := range leaves[3:] {
+		if _, err := tree.Append(leaf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rebuilt, _ := NewMerkleTree(5, leaves...)
+	if tree.Root() != rebuilt.Root() {
+		t.Fatal("unexpected root after append")
+	}
+	empty, _ := NewMerkleTree(5)
+	if empty.Root() != EmptyNode(5) {
+		t.Fatal("unexpected empty root")
+	}
+	for i, leaf := range leaves {
+		proof, err := tree.Proof(uint32(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !VerifyProof(tree.Root(), leaf, proof, uint32(i)) || VerifyProof(tree.Root(), leaf, proof, uint32(i+1)) {
+			t.Fatalf("unexpected proof of %d", i)
+		}
+	}
+
+	account, err := DecodeConcurrentMerkleTree(encodeTree(t, tree, 8, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.Root() != tree.Root() || account.LeafCount() != 5 || account.RightmostProof.Leaf != leaves[4] {
+		t.Fatalf("unexpected account %+v", account.Header)
+	}
+	if account.Header.MaxDepth != 5 || account.Header.MaxBufferSize != 8 || account.CanopyDepth() != 2 || account.ProofLength() != 3 {
+		t.Fatalf("unexpected header %+v", account.Header)
+	}
+	proof, _ := tree.Proof(2)
+	if ok, err := account.VerifyLeaf(leaves[2], proof[:3], 2); err != nil || !ok {
+		t.Fatalf("unexpected verification %v %v", ok, err)
+	}
+	completed, err := account.CompleteProof(proof[:3], 2)
+	if err != nil || len(completed) != 5 || completed[4] != proof[4] {
+		t.Fatalf("unexpected completed proof %v", err)
+	}
+	if _, err := account.CompleteProof(proof[:2], 2); err == nil {
+		t.Fatal("expected a short proof error")
+	}
+
+	if err := tree.Update(2, [32]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := account.VerifyLeaf(leaves[2], proof, 2); !ok {
+		t.Fatal("expected the recent root to be valid")
+	}
+	if account.IsRecentRoot(tree.Root()) {
+		t.Fatal("unexpected recent root")
+	}
+}
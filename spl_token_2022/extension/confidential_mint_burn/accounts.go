@@ -0,0 +1,52 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_mint_burn
+
+import binary "github.com/gagliardetto/binary"
+
+// ConfidentialMintBurn Struct
+type ConfidentialMintBurn struct {
+	// The confidential supply of the mint (encrypted by `encryption_pubkey`)
+	ConfidentialSupply ElGamalCiphertext
+	// The decryptable confidential supply of the mint
+	DecryptableSupply DecryptableBalance
+	// The ElGamal pubkey used to encrypt the confidential supply
+	SupplyElgamalPubkey ElGamalPubkey
+	// The amount of burn amounts not yet aggregated into the confidential supply
+	PendingBurn ElGamalCiphertext
+}
+
+const CONFIDENTIAL_MINT_BURN_SIZE = 196
+
+func (obj *ConfidentialMintBurn) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ConfidentialSupply); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DecryptableSupply); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.SupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PendingBurn); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialMintBurn) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ConfidentialSupply); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DecryptableSupply); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.SupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PendingBurn); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,1310 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_mint_burn
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// InitializeMint Instruction
+// Initializes confidential mints and burns for a mint.
+type InitializeMint struct {
+	// The ElGamal pubkey used to encrypt the confidential supply
+	SupplyElgamalPubkey *ElGamalPubkey
+	// The initial 0 supply encrypted with the supply aes key
+	DecryptableSupply *DecryptableBalance
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeMintInstructionBuilder creates a new `InitializeMint` instruction builder.
+func NewInitializeMintInstructionBuilder() *InitializeMint {
+	return &InitializeMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeMintInstruction
+//
+// Parameters:
+//
+//	supplyElgamalPubkey: The ElGamal pubkey used to encrypt the confidential supply
+//	decryptableSupply: The initial 0 supply encrypted with the supply aes key
+//	mint: The SPL Token mint.
+func NewInitializeMintInstruction(
+	supplyElgamalPubkey ElGamalPubkey,
+	decryptableSupply DecryptableBalance,
+	mint common.PublicKey,
+) *InitializeMint {
+	return NewInitializeMintInstructionBuilder().
+		SetSupplyElgamalPubkey(supplyElgamalPubkey).
+		SetDecryptableSupply(decryptableSupply).
+		SetMintAccount(mint)
+}
+
+// SetSupplyElgamalPubkey sets the "supplyElgamalPubkey" parameter.
+func (obj *InitializeMint) SetSupplyElgamalPubkey(supplyElgamalPubkey ElGamalPubkey) *InitializeMint {
+	obj.SupplyElgamalPubkey = &supplyElgamalPubkey
+	return obj
+}
+
+// SetDecryptableSupply sets the "decryptableSupply" parameter.
+func (obj *InitializeMint) SetDecryptableSupply(decryptableSupply DecryptableBalance) *InitializeMint {
+	obj.DecryptableSupply = &decryptableSupply
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeMint) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *InitializeMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *InitializeMint) SetProgramId(programId *common.PublicKey) *InitializeMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *InitializeMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_InitializeMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *InitializeMint) Validate() error {
+	if obj.SupplyElgamalPubkey == nil {
+		return errors.New("[InitializeMint] supplyElgamalPubkey param is not set")
+	}
+	if obj.DecryptableSupply == nil {
+		return errors.New("[InitializeMint] decryptableSupply param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[InitializeMint] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *InitializeMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *InitializeMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.SupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DecryptableSupply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.SupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DecryptableSupply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("InitializeMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("SupplyElgamalPubkey", *obj.SupplyElgamalPubkey))
+						paramsBranch.Child(format.Param("  DecryptableSupply", *obj.DecryptableSupply))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// RotateSupplyElgamalPubkey Instruction
+// Rotates the ElGamal pubkey used to encrypt confidential supply.
+type RotateSupplyElgamalPubkey struct {
+	// The new ElGamal pubkey for supply encryption
+	NewSupplyElgamalPubkey *ElGamalPubkey
+	// The location of the `VerifyCiphertextCiphertextEquality` instruction relative to the `RotateSupplyElGamalPubkey` instruction in the transaction
+	ProofInstructionOffset *int8
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	// [1] = [] instructionsSysvarOrContextState `Instructions sysvar if `CiphertextCiphertextEquality` is included in the same transaction or context state account if `CiphertextCiphertextEquality` is pre-verified into a context state account.`
+	// [2] = [SIGNER] authority `The confidential mint authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewRotateSupplyElgamalPubkeyInstructionBuilder creates a new `RotateSupplyElgamalPubkey` instruction builder.
+func NewRotateSupplyElgamalPubkeyInstructionBuilder() *RotateSupplyElgamalPubkey {
+	return &RotateSupplyElgamalPubkey{
+		AccountMetaSlice: make(common.AccountMetaSlice, 3),
+	}
+}
+
+// NewRotateSupplyElgamalPubkeyInstruction
+//
+// Parameters:
+//
+//	newSupplyElgamalPubkey: The new ElGamal pubkey for supply encryption
+//	proofInstructionOffset: The location of the `VerifyCiphertextCiphertextEquality` instruction relative to the `RotateSupplyElGamalPubkey` instruction in the transaction
+//	mint: The SPL Token mint.
+//	instructionsSysvarOrContextState: Instructions sysvar if `CiphertextCiphertextEquality` is included in the same transaction or context state account if `CiphertextCiphertextEquality` is pre-verified into a context state account.
+//	authority: The confidential mint authority.
+func NewRotateSupplyElgamalPubkeyInstruction(
+	newSupplyElgamalPubkey ElGamalPubkey,
+	proofInstructionOffset int8,
+	mint common.PublicKey,
+	instructionsSysvarOrContextState common.PublicKey,
+	authority common.PublicKey,
+) *RotateSupplyElgamalPubkey {
+	return NewRotateSupplyElgamalPubkeyInstructionBuilder().
+		SetNewSupplyElgamalPubkey(newSupplyElgamalPubkey).
+		SetProofInstructionOffset(proofInstructionOffset).
+		SetMintAccount(mint).
+		SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState).
+		SetAuthorityAccount(authority)
+}
+
+// SetNewSupplyElgamalPubkey sets the "newSupplyElgamalPubkey" parameter.
+func (obj *RotateSupplyElgamalPubkey) SetNewSupplyElgamalPubkey(newSupplyElgamalPubkey ElGamalPubkey) *RotateSupplyElgamalPubkey {
+	obj.NewSupplyElgamalPubkey = &newSupplyElgamalPubkey
+	return obj
+}
+
+// SetProofInstructionOffset sets the "proofInstructionOffset" parameter.
+func (obj *RotateSupplyElgamalPubkey) SetProofInstructionOffset(proofInstructionOffset int8) *RotateSupplyElgamalPubkey {
+	obj.ProofInstructionOffset = &proofInstructionOffset
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *RotateSupplyElgamalPubkey) SetMintAccount(mint common.PublicKey) *RotateSupplyElgamalPubkey {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *RotateSupplyElgamalPubkey) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetInstructionsSysvarOrContextStateAccount sets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `CiphertextCiphertextEquality` is included in the same transaction or context state account if `CiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *RotateSupplyElgamalPubkey) SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState common.PublicKey) *RotateSupplyElgamalPubkey {
+	obj.AccountMetaSlice[1] = common.Meta(instructionsSysvarOrContextState)
+	return obj
+}
+
+// GetInstructionsSysvarOrContextStateAccount gets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `CiphertextCiphertextEquality` is included in the same transaction or context state account if `CiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *RotateSupplyElgamalPubkey) GetInstructionsSysvarOrContextStateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The confidential mint authority.
+func (obj *RotateSupplyElgamalPubkey) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *RotateSupplyElgamalPubkey {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[2] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The confidential mint authority.
+func (obj *RotateSupplyElgamalPubkey) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+func (obj *RotateSupplyElgamalPubkey) SetProgramId(programId *common.PublicKey) *RotateSupplyElgamalPubkey {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *RotateSupplyElgamalPubkey) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_RotateSupplyElgamalPubkey}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *RotateSupplyElgamalPubkey) Validate() error {
+	if obj.NewSupplyElgamalPubkey == nil {
+		return errors.New("[RotateSupplyElgamalPubkey] newSupplyElgamalPubkey param is not set")
+	}
+	if obj.ProofInstructionOffset == nil {
+		return errors.New("[RotateSupplyElgamalPubkey] proofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[RotateSupplyElgamalPubkey] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[RotateSupplyElgamalPubkey] accounts.instructionsSysvarOrContextState is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[RotateSupplyElgamalPubkey] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *RotateSupplyElgamalPubkey) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *RotateSupplyElgamalPubkey) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NewSupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RotateSupplyElgamalPubkey) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NewSupplyElgamalPubkey); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *RotateSupplyElgamalPubkey) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("RotateSupplyElgamalPubkey")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("NewSupplyElgamalPubkey", *obj.NewSupplyElgamalPubkey))
+						paramsBranch.Child(format.Param("ProofInstructionOffset", *obj.ProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=3]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                            mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvarOrContextState", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("                       authority", obj.AccountMetaSlice.Get(2)))
+					})
+				})
+		})
+}
+
+// UpdateDecryptableSupply Instruction
+// Updates the decryptable supply of the mint.
+type UpdateDecryptableSupply struct {
+	// The new decryptable supply
+	NewDecryptableSupply *DecryptableBalance
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	// [1] = [SIGNER] authority `The confidential mint authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateDecryptableSupplyInstructionBuilder creates a new `UpdateDecryptableSupply` instruction builder.
+func NewUpdateDecryptableSupplyInstructionBuilder() *UpdateDecryptableSupply {
+	return &UpdateDecryptableSupply{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewUpdateDecryptableSupplyInstruction
+//
+// Parameters:
+//
+//	newDecryptableSupply: The new decryptable supply
+//	mint: The SPL Token mint.
+//	authority: The confidential mint authority.
+func NewUpdateDecryptableSupplyInstruction(
+	newDecryptableSupply DecryptableBalance,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *UpdateDecryptableSupply {
+	return NewUpdateDecryptableSupplyInstructionBuilder().
+		SetNewDecryptableSupply(newDecryptableSupply).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetNewDecryptableSupply sets the "newDecryptableSupply" parameter.
+func (obj *UpdateDecryptableSupply) SetNewDecryptableSupply(newDecryptableSupply DecryptableBalance) *UpdateDecryptableSupply {
+	obj.NewDecryptableSupply = &newDecryptableSupply
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *UpdateDecryptableSupply) SetMintAccount(mint common.PublicKey) *UpdateDecryptableSupply {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *UpdateDecryptableSupply) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The confidential mint authority.
+func (obj *UpdateDecryptableSupply) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *UpdateDecryptableSupply {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The confidential mint authority.
+func (obj *UpdateDecryptableSupply) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *UpdateDecryptableSupply) SetProgramId(programId *common.PublicKey) *UpdateDecryptableSupply {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateDecryptableSupply) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateDecryptableSupply}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateDecryptableSupply) Validate() error {
+	if obj.NewDecryptableSupply == nil {
+		return errors.New("[UpdateDecryptableSupply] newDecryptableSupply param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateDecryptableSupply] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateDecryptableSupply] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateDecryptableSupply) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateDecryptableSupply) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NewDecryptableSupply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateDecryptableSupply) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NewDecryptableSupply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateDecryptableSupply) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateDecryptableSupply")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("NewDecryptableSupply", *obj.NewDecryptableSupply))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// Mint Instruction
+// Mints tokens to confidential balance.
+type Mint struct {
+	// The new decryptable supply if the mint succeeds
+	NewDecryptableSupply *DecryptableBalance
+	// The transfer amount encrypted under the auditor ElGamal public key
+	MintAmountAuditorCiphertextLo *ElGamalCiphertext
+	// The transfer amount encrypted under the auditor ElGamal public key
+	MintAmountAuditorCiphertextHi *ElGamalCiphertext
+	// Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	EqualityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	CiphertextValidityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::VerifyBatchedRangeProofU128` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	RangeProofInstructionOffset *int8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [WRITE] mint `The SPL Token mint.`
+	// [2] = [] instructionsSysvar `(Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.`
+	// [3] = [] equalityRecord `(Optional) Equality proof context state account.`
+	// [4] = [] ciphertextValidityRecord `(Optional) Ciphertext validity proof context state account.`
+	// [5] = [] rangeRecord `(Optional) Range proof context state account.`
+	// [6] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewMintInstructionBuilder creates a new `Mint` instruction builder.
+func NewMintInstructionBuilder() *Mint {
+	return &Mint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 7),
+	}
+}
+
+// NewMintInstruction
+//
+// Parameters:
+//
+//	newDecryptableSupply: The new decryptable supply if the mint succeeds
+//	mintAmountAuditorCiphertextLo: The transfer amount encrypted under the auditor ElGamal public key
+//	mintAmountAuditorCiphertextHi: The transfer amount encrypted under the auditor ElGamal public key
+//	equalityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	ciphertextValidityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	rangeProofInstructionOffset: Relative location of the `ProofInstruction::VerifyBatchedRangeProofU128` instruction to the `ConfidentialMint` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	token: The SPL Token account.
+//	mint: The SPL Token mint.
+//	instructionsSysvar: (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+//	equalityRecord: (Optional) Equality proof context state account.
+//	ciphertextValidityRecord: (Optional) Ciphertext validity proof context state account.
+//	rangeRecord: (Optional) Range proof context state account.
+//	authority: The single account owner.
+func NewMintInstruction(
+	newDecryptableSupply DecryptableBalance,
+	mintAmountAuditorCiphertextLo ElGamalCiphertext,
+	mintAmountAuditorCiphertextHi ElGamalCiphertext,
+	equalityProofInstructionOffset int8,
+	ciphertextValidityProofInstructionOffset int8,
+	rangeProofInstructionOffset int8,
+	token common.PublicKey,
+	mint common.PublicKey,
+	instructionsSysvar common.PublicKey,
+	equalityRecord common.PublicKey,
+	ciphertextValidityRecord common.PublicKey,
+	rangeRecord common.PublicKey,
+	authority common.PublicKey,
+) *Mint {
+	return NewMintInstructionBuilder().
+		SetNewDecryptableSupply(newDecryptableSupply).
+		SetMintAmountAuditorCiphertextLo(mintAmountAuditorCiphertextLo).
+		SetMintAmountAuditorCiphertextHi(mintAmountAuditorCiphertextHi).
+		SetEqualityProofInstructionOffset(equalityProofInstructionOffset).
+		SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset).
+		SetRangeProofInstructionOffset(rangeProofInstructionOffset).
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetInstructionsSysvarAccount(instructionsSysvar).
+		SetEqualityRecordAccount(equalityRecord).
+		SetCiphertextValidityRecordAccount(ciphertextValidityRecord).
+		SetRangeRecordAccount(rangeRecord).
+		SetAuthorityAccount(authority)
+}
+
+// SetNewDecryptableSupply sets the "newDecryptableSupply" parameter.
+func (obj *Mint) SetNewDecryptableSupply(newDecryptableSupply DecryptableBalance) *Mint {
+	obj.NewDecryptableSupply = &newDecryptableSupply
+	return obj
+}
+
+// SetMintAmountAuditorCiphertextLo sets the "mintAmountAuditorCiphertextLo" parameter.
+func (obj *Mint) SetMintAmountAuditorCiphertextLo(mintAmountAuditorCiphertextLo ElGamalCiphertext) *Mint {
+	obj.MintAmountAuditorCiphertextLo = &mintAmountAuditorCiphertextLo
+	return obj
+}
+
+// SetMintAmountAuditorCiphertextHi sets the "mintAmountAuditorCiphertextHi" parameter.
+func (obj *Mint) SetMintAmountAuditorCiphertextHi(mintAmountAuditorCiphertextHi ElGamalCiphertext) *Mint {
+	obj.MintAmountAuditorCiphertextHi = &mintAmountAuditorCiphertextHi
+	return obj
+}
+
+// SetEqualityProofInstructionOffset sets the "equalityProofInstructionOffset" parameter.
+func (obj *Mint) SetEqualityProofInstructionOffset(equalityProofInstructionOffset int8) *Mint {
+	obj.EqualityProofInstructionOffset = &equalityProofInstructionOffset
+	return obj
+}
+
+// SetCiphertextValidityProofInstructionOffset sets the "ciphertextValidityProofInstructionOffset" parameter.
+func (obj *Mint) SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset int8) *Mint {
+	obj.CiphertextValidityProofInstructionOffset = &ciphertextValidityProofInstructionOffset
+	return obj
+}
+
+// SetRangeProofInstructionOffset sets the "rangeProofInstructionOffset" parameter.
+func (obj *Mint) SetRangeProofInstructionOffset(rangeProofInstructionOffset int8) *Mint {
+	obj.RangeProofInstructionOffset = &rangeProofInstructionOffset
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *Mint) SetTokenAccount(token common.PublicKey) *Mint {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *Mint) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *Mint) SetMintAccount(mint common.PublicKey) *Mint {
+	obj.AccountMetaSlice[1] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *Mint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarAccount sets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Mint) SetInstructionsSysvarAccount(instructionsSysvar common.PublicKey) *Mint {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvar)
+	return obj
+}
+
+// GetInstructionsSysvarAccount gets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Mint) GetInstructionsSysvarAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetEqualityRecordAccount sets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Mint) SetEqualityRecordAccount(equalityRecord common.PublicKey) *Mint {
+	obj.AccountMetaSlice[3] = common.Meta(equalityRecord)
+	return obj
+}
+
+// GetEqualityRecordAccount gets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Mint) GetEqualityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetCiphertextValidityRecordAccount sets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Mint) SetCiphertextValidityRecordAccount(ciphertextValidityRecord common.PublicKey) *Mint {
+	obj.AccountMetaSlice[4] = common.Meta(ciphertextValidityRecord)
+	return obj
+}
+
+// GetCiphertextValidityRecordAccount gets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Mint) GetCiphertextValidityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetRangeRecordAccount sets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Mint) SetRangeRecordAccount(rangeRecord common.PublicKey) *Mint {
+	obj.AccountMetaSlice[5] = common.Meta(rangeRecord)
+	return obj
+}
+
+// GetRangeRecordAccount gets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Mint) GetRangeRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *Mint) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Mint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[6] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[6] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *Mint) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+func (obj *Mint) SetProgramId(programId *common.PublicKey) *Mint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Mint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Mint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Mint) Validate() error {
+	if obj.NewDecryptableSupply == nil {
+		return errors.New("[Mint] newDecryptableSupply param is not set")
+	}
+	if obj.MintAmountAuditorCiphertextLo == nil {
+		return errors.New("[Mint] mintAmountAuditorCiphertextLo param is not set")
+	}
+	if obj.MintAmountAuditorCiphertextHi == nil {
+		return errors.New("[Mint] mintAmountAuditorCiphertextHi param is not set")
+	}
+	if obj.EqualityProofInstructionOffset == nil {
+		return errors.New("[Mint] equalityProofInstructionOffset param is not set")
+	}
+	if obj.CiphertextValidityProofInstructionOffset == nil {
+		return errors.New("[Mint] ciphertextValidityProofInstructionOffset param is not set")
+	}
+	if obj.RangeProofInstructionOffset == nil {
+		return errors.New("[Mint] rangeProofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Mint] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Mint] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[Mint] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Mint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Mint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NewDecryptableSupply); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.MintAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.MintAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Mint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NewDecryptableSupply); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.MintAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.MintAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Mint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Mint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=6]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("                    NewDecryptableSupply", *obj.NewDecryptableSupply))
+						paramsBranch.Child(format.Param("           MintAmountAuditorCiphertextLo", *obj.MintAmountAuditorCiphertextLo))
+						paramsBranch.Child(format.Param("           MintAmountAuditorCiphertextHi", *obj.MintAmountAuditorCiphertextHi))
+						paramsBranch.Child(format.Param("          EqualityProofInstructionOffset", *obj.EqualityProofInstructionOffset))
+						paramsBranch.Child(format.Param("CiphertextValidityProofInstructionOffset", *obj.CiphertextValidityProofInstructionOffset))
+						paramsBranch.Child(format.Param("             RangeProofInstructionOffset", *obj.RangeProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                   token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                    mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      instructionsSysvar", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("          equalityRecord", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("ciphertextValidityRecord", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("             rangeRecord", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("               authority", obj.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+// Burn Instruction
+// Burn tokens from confidential balance.
+type Burn struct {
+	// The new decryptable balance of the burner if the burn succeeds
+	NewDecryptableAvailableBalance *DecryptableBalance
+	// The transfer amount encrypted under the auditor ElGamal public key
+	BurnAmountAuditorCiphertextLo *ElGamalCiphertext
+	// The transfer amount encrypted under the auditor ElGamal public key
+	BurnAmountAuditorCiphertextHi *ElGamalCiphertext
+	// Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	EqualityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	CiphertextValidityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::VerifyBatchedRangeProofU128` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+	RangeProofInstructionOffset *int8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [WRITE] mint `The SPL Token mint.`
+	// [2] = [] instructionsSysvar `(Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.`
+	// [3] = [] equalityRecord `(Optional) Equality proof context state account.`
+	// [4] = [] ciphertextValidityRecord `(Optional) Ciphertext validity proof context state account.`
+	// [5] = [] rangeRecord `(Optional) Range proof context state account.`
+	// [6] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnInstructionBuilder creates a new `Burn` instruction builder.
+func NewBurnInstructionBuilder() *Burn {
+	return &Burn{
+		AccountMetaSlice: make(common.AccountMetaSlice, 7),
+	}
+}
+
+// NewBurnInstruction
+//
+// Parameters:
+//
+//	newDecryptableAvailableBalance: The new decryptable balance of the burner if the burn succeeds
+//	burnAmountAuditorCiphertextLo: The transfer amount encrypted under the auditor ElGamal public key
+//	burnAmountAuditorCiphertextHi: The transfer amount encrypted under the auditor ElGamal public key
+//	equalityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	ciphertextValidityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	rangeProofInstructionOffset: Relative location of the `ProofInstruction::VerifyBatchedRangeProofU128` instruction to the `ConfidentialBurn` instruction in the transaction. 0 if the proof is in a pre-verified context account
+//	token: The SPL Token account.
+//	mint: The SPL Token mint.
+//	instructionsSysvar: (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+//	equalityRecord: (Optional) Equality proof context state account.
+//	ciphertextValidityRecord: (Optional) Ciphertext validity proof context state account.
+//	rangeRecord: (Optional) Range proof context state account.
+//	authority: The single account owner.
+func NewBurnInstruction(
+	newDecryptableAvailableBalance DecryptableBalance,
+	burnAmountAuditorCiphertextLo ElGamalCiphertext,
+	burnAmountAuditorCiphertextHi ElGamalCiphertext,
+	equalityProofInstructionOffset int8,
+	ciphertextValidityProofInstructionOffset int8,
+	rangeProofInstructionOffset int8,
+	token common.PublicKey,
+	mint common.PublicKey,
+	instructionsSysvar common.PublicKey,
+	equalityRecord common.PublicKey,
+	ciphertextValidityRecord common.PublicKey,
+	rangeRecord common.PublicKey,
+	authority common.PublicKey,
+) *Burn {
+	return NewBurnInstructionBuilder().
+		SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance).
+		SetBurnAmountAuditorCiphertextLo(burnAmountAuditorCiphertextLo).
+		SetBurnAmountAuditorCiphertextHi(burnAmountAuditorCiphertextHi).
+		SetEqualityProofInstructionOffset(equalityProofInstructionOffset).
+		SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset).
+		SetRangeProofInstructionOffset(rangeProofInstructionOffset).
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetInstructionsSysvarAccount(instructionsSysvar).
+		SetEqualityRecordAccount(equalityRecord).
+		SetCiphertextValidityRecordAccount(ciphertextValidityRecord).
+		SetRangeRecordAccount(rangeRecord).
+		SetAuthorityAccount(authority)
+}
+
+// SetNewDecryptableAvailableBalance sets the "newDecryptableAvailableBalance" parameter.
+func (obj *Burn) SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance DecryptableBalance) *Burn {
+	obj.NewDecryptableAvailableBalance = &newDecryptableAvailableBalance
+	return obj
+}
+
+// SetBurnAmountAuditorCiphertextLo sets the "burnAmountAuditorCiphertextLo" parameter.
+func (obj *Burn) SetBurnAmountAuditorCiphertextLo(burnAmountAuditorCiphertextLo ElGamalCiphertext) *Burn {
+	obj.BurnAmountAuditorCiphertextLo = &burnAmountAuditorCiphertextLo
+	return obj
+}
+
+// SetBurnAmountAuditorCiphertextHi sets the "burnAmountAuditorCiphertextHi" parameter.
+func (obj *Burn) SetBurnAmountAuditorCiphertextHi(burnAmountAuditorCiphertextHi ElGamalCiphertext) *Burn {
+	obj.BurnAmountAuditorCiphertextHi = &burnAmountAuditorCiphertextHi
+	return obj
+}
+
+// SetEqualityProofInstructionOffset sets the "equalityProofInstructionOffset" parameter.
+func (obj *Burn) SetEqualityProofInstructionOffset(equalityProofInstructionOffset int8) *Burn {
+	obj.EqualityProofInstructionOffset = &equalityProofInstructionOffset
+	return obj
+}
+
+// SetCiphertextValidityProofInstructionOffset sets the "ciphertextValidityProofInstructionOffset" parameter.
+func (obj *Burn) SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset int8) *Burn {
+	obj.CiphertextValidityProofInstructionOffset = &ciphertextValidityProofInstructionOffset
+	return obj
+}
+
+// SetRangeProofInstructionOffset sets the "rangeProofInstructionOffset" parameter.
+func (obj *Burn) SetRangeProofInstructionOffset(rangeProofInstructionOffset int8) *Burn {
+	obj.RangeProofInstructionOffset = &rangeProofInstructionOffset
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *Burn) SetTokenAccount(token common.PublicKey) *Burn {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *Burn) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *Burn) SetMintAccount(mint common.PublicKey) *Burn {
+	obj.AccountMetaSlice[1] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *Burn) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarAccount sets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Burn) SetInstructionsSysvarAccount(instructionsSysvar common.PublicKey) *Burn {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvar)
+	return obj
+}
+
+// GetInstructionsSysvarAccount gets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Burn) GetInstructionsSysvarAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetEqualityRecordAccount sets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Burn) SetEqualityRecordAccount(equalityRecord common.PublicKey) *Burn {
+	obj.AccountMetaSlice[3] = common.Meta(equalityRecord)
+	return obj
+}
+
+// GetEqualityRecordAccount gets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Burn) GetEqualityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetCiphertextValidityRecordAccount sets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Burn) SetCiphertextValidityRecordAccount(ciphertextValidityRecord common.PublicKey) *Burn {
+	obj.AccountMetaSlice[4] = common.Meta(ciphertextValidityRecord)
+	return obj
+}
+
+// GetCiphertextValidityRecordAccount gets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Burn) GetCiphertextValidityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetRangeRecordAccount sets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Burn) SetRangeRecordAccount(rangeRecord common.PublicKey) *Burn {
+	obj.AccountMetaSlice[5] = common.Meta(rangeRecord)
+	return obj
+}
+
+// GetRangeRecordAccount gets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Burn) GetRangeRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *Burn) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Burn {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[6] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[6] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *Burn) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+func (obj *Burn) SetProgramId(programId *common.PublicKey) *Burn {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Burn) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Burn}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Burn) Validate() error {
+	if obj.NewDecryptableAvailableBalance == nil {
+		return errors.New("[Burn] newDecryptableAvailableBalance param is not set")
+	}
+	if obj.BurnAmountAuditorCiphertextLo == nil {
+		return errors.New("[Burn] burnAmountAuditorCiphertextLo param is not set")
+	}
+	if obj.BurnAmountAuditorCiphertextHi == nil {
+		return errors.New("[Burn] burnAmountAuditorCiphertextHi param is not set")
+	}
+	if obj.EqualityProofInstructionOffset == nil {
+		return errors.New("[Burn] equalityProofInstructionOffset param is not set")
+	}
+	if obj.CiphertextValidityProofInstructionOffset == nil {
+		return errors.New("[Burn] ciphertextValidityProofInstructionOffset param is not set")
+	}
+	if obj.RangeProofInstructionOffset == nil {
+		return errors.New("[Burn] rangeProofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Burn] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Burn] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[6] == nil {
+		return errors.New("[Burn] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Burn) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Burn) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.BurnAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.BurnAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.BurnAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.BurnAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Burn")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=6]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("          NewDecryptableAvailableBalance", *obj.NewDecryptableAvailableBalance))
+						paramsBranch.Child(format.Param("           BurnAmountAuditorCiphertextLo", *obj.BurnAmountAuditorCiphertextLo))
+						paramsBranch.Child(format.Param("           BurnAmountAuditorCiphertextHi", *obj.BurnAmountAuditorCiphertextHi))
+						paramsBranch.Child(format.Param("          EqualityProofInstructionOffset", *obj.EqualityProofInstructionOffset))
+						paramsBranch.Child(format.Param("CiphertextValidityProofInstructionOffset", *obj.CiphertextValidityProofInstructionOffset))
+						paramsBranch.Child(format.Param("             RangeProofInstructionOffset", *obj.RangeProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=7]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                   token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                    mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("      instructionsSysvar", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("          equalityRecord", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("ciphertextValidityRecord", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("             rangeRecord", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("               authority", obj.AccountMetaSlice.Get(6)))
+					})
+				})
+		})
+}
+
+// ApplyPendingBurn Instruction
+// Applies the pending burn amount to the confidential supply.
+type ApplyPendingBurn struct {
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	// [1] = [SIGNER] authority `The confidential mint authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewApplyPendingBurnInstructionBuilder creates a new `ApplyPendingBurn` instruction builder.
+func NewApplyPendingBurnInstructionBuilder() *ApplyPendingBurn {
+	return &ApplyPendingBurn{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewApplyPendingBurnInstruction
+//
+// Parameters:
+//
+//	mint: The SPL Token mint.
+//	authority: The confidential mint authority.
+func NewApplyPendingBurnInstruction(
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *ApplyPendingBurn {
+	return NewApplyPendingBurnInstructionBuilder().
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *ApplyPendingBurn) SetMintAccount(mint common.PublicKey) *ApplyPendingBurn {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *ApplyPendingBurn) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The confidential mint authority.
+func (obj *ApplyPendingBurn) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *ApplyPendingBurn {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The confidential mint authority.
+func (obj *ApplyPendingBurn) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *ApplyPendingBurn) SetProgramId(programId *common.PublicKey) *ApplyPendingBurn {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ApplyPendingBurn) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ApplyPendingBurn}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ApplyPendingBurn) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ApplyPendingBurn] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[ApplyPendingBurn] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ApplyPendingBurn) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ApplyPendingBurn) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *ApplyPendingBurn) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *ApplyPendingBurn) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ApplyPendingBurn")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
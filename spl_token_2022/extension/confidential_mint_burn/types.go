@@ -0,0 +1,16 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_mint_burn
+
+// ElGamalPubkey Alias
+// ElGamal public key, a compressed ristretto point
+type ElGamalPubkey = [32]uint8
+
+// ElGamalCiphertext Alias
+// ElGamal ciphertext: Pedersen commitment followed by the decrypt handle
+type ElGamalCiphertext = [64]uint8
+
+// DecryptableBalance Alias
+// Authenticated encryption (AES-GCM-SIV) of a balance: nonce followed by the ciphertext
+type DecryptableBalance = [36]uint8
@@ -0,0 +1,157 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_transfer
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// ConfidentialTransferMint Struct
+type ConfidentialTransferMint struct {
+	// Authority to modify the `ConfidentialTransferMint` configuration and to approve new accounts (if `auto_approve_new_accounts` is true)
+	Authority common.PublicKey
+	// Indicate if newly configured accounts must be approved by the `authority` before they may be used by the user.
+	AutoApproveNewAccounts bool
+	// Authority to decode any transfer amount in a confidential transfer.
+	AuditorElgamalPubkey ElGamalPubkey
+}
+
+const CONFIDENTIAL_TRANSFER_MINT_SIZE = 65
+
+func (obj *ConfidentialTransferMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialTransferMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfidentialTransferAccount Struct
+type ConfidentialTransferAccount struct {
+	// `true` if this account has been approved for use. All confidential transfer operations for the account will fail until approval is granted.
+	Approved bool
+	// The public key associated with ElGamal encryption
+	ElgamalPubkey ElGamalPubkey
+	// The low 16 bits of the pending balance (encrypted by `elgamal_pubkey`)
+	PendingBalanceLo ElGamalCiphertext
+	// The high 48 bits of the pending balance (encrypted by `elgamal_pubkey`)
+	PendingBalanceHi ElGamalCiphertext
+	// The available balance (encrypted by `encryption_pubkey`)
+	AvailableBalance ElGamalCiphertext
+	// The decryptable available balance
+	DecryptableAvailableBalance DecryptableBalance
+	// If `false`, the extended account rejects any incoming confidential transfers
+	AllowConfidentialCredits bool
+	// If `false`, the base account rejects any incoming transfers
+	AllowNonConfidentialCredits bool
+	// The total number of `Deposit` and `Transfer` instructions that have credited `pending_balance`
+	PendingBalanceCreditCounter uint64
+	// The maximum number of `Deposit` and `Transfer` instructions that can credit `pending_balance` before the `ApplyPendingBalance` instruction is executed
+	MaximumPendingBalanceCreditCounter uint64
+	// The `expected_pending_balance_credit_counter` value that was included in the last `ApplyPendingBalance` instruction
+	ExpectedPendingBalanceCreditCounter uint64
+	// The actual `pending_balance_credit_counter` when the last `ApplyPendingBalance` instruction was executed
+	ActualPendingBalanceCreditCounter uint64
+}
+
+const CONFIDENTIAL_TRANSFER_ACCOUNT_SIZE = 295
+
+func (obj *ConfidentialTransferAccount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Approved); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ElgamalPubkey); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PendingBalanceLo); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PendingBalanceHi); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AvailableBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.DecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AllowConfidentialCredits); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AllowNonConfidentialCredits); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.PendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.MaximumPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ExpectedPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ActualPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialTransferAccount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Approved); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ElgamalPubkey); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PendingBalanceLo); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PendingBalanceHi); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AvailableBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.DecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AllowConfidentialCredits); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AllowNonConfidentialCredits); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.PendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.MaximumPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ExpectedPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ActualPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	return nil
+}
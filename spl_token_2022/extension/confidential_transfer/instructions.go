@@ -0,0 +1,2422 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_transfer
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// InitializeMint Instruction
+// Initializes confidential transfers for a mint.
+type InitializeMint struct {
+	// Authority to modify the `ConfidentialTransferMint` configuration and to approve new accounts.
+	Authority *common.PublicKey
+	// Determines if newly configured accounts must be approved by the `authority` before they may be used by the user.
+	AutoApproveNewAccounts *bool
+	// New authority to decode any transfer amount in a confidential transfer.
+	AuditorElgamalPubkey *ElGamalPubkey
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeMintInstructionBuilder creates a new `InitializeMint` instruction builder.
+func NewInitializeMintInstructionBuilder() *InitializeMint {
+	return &InitializeMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeMintInstruction
+//
+// Parameters:
+//
+//	authority: Authority to modify the `ConfidentialTransferMint` configuration and to approve new accounts.
+//	autoApproveNewAccounts: Determines if newly configured accounts must be approved by the `authority` before they may be used by the user.
+//	auditorElgamalPubkey: New authority to decode any transfer amount in a confidential transfer.
+//	mint: The SPL Token mint.
+func NewInitializeMintInstruction(
+	authority common.PublicKey,
+	autoApproveNewAccounts bool,
+	auditorElgamalPubkey ElGamalPubkey,
+	mint common.PublicKey,
+) *InitializeMint {
+	return NewInitializeMintInstructionBuilder().
+		SetAuthority(authority).
+		SetAutoApproveNewAccounts(autoApproveNewAccounts).
+		SetAuditorElgamalPubkey(auditorElgamalPubkey).
+		SetMintAccount(mint)
+}
+
+// SetAuthority sets the "authority" parameter.
+func (obj *InitializeMint) SetAuthority(authority common.PublicKey) *InitializeMint {
+	obj.Authority = &authority
+	return obj
+}
+
+// SetAutoApproveNewAccounts sets the "autoApproveNewAccounts" parameter.
+func (obj *InitializeMint) SetAutoApproveNewAccounts(autoApproveNewAccounts bool) *InitializeMint {
+	obj.AutoApproveNewAccounts = &autoApproveNewAccounts
+	return obj
+}
+
+// SetAuditorElgamalPubkey sets the "auditorElgamalPubkey" parameter.
+func (obj *InitializeMint) SetAuditorElgamalPubkey(auditorElgamalPubkey ElGamalPubkey) *InitializeMint {
+	obj.AuditorElgamalPubkey = &auditorElgamalPubkey
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeMint) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *InitializeMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *InitializeMint) SetProgramId(programId *common.PublicKey) *InitializeMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *InitializeMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_InitializeMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *InitializeMint) Validate() error {
+	if obj.Authority == nil {
+		return errors.New("[InitializeMint] authority param is not set")
+	}
+	if obj.AutoApproveNewAccounts == nil {
+		return errors.New("[InitializeMint] autoApproveNewAccounts param is not set")
+	}
+	if obj.AuditorElgamalPubkey == nil {
+		return errors.New("[InitializeMint] auditorElgamalPubkey param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[InitializeMint] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *InitializeMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *InitializeMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("InitializeMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=3]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("             Authority", *obj.Authority))
+						paramsBranch.Child(format.Param("AutoApproveNewAccounts", *obj.AutoApproveNewAccounts))
+						paramsBranch.Child(format.Param("  AuditorElgamalPubkey", *obj.AuditorElgamalPubkey))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// UpdateMint Instruction
+// Updates the confidential transfer mint configuration for a mint.
+type UpdateMint struct {
+	// Determines if newly configured accounts must be approved by the `authority` before they may be used by the user.
+	AutoApproveNewAccounts *bool
+	// New authority to decode any transfer amount in a confidential transfer.
+	AuditorElgamalPubkey *ElGamalPubkey
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	// [1] = [SIGNER] authority `Confidential transfer mint authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateMintInstructionBuilder creates a new `UpdateMint` instruction builder.
+func NewUpdateMintInstructionBuilder() *UpdateMint {
+	return &UpdateMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewUpdateMintInstruction
+//
+// Parameters:
+//
+//	autoApproveNewAccounts: Determines if newly configured accounts must be approved by the `authority` before they may be used by the user.
+//	auditorElgamalPubkey: New authority to decode any transfer amount in a confidential transfer.
+//	mint: The SPL Token mint.
+//	authority: Confidential transfer mint authority.
+func NewUpdateMintInstruction(
+	autoApproveNewAccounts bool,
+	auditorElgamalPubkey ElGamalPubkey,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *UpdateMint {
+	return NewUpdateMintInstructionBuilder().
+		SetAutoApproveNewAccounts(autoApproveNewAccounts).
+		SetAuditorElgamalPubkey(auditorElgamalPubkey).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetAutoApproveNewAccounts sets the "autoApproveNewAccounts" parameter.
+func (obj *UpdateMint) SetAutoApproveNewAccounts(autoApproveNewAccounts bool) *UpdateMint {
+	obj.AutoApproveNewAccounts = &autoApproveNewAccounts
+	return obj
+}
+
+// SetAuditorElgamalPubkey sets the "auditorElgamalPubkey" parameter.
+func (obj *UpdateMint) SetAuditorElgamalPubkey(auditorElgamalPubkey ElGamalPubkey) *UpdateMint {
+	obj.AuditorElgamalPubkey = &auditorElgamalPubkey
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *UpdateMint) SetMintAccount(mint common.PublicKey) *UpdateMint {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *UpdateMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// Confidential transfer mint authority.
+func (obj *UpdateMint) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *UpdateMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// Confidential transfer mint authority.
+func (obj *UpdateMint) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *UpdateMint) SetProgramId(programId *common.PublicKey) *UpdateMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateMint) Validate() error {
+	if obj.AutoApproveNewAccounts == nil {
+		return errors.New("[UpdateMint] autoApproveNewAccounts param is not set")
+	}
+	if obj.AuditorElgamalPubkey == nil {
+		return errors.New("[UpdateMint] auditorElgamalPubkey param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateMint] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateMint] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.AutoApproveNewAccounts); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AuditorElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("AutoApproveNewAccounts", *obj.AutoApproveNewAccounts))
+						paramsBranch.Child(format.Param("  AuditorElgamalPubkey", *obj.AuditorElgamalPubkey))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// ConfigureAccount Instruction
+// Configures confidential transfers for a token account.
+type ConfigureAccount struct {
+	// The decryptable balance (always 0) once the configure account succeeds
+	DecryptableZeroBalance *DecryptableBalance
+	// The maximum number of despots and transfers that an account can receiver before the `ApplyPendingBalance` is executed
+	MaximumPendingBalanceCreditCounter *uint64
+	// Relative location of the `ProofInstruction::VerifyPubkeyValidity` instruction to the `ConfigureAccount` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	ProofInstructionOffset *int8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [] mint `The corresponding SPL Token mint.`
+	// [2] = [] instructionsSysvarOrContextState `Instructions sysvar if `VerifyPubkeyValidity` is included in the same transaction or context state account if `VerifyPubkeyValidity` is pre-verified into a context state account.`
+	// [3] = [SIGNER] authority `The single source account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewConfigureAccountInstructionBuilder creates a new `ConfigureAccount` instruction builder.
+func NewConfigureAccountInstructionBuilder() *ConfigureAccount {
+	return &ConfigureAccount{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewConfigureAccountInstruction
+//
+// Parameters:
+//
+//	decryptableZeroBalance: The decryptable balance (always 0) once the configure account succeeds
+//	maximumPendingBalanceCreditCounter: The maximum number of despots and transfers that an account can receiver before the `ApplyPendingBalance` is executed
+//	proofInstructionOffset: Relative location of the `ProofInstruction::VerifyPubkeyValidity` instruction to the `ConfigureAccount` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	token: The SPL Token account.
+//	mint: The corresponding SPL Token mint.
+//	instructionsSysvarOrContextState: Instructions sysvar if `VerifyPubkeyValidity` is included in the same transaction or context state account if `VerifyPubkeyValidity` is pre-verified into a context state account.
+//	authority: The single source account owner.
+func NewConfigureAccountInstruction(
+	decryptableZeroBalance DecryptableBalance,
+	maximumPendingBalanceCreditCounter uint64,
+	proofInstructionOffset int8,
+	token common.PublicKey,
+	mint common.PublicKey,
+	instructionsSysvarOrContextState common.PublicKey,
+	authority common.PublicKey,
+) *ConfigureAccount {
+	return NewConfigureAccountInstructionBuilder().
+		SetDecryptableZeroBalance(decryptableZeroBalance).
+		SetMaximumPendingBalanceCreditCounter(maximumPendingBalanceCreditCounter).
+		SetProofInstructionOffset(proofInstructionOffset).
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState).
+		SetAuthorityAccount(authority)
+}
+
+// SetDecryptableZeroBalance sets the "decryptableZeroBalance" parameter.
+func (obj *ConfigureAccount) SetDecryptableZeroBalance(decryptableZeroBalance DecryptableBalance) *ConfigureAccount {
+	obj.DecryptableZeroBalance = &decryptableZeroBalance
+	return obj
+}
+
+// SetMaximumPendingBalanceCreditCounter sets the "maximumPendingBalanceCreditCounter" parameter.
+func (obj *ConfigureAccount) SetMaximumPendingBalanceCreditCounter(maximumPendingBalanceCreditCounter uint64) *ConfigureAccount {
+	obj.MaximumPendingBalanceCreditCounter = &maximumPendingBalanceCreditCounter
+	return obj
+}
+
+// SetProofInstructionOffset sets the "proofInstructionOffset" parameter.
+func (obj *ConfigureAccount) SetProofInstructionOffset(proofInstructionOffset int8) *ConfigureAccount {
+	obj.ProofInstructionOffset = &proofInstructionOffset
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *ConfigureAccount) SetTokenAccount(token common.PublicKey) *ConfigureAccount {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *ConfigureAccount) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The corresponding SPL Token mint.
+func (obj *ConfigureAccount) SetMintAccount(mint common.PublicKey) *ConfigureAccount {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The corresponding SPL Token mint.
+func (obj *ConfigureAccount) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarOrContextStateAccount sets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyPubkeyValidity` is included in the same transaction or context state account if `VerifyPubkeyValidity` is pre-verified into a context state account.
+func (obj *ConfigureAccount) SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState common.PublicKey) *ConfigureAccount {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvarOrContextState)
+	return obj
+}
+
+// GetInstructionsSysvarOrContextStateAccount gets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyPubkeyValidity` is included in the same transaction or context state account if `VerifyPubkeyValidity` is pre-verified into a context state account.
+func (obj *ConfigureAccount) GetInstructionsSysvarOrContextStateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single source account owner.
+func (obj *ConfigureAccount) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *ConfigureAccount {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single source account owner.
+func (obj *ConfigureAccount) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *ConfigureAccount) SetProgramId(programId *common.PublicKey) *ConfigureAccount {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ConfigureAccount) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ConfigureAccount}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ConfigureAccount) Validate() error {
+	if obj.DecryptableZeroBalance == nil {
+		return errors.New("[ConfigureAccount] decryptableZeroBalance param is not set")
+	}
+	if obj.MaximumPendingBalanceCreditCounter == nil {
+		return errors.New("[ConfigureAccount] maximumPendingBalanceCreditCounter param is not set")
+	}
+	if obj.ProofInstructionOffset == nil {
+		return errors.New("[ConfigureAccount] proofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ConfigureAccount] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[ConfigureAccount] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[ConfigureAccount] accounts.instructionsSysvarOrContextState is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[ConfigureAccount] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ConfigureAccount) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ConfigureAccount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.DecryptableZeroBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.MaximumPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfigureAccount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.DecryptableZeroBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.MaximumPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfigureAccount) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ConfigureAccount")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=3]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("            DecryptableZeroBalance", *obj.DecryptableZeroBalance))
+						paramsBranch.Child(format.Param("MaximumPendingBalanceCreditCounter", *obj.MaximumPendingBalanceCreditCounter))
+						paramsBranch.Child(format.Param("            ProofInstructionOffset", *obj.ProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                           token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                            mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvarOrContextState", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                       authority", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// ApproveAccount Instruction
+// Approves a token account for confidential transfers.
+type ApproveAccount struct {
+	// [0] = [WRITE] token `The SPL Token account to approve.`
+	// [1] = [] mint `The SPL Token mint.`
+	// [2] = [SIGNER] authority `Confidential transfer mint authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewApproveAccountInstructionBuilder creates a new `ApproveAccount` instruction builder.
+func NewApproveAccountInstructionBuilder() *ApproveAccount {
+	return &ApproveAccount{
+		AccountMetaSlice: make(common.AccountMetaSlice, 3),
+	}
+}
+
+// NewApproveAccountInstruction
+//
+// Parameters:
+//
+//	token: The SPL Token account to approve.
+//	mint: The SPL Token mint.
+//	authority: Confidential transfer mint authority.
+func NewApproveAccountInstruction(
+	token common.PublicKey,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *ApproveAccount {
+	return NewApproveAccountInstructionBuilder().
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account to approve.
+func (obj *ApproveAccount) SetTokenAccount(token common.PublicKey) *ApproveAccount {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account to approve.
+func (obj *ApproveAccount) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *ApproveAccount) SetMintAccount(mint common.PublicKey) *ApproveAccount {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *ApproveAccount) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// Confidential transfer mint authority.
+func (obj *ApproveAccount) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *ApproveAccount {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[2] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// Confidential transfer mint authority.
+func (obj *ApproveAccount) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+func (obj *ApproveAccount) SetProgramId(programId *common.PublicKey) *ApproveAccount {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ApproveAccount) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ApproveAccount}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ApproveAccount) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ApproveAccount] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[ApproveAccount] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[ApproveAccount] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ApproveAccount) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ApproveAccount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *ApproveAccount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *ApproveAccount) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ApproveAccount")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=3]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(2)))
+					})
+				})
+		})
+}
+
+// EmptyAccount Instruction
+// Empty the available balance in a confidential token account.
+type EmptyAccount struct {
+	// Relative location of the `ProofInstruction::VerifyZeroCiphertext` instruction to the `EmptyAccount` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	ProofInstructionOffset *int8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [] instructionsSysvarOrContextState `Instructions sysvar if `VerifyZeroCiphertext` is included in the same transaction or context state account if `VerifyZeroCiphertext` is pre-verified into a context state account.`
+	// [2] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewEmptyAccountInstructionBuilder creates a new `EmptyAccount` instruction builder.
+func NewEmptyAccountInstructionBuilder() *EmptyAccount {
+	return &EmptyAccount{
+		AccountMetaSlice: make(common.AccountMetaSlice, 3),
+	}
+}
+
+// NewEmptyAccountInstruction
+//
+// Parameters:
+//
+//	proofInstructionOffset: Relative location of the `ProofInstruction::VerifyZeroCiphertext` instruction to the `EmptyAccount` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	token: The SPL Token account.
+//	instructionsSysvarOrContextState: Instructions sysvar if `VerifyZeroCiphertext` is included in the same transaction or context state account if `VerifyZeroCiphertext` is pre-verified into a context state account.
+//	authority: The single account owner.
+func NewEmptyAccountInstruction(
+	proofInstructionOffset int8,
+	token common.PublicKey,
+	instructionsSysvarOrContextState common.PublicKey,
+	authority common.PublicKey,
+) *EmptyAccount {
+	return NewEmptyAccountInstructionBuilder().
+		SetProofInstructionOffset(proofInstructionOffset).
+		SetTokenAccount(token).
+		SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState).
+		SetAuthorityAccount(authority)
+}
+
+// SetProofInstructionOffset sets the "proofInstructionOffset" parameter.
+func (obj *EmptyAccount) SetProofInstructionOffset(proofInstructionOffset int8) *EmptyAccount {
+	obj.ProofInstructionOffset = &proofInstructionOffset
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *EmptyAccount) SetTokenAccount(token common.PublicKey) *EmptyAccount {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *EmptyAccount) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetInstructionsSysvarOrContextStateAccount sets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyZeroCiphertext` is included in the same transaction or context state account if `VerifyZeroCiphertext` is pre-verified into a context state account.
+func (obj *EmptyAccount) SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState common.PublicKey) *EmptyAccount {
+	obj.AccountMetaSlice[1] = common.Meta(instructionsSysvarOrContextState)
+	return obj
+}
+
+// GetInstructionsSysvarOrContextStateAccount gets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyZeroCiphertext` is included in the same transaction or context state account if `VerifyZeroCiphertext` is pre-verified into a context state account.
+func (obj *EmptyAccount) GetInstructionsSysvarOrContextStateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *EmptyAccount) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *EmptyAccount {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[2] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *EmptyAccount) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+func (obj *EmptyAccount) SetProgramId(programId *common.PublicKey) *EmptyAccount {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *EmptyAccount) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_EmptyAccount}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *EmptyAccount) Validate() error {
+	if obj.ProofInstructionOffset == nil {
+		return errors.New("[EmptyAccount] proofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[EmptyAccount] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[EmptyAccount] accounts.instructionsSysvarOrContextState is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[EmptyAccount] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *EmptyAccount) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *EmptyAccount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *EmptyAccount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *EmptyAccount) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("EmptyAccount")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ProofInstructionOffset", *obj.ProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=3]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                           token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvarOrContextState", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("                       authority", obj.AccountMetaSlice.Get(2)))
+					})
+				})
+		})
+}
+
+// Deposit Instruction
+// Deposit SPL Tokens into the pending balance of a confidential token account.
+type Deposit struct {
+	// The amount of tokens to deposit
+	Amount *uint64
+	// Expected number of base 10 digits to the right of the decimal place
+	Decimals *uint8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [] mint `The token mint.`
+	// [2] = [SIGNER] authority `The single account owner or delegate.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewDepositInstructionBuilder creates a new `Deposit` instruction builder.
+func NewDepositInstructionBuilder() *Deposit {
+	return &Deposit{
+		AccountMetaSlice: make(common.AccountMetaSlice, 3),
+	}
+}
+
+// NewDepositInstruction
+//
+// Parameters:
+//
+//	amount: The amount of tokens to deposit
+//	decimals: Expected number of base 10 digits to the right of the decimal place
+//	token: The SPL Token account.
+//	mint: The token mint.
+//	authority: The single account owner or delegate.
+func NewDepositInstruction(
+	amount uint64,
+	decimals uint8,
+	token common.PublicKey,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *Deposit {
+	return NewDepositInstructionBuilder().
+		SetAmount(amount).
+		SetDecimals(decimals).
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetAmount sets the "amount" parameter.
+func (obj *Deposit) SetAmount(amount uint64) *Deposit {
+	obj.Amount = &amount
+	return obj
+}
+
+// SetDecimals sets the "decimals" parameter.
+func (obj *Deposit) SetDecimals(decimals uint8) *Deposit {
+	obj.Decimals = &decimals
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *Deposit) SetTokenAccount(token common.PublicKey) *Deposit {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *Deposit) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint.
+func (obj *Deposit) SetMintAccount(mint common.PublicKey) *Deposit {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint.
+func (obj *Deposit) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner or delegate.
+func (obj *Deposit) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Deposit {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[2] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner or delegate.
+func (obj *Deposit) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+func (obj *Deposit) SetProgramId(programId *common.PublicKey) *Deposit {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Deposit) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Deposit}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Deposit) Validate() error {
+	if obj.Amount == nil {
+		return errors.New("[Deposit] amount param is not set")
+	}
+	if obj.Decimals == nil {
+		return errors.New("[Deposit] decimals param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Deposit] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Deposit] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Deposit] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Deposit) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Deposit) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Decimals); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Deposit) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Decimals); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Deposit) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Deposit")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("  Amount", *obj.Amount))
+						paramsBranch.Child(format.Param("Decimals", *obj.Decimals))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=3]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(2)))
+					})
+				})
+		})
+}
+
+// Withdraw Instruction
+// Withdraw SPL Tokens from the available balance of a confidential token account.
+type Withdraw struct {
+	// The amount of tokens to withdraw
+	Amount *uint64
+	// Expected number of base 10 digits to the right of the decimal place
+	Decimals *uint8
+	// The new decryptable balance if the withdrawal succeeds
+	NewDecryptableAvailableBalance *DecryptableBalance
+	// Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `Withdraw` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	EqualityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::BatchedRangeProofU64` instruction to the `Withdraw` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	RangeProofInstructionOffset *int8
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [] mint `The token mint.`
+	// [2] = [] instructionsSysvar `(Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.`
+	// [3] = [] equalityRecord `(Optional) Equality proof context state account.`
+	// [4] = [] rangeRecord `(Optional) Range proof context state account.`
+	// [5] = [SIGNER] authority `The single source account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewWithdrawInstructionBuilder creates a new `Withdraw` instruction builder.
+func NewWithdrawInstructionBuilder() *Withdraw {
+	return &Withdraw{
+		AccountMetaSlice: make(common.AccountMetaSlice, 6),
+	}
+}
+
+// NewWithdrawInstruction
+//
+// Parameters:
+//
+//	amount: The amount of tokens to withdraw
+//	decimals: Expected number of base 10 digits to the right of the decimal place
+//	newDecryptableAvailableBalance: The new decryptable balance if the withdrawal succeeds
+//	equalityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `Withdraw` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	rangeProofInstructionOffset: Relative location of the `ProofInstruction::BatchedRangeProofU64` instruction to the `Withdraw` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	token: The SPL Token account.
+//	mint: The token mint.
+//	instructionsSysvar: (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+//	equalityRecord: (Optional) Equality proof context state account.
+//	rangeRecord: (Optional) Range proof context state account.
+//	authority: The single source account owner.
+func NewWithdrawInstruction(
+	amount uint64,
+	decimals uint8,
+	newDecryptableAvailableBalance DecryptableBalance,
+	equalityProofInstructionOffset int8,
+	rangeProofInstructionOffset int8,
+	token common.PublicKey,
+	mint common.PublicKey,
+	instructionsSysvar common.PublicKey,
+	equalityRecord common.PublicKey,
+	rangeRecord common.PublicKey,
+	authority common.PublicKey,
+) *Withdraw {
+	return NewWithdrawInstructionBuilder().
+		SetAmount(amount).
+		SetDecimals(decimals).
+		SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance).
+		SetEqualityProofInstructionOffset(equalityProofInstructionOffset).
+		SetRangeProofInstructionOffset(rangeProofInstructionOffset).
+		SetTokenAccount(token).
+		SetMintAccount(mint).
+		SetInstructionsSysvarAccount(instructionsSysvar).
+		SetEqualityRecordAccount(equalityRecord).
+		SetRangeRecordAccount(rangeRecord).
+		SetAuthorityAccount(authority)
+}
+
+// SetAmount sets the "amount" parameter.
+func (obj *Withdraw) SetAmount(amount uint64) *Withdraw {
+	obj.Amount = &amount
+	return obj
+}
+
+// SetDecimals sets the "decimals" parameter.
+func (obj *Withdraw) SetDecimals(decimals uint8) *Withdraw {
+	obj.Decimals = &decimals
+	return obj
+}
+
+// SetNewDecryptableAvailableBalance sets the "newDecryptableAvailableBalance" parameter.
+func (obj *Withdraw) SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance DecryptableBalance) *Withdraw {
+	obj.NewDecryptableAvailableBalance = &newDecryptableAvailableBalance
+	return obj
+}
+
+// SetEqualityProofInstructionOffset sets the "equalityProofInstructionOffset" parameter.
+func (obj *Withdraw) SetEqualityProofInstructionOffset(equalityProofInstructionOffset int8) *Withdraw {
+	obj.EqualityProofInstructionOffset = &equalityProofInstructionOffset
+	return obj
+}
+
+// SetRangeProofInstructionOffset sets the "rangeProofInstructionOffset" parameter.
+func (obj *Withdraw) SetRangeProofInstructionOffset(rangeProofInstructionOffset int8) *Withdraw {
+	obj.RangeProofInstructionOffset = &rangeProofInstructionOffset
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *Withdraw) SetTokenAccount(token common.PublicKey) *Withdraw {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *Withdraw) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint.
+func (obj *Withdraw) SetMintAccount(mint common.PublicKey) *Withdraw {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint.
+func (obj *Withdraw) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarAccount sets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Withdraw) SetInstructionsSysvarAccount(instructionsSysvar common.PublicKey) *Withdraw {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvar)
+	return obj
+}
+
+// GetInstructionsSysvarAccount gets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Withdraw) GetInstructionsSysvarAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetEqualityRecordAccount sets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Withdraw) SetEqualityRecordAccount(equalityRecord common.PublicKey) *Withdraw {
+	obj.AccountMetaSlice[3] = common.Meta(equalityRecord)
+	return obj
+}
+
+// GetEqualityRecordAccount gets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Withdraw) GetEqualityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetRangeRecordAccount sets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Withdraw) SetRangeRecordAccount(rangeRecord common.PublicKey) *Withdraw {
+	obj.AccountMetaSlice[4] = common.Meta(rangeRecord)
+	return obj
+}
+
+// GetRangeRecordAccount gets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Withdraw) GetRangeRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single source account owner.
+func (obj *Withdraw) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Withdraw {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[5] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[5] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single source account owner.
+func (obj *Withdraw) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+func (obj *Withdraw) SetProgramId(programId *common.PublicKey) *Withdraw {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Withdraw) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Withdraw}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Withdraw) Validate() error {
+	if obj.Amount == nil {
+		return errors.New("[Withdraw] amount param is not set")
+	}
+	if obj.Decimals == nil {
+		return errors.New("[Withdraw] decimals param is not set")
+	}
+	if obj.NewDecryptableAvailableBalance == nil {
+		return errors.New("[Withdraw] newDecryptableAvailableBalance param is not set")
+	}
+	if obj.EqualityProofInstructionOffset == nil {
+		return errors.New("[Withdraw] equalityProofInstructionOffset param is not set")
+	}
+	if obj.RangeProofInstructionOffset == nil {
+		return errors.New("[Withdraw] rangeProofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Withdraw] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Withdraw] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[5] == nil {
+		return errors.New("[Withdraw] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Withdraw) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Withdraw) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Decimals); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Withdraw) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Decimals); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Withdraw) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Withdraw")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=5]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("                        Amount", *obj.Amount))
+						paramsBranch.Child(format.Param("                      Decimals", *obj.Decimals))
+						paramsBranch.Child(format.Param("NewDecryptableAvailableBalance", *obj.NewDecryptableAvailableBalance))
+						paramsBranch.Child(format.Param("EqualityProofInstructionOffset", *obj.EqualityProofInstructionOffset))
+						paramsBranch.Child(format.Param("   RangeProofInstructionOffset", *obj.RangeProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=6]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("             token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("              mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvar", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("    equalityRecord", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("       rangeRecord", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("         authority", obj.AccountMetaSlice.Get(5)))
+					})
+				})
+		})
+}
+
+// Transfer Instruction
+// Transfer tokens confidentially.
+type Transfer struct {
+	// The new source decryptable balance if the transfer succeeds
+	NewSourceDecryptableAvailableBalance *DecryptableBalance
+	// The transfer amount encrypted under the auditor ElGamal public key
+	TransferAmountAuditorCiphertextLo *ElGamalCiphertext
+	// The transfer amount encrypted under the auditor ElGamal public key
+	TransferAmountAuditorCiphertextHi *ElGamalCiphertext
+	// Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	EqualityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	CiphertextValidityProofInstructionOffset *int8
+	// Relative location of the `ProofInstruction::BatchedRangeProofU128Data` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	RangeProofInstructionOffset *int8
+	// [0] = [WRITE] sourceToken `The source SPL Token account.`
+	// [1] = [] mint `The token mint.`
+	// [2] = [WRITE] destinationToken `The destination SPL Token account.`
+	// [3] = [] instructionsSysvar `(Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.`
+	// [4] = [] equalityRecord `(Optional) Equality proof context state account.`
+	// [5] = [] ciphertextValidityRecord `(Optional) Ciphertext validity proof context state account.`
+	// [6] = [] rangeRecord `(Optional) Range proof context state account.`
+	// [7] = [SIGNER] authority `The single source account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewTransferInstructionBuilder creates a new `Transfer` instruction builder.
+func NewTransferInstructionBuilder() *Transfer {
+	return &Transfer{
+		AccountMetaSlice: make(common.AccountMetaSlice, 8),
+	}
+}
+
+// NewTransferInstruction
+//
+// Parameters:
+//
+//	newSourceDecryptableAvailableBalance: The new source decryptable balance if the transfer succeeds
+//	transferAmountAuditorCiphertextLo: The transfer amount encrypted under the auditor ElGamal public key
+//	transferAmountAuditorCiphertextHi: The transfer amount encrypted under the auditor ElGamal public key
+//	equalityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyCiphertextCommitmentEquality` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	ciphertextValidityProofInstructionOffset: Relative location of the `ProofInstruction::VerifyBatchedGroupedCiphertext3HandlesValidity` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	rangeProofInstructionOffset: Relative location of the `ProofInstruction::BatchedRangeProofU128Data` instruction to the `Transfer` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	sourceToken: The source SPL Token account.
+//	mint: The token mint.
+//	destinationToken: The destination SPL Token account.
+//	instructionsSysvar: (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+//	equalityRecord: (Optional) Equality proof context state account.
+//	ciphertextValidityRecord: (Optional) Ciphertext validity proof context state account.
+//	rangeRecord: (Optional) Range proof context state account.
+//	authority: The single source account owner.
+func NewTransferInstruction(
+	newSourceDecryptableAvailableBalance DecryptableBalance,
+	transferAmountAuditorCiphertextLo ElGamalCiphertext,
+	transferAmountAuditorCiphertextHi ElGamalCiphertext,
+	equalityProofInstructionOffset int8,
+	ciphertextValidityProofInstructionOffset int8,
+	rangeProofInstructionOffset int8,
+	sourceToken common.PublicKey,
+	mint common.PublicKey,
+	destinationToken common.PublicKey,
+	instructionsSysvar common.PublicKey,
+	equalityRecord common.PublicKey,
+	ciphertextValidityRecord common.PublicKey,
+	rangeRecord common.PublicKey,
+	authority common.PublicKey,
+) *Transfer {
+	return NewTransferInstructionBuilder().
+		SetNewSourceDecryptableAvailableBalance(newSourceDecryptableAvailableBalance).
+		SetTransferAmountAuditorCiphertextLo(transferAmountAuditorCiphertextLo).
+		SetTransferAmountAuditorCiphertextHi(transferAmountAuditorCiphertextHi).
+		SetEqualityProofInstructionOffset(equalityProofInstructionOffset).
+		SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset).
+		SetRangeProofInstructionOffset(rangeProofInstructionOffset).
+		SetSourceTokenAccount(sourceToken).
+		SetMintAccount(mint).
+		SetDestinationTokenAccount(destinationToken).
+		SetInstructionsSysvarAccount(instructionsSysvar).
+		SetEqualityRecordAccount(equalityRecord).
+		SetCiphertextValidityRecordAccount(ciphertextValidityRecord).
+		SetRangeRecordAccount(rangeRecord).
+		SetAuthorityAccount(authority)
+}
+
+// SetNewSourceDecryptableAvailableBalance sets the "newSourceDecryptableAvailableBalance" parameter.
+func (obj *Transfer) SetNewSourceDecryptableAvailableBalance(newSourceDecryptableAvailableBalance DecryptableBalance) *Transfer {
+	obj.NewSourceDecryptableAvailableBalance = &newSourceDecryptableAvailableBalance
+	return obj
+}
+
+// SetTransferAmountAuditorCiphertextLo sets the "transferAmountAuditorCiphertextLo" parameter.
+func (obj *Transfer) SetTransferAmountAuditorCiphertextLo(transferAmountAuditorCiphertextLo ElGamalCiphertext) *Transfer {
+	obj.TransferAmountAuditorCiphertextLo = &transferAmountAuditorCiphertextLo
+	return obj
+}
+
+// SetTransferAmountAuditorCiphertextHi sets the "transferAmountAuditorCiphertextHi" parameter.
+func (obj *Transfer) SetTransferAmountAuditorCiphertextHi(transferAmountAuditorCiphertextHi ElGamalCiphertext) *Transfer {
+	obj.TransferAmountAuditorCiphertextHi = &transferAmountAuditorCiphertextHi
+	return obj
+}
+
+// SetEqualityProofInstructionOffset sets the "equalityProofInstructionOffset" parameter.
+func (obj *Transfer) SetEqualityProofInstructionOffset(equalityProofInstructionOffset int8) *Transfer {
+	obj.EqualityProofInstructionOffset = &equalityProofInstructionOffset
+	return obj
+}
+
+// SetCiphertextValidityProofInstructionOffset sets the "ciphertextValidityProofInstructionOffset" parameter.
+func (obj *Transfer) SetCiphertextValidityProofInstructionOffset(ciphertextValidityProofInstructionOffset int8) *Transfer {
+	obj.CiphertextValidityProofInstructionOffset = &ciphertextValidityProofInstructionOffset
+	return obj
+}
+
+// SetRangeProofInstructionOffset sets the "rangeProofInstructionOffset" parameter.
+func (obj *Transfer) SetRangeProofInstructionOffset(rangeProofInstructionOffset int8) *Transfer {
+	obj.RangeProofInstructionOffset = &rangeProofInstructionOffset
+	return obj
+}
+
+// SetSourceTokenAccount sets the "sourceToken" parameter.
+// The source SPL Token account.
+func (obj *Transfer) SetSourceTokenAccount(sourceToken common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[0] = common.Meta(sourceToken).WRITE()
+	return obj
+}
+
+// GetSourceTokenAccount gets the "sourceToken" parameter.
+// The source SPL Token account.
+func (obj *Transfer) GetSourceTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint.
+func (obj *Transfer) SetMintAccount(mint common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint.
+func (obj *Transfer) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetDestinationTokenAccount sets the "destinationToken" parameter.
+// The destination SPL Token account.
+func (obj *Transfer) SetDestinationTokenAccount(destinationToken common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[2] = common.Meta(destinationToken).WRITE()
+	return obj
+}
+
+// GetDestinationTokenAccount gets the "destinationToken" parameter.
+// The destination SPL Token account.
+func (obj *Transfer) GetDestinationTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetInstructionsSysvarAccount sets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Transfer) SetInstructionsSysvarAccount(instructionsSysvar common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[3] = common.Meta(instructionsSysvar)
+	return obj
+}
+
+// GetInstructionsSysvarAccount gets the "instructionsSysvar" parameter.
+// (Optional) Instructions sysvar if at least one of the `zk_elgamal_proof` instructions are included in the same transaction.
+func (obj *Transfer) GetInstructionsSysvarAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetEqualityRecordAccount sets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Transfer) SetEqualityRecordAccount(equalityRecord common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[4] = common.Meta(equalityRecord)
+	return obj
+}
+
+// GetEqualityRecordAccount gets the "equalityRecord" parameter.
+// (Optional) Equality proof context state account.
+func (obj *Transfer) GetEqualityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+// SetCiphertextValidityRecordAccount sets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Transfer) SetCiphertextValidityRecordAccount(ciphertextValidityRecord common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[5] = common.Meta(ciphertextValidityRecord)
+	return obj
+}
+
+// GetCiphertextValidityRecordAccount gets the "ciphertextValidityRecord" parameter.
+// (Optional) Ciphertext validity proof context state account.
+func (obj *Transfer) GetCiphertextValidityRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(5)
+}
+
+// SetRangeRecordAccount sets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Transfer) SetRangeRecordAccount(rangeRecord common.PublicKey) *Transfer {
+	obj.AccountMetaSlice[6] = common.Meta(rangeRecord)
+	return obj
+}
+
+// GetRangeRecordAccount gets the "rangeRecord" parameter.
+// (Optional) Range proof context state account.
+func (obj *Transfer) GetRangeRecordAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(6)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single source account owner.
+func (obj *Transfer) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Transfer {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[7] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[7] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single source account owner.
+func (obj *Transfer) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(7)
+}
+
+func (obj *Transfer) SetProgramId(programId *common.PublicKey) *Transfer {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Transfer) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Transfer}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Transfer) Validate() error {
+	if obj.NewSourceDecryptableAvailableBalance == nil {
+		return errors.New("[Transfer] newSourceDecryptableAvailableBalance param is not set")
+	}
+	if obj.TransferAmountAuditorCiphertextLo == nil {
+		return errors.New("[Transfer] transferAmountAuditorCiphertextLo param is not set")
+	}
+	if obj.TransferAmountAuditorCiphertextHi == nil {
+		return errors.New("[Transfer] transferAmountAuditorCiphertextHi param is not set")
+	}
+	if obj.EqualityProofInstructionOffset == nil {
+		return errors.New("[Transfer] equalityProofInstructionOffset param is not set")
+	}
+	if obj.CiphertextValidityProofInstructionOffset == nil {
+		return errors.New("[Transfer] ciphertextValidityProofInstructionOffset param is not set")
+	}
+	if obj.RangeProofInstructionOffset == nil {
+		return errors.New("[Transfer] rangeProofInstructionOffset param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Transfer] accounts.sourceToken is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Transfer] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Transfer] accounts.destinationToken is not set")
+	}
+	if obj.AccountMetaSlice[7] == nil {
+		return errors.New("[Transfer] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Transfer) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Transfer) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NewSourceDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.TransferAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.TransferAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Transfer) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NewSourceDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.TransferAmountAuditorCiphertextLo); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.TransferAmountAuditorCiphertextHi); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.EqualityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.CiphertextValidityProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.RangeProofInstructionOffset); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Transfer) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Transfer")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=6]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("    NewSourceDecryptableAvailableBalance", *obj.NewSourceDecryptableAvailableBalance))
+						paramsBranch.Child(format.Param("       TransferAmountAuditorCiphertextLo", *obj.TransferAmountAuditorCiphertextLo))
+						paramsBranch.Child(format.Param("       TransferAmountAuditorCiphertextHi", *obj.TransferAmountAuditorCiphertextHi))
+						paramsBranch.Child(format.Param("          EqualityProofInstructionOffset", *obj.EqualityProofInstructionOffset))
+						paramsBranch.Child(format.Param("CiphertextValidityProofInstructionOffset", *obj.CiphertextValidityProofInstructionOffset))
+						paramsBranch.Child(format.Param("             RangeProofInstructionOffset", *obj.RangeProofInstructionOffset))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=8]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("             sourceToken", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                    mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("        destinationToken", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("      instructionsSysvar", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("          equalityRecord", obj.AccountMetaSlice.Get(4)))
+						accountsBranch.Child(common.FormatMeta("ciphertextValidityRecord", obj.AccountMetaSlice.Get(5)))
+						accountsBranch.Child(common.FormatMeta("             rangeRecord", obj.AccountMetaSlice.Get(6)))
+						accountsBranch.Child(common.FormatMeta("               authority", obj.AccountMetaSlice.Get(7)))
+					})
+				})
+		})
+}
+
+// ApplyPendingBalance Instruction
+// Applies the pending balance to the available balance, based on the history of `Deposit` and/or `Transfer` instructions.
+type ApplyPendingBalance struct {
+	// The expected number of pending balance credits since the last successful `ApplyPendingBalance` instruction
+	ExpectedPendingBalanceCreditCounter *uint64
+	// The new decryptable balance if the pending balance is applied successfully
+	NewDecryptableAvailableBalance *DecryptableBalance
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewApplyPendingBalanceInstructionBuilder creates a new `ApplyPendingBalance` instruction builder.
+func NewApplyPendingBalanceInstructionBuilder() *ApplyPendingBalance {
+	return &ApplyPendingBalance{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewApplyPendingBalanceInstruction
+//
+// Parameters:
+//
+//	expectedPendingBalanceCreditCounter: The expected number of pending balance credits since the last successful `ApplyPendingBalance` instruction
+//	newDecryptableAvailableBalance: The new decryptable balance if the pending balance is applied successfully
+//	token: The SPL Token account.
+//	authority: The single account owner.
+func NewApplyPendingBalanceInstruction(
+	expectedPendingBalanceCreditCounter uint64,
+	newDecryptableAvailableBalance DecryptableBalance,
+	token common.PublicKey,
+	authority common.PublicKey,
+) *ApplyPendingBalance {
+	return NewApplyPendingBalanceInstructionBuilder().
+		SetExpectedPendingBalanceCreditCounter(expectedPendingBalanceCreditCounter).
+		SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance).
+		SetTokenAccount(token).
+		SetAuthorityAccount(authority)
+}
+
+// SetExpectedPendingBalanceCreditCounter sets the "expectedPendingBalanceCreditCounter" parameter.
+func (obj *ApplyPendingBalance) SetExpectedPendingBalanceCreditCounter(expectedPendingBalanceCreditCounter uint64) *ApplyPendingBalance {
+	obj.ExpectedPendingBalanceCreditCounter = &expectedPendingBalanceCreditCounter
+	return obj
+}
+
+// SetNewDecryptableAvailableBalance sets the "newDecryptableAvailableBalance" parameter.
+func (obj *ApplyPendingBalance) SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance DecryptableBalance) *ApplyPendingBalance {
+	obj.NewDecryptableAvailableBalance = &newDecryptableAvailableBalance
+	return obj
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *ApplyPendingBalance) SetTokenAccount(token common.PublicKey) *ApplyPendingBalance {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *ApplyPendingBalance) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *ApplyPendingBalance) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *ApplyPendingBalance {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *ApplyPendingBalance) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *ApplyPendingBalance) SetProgramId(programId *common.PublicKey) *ApplyPendingBalance {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ApplyPendingBalance) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ApplyPendingBalance}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ApplyPendingBalance) Validate() error {
+	if obj.ExpectedPendingBalanceCreditCounter == nil {
+		return errors.New("[ApplyPendingBalance] expectedPendingBalanceCreditCounter param is not set")
+	}
+	if obj.NewDecryptableAvailableBalance == nil {
+		return errors.New("[ApplyPendingBalance] newDecryptableAvailableBalance param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[ApplyPendingBalance] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[ApplyPendingBalance] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ApplyPendingBalance) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ApplyPendingBalance) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ExpectedPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApplyPendingBalance) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ExpectedPendingBalanceCreditCounter); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ApplyPendingBalance) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ApplyPendingBalance")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ExpectedPendingBalanceCreditCounter", *obj.ExpectedPendingBalanceCreditCounter))
+						paramsBranch.Child(format.Param("     NewDecryptableAvailableBalance", *obj.NewDecryptableAvailableBalance))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// EnableConfidentialCredits Instruction
+// Configure a confidential extension account to accept incoming confidential transfers.
+type EnableConfidentialCredits struct {
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewEnableConfidentialCreditsInstructionBuilder creates a new `EnableConfidentialCredits` instruction builder.
+func NewEnableConfidentialCreditsInstructionBuilder() *EnableConfidentialCredits {
+	return &EnableConfidentialCredits{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewEnableConfidentialCreditsInstruction
+//
+// Parameters:
+//
+//	token: The SPL Token account.
+//	authority: The single account owner.
+func NewEnableConfidentialCreditsInstruction(
+	token common.PublicKey,
+	authority common.PublicKey,
+) *EnableConfidentialCredits {
+	return NewEnableConfidentialCreditsInstructionBuilder().
+		SetTokenAccount(token).
+		SetAuthorityAccount(authority)
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *EnableConfidentialCredits) SetTokenAccount(token common.PublicKey) *EnableConfidentialCredits {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *EnableConfidentialCredits) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *EnableConfidentialCredits) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *EnableConfidentialCredits {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *EnableConfidentialCredits) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *EnableConfidentialCredits) SetProgramId(programId *common.PublicKey) *EnableConfidentialCredits {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *EnableConfidentialCredits) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_EnableConfidentialCredits}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *EnableConfidentialCredits) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[EnableConfidentialCredits] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[EnableConfidentialCredits] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *EnableConfidentialCredits) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *EnableConfidentialCredits) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *EnableConfidentialCredits) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *EnableConfidentialCredits) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("EnableConfidentialCredits")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// DisableConfidentialCredits Instruction
+// Configure a confidential extension account to reject any incoming confidential transfers.
+type DisableConfidentialCredits struct {
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewDisableConfidentialCreditsInstructionBuilder creates a new `DisableConfidentialCredits` instruction builder.
+func NewDisableConfidentialCreditsInstructionBuilder() *DisableConfidentialCredits {
+	return &DisableConfidentialCredits{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewDisableConfidentialCreditsInstruction
+//
+// Parameters:
+//
+//	token: The SPL Token account.
+//	authority: The single account owner.
+func NewDisableConfidentialCreditsInstruction(
+	token common.PublicKey,
+	authority common.PublicKey,
+) *DisableConfidentialCredits {
+	return NewDisableConfidentialCreditsInstructionBuilder().
+		SetTokenAccount(token).
+		SetAuthorityAccount(authority)
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *DisableConfidentialCredits) SetTokenAccount(token common.PublicKey) *DisableConfidentialCredits {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *DisableConfidentialCredits) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *DisableConfidentialCredits) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *DisableConfidentialCredits {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *DisableConfidentialCredits) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *DisableConfidentialCredits) SetProgramId(programId *common.PublicKey) *DisableConfidentialCredits {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *DisableConfidentialCredits) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_DisableConfidentialCredits}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *DisableConfidentialCredits) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[DisableConfidentialCredits] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[DisableConfidentialCredits] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *DisableConfidentialCredits) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *DisableConfidentialCredits) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *DisableConfidentialCredits) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *DisableConfidentialCredits) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("DisableConfidentialCredits")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// EnableNonConfidentialCredits Instruction
+// Configure an account with the confidential extension to accept incoming non-confidential transfers.
+type EnableNonConfidentialCredits struct {
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewEnableNonConfidentialCreditsInstructionBuilder creates a new `EnableNonConfidentialCredits` instruction builder.
+func NewEnableNonConfidentialCreditsInstructionBuilder() *EnableNonConfidentialCredits {
+	return &EnableNonConfidentialCredits{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewEnableNonConfidentialCreditsInstruction
+//
+// Parameters:
+//
+//	token: The SPL Token account.
+//	authority: The single account owner.
+func NewEnableNonConfidentialCreditsInstruction(
+	token common.PublicKey,
+	authority common.PublicKey,
+) *EnableNonConfidentialCredits {
+	return NewEnableNonConfidentialCreditsInstructionBuilder().
+		SetTokenAccount(token).
+		SetAuthorityAccount(authority)
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *EnableNonConfidentialCredits) SetTokenAccount(token common.PublicKey) *EnableNonConfidentialCredits {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *EnableNonConfidentialCredits) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *EnableNonConfidentialCredits) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *EnableNonConfidentialCredits {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *EnableNonConfidentialCredits) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *EnableNonConfidentialCredits) SetProgramId(programId *common.PublicKey) *EnableNonConfidentialCredits {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *EnableNonConfidentialCredits) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_EnableNonConfidentialCredits}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *EnableNonConfidentialCredits) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[EnableNonConfidentialCredits] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[EnableNonConfidentialCredits] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *EnableNonConfidentialCredits) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *EnableNonConfidentialCredits) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *EnableNonConfidentialCredits) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *EnableNonConfidentialCredits) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("EnableNonConfidentialCredits")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// DisableNonConfidentialCredits Instruction
+// Configure an account with the confidential extension to reject any incoming non-confidential transfers.
+type DisableNonConfidentialCredits struct {
+	// [0] = [WRITE] token `The SPL Token account.`
+	// [1] = [SIGNER] authority `The single account owner.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewDisableNonConfidentialCreditsInstructionBuilder creates a new `DisableNonConfidentialCredits` instruction builder.
+func NewDisableNonConfidentialCreditsInstructionBuilder() *DisableNonConfidentialCredits {
+	return &DisableNonConfidentialCredits{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewDisableNonConfidentialCreditsInstruction
+//
+// Parameters:
+//
+//	token: The SPL Token account.
+//	authority: The single account owner.
+func NewDisableNonConfidentialCreditsInstruction(
+	token common.PublicKey,
+	authority common.PublicKey,
+) *DisableNonConfidentialCredits {
+	return NewDisableNonConfidentialCreditsInstructionBuilder().
+		SetTokenAccount(token).
+		SetAuthorityAccount(authority)
+}
+
+// SetTokenAccount sets the "token" parameter.
+// The SPL Token account.
+func (obj *DisableNonConfidentialCredits) SetTokenAccount(token common.PublicKey) *DisableNonConfidentialCredits {
+	obj.AccountMetaSlice[0] = common.Meta(token).WRITE()
+	return obj
+}
+
+// GetTokenAccount gets the "token" parameter.
+// The SPL Token account.
+func (obj *DisableNonConfidentialCredits) GetTokenAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The single account owner.
+func (obj *DisableNonConfidentialCredits) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *DisableNonConfidentialCredits {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The single account owner.
+func (obj *DisableNonConfidentialCredits) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *DisableNonConfidentialCredits) SetProgramId(programId *common.PublicKey) *DisableNonConfidentialCredits {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *DisableNonConfidentialCredits) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_DisableNonConfidentialCredits}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *DisableNonConfidentialCredits) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[DisableNonConfidentialCredits] accounts.token is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[DisableNonConfidentialCredits] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *DisableNonConfidentialCredits) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *DisableNonConfidentialCredits) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *DisableNonConfidentialCredits) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *DisableNonConfidentialCredits) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("DisableNonConfidentialCredits")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("    token", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
@@ -0,0 +1,216 @@
+package confidential_transfer
+
+import (
+	"errors"
+	"fmt"
+	common "github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	zk "github.com/donutnomad/solana-web3/zk_elgamal_proof"
+)
+
+const (
+	// TransferAmountLoBits the transfer amount is split into a 16 bits lo part
+	TransferAmountLoBits = 16
+	// TransferAmountHiBits and a 32 bits hi part, a confidential transfer is at most 2^48-1
+	TransferAmountHiBits = 32
+	// RemainingBalanceBitLength the bit length of the range proof of the remaining balance
+	RemainingBalanceBitLength = 64
+	// RangeProofPaddingBitLength pads the transfer range proof to 128 bits
+	RangeProofPaddingBitLength = 16
+	// PendingBalanceLoBits the pending balance is split the same way as the transfer amount
+	PendingBalanceLoBits = 16
+	// DefaultMaximumPendingBalanceCreditCounter the default of the spl-token cli
+	DefaultMaximumPendingBalanceCreditCounter uint64 = 65536
+)
+
+var ErrInsufficientFunds = errors.New("confidential_transfer: insufficient funds")
+
+// DeriveKeys derives the ElGamal keypair and the AE key of the token account from the owner, like the spl-token cli
+func DeriveKeys(owner web3.Signer, tokenAccount common.PublicKey) (*zk.ElGamalKeypair, zk.AeKey, error) {
+	elgamal, err := zk.NewElGamalKeypairFromSigner(owner, tokenAccount[:])
+	if err != nil {
+		return nil, zk.AeKey{}, err
+	}
+	aeKey, err := zk.NewAeKeyFromSigner(owner, tokenAccount[:])
+	if err != nil {
+		return nil, zk.AeKey{}, err
+	}
+	return elgamal, aeKey, nil
+}
+
+// AuditorPubkey returns nil if the mint has no auditor
+func (obj *ConfidentialTransferMint) AuditorPubkey() *zk.ElGamalPubkey {
+	if obj.AuditorElgamalPubkey == (ElGamalPubkey{}) {
+		return nil
+	}
+	pubkey := zk.ElGamalPubkey(obj.AuditorElgamalPubkey)
+	return &pubkey
+}
+
+// DecryptAvailableBalance decrypts the decryptable available balance
+func (obj *ConfidentialTransferAccount) DecryptAvailableBalance(aeKey zk.AeKey) (uint64, error) {
+	return aeKey.Decrypt(zk.AeCiphertext(obj.DecryptableAvailableBalance))
+}
+
+// DecryptPendingBalance decrypts the pending balance, lo + hi << 16
+func (obj *ConfidentialTransferAccount) DecryptPendingBalance(keypair *zk.ElGamalKeypair) (uint64, error) {
+	lo, err := keypair.DecryptU32(zk.ElGamalCiphertext(obj.PendingBalanceLo))
+	if err != nil {
+		return 0, fmt.Errorf("pending balance lo: %w", err)
+	}
+	hi, err := keypair.DecryptU32(zk.ElGamalCiphertext(obj.PendingBalanceHi))
+	if err != nil {
+		return 0, fmt.Errorf("pending balance hi: %w", err)
+	}
+	return lo + hi<<PendingBalanceLoBits, nil
+}
+
+// ApplyPendingBalanceArgs returns the arguments of ApplyPendingBalance:
+// the expected pending balance credit counter and the new decryptable available balance
+func (obj *ConfidentialTransferAccount) ApplyPendingBalanceArgs(keypair *zk.ElGamalKeypair, aeKey zk.AeKey) (uint64, DecryptableBalance, error) {
+	available, err := obj.DecryptAvailableBalance(aeKey)
+	if err != nil {
+		return 0, DecryptableBalance{}, err
+	}
+	pending, err := obj.DecryptPendingBalance(keypair)
+	if err != nil {
+		return 0, DecryptableBalance{}, err
+	}
+	return obj.PendingBalanceCreditCounter, DecryptableBalance(aeKey.Encrypt(available + pending)), nil
+}
+
+// EmptyAccountProofData proves that the available balance is zero
+func (obj *ConfidentialTransferAccount) EmptyAccountProofData(keypair *zk.ElGamalKeypair) (*zk.ZeroCiphertextProofData, error) {
+	return zk.NewZeroCiphertextProofData(keypair, zk.ElGamalCiphertext(obj.AvailableBalance))
+}
+
+// WithdrawProofData the proofs and the arguments of Withdraw
+type WithdrawProofData struct {
+	Equality                       *zk.CiphertextCommitmentEqualityProofData
+	Range                          *zk.BatchedRangeProofData
+	NewDecryptableAvailableBalance DecryptableBalance
+}
+
+// WithdrawProofData generates the proofs to withdraw amount from the available balance
+func (obj *ConfidentialTransferAccount) WithdrawProofData(keypair *zk.ElGamalKeypair, aeKey zk.AeKey, amount uint64) (*WithdrawProofData, error) {
+	current, err := obj.DecryptAvailableBalance(aeKey)
+	if err != nil {
+		return nil, err
+	}
+	if current < amount {
+		return nil, ErrInsufficientFunds
+	}
+	remaining := current - amount
+	commitment, opening := zk.NewPedersenCommitment(remaining)
+	ciphertext, err := zk.ElGamalCiphertext(obj.AvailableBalance).Sub(zk.EncodeElGamalCiphertext(amount))
+	if err != nil {
+		return nil, err
+	}
+	equality, err := zk.NewCiphertextCommitmentEqualityProofData(keypair, ciphertext, commitment, opening, remaining)
+	if err != nil {
+		return nil, err
+	}
+	rangeProof, err := zk.NewBatchedRangeProofData(
+		[]zk.PedersenCommitment{commitment},
+		[]uint64{remaining},
+		[]int{RemainingBalanceBitLength},
+		[]*zk.PedersenOpening{opening},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawProofData{
+		Equality:                       equality,
+		Range:                          rangeProof,
+		NewDecryptableAvailableBalance: DecryptableBalance(aeKey.Encrypt(remaining)),
+	}, nil
+}
+
+// TransferProofData the proofs and the arguments of Transfer
+type TransferProofData struct {
+	Equality                             *zk.CiphertextCommitmentEqualityProofData
+	CiphertextValidity                   *zk.BatchedGroupedCiphertext3HandlesValidityProofData
+	Range                                *zk.BatchedRangeProofData
+	NewSourceDecryptableAvailableBalance DecryptableBalance
+	TransferAmountAuditorCiphertextLo    ElGamalCiphertext
+	TransferAmountAuditorCiphertextHi    ElGamalCiphertext
+}
+
+// TransferProofData generates the proofs to transfer amount from the available balance to the destination ElGamal public key,
+// auditor is the auditor of the mint, nil if the mint has no auditor
+func (obj *ConfidentialTransferAccount) TransferProofData(
+	keypair *zk.ElGamalKeypair,
+	aeKey zk.AeKey,
+	amount uint64,
+	destination zk.ElGamalPubkey,
+	auditor *zk.ElGamalPubkey,
+) (*TransferProofData, error) {
+	if amount>>(TransferAmountLoBits+TransferAmountHiBits) != 0 {
+		return nil, fmt.Errorf("confidential_transfer: the transfer amount %d exceeds 48 bits", amount)
+	}
+	var auditorPubkey zk.ElGamalPubkey
+	if auditor != nil {
+		auditorPubkey = *auditor
+	}
+	pubkeys := [3]zk.ElGamalPubkey{keypair.Pubkey, destination, auditorPubkey}
+	amountLo, amountHi := amount&(1<<TransferAmountLoBits-1), amount>>TransferAmountLoBits
+	openingLo, openingHi := zk.NewPedersenOpening(), zk.NewPedersenOpening()
+	ciphertextLo, err := zk.NewGroupedElGamalCiphertext3Handles(pubkeys, amountLo, openingLo)
+	if err != nil {
+		return nil, err
+	}
+	ciphertextHi, err := zk.NewGroupedElGamalCiphertext3Handles(pubkeys, amountHi, openingHi)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := obj.DecryptAvailableBalance(aeKey)
+	if err != nil {
+		return nil, err
+	}
+	if current < amount {
+		return nil, ErrInsufficientFunds
+	}
+	remaining := current - amount
+	commitment, opening := zk.NewPedersenCommitment(remaining)
+	// available - (lo + hi * 2^16) under the source public key
+	sourceHi, err := ciphertextHi.Ciphertext(0).MulU64(1 << TransferAmountLoBits)
+	if err != nil {
+		return nil, err
+	}
+	sourceAmount, err := ciphertextLo.Ciphertext(0).Add(sourceHi)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := zk.ElGamalCiphertext(obj.AvailableBalance).Sub(sourceAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	equality, err := zk.NewCiphertextCommitmentEqualityProofData(keypair, ciphertext, commitment, opening, remaining)
+	if err != nil {
+		return nil, err
+	}
+	validity, err := zk.NewBatchedGroupedCiphertext3HandlesValidityProofData(pubkeys, ciphertextLo, ciphertextHi, amountLo, amountHi, openingLo, openingHi)
+	if err != nil {
+		return nil, err
+	}
+	paddingCommitment, paddingOpening := zk.NewPedersenCommitment(0)
+	rangeProof, err := zk.NewBatchedRangeProofData(
+		[]zk.PedersenCommitment{commitment, ciphertextLo.Commitment(), ciphertextHi.Commitment(), paddingCommitment},
+		[]uint64{remaining, amountLo, amountHi, 0},
+		[]int{RemainingBalanceBitLength, TransferAmountLoBits, TransferAmountHiBits, RangeProofPaddingBitLength},
+		[]*zk.PedersenOpening{opening, openingLo, openingHi, paddingOpening},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferProofData{
+		Equality:                             equality,
+		CiphertextValidity:                   validity,
+		Range:                                rangeProof,
+		NewSourceDecryptableAvailableBalance: DecryptableBalance(aeKey.Encrypt(remaining)),
+		TransferAmountAuditorCiphertextLo:    ElGamalCiphertext(ciphertextLo.Ciphertext(2)),
+		TransferAmountAuditorCiphertextHi:    ElGamalCiphertext(ciphertextHi.Ciphertext(2)),
+	}, nil
+}
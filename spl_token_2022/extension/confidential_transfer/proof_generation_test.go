@@ -0,0 +1,72 @@
+package confidential_transfer
+
+import (
+	zk "github.com/donutnomad/solana-web3/zk_elgamal_proof"
+	"testing"
+)
+
+func TestProofGeneration(t *testing.T) {
+	keypair, aeKey := zk.NewElGamalKeypair(), zk.NewAeKey()
+	available, err := keypair.Pubkey.Encrypt(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pendingLo, _ := keypair.Pubkey.Encrypt(300)
+	pendingHi, _ := keypair.Pubkey.Encrypt(2)
+	account := &ConfidentialTransferAccount{
+		Approved:                    true,
+		ElgamalPubkey:               keypair.Pubkey,
+		PendingBalanceLo:            pendingLo,
+		PendingBalanceHi:            pendingHi,
+		AvailableBalance:            available,
+		DecryptableAvailableBalance: aeKey.Encrypt(1000),
+		PendingBalanceCreditCounter: 3,
+	}
+
+	counter, decryptable, err := account.ApplyPendingBalanceArgs(keypair, aeKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance, err := aeKey.Decrypt(decryptable); counter != 3 || err != nil || balance != 1000+300+2<<16 {
+		t.Fatalf("unexpected balance %d %v", balance, err)
+	}
+
+	withdraw, err := account.WithdrawProofData(keypair, aeKey, 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, proof := range []zk.ProofData{withdraw.Equality, withdraw.Range} {
+		if err := proof.Verify(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ok, _ := keypair.CheckAmount(withdraw.Equality.Ciphertext, 600); !ok {
+		t.Fatal("unexpected remaining balance ciphertext")
+	}
+	if _, err := account.WithdrawProofData(keypair, aeKey, 1001); err != ErrInsufficientFunds {
+		t.Fatalf("expected insufficient funds, got %v", err)
+	}
+
+	destination, auditor := zk.NewElGamalKeypair(), zk.NewElGamalKeypair()
+	transfer, err := account.TransferProofData(keypair, aeKey, 999, destination.Pubkey, &auditor.Pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, proof := range []zk.ProofData{transfer.Equality, transfer.CiphertextValidity, transfer.Range} {
+		if err := proof.Verify(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if transfer.Range.ProofType() != zk.ProofTypeBatchedRangeProofU128 {
+		t.Fatalf("unexpected range proof type %d", transfer.Range.ProofType())
+	}
+	if amount, err := auditor.DecryptU32(zk.ElGamalCiphertext(transfer.TransferAmountAuditorCiphertextLo)); err != nil || amount != 999 {
+		t.Fatalf("unexpected auditor amount %d %v", amount, err)
+	}
+	if amount, err := destination.DecryptU32(transfer.CiphertextValidity.GroupedCiphertextLo.Ciphertext(1)); err != nil || amount != 999 {
+		t.Fatalf("unexpected destination amount %d %v", amount, err)
+	}
+	if balance, err := aeKey.Decrypt(transfer.NewSourceDecryptableAvailableBalance); err != nil || balance != 1 {
+		t.Fatalf("unexpected balance %d %v", balance, err)
+	}
+}
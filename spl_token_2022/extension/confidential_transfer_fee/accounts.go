@@ -0,0 +1,77 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_transfer_fee
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// ConfidentialTransferFeeConfig Struct
+type ConfidentialTransferFeeConfig struct {
+	// Optional authority to set the withdraw withheld authority ElGamal key
+	Authority common.PublicKey
+	// Withheld fees from accounts must be encrypted with this ElGamal key.
+	WithdrawWithheldAuthorityElgamalPubkey ElGamalPubkey
+	// If `false`, the harvest of withheld tokens to mint is rejected.
+	HarvestToMintEnabled bool
+	// Withheld confidential transfer fee tokens that have been moved to the mint for withdrawal.
+	WithheldAmount ElGamalCiphertext
+}
+
+const CONFIDENTIAL_TRANSFER_FEE_CONFIG_SIZE = 129
+
+func (obj *ConfidentialTransferFeeConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.WithdrawWithheldAuthorityElgamalPubkey); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.HarvestToMintEnabled); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialTransferFeeConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.WithdrawWithheldAuthorityElgamalPubkey); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.HarvestToMintEnabled); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConfidentialTransferFeeAmount Struct
+type ConfidentialTransferFeeAmount struct {
+	// Amount withheld during confidential transfers, to be harvest to the mint
+	WithheldAmount ElGamalCiphertext
+}
+
+const CONFIDENTIAL_TRANSFER_FEE_AMOUNT_SIZE = 64
+
+func (obj *ConfidentialTransferFeeAmount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialTransferFeeAmount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
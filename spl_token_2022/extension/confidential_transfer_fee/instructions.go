@@ -0,0 +1,954 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package confidential_transfer_fee
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// InitializeConfidentialTransferFeeConfig Instruction
+// Initializes confidential transfer fees for a mint.
+type InitializeConfidentialTransferFeeConfig struct {
+	// confidential transfer fee authority
+	Authority *common.PublicKey
+	// ElGamal public key used to encrypt withheld fees.
+	WithdrawWithheldAuthorityElgamalPubkey *ElGamalPubkey
+	// [0] = [WRITE] mint `The SPL Token mint.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeConfidentialTransferFeeConfigInstructionBuilder creates a new `InitializeConfidentialTransferFeeConfig` instruction builder.
+func NewInitializeConfidentialTransferFeeConfigInstructionBuilder() *InitializeConfidentialTransferFeeConfig {
+	return &InitializeConfidentialTransferFeeConfig{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeConfidentialTransferFeeConfigInstruction
+//
+// Parameters:
+//
+//	authority: confidential transfer fee authority
+//	withdrawWithheldAuthorityElgamalPubkey: ElGamal public key used to encrypt withheld fees.
+//	mint: The SPL Token mint.
+func NewInitializeConfidentialTransferFeeConfigInstruction(
+	authority common.PublicKey,
+	withdrawWithheldAuthorityElgamalPubkey ElGamalPubkey,
+	mint common.PublicKey,
+) *InitializeConfidentialTransferFeeConfig {
+	return NewInitializeConfidentialTransferFeeConfigInstructionBuilder().
+		SetAuthority(authority).
+		SetWithdrawWithheldAuthorityElgamalPubkey(withdrawWithheldAuthorityElgamalPubkey).
+		SetMintAccount(mint)
+}
+
+// SetAuthority sets the "authority" parameter.
+func (obj *InitializeConfidentialTransferFeeConfig) SetAuthority(authority common.PublicKey) *InitializeConfidentialTransferFeeConfig {
+	obj.Authority = &authority
+	return obj
+}
+
+// SetWithdrawWithheldAuthorityElgamalPubkey sets the "withdrawWithheldAuthorityElgamalPubkey" parameter.
+func (obj *InitializeConfidentialTransferFeeConfig) SetWithdrawWithheldAuthorityElgamalPubkey(withdrawWithheldAuthorityElgamalPubkey ElGamalPubkey) *InitializeConfidentialTransferFeeConfig {
+	obj.WithdrawWithheldAuthorityElgamalPubkey = &withdrawWithheldAuthorityElgamalPubkey
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeConfidentialTransferFeeConfig) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *InitializeConfidentialTransferFeeConfig {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The SPL Token mint.
+func (obj *InitializeConfidentialTransferFeeConfig) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) SetProgramId(programId *common.PublicKey) *InitializeConfidentialTransferFeeConfig {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_InitializeConfidentialTransferFeeConfig}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) Validate() error {
+	if obj.Authority == nil {
+		return errors.New("[InitializeConfidentialTransferFeeConfig] authority param is not set")
+	}
+	if obj.WithdrawWithheldAuthorityElgamalPubkey == nil {
+		return errors.New("[InitializeConfidentialTransferFeeConfig] withdrawWithheldAuthorityElgamalPubkey param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[InitializeConfidentialTransferFeeConfig] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *InitializeConfidentialTransferFeeConfig) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.WithdrawWithheldAuthorityElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.WithdrawWithheldAuthorityElgamalPubkey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeConfidentialTransferFeeConfig) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("InitializeConfidentialTransferFeeConfig")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("                             Authority", *obj.Authority))
+						paramsBranch.Child(format.Param("WithdrawWithheldAuthorityElgamalPubkey", *obj.WithdrawWithheldAuthorityElgamalPubkey))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// WithdrawWithheldTokensFromMint Instruction
+// Transfer all withheld confidential tokens in the mint to an account.
+type WithdrawWithheldTokensFromMint struct {
+	// Relative location of the `ProofInstruction::VerifyCiphertextCiphertextEquality` instruction to the `WithdrawWithheldTokensFromMint` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	ProofInstructionOffset *int8
+	// The new decryptable balance in the destination token account.
+	NewDecryptableAvailableBalance *DecryptableBalance
+	// [0] = [WRITE] mint `The token mint. Must include the `TransferFeeConfig` extension.`
+	// [1] = [WRITE] destination `The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.`
+	// [2] = [] instructionsSysvarOrContextState `Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.`
+	// [3] = [SIGNER] authority `The mint's `withdraw_withheld_authority`.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewWithdrawWithheldTokensFromMintInstructionBuilder creates a new `WithdrawWithheldTokensFromMint` instruction builder.
+func NewWithdrawWithheldTokensFromMintInstructionBuilder() *WithdrawWithheldTokensFromMint {
+	return &WithdrawWithheldTokensFromMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewWithdrawWithheldTokensFromMintInstruction
+//
+// Parameters:
+//
+//	proofInstructionOffset: Relative location of the `ProofInstruction::VerifyCiphertextCiphertextEquality` instruction to the `WithdrawWithheldTokensFromMint` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	newDecryptableAvailableBalance: The new decryptable balance in the destination token account.
+//	mint: The token mint. Must include the `TransferFeeConfig` extension.
+//	destination: The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+//	instructionsSysvarOrContextState: Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+//	authority: The mint's `withdraw_withheld_authority`.
+func NewWithdrawWithheldTokensFromMintInstruction(
+	proofInstructionOffset int8,
+	newDecryptableAvailableBalance DecryptableBalance,
+	mint common.PublicKey,
+	destination common.PublicKey,
+	instructionsSysvarOrContextState common.PublicKey,
+	authority common.PublicKey,
+) *WithdrawWithheldTokensFromMint {
+	return NewWithdrawWithheldTokensFromMintInstructionBuilder().
+		SetProofInstructionOffset(proofInstructionOffset).
+		SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance).
+		SetMintAccount(mint).
+		SetDestinationAccount(destination).
+		SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState).
+		SetAuthorityAccount(authority)
+}
+
+// SetProofInstructionOffset sets the "proofInstructionOffset" parameter.
+func (obj *WithdrawWithheldTokensFromMint) SetProofInstructionOffset(proofInstructionOffset int8) *WithdrawWithheldTokensFromMint {
+	obj.ProofInstructionOffset = &proofInstructionOffset
+	return obj
+}
+
+// SetNewDecryptableAvailableBalance sets the "newDecryptableAvailableBalance" parameter.
+func (obj *WithdrawWithheldTokensFromMint) SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance DecryptableBalance) *WithdrawWithheldTokensFromMint {
+	obj.NewDecryptableAvailableBalance = &newDecryptableAvailableBalance
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint. Must include the `TransferFeeConfig` extension.
+func (obj *WithdrawWithheldTokensFromMint) SetMintAccount(mint common.PublicKey) *WithdrawWithheldTokensFromMint {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint. Must include the `TransferFeeConfig` extension.
+func (obj *WithdrawWithheldTokensFromMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetDestinationAccount sets the "destination" parameter.
+// The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+func (obj *WithdrawWithheldTokensFromMint) SetDestinationAccount(destination common.PublicKey) *WithdrawWithheldTokensFromMint {
+	obj.AccountMetaSlice[1] = common.Meta(destination).WRITE()
+	return obj
+}
+
+// GetDestinationAccount gets the "destination" parameter.
+// The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+func (obj *WithdrawWithheldTokensFromMint) GetDestinationAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarOrContextStateAccount sets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *WithdrawWithheldTokensFromMint) SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState common.PublicKey) *WithdrawWithheldTokensFromMint {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvarOrContextState)
+	return obj
+}
+
+// GetInstructionsSysvarOrContextStateAccount gets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *WithdrawWithheldTokensFromMint) GetInstructionsSysvarOrContextStateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The mint's `withdraw_withheld_authority`.
+func (obj *WithdrawWithheldTokensFromMint) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *WithdrawWithheldTokensFromMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The mint's `withdraw_withheld_authority`.
+func (obj *WithdrawWithheldTokensFromMint) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *WithdrawWithheldTokensFromMint) SetProgramId(programId *common.PublicKey) *WithdrawWithheldTokensFromMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *WithdrawWithheldTokensFromMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_WithdrawWithheldTokensFromMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *WithdrawWithheldTokensFromMint) Validate() error {
+	if obj.ProofInstructionOffset == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] proofInstructionOffset param is not set")
+	}
+	if obj.NewDecryptableAvailableBalance == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] newDecryptableAvailableBalance param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] accounts.destination is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] accounts.instructionsSysvarOrContextState is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[WithdrawWithheldTokensFromMint] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *WithdrawWithheldTokensFromMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *WithdrawWithheldTokensFromMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *WithdrawWithheldTokensFromMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *WithdrawWithheldTokensFromMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("WithdrawWithheldTokensFromMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("        ProofInstructionOffset", *obj.ProofInstructionOffset))
+						paramsBranch.Child(format.Param("NewDecryptableAvailableBalance", *obj.NewDecryptableAvailableBalance))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                            mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                     destination", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvarOrContextState", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                       authority", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// WithdrawWithheldTokensFromAccounts Instruction
+// Transfer all withheld tokens to an account.
+type WithdrawWithheldTokensFromAccounts struct {
+	// Number of token accounts harvested
+	NumTokenAccounts *uint8
+	// Relative location of the `ProofInstruction::VerifyWithdrawWithheld` instruction to the `VerifyWithdrawWithheldTokensFromAccounts` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+	ProofInstructionOffset *int8
+	// The new decryptable balance in the destination token account.
+	NewDecryptableAvailableBalance *DecryptableBalance
+	// [0] = [] mint `The token mint. Must include the `TransferFeeConfig` extension.`
+	// [1] = [WRITE] destination `The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.`
+	// [2] = [] instructionsSysvarOrContextState `Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.`
+	// [3] = [SIGNER] authority `The mint's `withdraw_withheld_authority`. The source accounts follow.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewWithdrawWithheldTokensFromAccountsInstructionBuilder creates a new `WithdrawWithheldTokensFromAccounts` instruction builder.
+func NewWithdrawWithheldTokensFromAccountsInstructionBuilder() *WithdrawWithheldTokensFromAccounts {
+	return &WithdrawWithheldTokensFromAccounts{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewWithdrawWithheldTokensFromAccountsInstruction
+//
+// Parameters:
+//
+//	numTokenAccounts: Number of token accounts harvested
+//	proofInstructionOffset: Relative location of the `ProofInstruction::VerifyWithdrawWithheld` instruction to the `VerifyWithdrawWithheldTokensFromAccounts` instruction in the transaction. If the offset is `0`, then use a context state account for the proof.
+//	newDecryptableAvailableBalance: The new decryptable balance in the destination token account.
+//	mint: The token mint. Must include the `TransferFeeConfig` extension.
+//	destination: The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+//	instructionsSysvarOrContextState: Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+//	authority: The mint's `withdraw_withheld_authority`. The source accounts follow.
+func NewWithdrawWithheldTokensFromAccountsInstruction(
+	numTokenAccounts uint8,
+	proofInstructionOffset int8,
+	newDecryptableAvailableBalance DecryptableBalance,
+	mint common.PublicKey,
+	destination common.PublicKey,
+	instructionsSysvarOrContextState common.PublicKey,
+	authority common.PublicKey,
+) *WithdrawWithheldTokensFromAccounts {
+	return NewWithdrawWithheldTokensFromAccountsInstructionBuilder().
+		SetNumTokenAccounts(numTokenAccounts).
+		SetProofInstructionOffset(proofInstructionOffset).
+		SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance).
+		SetMintAccount(mint).
+		SetDestinationAccount(destination).
+		SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState).
+		SetAuthorityAccount(authority)
+}
+
+// SetNumTokenAccounts sets the "numTokenAccounts" parameter.
+func (obj *WithdrawWithheldTokensFromAccounts) SetNumTokenAccounts(numTokenAccounts uint8) *WithdrawWithheldTokensFromAccounts {
+	obj.NumTokenAccounts = &numTokenAccounts
+	return obj
+}
+
+// SetProofInstructionOffset sets the "proofInstructionOffset" parameter.
+func (obj *WithdrawWithheldTokensFromAccounts) SetProofInstructionOffset(proofInstructionOffset int8) *WithdrawWithheldTokensFromAccounts {
+	obj.ProofInstructionOffset = &proofInstructionOffset
+	return obj
+}
+
+// SetNewDecryptableAvailableBalance sets the "newDecryptableAvailableBalance" parameter.
+func (obj *WithdrawWithheldTokensFromAccounts) SetNewDecryptableAvailableBalance(newDecryptableAvailableBalance DecryptableBalance) *WithdrawWithheldTokensFromAccounts {
+	obj.NewDecryptableAvailableBalance = &newDecryptableAvailableBalance
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint. Must include the `TransferFeeConfig` extension.
+func (obj *WithdrawWithheldTokensFromAccounts) SetMintAccount(mint common.PublicKey) *WithdrawWithheldTokensFromAccounts {
+	obj.AccountMetaSlice[0] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint. Must include the `TransferFeeConfig` extension.
+func (obj *WithdrawWithheldTokensFromAccounts) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetDestinationAccount sets the "destination" parameter.
+// The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+func (obj *WithdrawWithheldTokensFromAccounts) SetDestinationAccount(destination common.PublicKey) *WithdrawWithheldTokensFromAccounts {
+	obj.AccountMetaSlice[1] = common.Meta(destination).WRITE()
+	return obj
+}
+
+// GetDestinationAccount gets the "destination" parameter.
+// The fee receiver account. Must include the `TransferFeeAmount` and `ConfidentialTransferAccount` extensions.
+func (obj *WithdrawWithheldTokensFromAccounts) GetDestinationAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetInstructionsSysvarOrContextStateAccount sets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *WithdrawWithheldTokensFromAccounts) SetInstructionsSysvarOrContextStateAccount(instructionsSysvarOrContextState common.PublicKey) *WithdrawWithheldTokensFromAccounts {
+	obj.AccountMetaSlice[2] = common.Meta(instructionsSysvarOrContextState)
+	return obj
+}
+
+// GetInstructionsSysvarOrContextStateAccount gets the "instructionsSysvarOrContextState" parameter.
+// Instructions sysvar if `VerifyCiphertextCiphertextEquality` is included in the same transaction or context state account if `VerifyCiphertextCiphertextEquality` is pre-verified into a context state account.
+func (obj *WithdrawWithheldTokensFromAccounts) GetInstructionsSysvarOrContextStateAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The mint's `withdraw_withheld_authority`. The source accounts follow.
+func (obj *WithdrawWithheldTokensFromAccounts) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *WithdrawWithheldTokensFromAccounts {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The mint's `withdraw_withheld_authority`. The source accounts follow.
+func (obj *WithdrawWithheldTokensFromAccounts) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) SetProgramId(programId *common.PublicKey) *WithdrawWithheldTokensFromAccounts {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_WithdrawWithheldTokensFromAccounts}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) Validate() error {
+	if obj.NumTokenAccounts == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] numTokenAccounts param is not set")
+	}
+	if obj.ProofInstructionOffset == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] proofInstructionOffset param is not set")
+	}
+	if obj.NewDecryptableAvailableBalance == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] newDecryptableAvailableBalance param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] accounts.destination is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] accounts.instructionsSysvarOrContextState is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[WithdrawWithheldTokensFromAccounts] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *WithdrawWithheldTokensFromAccounts) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.NumTokenAccounts); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.NumTokenAccounts); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.ProofInstructionOffset); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewDecryptableAvailableBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *WithdrawWithheldTokensFromAccounts) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("WithdrawWithheldTokensFromAccounts")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=3]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("              NumTokenAccounts", *obj.NumTokenAccounts))
+						paramsBranch.Child(format.Param("        ProofInstructionOffset", *obj.ProofInstructionOffset))
+						paramsBranch.Child(format.Param("NewDecryptableAvailableBalance", *obj.NewDecryptableAvailableBalance))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                            mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                     destination", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("instructionsSysvarOrContextState", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                       authority", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// HarvestWithheldTokensToMint Instruction
+// Permissionless instruction to transfer all withheld confidential tokens to the mint.
+type HarvestWithheldTokensToMint struct {
+	// [0] = [WRITE] mint `The mint. The source accounts to harvest from follow.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewHarvestWithheldTokensToMintInstructionBuilder creates a new `HarvestWithheldTokensToMint` instruction builder.
+func NewHarvestWithheldTokensToMintInstructionBuilder() *HarvestWithheldTokensToMint {
+	return &HarvestWithheldTokensToMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewHarvestWithheldTokensToMintInstruction
+//
+// Parameters:
+//
+//	mint: The mint. The source accounts to harvest from follow.
+func NewHarvestWithheldTokensToMintInstruction(
+	mint common.PublicKey,
+) *HarvestWithheldTokensToMint {
+	return NewHarvestWithheldTokensToMintInstructionBuilder().
+		SetMintAccount(mint)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint. The source accounts to harvest from follow.
+func (obj *HarvestWithheldTokensToMint) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *HarvestWithheldTokensToMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint. The source accounts to harvest from follow.
+func (obj *HarvestWithheldTokensToMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *HarvestWithheldTokensToMint) SetProgramId(programId *common.PublicKey) *HarvestWithheldTokensToMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *HarvestWithheldTokensToMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_HarvestWithheldTokensToMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *HarvestWithheldTokensToMint) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[HarvestWithheldTokensToMint] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *HarvestWithheldTokensToMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *HarvestWithheldTokensToMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *HarvestWithheldTokensToMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *HarvestWithheldTokensToMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("HarvestWithheldTokensToMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// EnableHarvestToMint Instruction
+// Configure a confidential transfer fee mint to accept harvested confidential fees.
+type EnableHarvestToMint struct {
+	// [0] = [WRITE] mint `The mint.`
+	// [1] = [SIGNER] authority `The confidential transfer fee authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewEnableHarvestToMintInstructionBuilder creates a new `EnableHarvestToMint` instruction builder.
+func NewEnableHarvestToMintInstructionBuilder() *EnableHarvestToMint {
+	return &EnableHarvestToMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewEnableHarvestToMintInstruction
+//
+// Parameters:
+//
+//	mint: The mint.
+//	authority: The confidential transfer fee authority.
+func NewEnableHarvestToMintInstruction(
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *EnableHarvestToMint {
+	return NewEnableHarvestToMintInstructionBuilder().
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint.
+func (obj *EnableHarvestToMint) SetMintAccount(mint common.PublicKey) *EnableHarvestToMint {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint.
+func (obj *EnableHarvestToMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The confidential transfer fee authority.
+func (obj *EnableHarvestToMint) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *EnableHarvestToMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The confidential transfer fee authority.
+func (obj *EnableHarvestToMint) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *EnableHarvestToMint) SetProgramId(programId *common.PublicKey) *EnableHarvestToMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *EnableHarvestToMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_EnableHarvestToMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *EnableHarvestToMint) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[EnableHarvestToMint] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[EnableHarvestToMint] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *EnableHarvestToMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *EnableHarvestToMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *EnableHarvestToMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *EnableHarvestToMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("EnableHarvestToMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// DisableHarvestToMint Instruction
+// Configure a confidential transfer fee mint to reject any harvested confidential fees.
+type DisableHarvestToMint struct {
+	// [0] = [WRITE] mint `The mint.`
+	// [1] = [SIGNER] authority `The confidential transfer fee authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewDisableHarvestToMintInstructionBuilder creates a new `DisableHarvestToMint` instruction builder.
+func NewDisableHarvestToMintInstructionBuilder() *DisableHarvestToMint {
+	return &DisableHarvestToMint{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewDisableHarvestToMintInstruction
+//
+// Parameters:
+//
+//	mint: The mint.
+//	authority: The confidential transfer fee authority.
+func NewDisableHarvestToMintInstruction(
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *DisableHarvestToMint {
+	return NewDisableHarvestToMintInstructionBuilder().
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint.
+func (obj *DisableHarvestToMint) SetMintAccount(mint common.PublicKey) *DisableHarvestToMint {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint.
+func (obj *DisableHarvestToMint) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The confidential transfer fee authority.
+func (obj *DisableHarvestToMint) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *DisableHarvestToMint {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The confidential transfer fee authority.
+func (obj *DisableHarvestToMint) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *DisableHarvestToMint) SetProgramId(programId *common.PublicKey) *DisableHarvestToMint {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *DisableHarvestToMint) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_DisableHarvestToMint}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *DisableHarvestToMint) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[DisableHarvestToMint] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[DisableHarvestToMint] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *DisableHarvestToMint) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *DisableHarvestToMint) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *DisableHarvestToMint) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *DisableHarvestToMint) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("DisableHarvestToMint")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
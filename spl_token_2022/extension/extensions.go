@@ -3,6 +3,9 @@ package extension
 import (
 	"errors"
 	. "github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_mint_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer_fee"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/cpi_guard"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/default_account_state"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_member_pointer"
@@ -10,6 +13,9 @@ import (
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/interest_bearing_mint"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/memo_transfer"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/metadata_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/pausable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permissioned_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/scaled_ui_amount"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_hook"
@@ -189,6 +195,62 @@ func NewInterestBearingConfigParams(rateAuthority *web3.PublicKey, rate int16) I
 	return o
 }
 
+type ScaledUiAmountConfigParams struct {
+	Authority  web3.PublicKey
+	Multiplier float64
+}
+
+func (p ScaledUiAmountConfigParams) ExtensionType() ExtensionType {
+	return ExtensionTypeScaledUiAmount
+}
+
+func NewScaledUiAmountConfigParams(authority *web3.PublicKey, multiplier float64) ScaledUiAmountConfigParams {
+	o := ScaledUiAmountConfigParams{}
+	if authority != nil {
+		o.Authority = *authority
+	}
+	o.Multiplier = multiplier
+	return o
+}
+
+type PausableConfigParams struct {
+	Authority web3.PublicKey
+}
+
+func (p PausableConfigParams) ExtensionType() ExtensionType {
+	return ExtensionTypePausable
+}
+
+func NewPausableConfigParams(authority web3.PublicKey) PausableConfigParams {
+	return PausableConfigParams{Authority: authority}
+}
+
+type PermissionedBurnParams struct {
+	Authority web3.PublicKey
+}
+
+func (p PermissionedBurnParams) ExtensionType() ExtensionType {
+	return ExtensionTypePermissionedBurn
+}
+
+func NewPermissionedBurnParams(authority web3.PublicKey) PermissionedBurnParams {
+	return PermissionedBurnParams{Authority: authority}
+}
+
+type ConfidentialMintBurnParams struct {
+	SupplyElgamalPubkey confidential_mint_burn.ElGamalPubkey
+	DecryptableSupply   confidential_mint_burn.DecryptableBalance
+}
+
+func (p ConfidentialMintBurnParams) ExtensionType() ExtensionType {
+	return ExtensionTypeConfidentialMintBurn
+}
+
+// NewConfidentialMintBurnParams decryptableSupply is the zero supply encrypted with the supply AE key
+func NewConfidentialMintBurnParams(supplyElgamalPubkey confidential_mint_burn.ElGamalPubkey, decryptableSupply confidential_mint_burn.DecryptableBalance) ConfidentialMintBurnParams {
+	return ConfidentialMintBurnParams{SupplyElgamalPubkey: supplyElgamalPubkey, DecryptableSupply: decryptableSupply}
+}
+
 type PermanentDelegateParams struct {
 	Delegate web3.PublicKey
 }
@@ -203,6 +265,39 @@ func NewPermanentDelegateParams(delegate web3.PublicKey) PermanentDelegateParams
 	}
 }
 
+type ConfidentialTransferMintParams struct {
+	Initialize *confidential_transfer.InitializeMint
+}
+
+// NewConfidentialTransferMintParams authority may be the zero key (the configuration cannot be updated),
+// auditorElgamalPubkey is the zero key if the mint has no auditor
+func NewConfidentialTransferMintParams(authority web3.PublicKey,
+	autoApproveNewAccounts bool,
+	auditorElgamalPubkey confidential_transfer.ElGamalPubkey,
+	mint web3.PublicKey) ConfidentialTransferMintParams {
+	initialize := confidential_transfer.NewInitializeMintInstruction(authority, autoApproveNewAccounts, auditorElgamalPubkey, mint)
+	return ConfidentialTransferMintParams{Initialize: initialize}
+}
+
+func (p ConfidentialTransferMintParams) ExtensionType() ExtensionType {
+	return ExtensionTypeConfidentialTransferMint
+}
+
+type ConfidentialTransferFeeConfigParams struct {
+	Initialize *confidential_transfer_fee.InitializeConfidentialTransferFeeConfig
+}
+
+func NewConfidentialTransferFeeConfigParams(authority web3.PublicKey,
+	withdrawWithheldAuthorityElgamalPubkey confidential_transfer_fee.ElGamalPubkey,
+	mint web3.PublicKey) ConfidentialTransferFeeConfigParams {
+	initialize := confidential_transfer_fee.NewInitializeConfidentialTransferFeeConfigInstruction(authority, withdrawWithheldAuthorityElgamalPubkey, mint)
+	return ConfidentialTransferFeeConfigParams{Initialize: initialize}
+}
+
+func (p ConfidentialTransferFeeConfigParams) ExtensionType() ExtensionType {
+	return ExtensionTypeConfidentialTransferFeeConfig
+}
+
 type TransferHookParams struct {
 	Initialize *transfer_hook.Initialize
 	Update     *transfer_hook.Update
@@ -387,14 +482,14 @@ func ExtensionInitializationParamsToInstruction(
 		// this extension is not a instruction
 		return nil, nil
 	case ExtensionTypeConfidentialTransferMint:
-		// this extension is not a instruction
-		return nil, nil
+		p1 := extension.(ConfidentialTransferMintParams)
+		return Nested(&programId, p1.Initialize, NewConfidentialTransferExtensionInstruction)
 	case ExtensionTypeConfidentialTransferAccount:
 		// this extension is not a instruction
 		return nil, nil
 	case ExtensionTypeConfidentialTransferFeeConfig:
-		// this extension is not a instruction
-		return nil, nil
+		p1 := extension.(ConfidentialTransferFeeConfigParams)
+		return Nested(&programId, p1.Initialize, NewConfidentialTransferFeeExtensionInstruction)
 	case ExtensionTypeConfidentialTransferFeeAmount:
 		// this extension is not a instruction
 		return nil, nil
@@ -433,6 +528,29 @@ func ExtensionInitializationParamsToInstruction(
 		_ = token_metadata.UpdateAuthority{}
 		_ = token_metadata.Emit{}
 		return nil, nil
+	case ExtensionTypeScaledUiAmount:
+		p1 := extension.(ScaledUiAmountConfigParams)
+		return Nested(
+			&programId,
+			scaled_ui_amount.NewInitializeInstruction(p1.Authority, p1.Multiplier, mint),
+			NewScaledUiAmountExtensionInstruction,
+		)
+	case ExtensionTypePausable:
+		p1 := extension.(PausableConfigParams)
+		return Nested(&programId, pausable.NewInitializeInstruction(p1.Authority, mint), NewPausableExtensionInstruction)
+	case ExtensionTypePausableAccount:
+		// this extension is not a instruction
+		return nil, nil
+	case ExtensionTypePermissionedBurn:
+		p1 := extension.(PermissionedBurnParams)
+		return Nested(&programId, permissioned_burn.NewInitializeInstruction(p1.Authority, mint), NewPermissionedBurnExtensionInstruction)
+	case ExtensionTypeConfidentialMintBurn:
+		p1 := extension.(ConfidentialMintBurnParams)
+		return Nested(
+			&programId,
+			confidential_mint_burn.NewInitializeMintInstruction(p1.SupplyElgamalPubkey, p1.DecryptableSupply, mint),
+			NewConfidentialMintBurnExtensionInstruction,
+		)
 	case ExtensionTypeTokenGroupMember:
 		// ignore, use alone --> see
 		_ = token_group.InitializeMember{}
@@ -0,0 +1,60 @@
+package interest_bearing_mint
+
+import (
+	"errors"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"math"
+)
+
+const (
+	oneInBasisPoints = 10_000.
+	secondsPerYear   = 60. * 60. * 24. * 365.24
+)
+
+var ErrTimestampOverflow = errors.New("interest_bearing_mint: timestamp overflow")
+
+// exp continuous compounding of rate (basis points) over timespan (seconds)
+func exp(rate int16, timespan int64) float64 {
+	return math.Exp(float64(rate) * float64(timespan) / secondsPerYear / oneInBasisPoints)
+}
+
+func sub(a, b int64) (int64, error) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return 0, ErrTimestampOverflow
+	}
+	return c, nil
+}
+
+// TotalScale the scale from the raw amount to the ui amount at unixTimestamp, accrued interest included
+func (obj *InterestBearingConfig) TotalScale(decimals uint8, unixTimestamp int64) (float64, error) {
+	preUpdateTimespan, err := sub(int64(obj.LastUpdateTimestamp), int64(obj.InitializationTimestamp))
+	if err != nil {
+		return 0, err
+	}
+	postUpdateTimespan, err := sub(unixTimestamp, int64(obj.LastUpdateTimestamp))
+	if err != nil {
+		return 0, err
+	}
+	return exp(obj.PreUpdateAverageRate, preUpdateTimespan) * exp(obj.CurrentRate, postUpdateTimespan) / math.Pow(10, float64(decimals)), nil
+}
+
+// AmountToUiAmount converts the raw amount to the ui amount string with the accrued interest at unixTimestamp,
+// the same as the AmountToUiAmount instruction
+func (obj *InterestBearingConfig) AmountToUiAmount(amount uint64, decimals uint8, unixTimestamp int64) (string, error) {
+	scale, err := obj.TotalScale(decimals, unixTimestamp)
+	if err != nil {
+		return "", err
+	}
+	return spl_token_2022.FormatScaledUiAmount(float64(amount)*scale, decimals), nil
+}
+
+// UiAmountToAmount converts the ui amount string with the accrued interest at unixTimestamp to the raw amount,
+// the same as the UiAmountToAmount instruction
+func (obj *InterestBearingConfig) UiAmountToAmount(uiAmount string, decimals uint8, unixTimestamp int64) (uint64, error) {
+	scale, err := obj.TotalScale(decimals, unixTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	return spl_token_2022.ParseScaledUiAmount(uiAmount, scale)
+}
@@ -0,0 +1,53 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package pausable
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// PausableConfig Struct
+type PausableConfig struct {
+	// Authority that can pause or resume activity on the mint
+	Authority common.PublicKey
+	// Whether minting / transferring / burning tokens is paused
+	Paused bool
+}
+
+const PAUSABLE_CONFIG_SIZE = 33
+
+func (obj *PausableConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Paused); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PausableConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Paused); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PausableAccount Struct
+type PausableAccount struct {
+}
+
+const PAUSABLE_ACCOUNT_SIZE = 0
+
+func (obj *PausableAccount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *PausableAccount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
@@ -0,0 +1,388 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package pausable
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// Initialize Instruction
+// Initialize the pausable extension for the given mint account.
+type Initialize struct {
+	// The public key for the account that can pause the mint
+	Authority *common.PublicKey
+	// [0] = [WRITE] mint `The mint to initialize.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeInstructionBuilder creates a new `Initialize` instruction builder.
+func NewInitializeInstructionBuilder() *Initialize {
+	return &Initialize{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeInstruction
+//
+// Parameters:
+//
+//	authority: The public key for the account that can pause the mint
+//	mint: The mint to initialize.
+func NewInitializeInstruction(
+	authority common.PublicKey,
+	mint common.PublicKey,
+) *Initialize {
+	return NewInitializeInstructionBuilder().
+		SetAuthority(authority).
+		SetMintAccount(mint)
+}
+
+// SetAuthority sets the "authority" parameter.
+func (obj *Initialize) SetAuthority(authority common.PublicKey) *Initialize {
+	obj.Authority = &authority
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *Initialize {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *Initialize) SetProgramId(programId *common.PublicKey) *Initialize {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Initialize) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Initialize}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Initialize) Validate() error {
+	if obj.Authority == nil {
+		return errors.New("[Initialize] authority param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Initialize] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Initialize) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Initialize) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Initialize")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Authority", *obj.Authority))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// Pause Instruction
+// Pause minting, burning, and transferring for the mint.
+type Pause struct {
+	// [0] = [WRITE] mint `The mint.`
+	// [1] = [SIGNER] authority `The pause authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewPauseInstructionBuilder creates a new `Pause` instruction builder.
+func NewPauseInstructionBuilder() *Pause {
+	return &Pause{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewPauseInstruction
+//
+// Parameters:
+//
+//	mint: The mint.
+//	authority: The pause authority.
+func NewPauseInstruction(
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *Pause {
+	return NewPauseInstructionBuilder().
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint.
+func (obj *Pause) SetMintAccount(mint common.PublicKey) *Pause {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint.
+func (obj *Pause) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The pause authority.
+func (obj *Pause) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Pause {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The pause authority.
+func (obj *Pause) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *Pause) SetProgramId(programId *common.PublicKey) *Pause {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Pause) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Pause}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Pause) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Pause] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Pause] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Pause) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Pause) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *Pause) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *Pause) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Pause")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
+
+// Resume Instruction
+// Resume minting, burning, and transferring for the mint.
+type Resume struct {
+	// [0] = [WRITE] mint `The mint.`
+	// [1] = [SIGNER] authority `The pause authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewResumeInstructionBuilder creates a new `Resume` instruction builder.
+func NewResumeInstructionBuilder() *Resume {
+	return &Resume{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewResumeInstruction
+//
+// Parameters:
+//
+//	mint: The mint.
+//	authority: The pause authority.
+func NewResumeInstruction(
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *Resume {
+	return NewResumeInstructionBuilder().
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint.
+func (obj *Resume) SetMintAccount(mint common.PublicKey) *Resume {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint.
+func (obj *Resume) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The pause authority.
+func (obj *Resume) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Resume {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The pause authority.
+func (obj *Resume) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *Resume) SetProgramId(programId *common.PublicKey) *Resume {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Resume) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Resume}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Resume) Validate() error {
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Resume] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Resume] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Resume) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Resume) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	return nil
+}
+
+func (obj *Resume) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	return nil
+}
+
+func (obj *Resume) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Resume")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
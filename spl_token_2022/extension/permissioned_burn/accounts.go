@@ -0,0 +1,31 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package permissioned_burn
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+)
+
+// PermissionedBurnConfig Struct
+type PermissionedBurnConfig struct {
+	// Authority that must sign the burns
+	Authority common.PublicKey
+}
+
+const PERMISSIONED_BURN_CONFIG_SIZE = 32
+
+func (obj *PermissionedBurnConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PermissionedBurnConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,537 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package permissioned_burn
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// Initialize Instruction
+// Require the permissioned burn authority to sign every burn of the mint.
+type Initialize struct {
+	// The public key for the account that must sign the burns
+	Authority *common.PublicKey
+	// [0] = [WRITE] mint `The mint to initialize.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeInstructionBuilder creates a new `Initialize` instruction builder.
+func NewInitializeInstructionBuilder() *Initialize {
+	return &Initialize{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeInstruction
+//
+// Parameters:
+//
+//	authority: The public key for the account that must sign the burns
+//	mint: The mint to initialize.
+func NewInitializeInstruction(
+	authority common.PublicKey,
+	mint common.PublicKey,
+) *Initialize {
+	return NewInitializeInstructionBuilder().
+		SetAuthority(authority).
+		SetMintAccount(mint)
+}
+
+// SetAuthority sets the "authority" parameter.
+func (obj *Initialize) SetAuthority(authority common.PublicKey) *Initialize {
+	obj.Authority = &authority
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *Initialize {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *Initialize) SetProgramId(programId *common.PublicKey) *Initialize {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Initialize) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Initialize}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Initialize) Validate() error {
+	if obj.Authority == nil {
+		return errors.New("[Initialize] authority param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Initialize] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Initialize) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Initialize) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Initialize")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Authority", *obj.Authority))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// Burn Instruction
+// Burns tokens with the signature of the permissioned burn authority.
+type Burn struct {
+	// The amount of tokens to burn
+	Amount *uint64
+	// [0] = [WRITE] account `The account to burn from.`
+	// [1] = [WRITE] mint `The token mint.`
+	// [2] = [SIGNER] permissionedBurnAuthority `The permissioned burn authority.`
+	// [3] = [SIGNER] authority `The account's owner/delegate.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnInstructionBuilder creates a new `Burn` instruction builder.
+func NewBurnInstructionBuilder() *Burn {
+	return &Burn{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewBurnInstruction
+//
+// Parameters:
+//
+//	amount: The amount of tokens to burn
+//	account: The account to burn from.
+//	mint: The token mint.
+//	permissionedBurnAuthority: The permissioned burn authority.
+//	authority: The account's owner/delegate.
+func NewBurnInstruction(
+	amount uint64,
+	account common.PublicKey,
+	mint common.PublicKey,
+	permissionedBurnAuthority common.PublicKey,
+	authority common.PublicKey,
+) *Burn {
+	return NewBurnInstructionBuilder().
+		SetAmount(amount).
+		SetAccountAccount(account).
+		SetMintAccount(mint).
+		SetPermissionedBurnAuthorityAccount(permissionedBurnAuthority).
+		SetAuthorityAccount(authority)
+}
+
+// SetAmount sets the "amount" parameter.
+func (obj *Burn) SetAmount(amount uint64) *Burn {
+	obj.Amount = &amount
+	return obj
+}
+
+// SetAccountAccount sets the "account" parameter.
+// The account to burn from.
+func (obj *Burn) SetAccountAccount(account common.PublicKey) *Burn {
+	obj.AccountMetaSlice[0] = common.Meta(account).WRITE()
+	return obj
+}
+
+// GetAccountAccount gets the "account" parameter.
+// The account to burn from.
+func (obj *Burn) GetAccountAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint.
+func (obj *Burn) SetMintAccount(mint common.PublicKey) *Burn {
+	obj.AccountMetaSlice[1] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint.
+func (obj *Burn) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPermissionedBurnAuthorityAccount sets the "permissionedBurnAuthority" parameter.
+// The permissioned burn authority.
+func (obj *Burn) SetPermissionedBurnAuthorityAccount(permissionedBurnAuthority common.PublicKey) *Burn {
+	obj.AccountMetaSlice[2] = common.Meta(permissionedBurnAuthority).SIGNER()
+	return obj
+}
+
+// GetPermissionedBurnAuthorityAccount gets the "permissionedBurnAuthority" parameter.
+// The permissioned burn authority.
+func (obj *Burn) GetPermissionedBurnAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The account's owner/delegate.
+func (obj *Burn) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *Burn {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The account's owner/delegate.
+func (obj *Burn) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *Burn) SetProgramId(programId *common.PublicKey) *Burn {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Burn) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Burn}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Burn) Validate() error {
+	if obj.Amount == nil {
+		return errors.New("[Burn] amount param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Burn] accounts.account is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Burn] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Burn] accounts.permissionedBurnAuthority is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[Burn] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Burn) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Burn) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Burn) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Burn")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Amount", *obj.Amount))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                  account", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                     mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("permissionedBurnAuthority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                authority", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// BurnChecked Instruction
+// Burns tokens with the signature of the permissioned burn authority, asserting the token mint and decimals.
+type BurnChecked struct {
+	// The amount of tokens to burn
+	Amount *uint64
+	// Expected number of base 10 digits to the right of the decimal place
+	Decimals *uint8
+	// [0] = [WRITE] account `The account to burn from.`
+	// [1] = [WRITE] mint `The token mint.`
+	// [2] = [SIGNER] permissionedBurnAuthority `The permissioned burn authority.`
+	// [3] = [SIGNER] authority `The account's owner/delegate.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewBurnCheckedInstructionBuilder creates a new `BurnChecked` instruction builder.
+func NewBurnCheckedInstructionBuilder() *BurnChecked {
+	return &BurnChecked{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewBurnCheckedInstruction
+//
+// Parameters:
+//
+//	amount: The amount of tokens to burn
+//	decimals: Expected number of base 10 digits to the right of the decimal place
+//	account: The account to burn from.
+//	mint: The token mint.
+//	permissionedBurnAuthority: The permissioned burn authority.
+//	authority: The account's owner/delegate.
+func NewBurnCheckedInstruction(
+	amount uint64,
+	decimals uint8,
+	account common.PublicKey,
+	mint common.PublicKey,
+	permissionedBurnAuthority common.PublicKey,
+	authority common.PublicKey,
+) *BurnChecked {
+	return NewBurnCheckedInstructionBuilder().
+		SetAmount(amount).
+		SetDecimals(decimals).
+		SetAccountAccount(account).
+		SetMintAccount(mint).
+		SetPermissionedBurnAuthorityAccount(permissionedBurnAuthority).
+		SetAuthorityAccount(authority)
+}
+
+// SetAmount sets the "amount" parameter.
+func (obj *BurnChecked) SetAmount(amount uint64) *BurnChecked {
+	obj.Amount = &amount
+	return obj
+}
+
+// SetDecimals sets the "decimals" parameter.
+func (obj *BurnChecked) SetDecimals(decimals uint8) *BurnChecked {
+	obj.Decimals = &decimals
+	return obj
+}
+
+// SetAccountAccount sets the "account" parameter.
+// The account to burn from.
+func (obj *BurnChecked) SetAccountAccount(account common.PublicKey) *BurnChecked {
+	obj.AccountMetaSlice[0] = common.Meta(account).WRITE()
+	return obj
+}
+
+// GetAccountAccount gets the "account" parameter.
+// The account to burn from.
+func (obj *BurnChecked) GetAccountAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The token mint.
+func (obj *BurnChecked) SetMintAccount(mint common.PublicKey) *BurnChecked {
+	obj.AccountMetaSlice[1] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The token mint.
+func (obj *BurnChecked) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetPermissionedBurnAuthorityAccount sets the "permissionedBurnAuthority" parameter.
+// The permissioned burn authority.
+func (obj *BurnChecked) SetPermissionedBurnAuthorityAccount(permissionedBurnAuthority common.PublicKey) *BurnChecked {
+	obj.AccountMetaSlice[2] = common.Meta(permissionedBurnAuthority).SIGNER()
+	return obj
+}
+
+// GetPermissionedBurnAuthorityAccount gets the "permissionedBurnAuthority" parameter.
+// The permissioned burn authority.
+func (obj *BurnChecked) GetPermissionedBurnAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The account's owner/delegate.
+func (obj *BurnChecked) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *BurnChecked {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The account's owner/delegate.
+func (obj *BurnChecked) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *BurnChecked) SetProgramId(programId *common.PublicKey) *BurnChecked {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *BurnChecked) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_BurnChecked}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *BurnChecked) Validate() error {
+	if obj.Amount == nil {
+		return errors.New("[BurnChecked] amount param is not set")
+	}
+	if obj.Decimals == nil {
+		return errors.New("[BurnChecked] decimals param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[BurnChecked] accounts.account is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[BurnChecked] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[BurnChecked] accounts.permissionedBurnAuthority is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[BurnChecked] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *BurnChecked) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *BurnChecked) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Decimals); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnChecked) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Decimals); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *BurnChecked) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("BurnChecked")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("  Amount", *obj.Amount))
+						paramsBranch.Child(format.Param("Decimals", *obj.Decimals))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("                  account", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                     mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("permissionedBurnAuthority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("                authority", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
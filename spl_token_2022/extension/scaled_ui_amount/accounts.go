@@ -0,0 +1,54 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package scaled_ui_amount
+
+import (
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+)
+
+// ScaledUiAmountConfig Struct
+type ScaledUiAmountConfig struct {
+	// Authority that can set the scaling amount and authority
+	Authority common.PublicKey
+	// Amount to multiply raw amounts by, outside of the program
+	Multiplier float64
+	// Unix timestamp at which `new_multiplier` comes into effective
+	NewMultiplierEffectiveTimestamp solanago.UnixTimeSeconds
+	// Next multiplier, once `new_multiplier_effective_timestamp` is reached
+	NewMultiplier float64
+}
+
+func (obj *ScaledUiAmountConfig) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Multiplier); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewMultiplierEffectiveTimestamp); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.NewMultiplier); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ScaledUiAmountConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Multiplier); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewMultiplierEffectiveTimestamp); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.NewMultiplier); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package scaled_ui_amount
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// Initialize Instruction
+// Initialize a new mint with scaled UI amounts.
+type Initialize struct {
+	// The public key for the account that can update the multiplier
+	Authority *common.PublicKey
+	// The initial multiplier
+	Multiplier *float64
+	// [0] = [WRITE] mint `The mint to initialize.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeInstructionBuilder creates a new `Initialize` instruction builder.
+func NewInitializeInstructionBuilder() *Initialize {
+	return &Initialize{
+		AccountMetaSlice: make(common.AccountMetaSlice, 1),
+	}
+}
+
+// NewInitializeInstruction
+//
+// Parameters:
+//
+//	authority: The public key for the account that can update the multiplier
+//	multiplier: The initial multiplier
+//	mint: The mint to initialize.
+func NewInitializeInstruction(
+	authority common.PublicKey,
+	multiplier float64,
+	mint common.PublicKey,
+) *Initialize {
+	return NewInitializeInstructionBuilder().
+		SetAuthority(authority).
+		SetMultiplier(multiplier).
+		SetMintAccount(mint)
+}
+
+// SetAuthority sets the "authority" parameter.
+func (obj *Initialize) SetAuthority(authority common.PublicKey) *Initialize {
+	obj.Authority = &authority
+	return obj
+}
+
+// SetMultiplier sets the "multiplier" parameter.
+func (obj *Initialize) SetMultiplier(multiplier float64) *Initialize {
+	obj.Multiplier = &multiplier
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) SetMintAccount(mint common.PublicKey, multiSigners ...common.PublicKey) *Initialize {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[0] = common.Meta(mint)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	}
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint to initialize.
+func (obj *Initialize) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+func (obj *Initialize) SetProgramId(programId *common.PublicKey) *Initialize {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Initialize) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_Initialize}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *Initialize) Validate() error {
+	if obj.Authority == nil {
+		return errors.New("[Initialize] authority param is not set")
+	}
+	if obj.Multiplier == nil {
+		return errors.New("[Initialize] multiplier param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Initialize] accounts.mint is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Initialize) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Initialize) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.Multiplier); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Authority); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.Multiplier); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Initialize) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Initialize")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param(" Authority", *obj.Authority))
+						paramsBranch.Child(format.Param("Multiplier", *obj.Multiplier))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=1]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("mint", obj.AccountMetaSlice.Get(0)))
+					})
+				})
+		})
+}
+
+// UpdateMultiplier Instruction
+// Update the multiplier. Only supported for mints that include the `ScaledUiAmountConfig` extension.
+type UpdateMultiplier struct {
+	// The new multiplier
+	Multiplier *float64
+	// Timestamp at which the new multiplier will take effect
+	EffectiveTimestamp *solanago.UnixTimeSeconds
+	// [0] = [WRITE] mint `The mint.`
+	// [1] = [SIGNER] authority `The multiplier authority.`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateMultiplierInstructionBuilder creates a new `UpdateMultiplier` instruction builder.
+func NewUpdateMultiplierInstructionBuilder() *UpdateMultiplier {
+	return &UpdateMultiplier{
+		AccountMetaSlice: make(common.AccountMetaSlice, 2),
+	}
+}
+
+// NewUpdateMultiplierInstruction
+//
+// Parameters:
+//
+//	multiplier: The new multiplier
+//	effectiveTimestamp: Timestamp at which the new multiplier will take effect
+//	mint: The mint.
+//	authority: The multiplier authority.
+func NewUpdateMultiplierInstruction(
+	multiplier float64,
+	effectiveTimestamp solanago.UnixTimeSeconds,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *UpdateMultiplier {
+	return NewUpdateMultiplierInstructionBuilder().
+		SetMultiplier(multiplier).
+		SetEffectiveTimestamp(effectiveTimestamp).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetMultiplier sets the "multiplier" parameter.
+func (obj *UpdateMultiplier) SetMultiplier(multiplier float64) *UpdateMultiplier {
+	obj.Multiplier = &multiplier
+	return obj
+}
+
+// SetEffectiveTimestamp sets the "effectiveTimestamp" parameter.
+func (obj *UpdateMultiplier) SetEffectiveTimestamp(effectiveTimestamp solanago.UnixTimeSeconds) *UpdateMultiplier {
+	obj.EffectiveTimestamp = &effectiveTimestamp
+	return obj
+}
+
+// SetMintAccount sets the "mint" parameter.
+// The mint.
+func (obj *UpdateMultiplier) SetMintAccount(mint common.PublicKey) *UpdateMultiplier {
+	obj.AccountMetaSlice[0] = common.Meta(mint).WRITE()
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// The mint.
+func (obj *UpdateMultiplier) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// The multiplier authority.
+func (obj *UpdateMultiplier) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *UpdateMultiplier {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[1] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[1] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// The multiplier authority.
+func (obj *UpdateMultiplier) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+func (obj *UpdateMultiplier) SetProgramId(programId *common.PublicKey) *UpdateMultiplier {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateMultiplier) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_UpdateMultiplier}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *UpdateMultiplier) Validate() error {
+	if obj.Multiplier == nil {
+		return errors.New("[UpdateMultiplier] multiplier param is not set")
+	}
+	if obj.EffectiveTimestamp == nil {
+		return errors.New("[UpdateMultiplier] effectiveTimestamp param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateMultiplier] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateMultiplier] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateMultiplier) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateMultiplier) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Multiplier); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.EffectiveTimestamp); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateMultiplier) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Multiplier); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.EffectiveTimestamp); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateMultiplier) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateMultiplier")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=2]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("        Multiplier", *obj.Multiplier))
+						paramsBranch.Child(format.Param("EffectiveTimestamp", *obj.EffectiveTimestamp))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=2]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("     mint", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("authority", obj.AccountMetaSlice.Get(1)))
+					})
+				})
+		})
+}
@@ -0,0 +1,4 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package scaled_ui_amount
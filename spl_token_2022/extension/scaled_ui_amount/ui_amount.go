@@ -0,0 +1,27 @@
+package scaled_ui_amount
+
+import (
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"math"
+)
+
+// CurrentMultiplier the multiplier in effect at unixTimestamp
+func (obj *ScaledUiAmountConfig) CurrentMultiplier(unixTimestamp int64) float64 {
+	if unixTimestamp >= int64(obj.NewMultiplierEffectiveTimestamp) {
+		return obj.NewMultiplier
+	}
+	return obj.Multiplier
+}
+
+// AmountToUiAmount converts the raw amount to the ui amount string with the multiplier in effect at unixTimestamp,
+// the scaled amount is truncated to an integer first, the same as the AmountToUiAmount instruction
+func (obj *ScaledUiAmountConfig) AmountToUiAmount(amount uint64, decimals uint8, unixTimestamp int64) string {
+	scaledAmount := math.Trunc(float64(amount)*obj.CurrentMultiplier(unixTimestamp)) / math.Pow(10, float64(decimals))
+	return spl_token_2022.FormatScaledUiAmount(scaledAmount, decimals)
+}
+
+// UiAmountToAmount converts the ui amount string with the multiplier in effect at unixTimestamp to the raw amount,
+// the same as the UiAmountToAmount instruction
+func (obj *ScaledUiAmountConfig) UiAmountToAmount(uiAmount string, decimals uint8, unixTimestamp int64) (uint64, error) {
+	return spl_token_2022.ParseScaledUiAmount(uiAmount, obj.CurrentMultiplier(unixTimestamp)/math.Pow(10, float64(decimals)))
+}
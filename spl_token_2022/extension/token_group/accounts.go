@@ -4,8 +4,6 @@
 package token_group
 
 import (
-	"bytes"
-	"fmt"
 	common "github.com/donutnomad/solana-web3/common"
 	binary "github.com/gagliardetto/binary"
 )
@@ -20,22 +18,18 @@ type TokenGroup struct {
 	// belongs to a particular mint
 	Mint common.PublicKey
 	// The current number of group members
-	Size uint32
+	Size uint64
 	// The maximum number of group members
-	MaxSize uint32
+	MaxSize uint64
 }
 
-const TOKEN_GROUP_SIZE = 72
+const TOKEN_GROUP_SIZE = 80
 
 // TokenGroupDiscriminator DETERMINANT: spl_token_group_interface:group
+// The tlv type of a group outside of Token-2022, the Token-2022 tlv type is ExtensionTypeTokenGroup
 var TokenGroupDiscriminator = [8]byte{214, 15, 63, 132, 49, 119, 209, 40}
 
 func (obj *TokenGroup) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
-	// Write account discriminator:
-	err = encoder.WriteBytes(TokenGroupDiscriminator[:], false)
-	if err != nil {
-		return err
-	}
 	if err = encoder.Encode(&obj.UpdateAuthority); err != nil {
 		return err
 	}
@@ -52,16 +46,6 @@ func (obj *TokenGroup) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
 }
 
 func (obj *TokenGroup) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
-	// Read and check account discriminator:
-	{
-		discriminator, err := decoder.ReadNBytes(8)
-		if err != nil {
-			return err
-		}
-		if !bytes.Equal(discriminator, TokenGroupDiscriminator[:]) {
-			return fmt.Errorf("wrong discriminator: wanted %s, got %s", fmt.Sprint(TokenGroupDiscriminator[:]), fmt.Sprint(discriminator[:]))
-		}
-	}
 	if err = decoder.Decode(&obj.UpdateAuthority); err != nil {
 		return err
 	}
@@ -87,20 +71,16 @@ type TokenGroupMember struct {
 	// The pubkey of the `TokenGroup`
 	Group common.PublicKey
 	// The member number
-	MemberNumber uint32
+	MemberNumber uint64
 }
 
-const TOKEN_GROUP_MEMBER_SIZE = 68
+const TOKEN_GROUP_MEMBER_SIZE = 72
 
 // TokenGroupMemberDiscriminator DETERMINANT: spl_token_group_interface:member
+// The tlv type of a member outside of Token-2022, the Token-2022 tlv type is ExtensionTypeTokenGroupMember
 var TokenGroupMemberDiscriminator = [8]byte{254, 50, 168, 134, 88, 126, 100, 186}
 
 func (obj *TokenGroupMember) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
-	// Write account discriminator:
-	err = encoder.WriteBytes(TokenGroupMemberDiscriminator[:], false)
-	if err != nil {
-		return err
-	}
 	if err = encoder.Encode(&obj.Mint); err != nil {
 		return err
 	}
@@ -114,16 +94,6 @@ func (obj *TokenGroupMember) MarshalWithEncoder(encoder *binary.Encoder) (err er
 }
 
 func (obj *TokenGroupMember) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
-	// Read and check account discriminator:
-	{
-		discriminator, err := decoder.ReadNBytes(8)
-		if err != nil {
-			return err
-		}
-		if !bytes.Equal(discriminator, TokenGroupMemberDiscriminator[:]) {
-			return fmt.Errorf("wrong discriminator: wanted %s, got %s", fmt.Sprint(TokenGroupMemberDiscriminator[:]), fmt.Sprint(discriminator[:]))
-		}
-	}
 	if err = decoder.Decode(&obj.Mint); err != nil {
 		return err
 	}
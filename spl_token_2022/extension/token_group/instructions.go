@@ -16,7 +16,7 @@ type Initialize struct {
 	// Update authority for the group
 	UpdateAuthority *common.PublicKey
 	// The maximum number of group members
-	MaxSize *uint32
+	MaxSize *uint64
 	// [0] = [WRITE] group “
 	// [1] = [] mint “
 	// [2] = [SIGNER] mintAuthority “
@@ -42,7 +42,7 @@ func NewInitializeInstructionBuilder() *Initialize {
 //	mintAuthority:
 func NewInitializeInstruction(
 	updateAuthority common.PublicKey,
-	maxSize uint32,
+	maxSize uint64,
 	group common.PublicKey,
 	mint common.PublicKey,
 	mintAuthority common.PublicKey,
@@ -62,7 +62,7 @@ func (obj *Initialize) SetUpdateAuthority(updateAuthority common.PublicKey) *Ini
 }
 
 // SetMaxSize sets the "maxSize" parameter.
-func (obj *Initialize) SetMaxSize(maxSize uint32) *Initialize {
+func (obj *Initialize) SetMaxSize(maxSize uint64) *Initialize {
 	obj.MaxSize = &maxSize
 	return obj
 }
@@ -196,7 +196,7 @@ func (obj *Initialize) EncodeToTree(parent treeout.Branches) {
 // UpdateGroupMaxSize Instruction
 type UpdateGroupMaxSize struct {
 	// New max size for the group
-	MaxSize *uint32
+	MaxSize *uint64
 	// [0] = [WRITE] group `Group`
 	// [1] = [SIGNER] updateAuthority `Update authority`
 	common.AccountMetaSlice `bin:"-"`
@@ -218,7 +218,7 @@ func NewUpdateGroupMaxSizeInstructionBuilder() *UpdateGroupMaxSize {
 //	group: Group
 //	updateAuthority: Update authority
 func NewUpdateGroupMaxSizeInstruction(
-	maxSize uint32,
+	maxSize uint64,
 	group common.PublicKey,
 	updateAuthority common.PublicKey,
 ) *UpdateGroupMaxSize {
@@ -229,7 +229,7 @@ func NewUpdateGroupMaxSizeInstruction(
 }
 
 // SetMaxSize sets the "maxSize" parameter.
-func (obj *UpdateGroupMaxSize) SetMaxSize(maxSize uint32) *UpdateGroupMaxSize {
+func (obj *UpdateGroupMaxSize) SetMaxSize(maxSize uint64) *UpdateGroupMaxSize {
 	obj.MaxSize = &maxSize
 	return obj
 }
@@ -101,3 +101,25 @@ func (obj *TransferFeeConfig) UnmarshalWithDecoder(decoder *binary.Decoder) (err
 	}
 	return nil
 }
+
+// TransferFeeAmount Struct
+type TransferFeeAmount struct {
+	// Amount withheld during transfers, to be harvested to the mint
+	WithheldAmount uint64
+}
+
+const TRANSFER_FEE_AMOUNT_SIZE = 8
+
+func (obj *TransferFeeAmount) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *TransferFeeAmount) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.WithheldAmount); err != nil {
+		return err
+	}
+	return nil
+}
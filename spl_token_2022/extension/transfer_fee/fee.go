@@ -0,0 +1,122 @@
+package transfer_fee
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// MaxFeeBasisPoints maximum transfer fee basis points, 100%
+const MaxFeeBasisPoints = 10_000
+
+var ErrFeeOverflow = errors.New("transfer_fee: fee calculation overflow")
+
+// ceilDiv divides the 128-bit hi:lo by divisor and rounds up, fails if the quotient does not fit in an uint64
+func ceilDiv(hi, lo, divisor uint64) (uint64, bool) {
+	if hi >= divisor {
+		return 0, false
+	}
+	quotient, remainder := bits.Div64(hi, lo, divisor)
+	if remainder > 0 {
+		if quotient == ^uint64(0) {
+			return 0, false
+		}
+		quotient++
+	}
+	return quotient, true
+}
+
+// CalculateFee the fee of the transfer of preFeeAmount, rounded up and capped at MaximumFee
+func (obj *TransferFee) CalculateFee(preFeeAmount uint64) (uint64, error) {
+	if obj.TransferFeeBasisPoints == 0 || preFeeAmount == 0 {
+		return 0, nil
+	}
+	hi, lo := bits.Mul64(preFeeAmount, uint64(obj.TransferFeeBasisPoints))
+	fee, ok := ceilDiv(hi, lo, MaxFeeBasisPoints)
+	if !ok {
+		return 0, ErrFeeOverflow
+	}
+	return min(fee, obj.MaximumFee), nil
+}
+
+// CalculatePostFeeAmount the amount received by the destination for the transfer of preFeeAmount
+func (obj *TransferFee) CalculatePostFeeAmount(preFeeAmount uint64) (uint64, error) {
+	fee, err := obj.CalculateFee(preFeeAmount)
+	if err != nil {
+		return 0, err
+	}
+	if fee > preFeeAmount {
+		return 0, ErrFeeOverflow
+	}
+	return preFeeAmount - fee, nil
+}
+
+// CalculatePreFeeAmount the amount to transfer so that the destination receives postFeeAmount
+func (obj *TransferFee) CalculatePreFeeAmount(postFeeAmount uint64) (uint64, error) {
+	basisPoints := uint64(obj.TransferFeeBasisPoints)
+	switch {
+	case basisPoints == 0:
+		return postFeeAmount, nil
+	case postFeeAmount == 0:
+		return 0, nil
+	case basisPoints == MaxFeeBasisPoints:
+		return addUint64(postFeeAmount, obj.MaximumFee)
+	case basisPoints > MaxFeeBasisPoints:
+		return 0, ErrFeeOverflow
+	}
+	// the raw pre-fee amount may exceed an uint64 before being capped by the maximum fee
+	numerator := new(big.Int).Mul(new(big.Int).SetUint64(postFeeAmount), big.NewInt(MaxFeeBasisPoints))
+	denominator := new(big.Int).SetUint64(MaxFeeBasisPoints - basisPoints)
+	rawPreFeeAmount, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() > 0 {
+		rawPreFeeAmount.Add(rawPreFeeAmount, big.NewInt(1))
+	}
+	rawFee := new(big.Int).Sub(rawPreFeeAmount, new(big.Int).SetUint64(postFeeAmount))
+	if rawFee.Cmp(new(big.Int).SetUint64(obj.MaximumFee)) >= 0 {
+		return addUint64(postFeeAmount, obj.MaximumFee)
+	}
+	if !rawPreFeeAmount.IsUint64() {
+		return 0, ErrFeeOverflow
+	}
+	return rawPreFeeAmount.Uint64(), nil
+}
+
+// CalculateInverseFee the fee of the transfer so that the destination receives postFeeAmount
+func (obj *TransferFee) CalculateInverseFee(postFeeAmount uint64) (uint64, error) {
+	preFeeAmount, err := obj.CalculatePreFeeAmount(postFeeAmount)
+	if err != nil {
+		return 0, err
+	}
+	return obj.CalculateFee(preFeeAmount)
+}
+
+// GetEpochFee the transfer fee in effect at epoch
+func (obj *TransferFeeConfig) GetEpochFee(epoch uint64) *TransferFee {
+	if epoch >= obj.NewerTransferFee.Epoch {
+		return &obj.NewerTransferFee
+	}
+	return &obj.OlderTransferFee
+}
+
+// CalculateEpochFee the fee of the transfer of preFeeAmount at epoch
+func (obj *TransferFeeConfig) CalculateEpochFee(epoch, preFeeAmount uint64) (uint64, error) {
+	return obj.GetEpochFee(epoch).CalculateFee(preFeeAmount)
+}
+
+// CalculateEpochPreFeeAmount the amount to transfer at epoch so that the destination receives postFeeAmount
+func (obj *TransferFeeConfig) CalculateEpochPreFeeAmount(epoch, postFeeAmount uint64) (uint64, error) {
+	return obj.GetEpochFee(epoch).CalculatePreFeeAmount(postFeeAmount)
+}
+
+// CalculateInverseEpochFee the fee of the transfer at epoch so that the destination receives postFeeAmount
+func (obj *TransferFeeConfig) CalculateInverseEpochFee(epoch, postFeeAmount uint64) (uint64, error) {
+	return obj.GetEpochFee(epoch).CalculateInverseFee(postFeeAmount)
+}
+
+func addUint64(a, b uint64) (uint64, error) {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return 0, ErrFeeOverflow
+	}
+	return sum, nil
+}
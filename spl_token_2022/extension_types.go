@@ -0,0 +1,28 @@
+package spl_token_2022
+
+import (
+	binary "github.com/gagliardetto/binary"
+)
+
+// encodeExtensionTypes encodes the extension types as the remainder of the instruction data, an u16 each without a length prefix
+func encodeExtensionTypes(encoder *binary.Encoder, extensionTypes []ExtensionType) error {
+	for _, extensionType := range extensionTypes {
+		if err := encoder.WriteUint16(uint16(extensionType), binary.LE); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeExtensionTypes decodes the extension types from the remainder of the instruction data
+func decodeExtensionTypes(decoder *binary.Decoder) ([]ExtensionType, error) {
+	var extensionTypes = make([]ExtensionType, 0, decoder.Remaining()/2)
+	for decoder.Remaining() >= 2 {
+		extensionType, err := decoder.ReadUint16(binary.LE)
+		if err != nil {
+			return nil, err
+		}
+		extensionTypes = append(extensionTypes, ExtensionType(extensionType))
+	}
+	return extensionTypes, nil
+}
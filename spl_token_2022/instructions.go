@@ -3511,17 +3511,12 @@ func (obj *GetAccountDataSize) ValidateAndBuild() (*Instruction, error) {
 }
 
 func (obj *GetAccountDataSize) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
-	if err = encoder.Encode(&obj.ExtensionTypes); err != nil {
-		return err
-	}
-	return nil
+	return encodeExtensionTypes(encoder, obj.ExtensionTypes)
 }
 
 func (obj *GetAccountDataSize) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
-	if err = decoder.Decode(&obj.ExtensionTypes); err != nil {
-		return err
-	}
-	return nil
+	obj.ExtensionTypes, err = decodeExtensionTypes(decoder)
+	return err
 }
 
 func (obj *GetAccountDataSize) EncodeToTree(parent treeout.Branches) {
@@ -4310,6 +4305,8 @@ func (obj *DefaultAccountStateExtension) EncodeToTree(parent treeout.Branches) {
 
 // Reallocate Instruction
 type Reallocate struct {
+	// New extension types to include in the reallocated account
+	ExtensionTypes []ExtensionType
 	// [0] = [WRITE] account `The account to reallocate.`
 	// [1] = [WRITE, SIGNER] payer `The payer account to fund reallocation`
 	// [2] = [] system `System program for reallocation funding`
@@ -4329,23 +4326,33 @@ func NewReallocateInstructionBuilder() *Reallocate {
 //
 // Parameters:
 //
+//	extensionTypes: New extension types to include in the reallocated account
 //	account: The account to reallocate.
 //	payer: The payer account to fund reallocation
 //	system: System program for reallocation funding
 //	accountOwner: The account's owner.
 func NewReallocateInstruction(
+	extensionTypes []ExtensionType,
 	account common.PublicKey,
 	payer common.PublicKey,
 	system common.PublicKey,
 	accountOwner common.PublicKey,
 ) *Reallocate {
 	return NewReallocateInstructionBuilder().
+		SetExtensionTypes(extensionTypes).
 		SetAccountAccount(account).
 		SetPayerAccount(payer).
 		SetSystemAccount(system).
 		SetAccountOwnerAccount(accountOwner)
 }
 
+// SetExtensionTypes sets the "extensionTypes" parameter.
+// New extension types to include in the reallocated account
+func (obj *Reallocate) SetExtensionTypes(extensionTypes []ExtensionType) *Reallocate {
+	obj.ExtensionTypes = extensionTypes
+	return obj
+}
+
 // SetAccountAccount sets the "account" parameter.
 // The account to reallocate.
 func (obj *Reallocate) SetAccountAccount(account common.PublicKey) *Reallocate {
@@ -4422,6 +4429,9 @@ func (obj *Reallocate) Build() *Instruction {
 }
 
 func (obj *Reallocate) Validate() error {
+	if obj.ExtensionTypes == nil {
+		return errors.New("[Reallocate] extensionTypes param is not set")
+	}
 
 	if obj.AccountMetaSlice[0] == nil {
 		return errors.New("[Reallocate] accounts.account is not set")
@@ -4449,11 +4459,12 @@ func (obj *Reallocate) ValidateAndBuild() (*Instruction, error) {
 }
 
 func (obj *Reallocate) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
-	return nil
+	return encodeExtensionTypes(encoder, obj.ExtensionTypes)
 }
 
 func (obj *Reallocate) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
-	return nil
+	obj.ExtensionTypes, err = decodeExtensionTypes(decoder)
+	return err
 }
 
 func (obj *Reallocate) EncodeToTree(parent treeout.Branches) {
@@ -4462,7 +4473,9 @@ func (obj *Reallocate) EncodeToTree(parent treeout.Branches) {
 			programBranch.Child(format.Instruction("Reallocate")).
 				ParentFunc(func(instructionBranch treeout.Branches) {
 					// Parameters of the instruction:
-					instructionBranch.Child("Params[len=0]").ParentFunc(func(paramsBranch treeout.Branches) {})
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ExtensionTypes", obj.ExtensionTypes))
+					})
 					// Accounts of the instruction:
 					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
 						accountsBranch.Child(common.FormatMeta("     account", obj.AccountMetaSlice.Get(0)))
@@ -5749,3 +5762,383 @@ func (obj *GroupMemberPointerExtension) EncodeToTree(parent treeout.Branches) {
 				})
 		})
 }
+
+// ConfidentialMintBurnExtension Instruction
+type ConfidentialMintBurnExtension struct {
+	Data                    []byte
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewConfidentialMintBurnExtensionInstructionBuilder creates a new `ConfidentialMintBurnExtension` instruction builder.
+func NewConfidentialMintBurnExtensionInstructionBuilder() *ConfidentialMintBurnExtension {
+	return &ConfidentialMintBurnExtension{
+		AccountMetaSlice: make(common.AccountMetaSlice, 0),
+	}
+}
+
+// NewConfidentialMintBurnExtensionInstruction
+//
+// Parameters:
+//
+//	data:
+func NewConfidentialMintBurnExtensionInstruction(
+	data []byte,
+) *ConfidentialMintBurnExtension {
+	return NewConfidentialMintBurnExtensionInstructionBuilder().
+		SetData(data)
+}
+
+// SetData sets the "data" parameter.
+func (obj *ConfidentialMintBurnExtension) SetData(data []byte) *ConfidentialMintBurnExtension {
+	obj.Data = data
+	return obj
+}
+
+func (obj *ConfidentialMintBurnExtension) SetProgramId(programId *common.PublicKey) *ConfidentialMintBurnExtension {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ConfidentialMintBurnExtension) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ConfidentialMintBurnExtension}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ConfidentialMintBurnExtension) Validate() error {
+	if obj.Data == nil {
+		return errors.New("[ConfidentialMintBurnExtension] data param is not set")
+	}
+
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ConfidentialMintBurnExtension) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ConfidentialMintBurnExtension) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBytes(obj.Data, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialMintBurnExtension) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if obj.Data, err = decoder.ReadBytes(decoder.Remaining()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ConfidentialMintBurnExtension) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ConfidentialMintBurnExtension")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Data", obj.Data))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=0]").ParentFunc(func(accountsBranch treeout.Branches) {})
+				})
+		})
+}
+
+// ScaledUiAmountExtension Instruction
+type ScaledUiAmountExtension struct {
+	Data                    []byte
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewScaledUiAmountExtensionInstructionBuilder creates a new `ScaledUiAmountExtension` instruction builder.
+func NewScaledUiAmountExtensionInstructionBuilder() *ScaledUiAmountExtension {
+	return &ScaledUiAmountExtension{
+		AccountMetaSlice: make(common.AccountMetaSlice, 0),
+	}
+}
+
+// NewScaledUiAmountExtensionInstruction
+//
+// Parameters:
+//
+//	data:
+func NewScaledUiAmountExtensionInstruction(
+	data []byte,
+) *ScaledUiAmountExtension {
+	return NewScaledUiAmountExtensionInstructionBuilder().
+		SetData(data)
+}
+
+// SetData sets the "data" parameter.
+func (obj *ScaledUiAmountExtension) SetData(data []byte) *ScaledUiAmountExtension {
+	obj.Data = data
+	return obj
+}
+
+func (obj *ScaledUiAmountExtension) SetProgramId(programId *common.PublicKey) *ScaledUiAmountExtension {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *ScaledUiAmountExtension) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_ScaledUiAmountExtension}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *ScaledUiAmountExtension) Validate() error {
+	if obj.Data == nil {
+		return errors.New("[ScaledUiAmountExtension] data param is not set")
+	}
+
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *ScaledUiAmountExtension) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *ScaledUiAmountExtension) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBytes(obj.Data, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ScaledUiAmountExtension) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if obj.Data, err = decoder.ReadBytes(decoder.Remaining()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ScaledUiAmountExtension) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("ScaledUiAmountExtension")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Data", obj.Data))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=0]").ParentFunc(func(accountsBranch treeout.Branches) {})
+				})
+		})
+}
+
+// PausableExtension Instruction
+type PausableExtension struct {
+	Data                    []byte
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewPausableExtensionInstructionBuilder creates a new `PausableExtension` instruction builder.
+func NewPausableExtensionInstructionBuilder() *PausableExtension {
+	return &PausableExtension{
+		AccountMetaSlice: make(common.AccountMetaSlice, 0),
+	}
+}
+
+// NewPausableExtensionInstruction
+//
+// Parameters:
+//
+//	data:
+func NewPausableExtensionInstruction(
+	data []byte,
+) *PausableExtension {
+	return NewPausableExtensionInstructionBuilder().
+		SetData(data)
+}
+
+// SetData sets the "data" parameter.
+func (obj *PausableExtension) SetData(data []byte) *PausableExtension {
+	obj.Data = data
+	return obj
+}
+
+func (obj *PausableExtension) SetProgramId(programId *common.PublicKey) *PausableExtension {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *PausableExtension) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_PausableExtension}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *PausableExtension) Validate() error {
+	if obj.Data == nil {
+		return errors.New("[PausableExtension] data param is not set")
+	}
+
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *PausableExtension) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *PausableExtension) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBytes(obj.Data, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PausableExtension) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if obj.Data, err = decoder.ReadBytes(decoder.Remaining()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PausableExtension) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("PausableExtension")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Data", obj.Data))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=0]").ParentFunc(func(accountsBranch treeout.Branches) {})
+				})
+		})
+}
+
+// PermissionedBurnExtension Instruction
+type PermissionedBurnExtension struct {
+	Data                    []byte
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewPermissionedBurnExtensionInstructionBuilder creates a new `PermissionedBurnExtension` instruction builder.
+func NewPermissionedBurnExtensionInstructionBuilder() *PermissionedBurnExtension {
+	return &PermissionedBurnExtension{
+		AccountMetaSlice: make(common.AccountMetaSlice, 0),
+	}
+}
+
+// NewPermissionedBurnExtensionInstruction
+//
+// Parameters:
+//
+//	data:
+func NewPermissionedBurnExtensionInstruction(
+	data []byte,
+) *PermissionedBurnExtension {
+	return NewPermissionedBurnExtensionInstructionBuilder().
+		SetData(data)
+}
+
+// SetData sets the "data" parameter.
+func (obj *PermissionedBurnExtension) SetData(data []byte) *PermissionedBurnExtension {
+	obj.Data = data
+	return obj
+}
+
+func (obj *PermissionedBurnExtension) SetProgramId(programId *common.PublicKey) *PermissionedBurnExtension {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *PermissionedBurnExtension) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes([]byte{Instruction_PermissionedBurnExtension}),
+		},
+		programId: obj._programId,
+		typeIdLen: 1,
+	}
+}
+
+func (obj *PermissionedBurnExtension) Validate() error {
+	if obj.Data == nil {
+		return errors.New("[PermissionedBurnExtension] data param is not set")
+	}
+
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *PermissionedBurnExtension) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *PermissionedBurnExtension) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.WriteBytes(obj.Data, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PermissionedBurnExtension) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if obj.Data, err = decoder.ReadBytes(decoder.Remaining()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *PermissionedBurnExtension) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("PermissionedBurnExtension")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Data", obj.Data))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=0]").ParentFunc(func(accountsBranch treeout.Branches) {})
+				})
+		})
+}
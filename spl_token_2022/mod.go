@@ -81,6 +81,10 @@ var (
 	Instruction_MetadataPointerExtension         uint8 = 39
 	Instruction_GroupPointerExtension            uint8 = 40
 	Instruction_GroupMemberPointerExtension      uint8 = 41
+	Instruction_ConfidentialMintBurnExtension    uint8 = 42
+	Instruction_ScaledUiAmountExtension          uint8 = 43
+	Instruction_PausableExtension                uint8 = 44
+	Instruction_PermissionedBurnExtension        uint8 = 45
 )
 
 var InstructionImplDef = binary.NewVariantDefinition(binary.Uint8TypeIDEncoding, []binary.VariantType{
@@ -210,6 +214,18 @@ var InstructionImplDef = binary.NewVariantDefinition(binary.Uint8TypeIDEncoding,
 	{
 		"group_member_pointer_extension", (*GroupMemberPointerExtension)(nil),
 	},
+	{
+		"confidential_mint_burn_extension", (*ConfidentialMintBurnExtension)(nil),
+	},
+	{
+		"scaled_ui_amount_extension", (*ScaledUiAmountExtension)(nil),
+	},
+	{
+		"pausable_extension", (*PausableExtension)(nil),
+	},
+	{
+		"permissioned_burn_extension", (*PermissionedBurnExtension)(nil),
+	},
 })
 
 // InstructionIDToName returns the name of the instruction given its ID.
@@ -299,6 +315,14 @@ func InstructionIDToName(id uint8) string {
 		return "GroupPointerExtension"
 	case Instruction_GroupMemberPointerExtension:
 		return "GroupMemberPointerExtension"
+	case Instruction_ConfidentialMintBurnExtension:
+		return "ConfidentialMintBurnExtension"
+	case Instruction_ScaledUiAmountExtension:
+		return "ScaledUiAmountExtension"
+	case Instruction_PausableExtension:
+		return "PausableExtension"
+	case Instruction_PermissionedBurnExtension:
+		return "PermissionedBurnExtension"
 	default:
 		return ""
 	}
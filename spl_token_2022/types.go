@@ -55,6 +55,11 @@ const (
 	ExtensionTypeTokenGroup
 	ExtensionTypeGroupMemberPointer
 	ExtensionTypeTokenGroupMember
+	ExtensionTypeConfidentialMintBurn
+	ExtensionTypeScaledUiAmount
+	ExtensionTypePausable
+	ExtensionTypePausableAccount
+	ExtensionTypePermissionedBurn
 )
 
 func (value ExtensionType) String() string {
@@ -107,6 +112,16 @@ func (value ExtensionType) String() string {
 		return "GroupMemberPointer"
 	case ExtensionTypeTokenGroupMember:
 		return "TokenGroupMember"
+	case ExtensionTypeConfidentialMintBurn:
+		return "ConfidentialMintBurn"
+	case ExtensionTypeScaledUiAmount:
+		return "ScaledUiAmount"
+	case ExtensionTypePausable:
+		return "Pausable"
+	case ExtensionTypePausableAccount:
+		return "PausableAccount"
+	case ExtensionTypePermissionedBurn:
+		return "PermissionedBurn"
 	default:
 		return ""
 	}
@@ -131,6 +146,9 @@ const (
 	AuthorityTypeMetadataPointer
 	AuthorityTypeGroupPointer
 	AuthorityTypeGroupMemberPointer
+	AuthorityTypeScaledUiAmount
+	AuthorityTypePause
+	AuthorityTypePermissionedBurn
 )
 
 func (value AuthorityType) String() string {
@@ -165,6 +183,12 @@ func (value AuthorityType) String() string {
 		return "GroupPointer"
 	case AuthorityTypeGroupMemberPointer:
 		return "GroupMemberPointer"
+	case AuthorityTypeScaledUiAmount:
+		return "ScaledUiAmount"
+	case AuthorityTypePause:
+		return "Pause"
+	case AuthorityTypePermissionedBurn:
+		return "PermissionedBurn"
 	default:
 		return ""
 	}
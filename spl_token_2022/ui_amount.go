@@ -0,0 +1,86 @@
+package spl_token_2022
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidUiAmount = errors.New("spl_token_2022: invalid ui amount")
+
+// AmountToUiAmountString converts a raw amount to its ui amount string, e.g. 1230, 3 => "1.230"
+func AmountToUiAmountString(amount uint64, decimals uint8) string {
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return s
+	}
+	// left-pad zeros to decimals + 1, so we at least have an integer zero
+	if pad := int(decimals) + 1 - len(s); pad > 0 {
+		s = strings.Repeat("0", pad) + s
+	}
+	return s[:len(s)-int(decimals)] + "." + s[len(s)-int(decimals):]
+}
+
+// AmountToUiAmountStringTrimmed converts a raw amount to its ui amount string without the trailing zeros, e.g. 1230, 3 => "1.23"
+func AmountToUiAmountStringTrimmed(amount uint64, decimals uint8) string {
+	return TrimUiAmountString(AmountToUiAmountString(amount, decimals), decimals)
+}
+
+// TrimUiAmountString removes the trailing zeros and the trailing decimal point
+func TrimUiAmountString(uiAmount string, decimals uint8) string {
+	if decimals > 0 {
+		uiAmount = strings.TrimRight(uiAmount, "0")
+		uiAmount = strings.TrimSuffix(uiAmount, ".")
+	}
+	return uiAmount
+}
+
+// ParseUiAmount converts a ui amount string to a raw amount, the inverse of AmountToUiAmountString
+func ParseUiAmount(uiAmount string, decimals uint8) (uint64, error) {
+	parts := strings.Split(uiAmount, ".")
+	if len(parts) > 2 {
+		return 0, ErrInvalidUiAmount
+	}
+	amount := parts[0]
+	afterDecimal := ""
+	if len(parts) == 2 {
+		afterDecimal = strings.TrimRight(parts[1], "0")
+	}
+	if (amount == "" && afterDecimal == "") || len(afterDecimal) > int(decimals) {
+		return 0, ErrInvalidUiAmount
+	}
+	amount += afterDecimal + strings.Repeat("0", int(decimals)-len(afterDecimal))
+	value, err := strconv.ParseUint(amount, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidUiAmount
+	}
+	return value, nil
+}
+
+// FormatScaledUiAmount formats the scaled amount with decimals digits, without the trailing zeros
+func FormatScaledUiAmount(scaledAmount float64, decimals uint8) string {
+	return TrimUiAmountString(strconv.FormatFloat(scaledAmount, 'f', int(decimals), 64), decimals)
+}
+
+// ParseScaledUiAmount parses the ui amount string of an interest-bearing or a scaled mint and converts it back to a raw amount with scale
+func ParseScaledUiAmount(uiAmount string, scale float64) (uint64, error) {
+	// only the decimal notations accepted by the program, no hexadecimal float nor underscore
+	if strings.ContainsAny(uiAmount, "xX_") {
+		return 0, ErrInvalidUiAmount
+	}
+	scaledAmount, err := strconv.ParseFloat(uiAmount, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, ErrInvalidUiAmount
+	}
+	amount := scaledAmount / scale
+	if amount > math.MaxUint64 || amount < 0 || math.IsNaN(amount) {
+		return 0, ErrInvalidUiAmount
+	}
+	// rounding only at the end, rounding earlier gives wrong "inf" answers
+	amount = math.Round(amount)
+	if amount >= math.MaxUint64 {
+		return math.MaxUint64, nil
+	}
+	return uint64(amount), nil
+}
@@ -0,0 +1,68 @@
+package token_metadata
+
+// Get returns the value of the field, false if the additional key does not exist
+func (obj *TokenMetadata) Get(field Field) (string, bool) {
+	switch {
+	case field.Name != nil:
+		return obj.Name, true
+	case field.Symbol != nil:
+		return obj.Symbol, true
+	case field.Uri != nil:
+		return obj.Uri, true
+	case field.Key != nil:
+		if i := obj.keyIndex(field.Key.Field0); i >= 0 {
+			return obj.AdditionalMetadata[i].Value, true
+		}
+	}
+	return "", false
+}
+
+// Update sets the value of the field the same as the UpdateField instruction,
+// an existing additional key is replaced, a new one is appended
+func (obj *TokenMetadata) Update(field Field, value string) {
+	switch {
+	case field.Name != nil:
+		obj.Name = value
+	case field.Symbol != nil:
+		obj.Symbol = value
+	case field.Uri != nil:
+		obj.Uri = value
+	case field.Key != nil:
+		if i := obj.keyIndex(field.Key.Field0); i >= 0 {
+			obj.AdditionalMetadata[i].Value = value
+		} else {
+			obj.AdditionalMetadata = append(obj.AdditionalMetadata, struct {
+				Key   string
+				Value string
+			}{Key: field.Key.Field0, Value: value})
+		}
+	}
+}
+
+// RemoveKey removes the additional key the same as the RemoveKey instruction, returns false if the key does not exist
+func (obj *TokenMetadata) RemoveKey(key string) bool {
+	i := obj.keyIndex(key)
+	if i < 0 {
+		return false
+	}
+	obj.AdditionalMetadata = append(obj.AdditionalMetadata[:i:i], obj.AdditionalMetadata[i+1:]...)
+	return true
+}
+
+// Size the size of the borsh encoded metadata, the length of its tlv entry
+func (obj *TokenMetadata) Size() int {
+	var size = 32 + 32 + 4 + len(obj.Name) + 4 + len(obj.Symbol) + 4 + len(obj.Uri) + 4
+	for _, item := range obj.AdditionalMetadata {
+		size += 4 + len(item.Key) + 4 + len(item.Value)
+	}
+	return size
+}
+
+func (obj *TokenMetadata) keyIndex(key string) int {
+	for i, item := range obj.AdditionalMetadata {
+		if item.Key == key {
+			return i
+		}
+	}
+	return -1
+}
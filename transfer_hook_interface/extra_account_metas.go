@@ -0,0 +1,440 @@
+package transfer_hook_interface
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// ExtraAccountMetasSeed the seed of the validation account: ["extra-account-metas", mint]
+const ExtraAccountMetasSeed = "extra-account-metas"
+
+// Discriminators of ExtraAccountMeta
+const (
+	ExtraAccountMetaFixed      uint8 = 0
+	ExtraAccountMetaSeeds      uint8 = 1
+	ExtraAccountMetaPubkeyData uint8 = 2
+	// ExtraAccountMetaExternalPDA + i: PDA of the program at account index i
+	ExtraAccountMetaExternalPDA uint8 = 1 << 7
+)
+
+// Kinds of Seed
+const (
+	SeedUninitialized   uint8 = 0
+	SeedLiteral         uint8 = 1
+	SeedInstructionData uint8 = 2
+	SeedAccountKey      uint8 = 3
+	SeedAccountData     uint8 = 4
+)
+
+// Kinds of PubkeyData
+const (
+	PubkeyDataInstructionData uint8 = 1
+	PubkeyDataAccountData     uint8 = 2
+)
+
+var (
+	ErrAccountNotFound     = errors.New("transfer hook: account not found")
+	ErrAccountDataTooSmall = errors.New("transfer hook: account data too small")
+	ErrInvalidSeedConfig   = errors.New("transfer hook: invalid seed config")
+	ErrInvalidPubkeyConfig = errors.New("transfer hook: invalid pubkey data config")
+	ErrIndexOutOfBounds    = errors.New("transfer hook: index out of bounds")
+)
+
+// AccountDataFetcher returns the data of an account, nil if the account does not exist
+type AccountDataFetcher func(address common.PublicKey) ([]byte, error)
+
+// Seed a seed of a PDA, resolved from a literal, the instruction data, an account key or an account data
+type Seed struct {
+	Kind uint8
+	// the bytes of a literal seed
+	Bytes []byte
+	// the index in the instruction data, or the account index
+	Index uint8
+	// the index in the account data
+	DataIndex uint8
+	// the length of the instruction data or account data seed
+	Length uint8
+}
+
+func NewLiteralSeed(bytes []byte) Seed {
+	return Seed{Kind: SeedLiteral, Bytes: bytes}
+}
+
+func NewInstructionDataSeed(index, length uint8) Seed {
+	return Seed{Kind: SeedInstructionData, Index: index, Length: length}
+}
+
+func NewAccountKeySeed(index uint8) Seed {
+	return Seed{Kind: SeedAccountKey, Index: index}
+}
+
+func NewAccountDataSeed(accountIndex, dataIndex, length uint8) Seed {
+	return Seed{Kind: SeedAccountData, Index: accountIndex, DataIndex: dataIndex, Length: length}
+}
+
+func (s Seed) pack(out []byte) []byte {
+	switch s.Kind {
+	case SeedLiteral:
+		out = append(out, s.Kind, uint8(len(s.Bytes)))
+		return append(out, s.Bytes...)
+	case SeedInstructionData:
+		return append(out, s.Kind, s.Index, s.Length)
+	case SeedAccountKey:
+		return append(out, s.Kind, s.Index)
+	case SeedAccountData:
+		return append(out, s.Kind, s.Index, s.DataIndex, s.Length)
+	default:
+		return out
+	}
+}
+
+// PackSeeds packs the seeds into the address config of an ExtraAccountMeta
+func PackSeeds(seeds []Seed) ([32]byte, error) {
+	var out [32]byte
+	var packed []byte
+	for _, seed := range seeds {
+		if seed.Kind < SeedLiteral || seed.Kind > SeedAccountData {
+			return out, fmt.Errorf("%w: kind %d", ErrInvalidSeedConfig, seed.Kind)
+		}
+		packed = seed.pack(packed)
+	}
+	if len(packed) > len(out) {
+		return out, fmt.Errorf("%w: %d bytes, max 32", ErrInvalidSeedConfig, len(packed))
+	}
+	copy(out[:], packed)
+	return out, nil
+}
+
+// UnpackSeeds unpacks the address config of an ExtraAccountMeta, the seeds end at the first uninitialized byte
+func UnpackSeeds(config [32]byte) ([]Seed, error) {
+	var seeds []Seed
+	for i := 0; i < len(config) && config[i] != SeedUninitialized; {
+		kind := config[i]
+		var size int
+		switch kind {
+		case SeedLiteral:
+			if i+1 < len(config) {
+				size = 2 + int(config[i+1])
+			}
+		case SeedInstructionData:
+			size = 3
+		case SeedAccountKey:
+			size = 2
+		case SeedAccountData:
+			size = 4
+		default:
+			return nil, fmt.Errorf("%w: kind %d", ErrInvalidSeedConfig, kind)
+		}
+		if size == 0 || i+size > len(config) {
+			return nil, ErrInvalidSeedConfig
+		}
+		b := config[i : i+size]
+		switch kind {
+		case SeedLiteral:
+			seeds = append(seeds, NewLiteralSeed(bytes.Clone(b[2:])))
+		case SeedInstructionData:
+			seeds = append(seeds, NewInstructionDataSeed(b[1], b[2]))
+		case SeedAccountKey:
+			seeds = append(seeds, NewAccountKeySeed(b[1]))
+		case SeedAccountData:
+			seeds = append(seeds, NewAccountDataSeed(b[1], b[2], b[3]))
+		}
+		i += size
+	}
+	return seeds, nil
+}
+
+// PubkeyData an address read from the instruction data or from the data of an account
+type PubkeyData struct {
+	Kind uint8
+	// the index in the instruction data, or the account index
+	Index uint8
+	// the index in the account data
+	DataIndex uint8
+}
+
+// NewExtraAccountMetaFixed a fixed address
+func NewExtraAccountMetaFixed(address common.PublicKey, isSigner, isWritable bool) ExtraAccountMeta {
+	return ExtraAccountMeta{
+		Discriminator: ExtraAccountMetaFixed,
+		AddressConfig: address,
+		IsSigner:      isSigner,
+		IsWritable:    isWritable,
+	}
+}
+
+// NewExtraAccountMetaSeeds a PDA of the transfer hook program
+func NewExtraAccountMetaSeeds(seeds []Seed, isSigner, isWritable bool) (ExtraAccountMeta, error) {
+	config, err := PackSeeds(seeds)
+	return ExtraAccountMeta{
+		Discriminator: ExtraAccountMetaSeeds,
+		AddressConfig: config,
+		IsSigner:      isSigner,
+		IsWritable:    isWritable,
+	}, err
+}
+
+// NewExtraAccountMetaExternalPDA a PDA of the program at the account index programIndex
+func NewExtraAccountMetaExternalPDA(programIndex uint8, seeds []Seed, isSigner, isWritable bool) (ExtraAccountMeta, error) {
+	if programIndex >= ExtraAccountMetaExternalPDA {
+		return ExtraAccountMeta{}, fmt.Errorf("%w: program index %d", ErrIndexOutOfBounds, programIndex)
+	}
+	config, err := PackSeeds(seeds)
+	return ExtraAccountMeta{
+		Discriminator: ExtraAccountMetaExternalPDA + programIndex,
+		AddressConfig: config,
+		IsSigner:      isSigner,
+		IsWritable:    isWritable,
+	}, err
+}
+
+// NewExtraAccountMetaPubkeyData an address read from the instruction data or from the data of an account
+func NewExtraAccountMetaPubkeyData(data PubkeyData, isSigner, isWritable bool) (ExtraAccountMeta, error) {
+	var config [32]byte
+	switch data.Kind {
+	case PubkeyDataInstructionData:
+		config[0], config[1] = data.Kind, data.Index
+	case PubkeyDataAccountData:
+		config[0], config[1], config[2] = data.Kind, data.Index, data.DataIndex
+	default:
+		return ExtraAccountMeta{}, fmt.Errorf("%w: kind %d", ErrInvalidPubkeyConfig, data.Kind)
+	}
+	return ExtraAccountMeta{
+		Discriminator: ExtraAccountMetaPubkeyData,
+		AddressConfig: config,
+		IsSigner:      isSigner,
+		IsWritable:    isWritable,
+	}, nil
+}
+
+// Resolve resolves the address of the extra account.
+// accounts are the accounts of the instruction resolved so far, data is the data of the instruction
+// and programId is the program executing the instruction
+func (obj *ExtraAccountMeta) Resolve(
+	accounts []*common.AccountMeta,
+	data []byte,
+	programId common.PublicKey,
+	fetch AccountDataFetcher,
+) (*common.AccountMeta, error) {
+	accountData := func(index uint8) ([]byte, error) {
+		if int(index) >= len(accounts) {
+			return nil, fmt.Errorf("%w: account index %d", ErrIndexOutOfBounds, index)
+		}
+		content, err := fetch(accounts[index].Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, accounts[index].Pubkey)
+		}
+		return content, nil
+	}
+	slice := func(content []byte, start, length int) ([]byte, error) {
+		if start+length > len(content) {
+			return nil, fmt.Errorf("%w: need %d bytes, got %d", ErrAccountDataTooSmall, start+length, len(content))
+		}
+		return content[start : start+length], nil
+	}
+
+	var address common.PublicKey
+	switch {
+	case obj.Discriminator == ExtraAccountMetaFixed:
+		address = obj.AddressConfig
+	case obj.Discriminator == ExtraAccountMetaPubkeyData:
+		var content []byte
+		var err error
+		var start int
+		switch obj.AddressConfig[0] {
+		case PubkeyDataInstructionData:
+			content, start = data, int(obj.AddressConfig[1])
+		case PubkeyDataAccountData:
+			content, err = accountData(obj.AddressConfig[1])
+			start = int(obj.AddressConfig[2])
+		default:
+			return nil, fmt.Errorf("%w: kind %d", ErrInvalidPubkeyConfig, obj.AddressConfig[0])
+		}
+		if err != nil {
+			return nil, err
+		}
+		key, err := slice(content, start, 32)
+		if err != nil {
+			return nil, err
+		}
+		address = web3.NewPublicKeyFromBs(key)
+	case obj.Discriminator == ExtraAccountMetaSeeds || obj.Discriminator >= ExtraAccountMetaExternalPDA:
+		if obj.Discriminator != ExtraAccountMetaSeeds {
+			index := obj.Discriminator - ExtraAccountMetaExternalPDA
+			if int(index) >= len(accounts) {
+				return nil, fmt.Errorf("%w: program index %d", ErrIndexOutOfBounds, index)
+			}
+			programId = accounts[index].Pubkey
+		}
+		seeds, err := UnpackSeeds(obj.AddressConfig)
+		if err != nil {
+			return nil, err
+		}
+		var seedBytes = make([][]byte, 0, len(seeds))
+		for _, seed := range seeds {
+			var value []byte
+			switch seed.Kind {
+			case SeedLiteral:
+				value = seed.Bytes
+			case SeedInstructionData:
+				value, err = slice(data, int(seed.Index), int(seed.Length))
+			case SeedAccountKey:
+				if int(seed.Index) >= len(accounts) {
+					return nil, fmt.Errorf("%w: account index %d", ErrIndexOutOfBounds, seed.Index)
+				}
+				value = accounts[seed.Index].Pubkey[:]
+			case SeedAccountData:
+				var content []byte
+				if content, err = accountData(seed.Index); err == nil {
+					value, err = slice(content, int(seed.DataIndex), int(seed.Length))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			seedBytes = append(seedBytes, value)
+		}
+		address, _, err = web3.FindProgramAddress(seedBytes, programId)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("transfer hook: unknown extra account meta discriminator %d", obj.Discriminator)
+	}
+	return common.NewAccountMeta(address, obj.IsWritable, obj.IsSigner), nil
+}
+
+// FindExtraAccountMetaListAddress returns the address of the validation account of a mint
+func FindExtraAccountMetaListAddress(mint, programId common.PublicKey) (common.PublicKey, uint8, error) {
+	return web3.FindProgramAddress([][]byte{[]byte(ExtraAccountMetasSeed), mint[:]}, programId)
+}
+
+// ExtraAccountMetaListSize the size of a validation account with n extra accounts
+func ExtraAccountMetaListSize(n int) int {
+	// discriminator + length + PodSlice length + items
+	return 8 + 4 + 4 + n*EXTRA_ACCOUNT_META_SIZE
+}
+
+// ParseExtraAccountMetaList parses the extra accounts of the Execute instruction from the TLV data of a validation account
+func ParseExtraAccountMetaList(data []byte) ([]ExtraAccountMeta, error) {
+	for offset := 0; offset+12 <= len(data); {
+		var discriminator = data[offset : offset+8]
+		var length = int(binary.LittleEndian.Uint32(data[offset+8:]))
+		offset += 12
+		if offset+length > len(data) {
+			return nil, errors.New("transfer hook: invalid extra account meta list, tlv entry out of bounds")
+		}
+		value := data[offset : offset+length]
+		offset += length
+		if !bytes.Equal(discriminator, Instruction_Execute.Bytes()) {
+			continue
+		}
+		if len(value) < 4 {
+			return nil, errors.New("transfer hook: invalid extra account meta list")
+		}
+		count := int(binary.LittleEndian.Uint32(value))
+		if len(value) != 4+count*EXTRA_ACCOUNT_META_SIZE {
+			return nil, fmt.Errorf("transfer hook: invalid extra account meta list, %d bytes for %d accounts", len(value), count)
+		}
+		var metas = make([]ExtraAccountMeta, count)
+		for i := range metas {
+			item := value[4+i*EXTRA_ACCOUNT_META_SIZE:]
+			metas[i] = ExtraAccountMeta{
+				Discriminator: item[0],
+				AddressConfig: [32]byte(item[1:33]),
+				IsSigner:      item[33] != 0,
+				IsWritable:    item[34] != 0,
+			}
+		}
+		return metas, nil
+	}
+	return nil, errors.New("transfer hook: no extra account metas for the Execute instruction")
+}
+
+// PackExtraAccountMetaList packs the extra accounts of the Execute instruction into the TLV data of a validation account
+func PackExtraAccountMetaList(metas []ExtraAccountMeta) []byte {
+	var out = make([]byte, 0, ExtraAccountMetaListSize(len(metas)))
+	out = append(out, Instruction_Execute.Bytes()...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(4+len(metas)*EXTRA_ACCOUNT_META_SIZE))
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(metas)))
+	for _, meta := range metas {
+		out = append(out, meta.Discriminator)
+		out = append(out, meta.AddressConfig[:]...)
+		out = append(out, btou8(meta.IsSigner), btou8(meta.IsWritable))
+	}
+	return out
+}
+
+func btou8(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ResolveExtraAccountMetasForExecute resolves the accounts the transfer hook program requires for a transfer.
+// The result must be appended to the accounts of the TransferChecked instruction:
+// the extra accounts, followed by the transfer hook program and the validation account
+func ResolveExtraAccountMetasForExecute(
+	programId common.PublicKey,
+	source, mint, destination, authority common.PublicKey,
+	amount uint64,
+	fetch AccountDataFetcher,
+) ([]*common.AccountMeta, error) {
+	validation, _, err := FindExtraAccountMetaListAddress(mint, programId)
+	if err != nil {
+		return nil, err
+	}
+	content, err := fetch(validation)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, fmt.Errorf("%w: extra account meta list %s", ErrAccountNotFound, validation)
+	}
+	metas, err := ParseExtraAccountMetaList(content)
+	if err != nil {
+		return nil, err
+	}
+
+	data := binary.LittleEndian.AppendUint64(Instruction_Execute.Bytes(), amount)
+	accounts := []*common.AccountMeta{
+		common.Meta(source),
+		common.Meta(mint),
+		common.Meta(destination),
+		common.Meta(authority),
+		common.Meta(validation),
+	}
+	for _, meta := range metas {
+		resolved, err := meta.Resolve(accounts, data, programId, fetch)
+		if err != nil {
+			return nil, err
+		}
+		deEscalate(resolved, accounts)
+		accounts = append(accounts, resolved)
+	}
+	return append(accounts[5:], common.Meta(programId), common.Meta(validation)), nil
+}
+
+// deEscalate an account already in the instruction can not gain privileges
+func deEscalate(meta *common.AccountMeta, accounts []*common.AccountMeta) {
+	var found, isSigner, isWritable bool
+	for _, item := range accounts {
+		if item.Pubkey == meta.Pubkey {
+			found = true
+			isSigner = isSigner || item.IsSigner
+			isWritable = isWritable || item.IsWritable
+		}
+	}
+	if found {
+		meta.IsSigner = meta.IsSigner && isSigner
+		meta.IsWritable = meta.IsWritable && isWritable
+	}
+}
@@ -0,0 +1,125 @@
+package transfer_hook_interface
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/donutnomad/solana-web3/common"
+	"github.com/donutnomad/solana-web3/web3"
+	"testing"
+)
+
+func TestSeeds(t *testing.T) {
+	seeds := []Seed{
+		NewLiteralSeed([]byte("seed")),
+		NewInstructionDataSeed(8, 8),
+		NewAccountKeySeed(2),
+		NewAccountDataSeed(0, 32, 32),
+	}
+	config, err := PackSeeds(seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{1, 4, 's', 'e', 'e', 'd', 2, 8, 8, 3, 2, 4, 0, 32, 32}
+	if !bytes.Equal(config[:len(want)], want) {
+		t.Fatalf("unexpected config %v", config)
+	}
+	unpacked, err := UnpackSeeds(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unpacked) != len(seeds) || string(unpacked[0].Bytes) != "seed" || unpacked[3].DataIndex != 32 || unpacked[3].Length != 32 {
+		t.Fatalf("unexpected seeds %v", unpacked)
+	}
+	if _, err := PackSeeds([]Seed{NewLiteralSeed(make([]byte, 31))}); err == nil {
+		t.Fatal("expected an error for seeds longer than 32 bytes")
+	}
+}
+
+func TestResolveExtraAccountMetasForExecute(t *testing.T) {
+	hookProgram := web3.Keypair.Generate().PublicKey()
+	source := web3.Keypair.Generate().PublicKey()
+	mint := web3.Keypair.Generate().PublicKey()
+	destination := web3.Keypair.Generate().PublicKey()
+	authority := web3.Keypair.Generate().PublicKey()
+	owner := web3.Keypair.Generate().PublicKey()
+	fixed := web3.Keypair.Generate().PublicKey()
+
+	counter, err := NewExtraAccountMetaSeeds([]Seed{NewLiteralSeed([]byte("counter")), NewAccountKeySeed(1)}, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the owner of the source token account
+	ownerMeta, err := NewExtraAccountMetaPubkeyData(PubkeyData{Kind: PubkeyDataAccountData, Index: 0, DataIndex: 32}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a PDA of the fixed program (account index 5), seeded with the amount
+	external, err := NewExtraAccountMetaExternalPDA(5, []Seed{NewInstructionDataSeed(8, 8)}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metas := []ExtraAccountMeta{
+		NewExtraAccountMetaFixed(fixed, false, false),
+		counter,
+		ownerMeta,
+		external,
+		NewExtraAccountMetaFixed(source, false, true),
+	}
+
+	validation, _, err := FindExtraAccountMetaListAddress(mint, hookProgram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceData := make([]byte, 165)
+	copy(sourceData[32:], owner[:])
+	accounts := map[common.PublicKey][]byte{
+		validation: PackExtraAccountMetaList(metas),
+		source:     sourceData,
+	}
+	if len(accounts[validation]) != ExtraAccountMetaListSize(len(metas)) {
+		t.Fatalf("unexpected size %d", len(accounts[validation]))
+	}
+	parsed, err := ParseExtraAccountMetaList(accounts[validation])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != len(metas) || parsed[1] != metas[1] {
+		t.Fatalf("unexpected metas %v", parsed)
+	}
+
+	resolved, err := ResolveExtraAccountMetasForExecute(hookProgram, source, mint, destination, authority, 42,
+		func(address common.PublicKey) ([]byte, error) {
+			return accounts[address], nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counterAddress, _, _ := web3.FindProgramAddress([][]byte{[]byte("counter"), mint[:]}, hookProgram)
+	externalAddress, _, _ := web3.FindProgramAddress([][]byte{binary.LittleEndian.AppendUint64(nil, 42)}, fixed)
+	want := []*common.AccountMeta{
+		common.Meta(fixed),
+		common.NewAccountMeta(counterAddress, true, false),
+		common.Meta(owner),
+		common.Meta(externalAddress),
+		// source is readonly in the Execute instruction, so it is not escalated
+		common.Meta(source),
+		common.Meta(hookProgram),
+		common.Meta(validation),
+	}
+	if len(resolved) != len(want) {
+		t.Fatalf("unexpected accounts %d", len(resolved))
+	}
+	for i := range want {
+		if *resolved[i] != *want[i] {
+			t.Fatalf("account %d: got %v, expected %v", i, resolved[i], want[i])
+		}
+	}
+
+	delete(accounts, validation)
+	if _, err := ResolveExtraAccountMetasForExecute(hookProgram, source, mint, destination, authority, 42,
+		func(address common.PublicKey) ([]byte, error) {
+			return accounts[address], nil
+		}); err == nil {
+		t.Fatal("expected an error without the validation account")
+	}
+}
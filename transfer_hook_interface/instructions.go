@@ -0,0 +1,579 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package transfer_hook_interface
+
+import (
+	"errors"
+	common "github.com/donutnomad/solana-web3/common"
+	binary "github.com/gagliardetto/binary"
+	format "github.com/gagliardetto/solana-go/text/format"
+	treeout "github.com/gagliardetto/treeout"
+)
+
+// Execute Instruction
+// Runs additional transfer logic, invoked by the token program during a transfer
+type Execute struct {
+	// Amount of tokens to transfer
+	Amount *uint64
+	// [0] = [] source `Source account`
+	// [1] = [] mint `Token mint`
+	// [2] = [] destination `Destination account`
+	// [3] = [] authority `Source account's owner/delegate`
+	// [4] = [] extraAccountMetaList `Validation account`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewExecuteInstructionBuilder creates a new `Execute` instruction builder.
+func NewExecuteInstructionBuilder() *Execute {
+	return &Execute{
+		AccountMetaSlice: make(common.AccountMetaSlice, 5),
+	}
+}
+
+// NewExecuteInstruction
+//
+// Parameters:
+//
+//	amount: Amount of tokens to transfer
+//	source: Source account
+//	mint: Token mint
+//	destination: Destination account
+//	authority: Source account's owner/delegate
+//	extraAccountMetaList: Validation account
+func NewExecuteInstruction(
+	amount uint64,
+	source common.PublicKey,
+	mint common.PublicKey,
+	destination common.PublicKey,
+	authority common.PublicKey,
+	extraAccountMetaList common.PublicKey,
+) *Execute {
+	return NewExecuteInstructionBuilder().
+		SetAmount(amount).
+		SetSourceAccount(source).
+		SetMintAccount(mint).
+		SetDestinationAccount(destination).
+		SetAuthorityAccount(authority).
+		SetExtraAccountMetaListAccount(extraAccountMetaList)
+}
+
+// SetAmount sets the "amount" parameter.
+func (obj *Execute) SetAmount(amount uint64) *Execute {
+	obj.Amount = &amount
+	return obj
+}
+
+// SetSourceAccount sets the "source" parameter.
+// Source account
+func (obj *Execute) SetSourceAccount(source common.PublicKey) *Execute {
+	obj.AccountMetaSlice[0] = common.Meta(source)
+	return obj
+}
+
+// GetSourceAccount gets the "source" parameter.
+// Source account
+func (obj *Execute) GetSourceAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// Token mint
+func (obj *Execute) SetMintAccount(mint common.PublicKey) *Execute {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// Token mint
+func (obj *Execute) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetDestinationAccount sets the "destination" parameter.
+// Destination account
+func (obj *Execute) SetDestinationAccount(destination common.PublicKey) *Execute {
+	obj.AccountMetaSlice[2] = common.Meta(destination)
+	return obj
+}
+
+// GetDestinationAccount gets the "destination" parameter.
+// Destination account
+func (obj *Execute) GetDestinationAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// Source account's owner/delegate
+func (obj *Execute) SetAuthorityAccount(authority common.PublicKey) *Execute {
+	obj.AccountMetaSlice[3] = common.Meta(authority)
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// Source account's owner/delegate
+func (obj *Execute) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+// SetExtraAccountMetaListAccount sets the "extraAccountMetaList" parameter.
+// Validation account
+func (obj *Execute) SetExtraAccountMetaListAccount(extraAccountMetaList common.PublicKey, multiSigners ...common.PublicKey) *Execute {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[4] = common.Meta(extraAccountMetaList)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[4] = common.Meta(extraAccountMetaList)
+	}
+	return obj
+}
+
+// GetExtraAccountMetaListAccount gets the "extraAccountMetaList" parameter.
+// Validation account
+func (obj *Execute) GetExtraAccountMetaListAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(4)
+}
+
+func (obj *Execute) SetProgramId(programId *common.PublicKey) *Execute {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *Execute) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_Execute[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *Execute) Validate() error {
+	if obj.Amount == nil {
+		return errors.New("[Execute] amount param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[Execute] accounts.source is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[Execute] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[Execute] accounts.destination is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[Execute] accounts.authority is not set")
+	}
+	if obj.AccountMetaSlice[4] == nil {
+		return errors.New("[Execute] accounts.extraAccountMetaList is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *Execute) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *Execute) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Execute) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *Execute) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("Execute")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("Amount", *obj.Amount))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=5]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("              source", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("         destination", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("           authority", obj.AccountMetaSlice.Get(3)))
+						accountsBranch.Child(common.FormatMeta("extraAccountMetaList", obj.AccountMetaSlice.Get(4)))
+					})
+				})
+		})
+}
+
+// InitializeExtraAccountMetaList Instruction
+// Initializes the extra account metas on an account, writing into the first open TLV space
+type InitializeExtraAccountMetaList struct {
+	// List of ExtraAccountMeta to write into the account
+	ExtraAccountMetas []ExtraAccountMeta
+	// [0] = [WRITE] extraAccountMetaList `Account with extra account metas`
+	// [1] = [] mint `Mint`
+	// [2] = [SIGNER] authority `Mint authority`
+	// [3] = [] systemProgram `System program`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewInitializeExtraAccountMetaListInstructionBuilder creates a new `InitializeExtraAccountMetaList` instruction builder.
+func NewInitializeExtraAccountMetaListInstructionBuilder() *InitializeExtraAccountMetaList {
+	return &InitializeExtraAccountMetaList{
+		AccountMetaSlice: make(common.AccountMetaSlice, 4),
+	}
+}
+
+// NewInitializeExtraAccountMetaListInstruction
+//
+// Parameters:
+//
+//	extraAccountMetas: List of ExtraAccountMeta to write into the account
+//	extraAccountMetaList: Account with extra account metas
+//	mint: Mint
+//	authority: Mint authority
+//	systemProgram: System program
+func NewInitializeExtraAccountMetaListInstruction(
+	extraAccountMetas []ExtraAccountMeta,
+	extraAccountMetaList common.PublicKey,
+	mint common.PublicKey,
+	authority common.PublicKey,
+	systemProgram common.PublicKey,
+) *InitializeExtraAccountMetaList {
+	return NewInitializeExtraAccountMetaListInstructionBuilder().
+		SetExtraAccountMetas(extraAccountMetas).
+		SetExtraAccountMetaListAccount(extraAccountMetaList).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority).
+		SetSystemProgramAccount(systemProgram)
+}
+
+// SetExtraAccountMetas sets the "extraAccountMetas" parameter.
+func (obj *InitializeExtraAccountMetaList) SetExtraAccountMetas(extraAccountMetas []ExtraAccountMeta) *InitializeExtraAccountMetaList {
+	obj.ExtraAccountMetas = extraAccountMetas
+	return obj
+}
+
+// SetExtraAccountMetaListAccount sets the "extraAccountMetaList" parameter.
+// Account with extra account metas
+func (obj *InitializeExtraAccountMetaList) SetExtraAccountMetaListAccount(extraAccountMetaList common.PublicKey) *InitializeExtraAccountMetaList {
+	obj.AccountMetaSlice[0] = common.Meta(extraAccountMetaList).WRITE()
+	return obj
+}
+
+// GetExtraAccountMetaListAccount gets the "extraAccountMetaList" parameter.
+// Account with extra account metas
+func (obj *InitializeExtraAccountMetaList) GetExtraAccountMetaListAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// Mint
+func (obj *InitializeExtraAccountMetaList) SetMintAccount(mint common.PublicKey) *InitializeExtraAccountMetaList {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// Mint
+func (obj *InitializeExtraAccountMetaList) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// Mint authority
+func (obj *InitializeExtraAccountMetaList) SetAuthorityAccount(authority common.PublicKey) *InitializeExtraAccountMetaList {
+	obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// Mint authority
+func (obj *InitializeExtraAccountMetaList) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+// SetSystemProgramAccount sets the "systemProgram" parameter.
+// System program
+func (obj *InitializeExtraAccountMetaList) SetSystemProgramAccount(systemProgram common.PublicKey, multiSigners ...common.PublicKey) *InitializeExtraAccountMetaList {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[3] = common.Meta(systemProgram)
+	}
+	return obj
+}
+
+// GetSystemProgramAccount gets the "systemProgram" parameter.
+// System program
+func (obj *InitializeExtraAccountMetaList) GetSystemProgramAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(3)
+}
+
+func (obj *InitializeExtraAccountMetaList) SetProgramId(programId *common.PublicKey) *InitializeExtraAccountMetaList {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *InitializeExtraAccountMetaList) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_InitializeExtraAccountMetaList[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *InitializeExtraAccountMetaList) Validate() error {
+	if obj.ExtraAccountMetas == nil {
+		return errors.New("[InitializeExtraAccountMetaList] extraAccountMetas param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[InitializeExtraAccountMetaList] accounts.extraAccountMetaList is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[InitializeExtraAccountMetaList] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[InitializeExtraAccountMetaList] accounts.authority is not set")
+	}
+	if obj.AccountMetaSlice[3] == nil {
+		return errors.New("[InitializeExtraAccountMetaList] accounts.systemProgram is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *InitializeExtraAccountMetaList) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *InitializeExtraAccountMetaList) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ExtraAccountMetas); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeExtraAccountMetaList) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ExtraAccountMetas); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *InitializeExtraAccountMetaList) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("InitializeExtraAccountMetaList")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ExtraAccountMetas", obj.ExtraAccountMetas))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=4]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("extraAccountMetaList", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("           authority", obj.AccountMetaSlice.Get(2)))
+						accountsBranch.Child(common.FormatMeta("       systemProgram", obj.AccountMetaSlice.Get(3)))
+					})
+				})
+		})
+}
+
+// UpdateExtraAccountMetaList Instruction
+// Updates the extra account metas on an account by overwriting the existing list
+type UpdateExtraAccountMetaList struct {
+	// The new list of ExtraAccountMetas to overwrite the existing entry in the account
+	ExtraAccountMetas []ExtraAccountMeta
+	// [0] = [WRITE] extraAccountMetaList `Account with extra account metas`
+	// [1] = [] mint `Mint`
+	// [2] = [SIGNER] authority `Mint authority`
+	common.AccountMetaSlice `bin:"-"`
+	_programId              *common.PublicKey
+}
+
+// NewUpdateExtraAccountMetaListInstructionBuilder creates a new `UpdateExtraAccountMetaList` instruction builder.
+func NewUpdateExtraAccountMetaListInstructionBuilder() *UpdateExtraAccountMetaList {
+	return &UpdateExtraAccountMetaList{
+		AccountMetaSlice: make(common.AccountMetaSlice, 3),
+	}
+}
+
+// NewUpdateExtraAccountMetaListInstruction
+//
+// Parameters:
+//
+//	extraAccountMetas: The new list of ExtraAccountMetas to overwrite the existing entry in the account
+//	extraAccountMetaList: Account with extra account metas
+//	mint: Mint
+//	authority: Mint authority
+func NewUpdateExtraAccountMetaListInstruction(
+	extraAccountMetas []ExtraAccountMeta,
+	extraAccountMetaList common.PublicKey,
+	mint common.PublicKey,
+	authority common.PublicKey,
+) *UpdateExtraAccountMetaList {
+	return NewUpdateExtraAccountMetaListInstructionBuilder().
+		SetExtraAccountMetas(extraAccountMetas).
+		SetExtraAccountMetaListAccount(extraAccountMetaList).
+		SetMintAccount(mint).
+		SetAuthorityAccount(authority)
+}
+
+// SetExtraAccountMetas sets the "extraAccountMetas" parameter.
+func (obj *UpdateExtraAccountMetaList) SetExtraAccountMetas(extraAccountMetas []ExtraAccountMeta) *UpdateExtraAccountMetaList {
+	obj.ExtraAccountMetas = extraAccountMetas
+	return obj
+}
+
+// SetExtraAccountMetaListAccount sets the "extraAccountMetaList" parameter.
+// Account with extra account metas
+func (obj *UpdateExtraAccountMetaList) SetExtraAccountMetaListAccount(extraAccountMetaList common.PublicKey) *UpdateExtraAccountMetaList {
+	obj.AccountMetaSlice[0] = common.Meta(extraAccountMetaList).WRITE()
+	return obj
+}
+
+// GetExtraAccountMetaListAccount gets the "extraAccountMetaList" parameter.
+// Account with extra account metas
+func (obj *UpdateExtraAccountMetaList) GetExtraAccountMetaListAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(0)
+}
+
+// SetMintAccount sets the "mint" parameter.
+// Mint
+func (obj *UpdateExtraAccountMetaList) SetMintAccount(mint common.PublicKey) *UpdateExtraAccountMetaList {
+	obj.AccountMetaSlice[1] = common.Meta(mint)
+	return obj
+}
+
+// GetMintAccount gets the "mint" parameter.
+// Mint
+func (obj *UpdateExtraAccountMetaList) GetMintAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(1)
+}
+
+// SetAuthorityAccount sets the "authority" parameter.
+// Mint authority
+func (obj *UpdateExtraAccountMetaList) SetAuthorityAccount(authority common.PublicKey, multiSigners ...common.PublicKey) *UpdateExtraAccountMetaList {
+	if len(multiSigners) > 0 {
+		obj.AccountMetaSlice[2] = common.Meta(authority)
+		for _, value := range multiSigners {
+			obj.AccountMetaSlice.Append(common.NewAccountMeta(value, false, true))
+		}
+	} else {
+		obj.AccountMetaSlice[2] = common.Meta(authority).SIGNER()
+	}
+	return obj
+}
+
+// GetAuthorityAccount gets the "authority" parameter.
+// Mint authority
+func (obj *UpdateExtraAccountMetaList) GetAuthorityAccount() *common.AccountMeta {
+	return obj.AccountMetaSlice.Get(2)
+}
+
+func (obj *UpdateExtraAccountMetaList) SetProgramId(programId *common.PublicKey) *UpdateExtraAccountMetaList {
+	obj._programId = programId
+	return obj
+}
+
+func (obj *UpdateExtraAccountMetaList) Build() *Instruction {
+	return &Instruction{
+		BaseVariant: binary.BaseVariant{
+			Impl:   obj,
+			TypeID: binary.TypeIDFromBytes(Instruction_UpdateExtraAccountMetaList[:]),
+		},
+		programId: obj._programId,
+		typeIdLen: 8,
+	}
+}
+
+func (obj *UpdateExtraAccountMetaList) Validate() error {
+	if obj.ExtraAccountMetas == nil {
+		return errors.New("[UpdateExtraAccountMetaList] extraAccountMetas param is not set")
+	}
+
+	if obj.AccountMetaSlice[0] == nil {
+		return errors.New("[UpdateExtraAccountMetaList] accounts.extraAccountMetaList is not set")
+	}
+	if obj.AccountMetaSlice[1] == nil {
+		return errors.New("[UpdateExtraAccountMetaList] accounts.mint is not set")
+	}
+	if obj.AccountMetaSlice[2] == nil {
+		return errors.New("[UpdateExtraAccountMetaList] accounts.authority is not set")
+	}
+	return nil
+}
+
+// ValidateAndBuild validates the instruction parameters and accounts;
+// if there is a validation error, it returns the error.
+// Otherwise, it builds and returns the instruction.
+func (obj *UpdateExtraAccountMetaList) ValidateAndBuild() (*Instruction, error) {
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+	return obj.Build(), nil
+}
+
+func (obj *UpdateExtraAccountMetaList) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.ExtraAccountMetas); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateExtraAccountMetaList) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.ExtraAccountMetas); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *UpdateExtraAccountMetaList) EncodeToTree(parent treeout.Branches) {
+	parent.Child(format.Program(ProgramName, common.As(ProgramID))).
+		ParentFunc(func(programBranch treeout.Branches) {
+			programBranch.Child(format.Instruction("UpdateExtraAccountMetaList")).
+				ParentFunc(func(instructionBranch treeout.Branches) {
+					// Parameters of the instruction:
+					instructionBranch.Child("Params[len=1]").ParentFunc(func(paramsBranch treeout.Branches) {
+						paramsBranch.Child(format.Param("ExtraAccountMetas", obj.ExtraAccountMetas))
+					})
+					// Accounts of the instruction:
+					instructionBranch.Child("Accounts[len=3]").ParentFunc(func(accountsBranch treeout.Branches) {
+						accountsBranch.Child(common.FormatMeta("extraAccountMetaList", obj.AccountMetaSlice.Get(0)))
+						accountsBranch.Child(common.FormatMeta("                mint", obj.AccountMetaSlice.Get(1)))
+						accountsBranch.Child(common.FormatMeta("           authority", obj.AccountMetaSlice.Get(2)))
+					})
+				})
+		})
+}
@@ -0,0 +1,51 @@
+// This code was AUTOGENERATED using the library.
+// Please DO NOT EDIT THIS FILE.
+
+package transfer_hook_interface
+
+import binary "github.com/gagliardetto/binary"
+
+// ExtraAccountMeta Struct
+// An account meta which can be resolved at runtime
+type ExtraAccountMeta struct {
+	// 0 = literal address, 1 = PDA of the executing program, 2 = address read from instruction or account data, 128 + i = PDA of the program at account index i
+	Discriminator uint8
+	// The address, the packed seeds or the pubkey data config, depending on the discriminator
+	AddressConfig [32]uint8
+	IsSigner      bool
+	IsWritable    bool
+}
+
+const EXTRA_ACCOUNT_META_SIZE = 35
+
+func (obj *ExtraAccountMeta) MarshalWithEncoder(encoder *binary.Encoder) (err error) {
+	if err = encoder.Encode(&obj.Discriminator); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.AddressConfig); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.IsSigner); err != nil {
+		return err
+	}
+	if err = encoder.Encode(&obj.IsWritable); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (obj *ExtraAccountMeta) UnmarshalWithDecoder(decoder *binary.Decoder) (err error) {
+	if err = decoder.Decode(&obj.Discriminator); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.AddressConfig); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.IsSigner); err != nil {
+		return err
+	}
+	if err = decoder.Decode(&obj.IsWritable); err != nil {
+		return err
+	}
+	return nil
+}
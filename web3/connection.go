@@ -2129,6 +2129,80 @@ func (c *Connection) GetAddressLookupTable(accountKey PublicKey, config GetAccou
 	}, nil
 }
 
+// GetUpgradeableProgramConfig Configuration object for GetUpgradeableProgram and GetUpgradeablePrograms
+type GetUpgradeableProgramConfig struct {
+	//  Optional commitment level
+	Commitment *Commitment
+	//  Only fetch the metadata of the ProgramData accounts, the Data of the programs is left empty
+	SkipData bool
+}
+
+// GetUpgradeableProgram Fetch the ProgramData address, upgrade authority, last deploy slot and ELF of a program
+// deployed with the BPF upgradeable loader, returns nil if the program account does not exist
+func (c *Connection) GetUpgradeableProgram(programId PublicKey, config GetUpgradeableProgramConfig) (*UpgradeableProgram, error) {
+	programs, err := c.GetUpgradeablePrograms([]PublicKey{programId}, config)
+	if err != nil {
+		return nil, err
+	}
+	return programs[0], nil
+}
+
+// GetUpgradeablePrograms Fetch multiple programs deployed with the BPF upgradeable loader,
+// the result keeps the order of programIds and contains nil for the program accounts that do not exist.
+// An error is returned if an account is not a program of the upgradeable loader
+func (c *Connection) GetUpgradeablePrograms(programIds []PublicKey, config GetUpgradeableProgramConfig) ([]*UpgradeableProgram, error) {
+	const maxAccounts = 100
+	var out = make([]*UpgradeableProgram, len(programIds))
+	for start := 0; start < len(programIds); start += maxAccounts {
+		ids := programIds[start:min(start+maxAccounts, len(programIds))]
+		accounts, err := c.GetMultipleAccountsInfo(ids, GetMultipleAccountsConfig{Commitment: config.Commitment})
+		if err != nil {
+			return nil, err
+		}
+		var programs []*UpgradeableProgram
+		var programDataKeys []PublicKey
+		for i, account := range accounts {
+			if account == nil {
+				continue
+			}
+			if !account.Owner.Equals(BPFLoaderUpgradeableProgramID) {
+				return nil, fmt.Errorf("program %s is owned by %s, not by the upgradeable loader", ids[i], account.Owner)
+			}
+			program, err := UpgradeableLoaderProgramFromAccountData(account.Data.Content)
+			if err != nil {
+				return nil, fmt.Errorf("program %s: %w", ids[i], err)
+			}
+			out[start+i] = &UpgradeableProgram{ProgramId: ids[i], ProgramData: program.ProgramData}
+			programs = append(programs, out[start+i])
+			programDataKeys = append(programDataKeys, program.ProgramData)
+		}
+		if len(programDataKeys) == 0 {
+			continue
+		}
+		dataConfig := GetMultipleAccountsConfig{Commitment: config.Commitment}
+		if config.SkipData {
+			dataConfig.DataSlice = &DataSlice{Offset: Ref[uint64](0), Length: Ref[uint64](UpgradeableLoaderProgramDataMetadataSize)}
+		}
+		dataAccounts, err := c.GetMultipleAccountsInfo(programDataKeys, dataConfig)
+		if err != nil {
+			return nil, err
+		}
+		for i, account := range dataAccounts {
+			if account == nil {
+				return nil, fmt.Errorf("program %s: ProgramData account %s does not exist", programs[i].ProgramId, programDataKeys[i])
+			}
+			programData, err := UpgradeableLoaderProgramDataFromAccountData(account.Data.Content)
+			if err != nil {
+				return nil, fmt.Errorf("program %s: %w", programs[i].ProgramId, err)
+			}
+			programs[i].UpgradeAuthority = programData.UpgradeAuthority
+			programs[i].LastDeploySlot = programData.Slot
+			programs[i].Data = programData.Data
+		}
+	}
+	return out, nil
+}
+
 // GetVersionedTransactionConfig Configuration object for changing `getTransaction` query behavior
 type GetVersionedTransactionConfig struct {
 	// The level of finality desired
@@ -2305,6 +2379,16 @@ func requestNonContext[T any](ctx context.Context, connection *Connection, metho
 	return createNonContext[T](unsafeRes, customErrMessage, connection.Debug)
 }
 
+// RequestParams sends a request of a method not covered by Connection, such as the methods of the DAS API,
+// by the JSON-RPC transport of the connection. The params are an array or an object, the result is decoded into T
+func RequestParams[T any](ctx context.Context, connection *Connection, method string, params any, customErrMessage string) (*T, error) {
+	unsafeRes, err := connection.rpcClient.SendParamsRequest(ctx, method, params, connection.Debug)
+	if err != nil {
+		return nil, fmt.Errorf("rpcRequest %s: %w", method, err)
+	}
+	return createNonContext[T](unsafeRes, customErrMessage, connection.Debug)
+}
+
 func createContext[T any](input io.ReadCloser, customErrMessage string, debug bool) (*RpcResponseAndContext[T], error) {
 	res, err := create[RpcResponse[T]](input, customErrMessage, debug)
 	if err != nil {
@@ -2377,12 +2461,19 @@ type RPCRequest struct {
 }
 
 func (client *CustomClient) SendRequest(ctx context.Context, method string, args []any, debug bool) (io.ReadCloser, error) {
+	var params any
+	if args != nil {
+		params = args
+	}
+	return client.SendParamsRequest(ctx, method, params, debug)
+}
+
+// SendParamsRequest sends a request whose params are an array or an object, nil for no params
+func (client *CustomClient) SendParamsRequest(ctx context.Context, method string, params any, debug bool) (io.ReadCloser, error) {
 	request := &RPCRequest{
 		Method:  method,
 		JSONRPC: "2.0",
-	}
-	if args != nil {
-		request.Params = args
+		Params:  params,
 	}
 	var writer = requestBytesPool.Get().(*bytes.Buffer)
 	writer.Reset()
@@ -9,3 +9,5 @@ var TokenProgram2022ID = MustPublicKey("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPx
 var SysvarInstructions = MustPublicKey("Sysvar1nstructions1111111111111111111111111")
 
 var SPLAssociatedTokenAccountProgramID = MustPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+
+var BPFLoaderUpgradeableProgramID = MustPublicKey("BPFLoaderUpgradeab1e11111111111111111111111")
@@ -3,12 +3,10 @@ package web3
 import (
 	"context"
 	"encoding/binary"
-	"encoding/json"
-	"fmt"
+	"errors"
 	"github.com/gagliardetto/solana-go/programs/system"
 	"log"
 	"math"
-	"strings"
 	"sync"
 	"time"
 )
@@ -114,88 +112,88 @@ func (impl LoaderImpl) Load(connection Connection, payer Signer, program Signer,
 		}
 	}
 
-	var chunkSize = uint32(Loader.ChunkSize())
-	var offset uint32 = 0
-	var array = data
-	var transactions []Transaction
-
-	for len(array) > 0 {
-		bs := array[:chunkSize]
-
-		out := make([]byte, 0, int(chunkSize+16))
-		out = binary.LittleEndian.AppendUint32(out, 0)      // instruction
-		out = binary.LittleEndian.AppendUint32(out, offset) // offset
-		out = binary.LittleEndian.AppendUint32(out, 0)      // bytesLength
-		out = binary.LittleEndian.AppendUint32(out, 0)      // bytesLengthPadding
-		out = append(out, bs[:]...)
-
-		transaction := Transaction{}
-
-		transaction.AddInstruction([]AccountMeta{
-			{Pubkey: program.PublicKey(), IsSigner: true, IsWritable: true},
-		}, programId, out)
-		transactions = append(transactions, transaction)
-		// Delay between sends in an attempt to reduce rate limit errors
-		if strings.Contains(connection.rpcEndpoint, "solana.com") {
-			time.Sleep((1000 / 4) * time.Millisecond)
-		}
-
-		offset += chunkSize
-		array = array[chunkSize:]
+	var chunkSize = Loader.ChunkSize()
+	var offset = 0
+	var transactions [][]TransactionInstruction
+
+	for offset < len(data) {
+		bs := data[offset:min(offset+chunkSize, len(data))]
+
+		out := make([]byte, 0, len(bs)+16)
+		out = binary.LittleEndian.AppendUint32(out, 0)               // instruction
+		out = binary.LittleEndian.AppendUint32(out, uint32(offset))  // offset
+		out = binary.LittleEndian.AppendUint64(out, uint64(len(bs))) // bytesLength
+		out = append(out, bs...)
+
+		transactions = append(transactions, []TransactionInstruction{{
+			Keys:      []AccountMeta{{Pubkey: program.PublicKey(), IsSigner: true, IsWritable: true}},
+			ProgramId: programId,
+			Data:      out,
+		}})
+		offset += len(bs)
 	}
 
+	opts := DeployOptions{}.withDefaults()
+	blockhashes := &blockhashCache{conn: &connection, commit: opts.Commitment}
 	if len(transactions) > 0 {
-		wg := sync.WaitGroup{}
-		wg.Add(len(transactions))
-		for _, transaction := range transactions {
-			transaction := transaction
-			go func() {
-				_, _ = connection.SendAndConfirmTransaction(context.Background(), transaction, []Signer{payer, program}, ConfirmOptions{
-					Commitment: &CommitmentConfirmed,
-				})
-				wg.Done()
-			}()
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			errs []error
+			sem  = make(chan struct{}, opts.Concurrency)
+		)
+		for _, instructions := range transactions {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(instructions []TransactionInstruction) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				_, err := sendSigned(context.Background(), &connection, blockhashes, instructions, payer.PublicKey(), []Signer{payer, program}, opts)
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}(instructions)
 		}
 		wg.Wait()
+		if len(errs) > 0 {
+			return false, errors.Join(errs...)
+		}
 	}
 
 	// Finalize the account loaded with program data for execution
 	{
-		transaction := Transaction{}
-		transaction.AddInstruction([]AccountMeta{
-			{
-				Pubkey:     program.PublicKey(),
-				IsSigner:   true,
-				IsWritable: true,
-			},
-			{
-				Pubkey:     SYSVAR_RENT_PUBKEY,
-				IsSigner:   false,
-				IsWritable: false,
+		finalize := TransactionInstruction{
+			Keys: []AccountMeta{
+				{
+					Pubkey:     program.PublicKey(),
+					IsSigner:   true,
+					IsWritable: true,
+				},
+				{
+					Pubkey:     SYSVAR_RENT_PUBKEY,
+					IsSigner:   false,
+					IsWritable: false,
+				},
 			},
-		}, programId, []byte{1, 0, 0, 0}) // Finalize instruction
+			ProgramId: programId,
+			Data:      []byte{1, 0, 0, 0}, // Finalize instruction
+		}
 
 		deployCommitment := CommitmentProcessed
-		finalizeSignature, err := connection.SendTransaction(&transactions, []Signer{payer, program}, SendOptions{
-			PreflightCommitment: &deployCommitment,
-		})
-		if err != nil {
+		opts.Commitment = &deployCommitment
+		if _, err := sendSigned(context.Background(), &connection, blockhashes, []TransactionInstruction{finalize}, payer.PublicKey(), []Signer{payer, program}, opts); err != nil {
 			return false, err
 		}
-		resp, err := connection.ConfirmTransaction(context.Background(), BlockheightBasedTransactionConfirmationStrategy{
-			Signature: finalizeSignature,
-			BlockhashWithExpiryBlockHeight: BlockhashWithExpiryBlockHeight{
-				LastValidBlockHeight: *transaction.LastValidBlockHeight,
-				Blockhash:            *transaction.RecentBlockhash,
-			},
-		}, &deployCommitment)
+		deploySlot, err := connection.GetSlot(GetSlotConfig{
+			Commitment: &deployCommitment,
+		})
 		if err != nil {
 			return false, err
 		}
-		if resp.Value.HasErr() {
-			content, _ := json.Marshal(&resp.Value)
-			return false, fmt.Errorf("transaction %s failed (%s)", finalizeSignature, string(content))
-		}
 		// We prevent programs from being usable until the slot after their deployment.
 		// See https://github.com/solana-labs/solana/pull/29654
 		for {
@@ -203,7 +201,7 @@ func (impl LoaderImpl) Load(connection Connection, payer Signer, program Signer,
 				Commitment: &deployCommitment,
 			})
 			if err == nil {
-				if currentSlot > resp.Context.Slot {
+				if currentSlot > deploySlot {
 					break
 				}
 			}
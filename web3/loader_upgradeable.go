@@ -0,0 +1,538 @@
+package web3
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"sync"
+	"time"
+)
+
+// Sizes of the account metadata of the BPF upgradeable loader
+const (
+	// UpgradeableLoaderBufferMetadataSize u32 tag + Option<Pubkey> authority
+	UpgradeableLoaderBufferMetadataSize = 37
+	// UpgradeableLoaderProgramSize u32 tag + Pubkey program data address
+	UpgradeableLoaderProgramSize = 36
+	// UpgradeableLoaderProgramDataMetadataSize u32 tag + u64 slot + Option<Pubkey> upgrade authority
+	UpgradeableLoaderProgramDataMetadataSize = 45
+)
+
+// Instruction tags of the BPF upgradeable loader (bincode u32)
+const (
+	upgradeableLoaderInitializeBuffer uint32 = iota
+	upgradeableLoaderWrite
+	upgradeableLoaderDeployWithMaxDataLen
+	upgradeableLoaderUpgrade
+	upgradeableLoaderSetAuthority
+	upgradeableLoaderClose
+	upgradeableLoaderExtendProgram
+	upgradeableLoaderSetAuthorityChecked
+)
+
+var UpgradeableLoader = UpgradeableLoaderImpl{}
+
+// UpgradeableLoaderImpl BPF upgradeable loader (BPFLoaderUpgradeab1e11111111111111111111111) instructions and deployer
+type UpgradeableLoaderImpl struct{}
+
+func upgradeableLoaderData(tag uint32, size int) []byte {
+	out := make([]byte, 0, 4+size)
+	return binary.LittleEndian.AppendUint32(out, tag)
+}
+
+// ProgramDataAddress returns the address of the ProgramData account of a program
+func (impl UpgradeableLoaderImpl) ProgramDataAddress(programId PublicKey) (PublicKey, error) {
+	address, _, err := FindProgramAddress([][]byte{programId[:]}, BPFLoaderUpgradeableProgramID)
+	return address, err
+}
+
+// InitializeBufferInstruction initializes a buffer account, the authority is required to write to the buffer
+func (impl UpgradeableLoaderImpl) InitializeBufferInstruction(buffer, authority PublicKey) TransactionInstruction {
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: buffer, IsWritable: true},
+			{Pubkey: authority},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      upgradeableLoaderData(upgradeableLoaderInitializeBuffer, 0),
+	}
+}
+
+// WriteInstruction writes the bytes at offset of the program data in the buffer
+func (impl UpgradeableLoaderImpl) WriteInstruction(buffer, authority PublicKey, offset uint32, data []byte) TransactionInstruction {
+	out := upgradeableLoaderData(upgradeableLoaderWrite, 12+len(data))
+	out = binary.LittleEndian.AppendUint32(out, offset)
+	out = binary.LittleEndian.AppendUint64(out, uint64(len(data)))
+	out = append(out, data...)
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: buffer, IsWritable: true},
+			{Pubkey: authority, IsSigner: true},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      out,
+	}
+}
+
+// DeployWithMaxDataLenInstruction deploys the buffer to the (uninitialized) program account,
+// maxDataLen is the maximum size of the program, it can be extended later with ExtendProgramInstruction
+func (impl UpgradeableLoaderImpl) DeployWithMaxDataLenInstruction(payer, program, buffer, authority PublicKey, maxDataLen uint64) (TransactionInstruction, error) {
+	programData, err := impl.ProgramDataAddress(program)
+	if err != nil {
+		return TransactionInstruction{}, err
+	}
+	out := upgradeableLoaderData(upgradeableLoaderDeployWithMaxDataLen, 8)
+	out = binary.LittleEndian.AppendUint64(out, maxDataLen)
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: payer, IsSigner: true, IsWritable: true},
+			{Pubkey: programData, IsWritable: true},
+			{Pubkey: program, IsWritable: true},
+			{Pubkey: buffer, IsWritable: true},
+			{Pubkey: SYSVAR_RENT_PUBKEY},
+			{Pubkey: SYSVAR_CLOCK_PUBKEY},
+			{Pubkey: SystemProgramID},
+			{Pubkey: authority, IsSigner: true},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      out,
+	}, nil
+}
+
+// UpgradeInstruction replaces the program data with the content of the buffer,
+// the lamports of the buffer are sent to spill
+func (impl UpgradeableLoaderImpl) UpgradeInstruction(program, buffer, authority, spill PublicKey) (TransactionInstruction, error) {
+	programData, err := impl.ProgramDataAddress(program)
+	if err != nil {
+		return TransactionInstruction{}, err
+	}
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: programData, IsWritable: true},
+			{Pubkey: program, IsWritable: true},
+			{Pubkey: buffer, IsWritable: true},
+			{Pubkey: spill, IsWritable: true},
+			{Pubkey: SYSVAR_RENT_PUBKEY},
+			{Pubkey: SYSVAR_CLOCK_PUBKEY},
+			{Pubkey: authority, IsSigner: true},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      upgradeableLoaderData(upgradeableLoaderUpgrade, 0),
+	}, nil
+}
+
+// SetAuthorityInstruction sets the authority of a buffer or a ProgramData account,
+// a nil newAuthority makes the buffer/program immutable
+func (impl UpgradeableLoaderImpl) SetAuthorityInstruction(account, currentAuthority PublicKey, newAuthority *PublicKey) TransactionInstruction {
+	keys := []AccountMeta{
+		{Pubkey: account, IsWritable: true},
+		{Pubkey: currentAuthority, IsSigner: true},
+	}
+	if newAuthority != nil {
+		keys = append(keys, AccountMeta{Pubkey: *newAuthority})
+	}
+	return TransactionInstruction{
+		Keys:      keys,
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      upgradeableLoaderData(upgradeableLoaderSetAuthority, 0),
+	}
+}
+
+// SetAuthorityCheckedInstruction sets the authority of a buffer or a ProgramData account,
+// the new authority must sign
+func (impl UpgradeableLoaderImpl) SetAuthorityCheckedInstruction(account, currentAuthority, newAuthority PublicKey) TransactionInstruction {
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: account, IsWritable: true},
+			{Pubkey: currentAuthority, IsSigner: true},
+			{Pubkey: newAuthority, IsSigner: true},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      upgradeableLoaderData(upgradeableLoaderSetAuthorityChecked, 0),
+	}
+}
+
+// CloseInstruction closes a buffer, an uninitialized account or a ProgramData account and sends its lamports to recipient.
+// authority is nil for uninitialized accounts, program is only required when closing a ProgramData account
+func (impl UpgradeableLoaderImpl) CloseInstruction(account, recipient PublicKey, authority, program *PublicKey) TransactionInstruction {
+	keys := []AccountMeta{
+		{Pubkey: account, IsWritable: true},
+		{Pubkey: recipient, IsWritable: true},
+	}
+	if authority != nil {
+		keys = append(keys, AccountMeta{Pubkey: *authority, IsSigner: true})
+	}
+	if program != nil {
+		keys = append(keys, AccountMeta{Pubkey: *program, IsWritable: true})
+	}
+	return TransactionInstruction{
+		Keys:      keys,
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      upgradeableLoaderData(upgradeableLoaderClose, 0),
+	}
+}
+
+// ExtendProgramInstruction extends the ProgramData account by additionalBytes, the rent is paid by payer
+func (impl UpgradeableLoaderImpl) ExtendProgramInstruction(program, payer PublicKey, additionalBytes uint32) (TransactionInstruction, error) {
+	programData, err := impl.ProgramDataAddress(program)
+	if err != nil {
+		return TransactionInstruction{}, err
+	}
+	out := upgradeableLoaderData(upgradeableLoaderExtendProgram, 4)
+	out = binary.LittleEndian.AppendUint32(out, additionalBytes)
+	return TransactionInstruction{
+		Keys: []AccountMeta{
+			{Pubkey: programData, IsWritable: true},
+			{Pubkey: program, IsWritable: true},
+			{Pubkey: SystemProgramID},
+			{Pubkey: payer, IsSigner: true, IsWritable: true},
+		},
+		ProgramId: BPFLoaderUpgradeableProgramID,
+		Data:      out,
+	}, nil
+}
+
+// WriteChunkSize returns the maximum size of the program bytes of a Write instruction,
+// so that the transaction fits in a packet
+func (impl UpgradeableLoaderImpl) WriteChunkSize(payer, authority PublicKey) int {
+	signers := 1
+	if !payer.Equals(authority) {
+		signers = 2
+	}
+	overhead := 1 + signers*SignatureLengthInBytes + // signatures
+		3 + // message header
+		1 + (signers+2)*32 + // account keys: signers, buffer, loader
+		32 + // recent blockhash
+		1 + 1 + 1 + 2 + 2 + // instruction count, program index, accounts, data length
+		4 + 4 + 8 // tag, offset, bytes length
+	return MaxTransactionBytes - overhead
+}
+
+// DeployOptions options of the upgradeable loader deployer
+type DeployOptions struct {
+	// Commitment defaults to confirmed
+	Commitment *Commitment
+	// ChunkSize the size of the program bytes per Write transaction, defaults to the maximum
+	ChunkSize int
+	// Concurrency the number of Write transactions in flight, defaults to 8
+	Concurrency int
+	// MaxRetries the number of retries of a Write transaction and of the verification rounds, defaults to 5
+	MaxRetries int
+	// MaxDataLen the maximum size of the program when deploying, defaults to twice the program size
+	MaxDataLen int
+	// Progress is called after each chunk is written, written includes the chunks already present on chain
+	Progress func(written, total int)
+}
+
+func (opts DeployOptions) withDefaults() DeployOptions {
+	if opts.Commitment == nil {
+		opts.Commitment = &CommitmentConfirmed
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 8
+	}
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = 5
+	}
+	return opts
+}
+
+func (opts DeployOptions) confirmOptions() ConfirmOptions {
+	return ConfirmOptions{
+		SkipPreflight:       Ref(false),
+		PreflightCommitment: opts.Commitment,
+		Commitment:          opts.Commitment,
+	}
+}
+
+// blockhashCache shares a recent blockhash between the concurrent Write transactions
+type blockhashCache struct {
+	mu        sync.Mutex
+	conn      *Connection
+	commit    *Commitment
+	value     BlockhashWithExpiryBlockHeight
+	fetchedAt time.Time
+}
+
+func (c *blockhashCache) get(refresh bool) (BlockhashWithExpiryBlockHeight, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !refresh && !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < 20*time.Second {
+		return c.value, nil
+	}
+	value, err := c.conn.GetLatestBlockhash(GetLatestBlockhashConfig{Commitment: c.commit})
+	if err != nil {
+		return BlockhashWithExpiryBlockHeight{}, err
+	}
+	c.value, c.fetchedAt = value, time.Now()
+	return value, nil
+}
+
+// sendSigned signs the transaction with a shared blockhash and waits for its confirmation, it retries with a new blockhash
+func sendSigned(ctx context.Context, conn *Connection, blockhashes *blockhashCache, ins []TransactionInstruction, payer PublicKey, signers []Signer, opts DeployOptions) (TransactionSignature, error) {
+	var lastErr error
+	for attempt := 0; attempt <= opts.MaxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Duration(attempt) * 500 * time.Millisecond):
+			}
+		}
+		blockhash, err := blockhashes.get(attempt > 0)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		tx := NewTransactionWithBlock(blockhash.Blockhash, blockhash.LastValidBlockHeight)
+		tx.AddInstructions(ins...)
+		tx.SetFeePayer(payer)
+		if err := tx.Sign(signers...); err != nil {
+			return "", err
+		}
+		signature, err := conn.SendAndConfirmTransaction(ctx, *tx, nil, opts.confirmOptions())
+		if err == nil {
+			return signature, nil
+		}
+		lastErr = err
+	}
+	return "", lastErr
+}
+
+// WriteBuffer writes the program to the buffer account.
+// The buffer is created when it does not exist, otherwise only the chunks which differ from the on-chain bytes are written,
+// so an interrupted deployment can be resumed with the same buffer.
+// After writing, the buffer is read back and the mismatching chunks are written again.
+func (impl UpgradeableLoaderImpl) WriteBuffer(ctx context.Context, conn *Connection, payer, buffer, authority Signer, program []byte, opts DeployOptions) error {
+	opts = opts.withDefaults()
+	if len(program) == 0 {
+		return errors.New("empty program")
+	}
+	size := UpgradeableLoaderBufferMetadataSize + len(program)
+	info, err := conn.GetAccountInfo(buffer.PublicKey(), GetAccountInfoConfig{Commitment: opts.Commitment})
+	if err != nil {
+		return err
+	}
+	blockhashes := &blockhashCache{conn: conn, commit: opts.Commitment}
+	var onChain []byte
+	if info == nil {
+		lamports, err := conn.GetMinimumBalanceForRentExemption(size, opts.Commitment)
+		if err != nil {
+			return err
+		}
+		createIns, err := instructionToTransactionInstruction2(system.NewCreateAccountInstruction(
+			lamports, uint64(size), BPFLoaderUpgradeableProgramID.D(), payer.PublicKey().D(), buffer.PublicKey().D(),
+		).Build())
+		if err != nil {
+			return err
+		}
+		_, err = sendSigned(ctx, conn, blockhashes, []TransactionInstruction{
+			*createIns,
+			impl.InitializeBufferInstruction(buffer.PublicKey(), authority.PublicKey()),
+		}, payer.PublicKey(), []Signer{payer, buffer}, opts)
+		if err != nil {
+			return fmt.Errorf("unable to create the buffer: %w", err)
+		}
+	} else {
+		if !info.Owner.Equals(BPFLoaderUpgradeableProgramID) {
+			return fmt.Errorf("buffer %s is not owned by the upgradeable loader", buffer.PublicKey())
+		}
+		current, err := UpgradeableLoaderBufferFromAccountData(info.Data.Content)
+		if err != nil {
+			return fmt.Errorf("buffer %s: %w", buffer.PublicKey(), err)
+		}
+		if current.Authority == nil || !current.Authority.Equals(authority.PublicKey()) {
+			return fmt.Errorf("buffer %s: authority mismatch", buffer.PublicKey())
+		}
+		if len(info.Data.Content) != size {
+			return fmt.Errorf("buffer %s: size %d, expected %d, close it and write a new buffer", buffer.PublicKey(), len(info.Data.Content), size)
+		}
+		onChain = current.Data
+	}
+
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = impl.WriteChunkSize(payer.PublicKey(), authority.PublicKey())
+	}
+	total := (len(program) + chunkSize - 1) / chunkSize
+	signers := []Signer{payer, authority}
+	for round := 0; ; round++ {
+		var pending []int
+		for i := 0; i < total; i++ {
+			start, end := i*chunkSize, min((i+1)*chunkSize, len(program))
+			if onChain == nil || !bytes.Equal(onChain[start:end], program[start:end]) {
+				pending = append(pending, i)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if round > opts.MaxRetries {
+			return fmt.Errorf("buffer %s: %d chunks still differ after %d rounds", buffer.PublicKey(), len(pending), round)
+		}
+
+		var (
+			wg              sync.WaitGroup
+			mu              sync.Mutex
+			errs            []error
+			written         = total - len(pending)
+			sem             = make(chan struct{}, opts.Concurrency)
+			writeCtx, abort = context.WithCancel(ctx)
+		)
+		for _, i := range pending {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				start, stop := i*chunkSize, min((i+1)*chunkSize, len(program))
+				ins := impl.WriteInstruction(buffer.PublicKey(), authority.PublicKey(), uint32(start), program[start:stop])
+				_, err := sendSigned(writeCtx, conn, blockhashes, []TransactionInstruction{ins}, payer.PublicKey(), signers, opts)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("chunk %d (offset %d): %w", i, start, err))
+					abort()
+					return
+				}
+				written++
+				if opts.Progress != nil {
+					opts.Progress(written, total)
+				}
+			}(i)
+		}
+		wg.Wait()
+		abort()
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+
+		info, err := conn.GetAccountInfo(buffer.PublicKey(), GetAccountInfoConfig{Commitment: opts.Commitment})
+		if err != nil {
+			return err
+		}
+		if info == nil || len(info.Data.Content) != size {
+			return fmt.Errorf("buffer %s: unexpected account after write", buffer.PublicKey())
+		}
+		onChain = info.Data.Content[UpgradeableLoaderBufferMetadataSize:]
+	}
+}
+
+// Deploy writes the program to the buffer and deploys it to a new program account,
+// authority is both the buffer authority and the upgrade authority of the program
+func (impl UpgradeableLoaderImpl) Deploy(ctx context.Context, conn *Connection, payer, program, buffer, authority Signer, data []byte, opts DeployOptions) (TransactionSignature, error) {
+	opts = opts.withDefaults()
+	if err := impl.WriteBuffer(ctx, conn, payer, buffer, authority, data, opts); err != nil {
+		return "", err
+	}
+	maxDataLen := opts.MaxDataLen
+	if maxDataLen <= 0 {
+		maxDataLen = 2 * len(data)
+	}
+	if maxDataLen < len(data) {
+		return "", fmt.Errorf("max data len %d is smaller than the program (%d bytes)", maxDataLen, len(data))
+	}
+	lamports, err := conn.GetMinimumBalanceForRentExemption(UpgradeableLoaderProgramSize, opts.Commitment)
+	if err != nil {
+		return "", err
+	}
+	createIns, err := instructionToTransactionInstruction2(system.NewCreateAccountInstruction(
+		lamports, UpgradeableLoaderProgramSize, BPFLoaderUpgradeableProgramID.D(), payer.PublicKey().D(), program.PublicKey().D(),
+	).Build())
+	if err != nil {
+		return "", err
+	}
+	deployIns, err := impl.DeployWithMaxDataLenInstruction(payer.PublicKey(), program.PublicKey(), buffer.PublicKey(), authority.PublicKey(), uint64(maxDataLen))
+	if err != nil {
+		return "", err
+	}
+	blockhashes := &blockhashCache{conn: conn, commit: opts.Commitment}
+	return sendSigned(ctx, conn, blockhashes, []TransactionInstruction{*createIns, deployIns},
+		payer.PublicKey(), []Signer{payer, program, authority}, opts)
+}
+
+// Upgrade writes the program to the buffer and upgrades the program,
+// the ProgramData account is extended first when the new program does not fit.
+// The lamports of the buffer are sent to spill
+func (impl UpgradeableLoaderImpl) Upgrade(ctx context.Context, conn *Connection, payer Signer, programId PublicKey, buffer, authority Signer, data []byte, spill PublicKey, opts DeployOptions) (TransactionSignature, error) {
+	opts = opts.withDefaults()
+	programData, err := impl.ProgramDataAddress(programId)
+	if err != nil {
+		return "", err
+	}
+	info, err := conn.GetAccountInfo(programData, GetAccountInfoConfig{
+		Commitment: opts.Commitment,
+		DataSlice:  &DataSlice{Offset: Ref[uint64](0), Length: Ref[uint64](0)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if info == nil {
+		return "", fmt.Errorf("program %s is not deployed with the upgradeable loader", programId)
+	}
+	space := int(info.Space)
+	if space == 0 {
+		// the node does not return the space of the account
+		if info, err = conn.GetAccountInfo(programData, GetAccountInfoConfig{Commitment: opts.Commitment}); err != nil {
+			return "", err
+		}
+		space = len(info.Data.Content)
+	}
+	if err := impl.WriteBuffer(ctx, conn, payer, buffer, authority, data, opts); err != nil {
+		return "", err
+	}
+
+	var instructions []TransactionInstruction
+	if current := space - UpgradeableLoaderProgramDataMetadataSize; current < len(data) {
+		extendIns, err := impl.ExtendProgramInstruction(programId, payer.PublicKey(), uint32(len(data)-current))
+		if err != nil {
+			return "", err
+		}
+		instructions = append(instructions, extendIns)
+	}
+	upgradeIns, err := impl.UpgradeInstruction(programId, buffer.PublicKey(), authority.PublicKey(), spill)
+	if err != nil {
+		return "", err
+	}
+	instructions = append(instructions, upgradeIns)
+	blockhashes := &blockhashCache{conn: conn, commit: opts.Commitment}
+	return sendSigned(ctx, conn, blockhashes, instructions, payer.PublicKey(), []Signer{payer, authority}, opts)
+}
+
+// SetUpgradeAuthority transfers the upgrade authority of a program, a nil newAuthority makes the program immutable
+func (impl UpgradeableLoaderImpl) SetUpgradeAuthority(ctx context.Context, conn *Connection, payer Signer, programId PublicKey, currentAuthority Signer, newAuthority *PublicKey, opts DeployOptions) (TransactionSignature, error) {
+	programData, err := impl.ProgramDataAddress(programId)
+	if err != nil {
+		return "", err
+	}
+	return impl.setAuthority(ctx, conn, payer, programData, currentAuthority, newAuthority, opts)
+}
+
+// SetBufferAuthority transfers the authority of a buffer
+func (impl UpgradeableLoaderImpl) SetBufferAuthority(ctx context.Context, conn *Connection, payer Signer, buffer PublicKey, currentAuthority Signer, newAuthority PublicKey, opts DeployOptions) (TransactionSignature, error) {
+	return impl.setAuthority(ctx, conn, payer, buffer, currentAuthority, &newAuthority, opts)
+}
+
+func (impl UpgradeableLoaderImpl) setAuthority(ctx context.Context, conn *Connection, payer Signer, account PublicKey, currentAuthority Signer, newAuthority *PublicKey, opts DeployOptions) (TransactionSignature, error) {
+	opts = opts.withDefaults()
+	ins := impl.SetAuthorityInstruction(account, currentAuthority.PublicKey(), newAuthority)
+	blockhashes := &blockhashCache{conn: conn, commit: opts.Commitment}
+	return sendSigned(ctx, conn, blockhashes, []TransactionInstruction{ins}, payer.PublicKey(), []Signer{payer, currentAuthority}, opts)
+}
+
+// CloseBuffer closes a buffer and sends its lamports to recipient
+func (impl UpgradeableLoaderImpl) CloseBuffer(ctx context.Context, conn *Connection, payer Signer, buffer PublicKey, authority Signer, recipient PublicKey, opts DeployOptions) (TransactionSignature, error) {
+	opts = opts.withDefaults()
+	authorityKey := authority.PublicKey()
+	ins := impl.CloseInstruction(buffer, recipient, &authorityKey, nil)
+	blockhashes := &blockhashCache{conn: conn, commit: opts.Commitment}
+	return sendSigned(ctx, conn, blockhashes, []TransactionInstruction{ins}, payer.PublicKey(), []Signer{payer, authority}, opts)
+}
@@ -0,0 +1,147 @@
+package web3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// Account state tags of the BPF upgradeable loader (bincode u32)
+const (
+	UpgradeableLoaderStateUninitialized uint32 = iota
+	UpgradeableLoaderStateBuffer
+	UpgradeableLoaderStateProgram
+	UpgradeableLoaderStateProgramData
+)
+
+// UpgradeableLoaderBuffer a buffer account of the BPF upgradeable loader
+type UpgradeableLoaderBuffer struct {
+	// nil if the buffer is immutable
+	Authority *PublicKey
+	// the bytes written to the buffer
+	Data []byte
+}
+
+// UpgradeableLoaderProgram a program account of the BPF upgradeable loader
+type UpgradeableLoaderProgram struct {
+	ProgramData PublicKey
+}
+
+// UpgradeableLoaderProgramData a ProgramData account of the BPF upgradeable loader
+type UpgradeableLoaderProgramData struct {
+	// the slot of the last deployment or upgrade
+	Slot uint64
+	// nil if the program is immutable
+	UpgradeAuthority *PublicKey
+	// the program ELF, followed by the zero padding up to the max data length
+	Data []byte
+}
+
+func upgradeableLoaderState(data []byte, tag uint32, size int) error {
+	if len(data) < 4 {
+		return fmt.Errorf("invalid upgradeable loader account: %d bytes", len(data))
+	}
+	if got := binary.LittleEndian.Uint32(data); got != tag {
+		return fmt.Errorf("invalid upgradeable loader account: state %d, expected %d", got, tag)
+	}
+	if len(data) < size {
+		return fmt.Errorf("invalid upgradeable loader account: %d bytes, expected at least %d", len(data), size)
+	}
+	return nil
+}
+
+func upgradeableLoaderAuthority(data []byte) *PublicKey {
+	if data[0] == 0 {
+		return nil
+	}
+	authority := NewPublicKeyFromBs(data[1:33])
+	return &authority
+}
+
+// UpgradeableLoaderBufferFromAccountData Deserialize UpgradeableLoaderBuffer from the account data.
+func UpgradeableLoaderBufferFromAccountData(data []byte) (*UpgradeableLoaderBuffer, error) {
+	if err := upgradeableLoaderState(data, UpgradeableLoaderStateBuffer, UpgradeableLoaderBufferMetadataSize); err != nil {
+		return nil, err
+	}
+	return &UpgradeableLoaderBuffer{
+		Authority: upgradeableLoaderAuthority(data[4:]),
+		Data:      data[UpgradeableLoaderBufferMetadataSize:],
+	}, nil
+}
+
+// UpgradeableLoaderProgramFromAccountData Deserialize UpgradeableLoaderProgram from the account data.
+func UpgradeableLoaderProgramFromAccountData(data []byte) (*UpgradeableLoaderProgram, error) {
+	if err := upgradeableLoaderState(data, UpgradeableLoaderStateProgram, UpgradeableLoaderProgramSize); err != nil {
+		return nil, err
+	}
+	return &UpgradeableLoaderProgram{ProgramData: NewPublicKeyFromBs(data[4:36])}, nil
+}
+
+// UpgradeableLoaderProgramDataFromAccountData Deserialize UpgradeableLoaderProgramData from the account data.
+// The data may be truncated to the metadata, e.g. when it is fetched with a DataSlice
+func UpgradeableLoaderProgramDataFromAccountData(data []byte) (*UpgradeableLoaderProgramData, error) {
+	if err := upgradeableLoaderState(data, UpgradeableLoaderStateProgramData, UpgradeableLoaderProgramDataMetadataSize); err != nil {
+		return nil, err
+	}
+	return &UpgradeableLoaderProgramData{
+		Slot:             binary.LittleEndian.Uint64(data[4:]),
+		UpgradeAuthority: upgradeableLoaderAuthority(data[12:]),
+		Data:             data[UpgradeableLoaderProgramDataMetadataSize:],
+	}, nil
+}
+
+// UpgradeableProgram a program deployed with the BPF upgradeable loader
+type UpgradeableProgram struct {
+	ProgramId   PublicKey
+	ProgramData PublicKey
+	// nil if the program is immutable
+	UpgradeAuthority *PublicKey
+	// the slot of the last deployment or upgrade
+	LastDeploySlot uint64
+	// the program ELF with the zero padding, empty when the program was fetched without data
+	Data []byte
+}
+
+// IsImmutable returns true if the program can not be upgraded anymore
+func (p *UpgradeableProgram) IsImmutable() bool {
+	return p.UpgradeAuthority == nil
+}
+
+// Executable returns the program ELF without the trailing zero padding
+func (p *UpgradeableProgram) Executable() []byte {
+	return bytes.TrimRight(p.Data, "\x00")
+}
+
+// Hash returns the hex sha256 of the executable, the same hash as `solana-verify get-program-hash`
+func (p *UpgradeableProgram) Hash() string {
+	sum := sha256.Sum256(p.Executable())
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches returns true if the on-chain program is the ELF, the trailing zero padding is ignored
+func (p *UpgradeableProgram) Matches(elf []byte) bool {
+	return len(p.Data) > 0 && bytes.Equal(p.Executable(), bytes.TrimRight(elf, "\x00"))
+}
+
+// MatchesFile compares the on-chain program with a local .so file
+func (p *UpgradeableProgram) MatchesFile(path string) (bool, error) {
+	elf, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	if len(p.Data) == 0 {
+		return false, fmt.Errorf("program %s was fetched without data", p.ProgramId)
+	}
+	return p.Matches(elf), nil
+}
+
+// Dump writes the program data to a file, like `solana program dump`
+func (p *UpgradeableProgram) Dump(path string) error {
+	if len(p.Data) == 0 {
+		return fmt.Errorf("program %s was fetched without data", p.ProgramId)
+	}
+	return os.WriteFile(path, p.Data, 0644)
+}
@@ -0,0 +1,80 @@
+package web3
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUpgradeableLoaderWriteChunkSize(t *testing.T) {
+	payer := Keypair.Generate()
+	authority := Keypair.Generate()
+	buffer := Keypair.Generate().PublicKey()
+	for _, signers := range [][]Signer{{payer}, {payer, authority}} {
+		chunkSize := UpgradeableLoader.WriteChunkSize(payer.PublicKey(), signers[len(signers)-1].PublicKey())
+		ins := UpgradeableLoader.WriteInstruction(buffer, signers[len(signers)-1].PublicKey(), 1024, make([]byte, chunkSize))
+		if !bytes.Equal(ins.Data[:16], []byte{1, 0, 0, 0, 0, 4, 0, 0, byte(chunkSize), byte(chunkSize >> 8), 0, 0, 0, 0, 0, 0}) {
+			t.Fatalf("unexpected instruction data %v", ins.Data[:16])
+		}
+		tx := NewTransactionWithBlock("EkSnNWid2cvwEVnVx9aBqawnmiCNiDgp3gUdkDPTKN1N", 1)
+		tx.AddInstructions(ins)
+		tx.SetFeePayer(payer.PublicKey())
+		if err := tx.Sign(signers...); err != nil {
+			t.Fatal(err)
+		}
+		wire, err := tx.Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(wire) != MaxTransactionBytes {
+			t.Fatalf("%d signers: transaction size %d, expected %d", len(signers), len(wire), MaxTransactionBytes)
+		}
+	}
+}
+
+func TestUpgradeableLoaderAccounts(t *testing.T) {
+	authority := Keypair.Generate().PublicKey()
+	data := make([]byte, UpgradeableLoaderBufferMetadataSize+3)
+	data[0], data[4] = 1, 1
+	copy(data[5:], authority[:])
+	buffer, err := UpgradeableLoaderBufferFromAccountData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buffer.Authority == nil || !buffer.Authority.Equals(authority) || len(buffer.Data) != 3 {
+		t.Fatalf("unexpected buffer %v", buffer)
+	}
+	data[4] = 0
+	if buffer, err := UpgradeableLoaderBufferFromAccountData(data); err != nil || buffer.Authority != nil {
+		t.Fatalf("expected an immutable buffer, got %v %v", buffer, err)
+	}
+	data[0] = 3
+	if _, err := UpgradeableLoaderBufferFromAccountData(data); err == nil {
+		t.Fatal("expected an error for a ProgramData account")
+	}
+
+	program := make([]byte, UpgradeableLoaderProgramSize)
+	program[0] = 2
+	copy(program[4:], authority[:])
+	if got, err := UpgradeableLoaderProgramFromAccountData(program); err != nil || !got.ProgramData.Equals(authority) {
+		t.Fatalf("unexpected program %v %v", got, err)
+	}
+
+	programData := make([]byte, UpgradeableLoaderProgramDataMetadataSize+6)
+	programData[0], programData[4], programData[12] = 3, 9, 1
+	copy(programData[13:], authority[:])
+	copy(programData[UpgradeableLoaderProgramDataMetadataSize:], "\x7fELF")
+	state, err := UpgradeableLoaderProgramDataFromAccountData(programData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Slot != 9 || state.UpgradeAuthority == nil || !state.UpgradeAuthority.Equals(authority) {
+		t.Fatalf("unexpected program data %v", state)
+	}
+	if _, err := UpgradeableLoaderProgramDataFromAccountData(programData[:UpgradeableLoaderProgramDataMetadataSize-1]); err == nil {
+		t.Fatal("expected an error for truncated data")
+	}
+	info := UpgradeableProgram{Data: state.Data}
+	if !info.Matches([]byte("\x7fELF")) || info.Matches([]byte("\x7fELG")) {
+		t.Fatal("unexpected match result")
+	}
+}
@@ -0,0 +1,515 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/das"
+	bubblegum "github.com/donutnomad/solana-web3/mpl_bubblegum"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	cmp "github.com/donutnomad/solana-web3/spl_account_compression"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"slices"
+)
+
+var (
+	InvalidTreeSizeErr    = errors.New("invalid max depth, max buffer size or canopy depth of the merkle tree")
+	MerkleTreeNotFoundErr = errors.New("the merkle tree not found")
+	TreeConfigNotFoundErr = errors.New("the tree config of the merkle tree not found")
+	StaleProofErr         = errors.New("the proof does not match a recent root of the merkle tree")
+	NotLeafAuthorityErr   = errors.New("the authority is neither the owner nor the delegate of the compressed NFT")
+	CompressedCreatorErr  = errors.New("the creator is not an unverified creator of the compressed NFT")
+	NotCompressedNftErr   = errors.New("the asset is not a compressed NFT")
+	LeafMetadataErr       = errors.New("the metadata does not match the data hash or the creator hash of the compressed NFT")
+)
+
+// CompressedNft a compressed NFT of a Bubblegum tree with the proof of its leaf, supplied by the caller or by a DAS API
+type CompressedNft struct {
+	Tree web3.PublicKey
+	bubblegum.LeafSchema
+	// the root of the proof, a recent root of the tree
+	Root [32]byte
+	// the full proof from the leaf, the nodes cached by the canopy of the tree are not passed to the instructions
+	Proof [][32]byte
+}
+
+// Index the index of the leaf in the tree, the nonce of a leaf minted by Bubblegum
+func (c *CompressedNft) Index() uint32 {
+	return uint32(c.Nonce)
+}
+
+// NewCompressedNft the compressed NFT of the asset and of its proof returned by a DAS API
+func NewCompressedNft(asset *das.Asset, proof *das.AssetProof) (*CompressedNft, error) {
+	if !asset.IsCompressed() {
+		return nil, NotCompressedNftErr
+	}
+	compression := asset.Compression
+	var delegate = asset.Ownership.Owner
+	if asset.Ownership.Delegate != nil {
+		delegate = *asset.Ownership.Delegate
+	}
+	nft := &CompressedNft{
+		Tree: *compression.Tree,
+		LeafSchema: bubblegum.LeafSchema{
+			Id:          asset.Id,
+			Owner:       asset.Ownership.Owner,
+			Delegate:    delegate,
+			Nonce:       compression.LeafId,
+			DataHash:    compression.DataHash,
+			CreatorHash: compression.CreatorHash,
+		},
+		Root:  proof.Root,
+		Proof: proof.Nodes(),
+	}
+	if proof.TreeId != nft.Tree || proof.LeafIndex() != nft.Index() || proof.Leaf != nft.Hash() {
+		return nil, fmt.Errorf("%w: the proof does not match the asset", StaleProofErr)
+	}
+	return nft, nil
+}
+
+// GetCompressedNft gets the compressed NFT and its proof by a DAS API
+func (m metaPlex) GetCompressedNft(ctx context.Context, client *das.Client, id web3.PublicKey) (_ *CompressedNft, err error) {
+	defer Recover(&err)
+
+	asset := Must1(client.GetAsset(ctx, id))
+	proof := Must1(client.GetAssetProof(ctx, id))
+	return NewCompressedNft(asset, proof)
+}
+
+// GetCreateTreeInstructions Get the instructions to allocate the merkle tree account and create its TreeConfig
+// @param merkleTree A new account, it signs the transaction
+// @param canopyDepth The cached top levels of the tree, the proofs passed to the instructions are shorter by the canopy depth
+// @param public Anyone can mint to a public tree, otherwise only the tree creator or the tree delegate
+func (m metaPlex) GetCreateTreeInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, merkleTree, treeCreator web3.PublicKey,
+	maxDepth, maxBufferSize, canopyDepth uint32,
+	public bool,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if !cmp.IsValidDepthSizePair(maxDepth, maxBufferSize) || canopyDepth >= maxDepth {
+		return nil, fmt.Errorf("%w: %d, %d, %d", InvalidTreeSizeErr, maxDepth, maxBufferSize, canopyDepth)
+	}
+	size := cmp.GetConcurrentMerkleTreeAccountSize(maxDepth, maxBufferSize, canopyDepth)
+	lamports := Must1(connection.GetMinimumBalanceForRentExemption(int(size), &commitment))
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInstructionAny(system.NewCreateAccountInstruction(lamports, size, cmp.ProgramID.D(), payer.D(), merkleTree.D()).Build()))
+	Must(tx.AddInsBuilder(bubblegum.NewCreateTreeInstruction(
+		maxDepth,
+		maxBufferSize,
+		&public,
+		Must1(bubblegum.FindTreeConfigAddress(merkleTree)),
+		merkleTree,
+		payer,
+		treeCreator,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// CreateTree creates a merkle tree of Bubblegum, see GetCreateTreeInstructions
+func (m metaPlex) CreateTree(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, merkleTree, treeCreator web3.Signer,
+	maxDepth, maxBufferSize, canopyDepth uint32,
+	public bool,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetCreateTreeInstructions(ctx, connection, payer.PublicKey(), merkleTree.PublicKey(), treeCreator.PublicKey(), maxDepth, maxBufferSize, canopyDepth, public, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, merkleTree, treeCreator}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetTreeConfig fetches the TreeConfig of the merkle tree
+func (m metaPlex) GetTreeConfig(
+	ctx context.Context,
+	connection *web3.Connection,
+	merkleTree web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *bubblegum.TreeConfig, err error) {
+	defer Recover(&err)
+
+	info := Must1(connection.GetAccountInfo(Must1(bubblegum.FindTreeConfigAddress(merkleTree)), web3.GetAccountInfoConfig{Commitment: &commitment}))
+	if info == nil || info.Owner != bubblegum.ProgramID {
+		return nil, TreeConfigNotFoundErr
+	}
+	return decodeObject[*bubblegum.TreeConfig](info.Data.Content)
+}
+
+// GetMintCompressedNftInstructions Get the instructions to mint a compressed NFT to the owner.
+// The leaf is computed from the number of minted NFTs of the tree, it is invalid if another mint lands first
+// @param treeDelegate The tree creator or the tree delegate, any signer for a public tree
+func (m metaPlex) GetMintCompressedNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, treeDelegate, merkleTree, owner web3.PublicKey,
+	metadata bubblegum.MetadataArgs,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, _ *bubblegum.LeafSchema, err error) {
+	defer Recover(&err)
+
+	config := Must1(m.GetTreeConfig(ctx, connection, merkleTree, commitment))
+	leaf := Must1(bubblegum.NewLeafSchema(merkleTree, config.NumMinted, owner, owner, &metadata))
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(bubblegum.NewMintV1Instruction(
+		metadata,
+		Must1(bubblegum.FindTreeConfigAddress(merkleTree)),
+		owner,
+		owner,
+		merkleTree,
+		payer,
+		treeDelegate,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)))
+	return tx.ExportIns(), leaf, nil
+}
+
+// GetMintCompressedNftToCollectionInstructions Get the instructions to mint a compressed NFT verified in the sized collection,
+// see GetMintCompressedNftInstructions
+// @param collectionAuthority The update authority of the collection, or an approved collection authority (CollectionAuthorityRecord)
+func (m metaPlex) GetMintCompressedNftToCollectionInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, treeDelegate, merkleTree, owner, collectionMint, collectionAuthority web3.PublicKey,
+	metadata bubblegum.MetadataArgs,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, _ *bubblegum.LeafSchema, err error) {
+	defer Recover(&err)
+
+	collection := Must1(mtm.FetchMetadata(ctx, connection, collectionMint, &commitment))
+	if collection == nil {
+		return nil, nil, CollectionNotFoundErr
+	}
+	var record = bubblegum.ProgramID
+	if collectionAuthority != collection.UpdateAuthority {
+		record = Must1(mtm.FindCollectionAuthorityRecordAddress(collectionMint, collectionAuthority))
+	}
+	config := Must1(m.GetTreeConfig(ctx, connection, merkleTree, commitment))
+	// the collection is verified by the instruction
+	metadata.Collection = &bubblegum.Collection{Key: collectionMint}
+	verified := metadata
+	verified.Collection = &bubblegum.Collection{Key: collectionMint, Verified: true}
+	leaf := Must1(bubblegum.NewLeafSchema(merkleTree, config.NumMinted, owner, owner, &verified))
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(bubblegum.NewMintToCollectionV1Instruction(
+		metadata,
+		Must1(bubblegum.FindTreeConfigAddress(merkleTree)),
+		owner,
+		owner,
+		merkleTree,
+		payer,
+		treeDelegate,
+		collectionAuthority,
+		record,
+		collectionMint,
+		Must1(mtm.FindAssociatedAddress(collectionMint)),
+		Must1(mtm.FindMasterEditionAddress(collectionMint)),
+		Must1(bubblegum.FindBubblegumSignerAddress()),
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		mtm.ProgramID,
+		web3.SystemProgramID,
+	)))
+	return tx.ExportIns(), leaf, nil
+}
+
+// MintCompressedNft mints a compressed NFT to the owner, verified in the collection if collectionAuthority is not nil.
+// Returns the signature and the leaf of the NFT
+func (m metaPlex) MintCompressedNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, treeDelegate web3.Signer,
+	collectionAuthority web3.Signer,
+	merkleTree, owner web3.PublicKey,
+	metadata bubblegum.MetadataArgs,
+	confirmOptions web3.ConfirmOptions,
+) (_ web3.TransactionSignature, _ *bubblegum.LeafSchema, err error) {
+	defer Recover(&err)
+
+	commitment := commitmentOrDefault(confirmOptions.Commitment)
+	var signers = []web3.Signer{payer, treeDelegate}
+	var instructions []web3.TransactionInstruction
+	var leaf *bubblegum.LeafSchema
+	if collectionAuthority != nil {
+		if metadata.Collection == nil {
+			return "", nil, CollectionNotFoundErr
+		}
+		signers = append(signers, collectionAuthority)
+		instructions, leaf = Must2(m.GetMintCompressedNftToCollectionInstructions(ctx, connection, payer.PublicKey(), treeDelegate.PublicKey(), merkleTree, owner, metadata.Collection.Key, collectionAuthority.PublicKey(), metadata, commitment))
+	} else {
+		instructions, leaf = Must2(m.GetMintCompressedNftInstructions(ctx, connection, payer.PublicKey(), treeDelegate.PublicKey(), merkleTree, owner, metadata, commitment))
+	}
+	signers = DeDupBy(signers, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	signature := Must1(sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions))
+	return signature, leaf, nil
+}
+
+// compressedProofAccounts verifies the proof of the NFT against the merkle tree account,
+// returns the proof accounts without the nodes cached by the canopy
+func compressedProofAccounts(
+	ctx context.Context,
+	connection *web3.Connection,
+	nft *CompressedNft,
+	commitment web3.Commitment,
+) ([]*web3.AccountMeta, error) {
+	tree, err := cmp.FetchConcurrentMerkleTree(ctx, connection, nft.Tree, &commitment)
+	if err != nil {
+		return nil, err
+	}
+	if tree == nil {
+		return nil, MerkleTreeNotFoundErr
+	}
+	if cmp.ComputeRoot(nft.Hash(), nft.Proof, nft.Index()) != nft.Root {
+		return nil, fmt.Errorf("%w: the proof does not match its root", StaleProofErr)
+	}
+	if !tree.IsRecentRoot(nft.Root) {
+		return nil, StaleProofErr
+	}
+	return bubblegum.ProofAccounts(nft.Proof, tree.CanopyDepth()), nil
+}
+
+// GetTransferCompressedNftInstructions Get the instructions to transfer the compressed NFT to the new owner
+// @param authority The owner or the delegate of the NFT, it signs the transaction
+func (m metaPlex) GetTransferCompressedNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	nft *CompressedNft,
+	authority, newOwner web3.PublicKey,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	proof := Must1(compressedProofAccounts(ctx, connection, nft, commitment))
+	transfer := bubblegum.NewTransferInstruction(
+		nft.Root,
+		nft.DataHash,
+		nft.CreatorHash,
+		nft.Nonce,
+		nft.Index(),
+		Must1(bubblegum.FindTreeConfigAddress(nft.Tree)),
+		nft.Owner,
+		nft.Delegate,
+		newOwner,
+		nft.Tree,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)
+	switch authority {
+	case nft.Owner:
+		transfer.GetLeafOwnerAccount().SIGNER()
+	case nft.Delegate:
+		transfer.GetLeafDelegateAccount().SIGNER()
+	default:
+		return nil, NotLeafAuthorityErr
+	}
+	for _, account := range proof {
+		transfer.AccountMetaSlice.Append(account)
+	}
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(transfer))
+	return tx.ExportIns(), nil
+}
+
+// TransferCompressedNft transfers the compressed NFT, see GetTransferCompressedNftInstructions
+func (m metaPlex) TransferCompressedNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	nft *CompressedNft,
+	newOwner web3.PublicKey,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetTransferCompressedNftInstructions(ctx, connection, nft, authority.PublicKey(), newOwner, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetBurnCompressedNftInstructions Get the instructions to burn the compressed NFT
+// @param authority The owner or the delegate of the NFT, it signs the transaction
+func (m metaPlex) GetBurnCompressedNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	nft *CompressedNft,
+	authority web3.PublicKey,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	proof := Must1(compressedProofAccounts(ctx, connection, nft, commitment))
+	burn := bubblegum.NewBurnInstruction(
+		nft.Root,
+		nft.DataHash,
+		nft.CreatorHash,
+		nft.Nonce,
+		nft.Index(),
+		Must1(bubblegum.FindTreeConfigAddress(nft.Tree)),
+		nft.Owner,
+		nft.Delegate,
+		nft.Tree,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)
+	switch authority {
+	case nft.Owner:
+		burn.GetLeafOwnerAccount().SIGNER()
+	case nft.Delegate:
+		burn.GetLeafDelegateAccount().SIGNER()
+	default:
+		return nil, NotLeafAuthorityErr
+	}
+	for _, account := range proof {
+		burn.AccountMetaSlice.Append(account)
+	}
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(burn))
+	return tx.ExportIns(), nil
+}
+
+// BurnCompressedNft burns the compressed NFT, see GetBurnCompressedNftInstructions
+func (m metaPlex) BurnCompressedNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	nft *CompressedNft,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetBurnCompressedNftInstructions(ctx, connection, nft, authority.PublicKey(), commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetDelegateCompressedNftInstructions Get the instructions to set the delegate of the compressed NFT,
+// the owner signs the transaction. Delegate to the owner to revoke the delegate
+func (m metaPlex) GetDelegateCompressedNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	nft *CompressedNft,
+	newDelegate web3.PublicKey,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	proof := Must1(compressedProofAccounts(ctx, connection, nft, commitment))
+	delegate := bubblegum.NewDelegateInstruction(
+		nft.Root,
+		nft.DataHash,
+		nft.CreatorHash,
+		nft.Nonce,
+		nft.Index(),
+		Must1(bubblegum.FindTreeConfigAddress(nft.Tree)),
+		nft.Owner,
+		nft.Delegate,
+		newDelegate,
+		nft.Tree,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)
+	for _, account := range proof {
+		delegate.AccountMetaSlice.Append(account)
+	}
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(delegate))
+	return tx.ExportIns(), nil
+}
+
+// DelegateCompressedNft sets the delegate of the compressed NFT, see GetDelegateCompressedNftInstructions
+func (m metaPlex) DelegateCompressedNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, owner web3.Signer,
+	nft *CompressedNft,
+	newDelegate web3.PublicKey,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetDelegateCompressedNftInstructions(ctx, connection, nft, newDelegate, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, owner}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetVerifyCompressedCreatorInstructions Get the instructions to verify the creator of the compressed NFT, the creator signs the transaction
+// @param metadata The metadata of the NFT, it must match the data hash of the leaf
+func (m metaPlex) GetVerifyCompressedCreatorInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, creator web3.PublicKey,
+	nft *CompressedNft,
+	metadata bubblegum.MetadataArgs,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if i := slices.IndexFunc(metadata.Creators, func(c bubblegum.Creator) bool { return c.Address == creator }); i < 0 || metadata.Creators[i].Verified {
+		return nil, CompressedCreatorErr
+	}
+	if Must1(bubblegum.HashMetadata(&metadata)) != nft.DataHash || bubblegum.HashCreators(metadata.Creators) != nft.CreatorHash {
+		return nil, LeafMetadataErr
+	}
+	proof := Must1(compressedProofAccounts(ctx, connection, nft, commitment))
+	verify := bubblegum.NewVerifyCreatorInstruction(
+		nft.Root,
+		nft.DataHash,
+		nft.CreatorHash,
+		nft.Nonce,
+		nft.Index(),
+		metadata,
+		Must1(bubblegum.FindTreeConfigAddress(nft.Tree)),
+		nft.Owner,
+		nft.Delegate,
+		nft.Tree,
+		payer,
+		creator,
+		cmp.NoopProgramID,
+		cmp.ProgramID,
+		web3.SystemProgramID,
+	)
+	for _, account := range proof {
+		verify.AccountMetaSlice.Append(account)
+	}
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(verify))
+	return tx.ExportIns(), nil
+}
+
+// VerifyCompressedCreator verifies the creator of the compressed NFT, see GetVerifyCompressedCreatorInstructions
+func (m metaPlex) VerifyCompressedCreator(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, creator web3.Signer,
+	nft *CompressedNft,
+	metadata bubblegum.MetadataArgs,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetVerifyCompressedCreatorInstructions(ctx, connection, payer.PublicKey(), creator.PublicKey(), nft, metadata, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, creator}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
@@ -0,0 +1,442 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// Offsets in the Metadata account, the name (32), the symbol (10) and the uri (200) are padded to their maximum length
+const (
+	MetadataUpdateAuthorityOffset = 1
+	MetadataFirstCreatorOffset    = 1 + 32 + 32 + 4 + 32 + 4 + 10 + 4 + 200 + 2 + 1 + 4
+)
+
+// DefaultCollectionBatchSize the instructions of the collection items in one transaction
+const DefaultCollectionBatchSize = 5
+
+var (
+	CollectionNotFoundErr     = errors.New("the metadata of the collection not found")
+	AlreadySizedCollectionErr = errors.New("the collection is already sized")
+)
+
+// NftArgs the args to create an NFT
+type NftArgs struct {
+	Name                 string
+	Symbol               string
+	Uri                  string
+	SellerFeeBasisPoints uint16
+	Creators             []mtm.Creator
+	// NonFungible (default) or ProgrammableNonFungible
+	TokenStandard mtm.TokenStandard
+	Immutable     bool
+	// the collection mint, the item is created unverified
+	Collection *web3.PublicKey
+	// set for a collection NFT, use NewCollectionDetails_V1(0) for a sized collection
+	CollectionDetails *mtm.CollectionDetails
+	// the rule set of a programmable NFT
+	RuleSet *web3.PublicKey
+	// the print supply of the master edition, Zero if nil
+	PrintSupply *mtm.PrintSupply
+}
+
+// GetCreateNftInstructions Get the instructions to create an NFT by the Create and Mint instructions of Token Metadata:
+// the mint, the metadata and the master edition are created, and one token is minted to the associated token account of the owner
+// @param mint A new account, it signs the transaction
+// @param authority The mint authority and the update authority of the NFT
+func (m metaPlex) GetCreateNftInstructions(
+	payer, mint, authority, owner web3.PublicKey,
+	args NftArgs,
+	options NftOptions,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var printSupply = mtm.NewPrintSupply_Zero()
+	if args.PrintSupply != nil {
+		printSupply = *args.PrintSupply
+	}
+	var decimals uint8 = 0
+	var collection *mtm.Collection
+	if args.Collection != nil {
+		collection = &mtm.Collection{Key: *args.Collection}
+	}
+	var asset = &NftAsset{
+		Mint:          mint,
+		TokenProgram:  web3.TokenProgramID,
+		Metadata:      Must1(mtm.FindAssociatedAddress(mint)),
+		Edition:       Must1(mtm.FindMasterEditionAddress(mint)),
+		TokenStandard: args.TokenStandard,
+		RuleSet:       args.RuleSet,
+	}
+	token := Must1(asset.AssociatedToken(owner))
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+
+	create := mtm.NewCreateInstruction(
+		mtm.NewCreateArgs_V1(mtm.AssetData{
+			Name:                 args.Name,
+			Symbol:               args.Symbol,
+			Uri:                  args.Uri,
+			SellerFeeBasisPoints: args.SellerFeeBasisPoints,
+			Creators:             args.Creators,
+			PrimarySaleHappened:  false,
+			IsMutable:            !args.Immutable,
+			TokenStandard:        args.TokenStandard,
+			Collection:           collection,
+			CollectionDetails:    args.CollectionDetails,
+			RuleSet:              args.RuleSet,
+		}, &decimals, &printSupply),
+		asset.Metadata,
+		asset.Edition,
+		mint,
+		authority,
+		payer,
+		authority,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+	)
+	// the mint is created by the instruction
+	create.GetMintAccount().SIGNER()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(create))
+	Must(tx.AddInsBuilder(mtm.NewMintInstruction(
+		mtm.NewMintArgs_V1(1, options.AuthorizationData),
+		token,
+		owner,
+		asset.Metadata,
+		asset.Edition,
+		Must1(asset.TokenRecord(token)),
+		mint,
+		authority,
+		mtm.ProgramID,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+		web3.SPLAssociatedTokenAccountProgramID,
+		rulesProgram,
+		ruleSet,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// CreateNft creates an NFT and mints it to the owner, see GetCreateNftInstructions
+func (m metaPlex) CreateNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, authority web3.Signer,
+	owner web3.PublicKey,
+	args NftArgs,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetCreateNftInstructions(payer.PublicKey(), mint.PublicKey(), authority.PublicKey(), owner, args, options)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, mint, authority}, instructions, true, confirmOptions)
+}
+
+// CreateCollection creates a sized collection NFT, the collection details of the args are set if absent
+func (m metaPlex) CreateCollection(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, authority web3.Signer,
+	owner web3.PublicKey,
+	args NftArgs,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	if args.CollectionDetails == nil {
+		details := mtm.NewCollectionDetails_V1(0)
+		args.CollectionDetails = &details
+	}
+	args.Collection = nil
+	return m.CreateNft(ctx, connection, payer, mint, authority, owner, args, options, confirmOptions)
+}
+
+// addCollectionVerificationInstruction adds the Verify or Unverify instruction of the item as the collection update authority or its collection delegate
+func (m metaPlex) addCollectionVerificationInstruction(
+	tx *web3.Transaction,
+	itemMint, collectionMint, collectionUpdateAuthority, authority web3.PublicKey,
+	verify bool,
+) (err error) {
+	defer Recover(&err)
+
+	var delegateRecord = mtm.ProgramID
+	if authority != collectionUpdateAuthority {
+		delegateRecord = Must1(mtm.FindMetadataDelegateRecordAddress(collectionMint, mtm.MetadataDelegateRoleCollection, collectionUpdateAuthority, authority))
+	}
+	metadata := Must1(mtm.FindAssociatedAddress(itemMint))
+	collectionMetadata := Must1(mtm.FindAssociatedAddress(collectionMint))
+	if verify {
+		return tx.AddInsBuilder(mtm.NewVerifyInstruction(
+			mtm.VerificationArgsCollectionV1,
+			authority,
+			delegateRecord,
+			metadata,
+			collectionMint,
+			collectionMetadata,
+			Must1(mtm.FindMasterEditionAddress(collectionMint)),
+			web3.SystemProgramID,
+			web3.SysvarInstructions,
+		))
+	}
+	return tx.AddInsBuilder(mtm.NewUnverifyInstruction(
+		mtm.VerificationArgsCollectionV1,
+		authority,
+		delegateRecord,
+		metadata,
+		collectionMint,
+		collectionMetadata,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+	))
+}
+
+// GetVerifyCollectionItemsInstructions Get the instructions to verify (or unverify) the items of the collection,
+// the size of a sized collection is updated by the program
+// @param authority The update authority of the collection, or its collection delegate (MetadataDelegateRoleCollection)
+func (m metaPlex) GetVerifyCollectionItemsInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	collectionMint, authority web3.PublicKey,
+	itemMints []web3.PublicKey,
+	verify bool,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	collection := Must1(mtm.FetchMetadata(ctx, connection, collectionMint, &commitment))
+	if collection == nil {
+		return nil, CollectionNotFoundErr
+	}
+	var tx = web3.Transaction{}
+	for _, itemMint := range itemMints {
+		Must(m.addCollectionVerificationInstruction(&tx, itemMint, collectionMint, collection.UpdateAuthority, authority, verify))
+	}
+	return tx.ExportIns(), nil
+}
+
+// VerifyCollectionItems verifies (or unverifies) the items of the collection, DefaultCollectionBatchSize items per transaction.
+// The signatures of the sent transactions are returned, with the error of the first failed one
+func (m metaPlex) VerifyCollectionItems(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	collectionMint web3.PublicKey,
+	itemMints []web3.PublicKey,
+	verify bool,
+	confirmOptions web3.ConfirmOptions,
+) ([]web3.TransactionSignature, error) {
+	instructions, err := m.GetVerifyCollectionItemsInstructions(ctx, connection, collectionMint, authority.PublicKey(), itemMints, verify, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return nil, err
+	}
+	var ret []web3.TransactionSignature
+	for start := 0; start < len(instructions); start += DefaultCollectionBatchSize {
+		batch := instructions[start:min(start+DefaultCollectionBatchSize, len(instructions))]
+		signature, err := sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, batch, true, confirmOptions)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, signature)
+	}
+	return ret, nil
+}
+
+// CreateCollectionItem creates an NFT of the collection and verifies it in the same transaction
+// @param collectionAuthority The update authority of the collection, or its collection delegate
+func (m metaPlex) CreateCollectionItem(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, authority, collectionAuthority web3.Signer,
+	collectionMint, owner web3.PublicKey,
+	args NftArgs,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (_ web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	args.Collection = &collectionMint
+	instructions := Must1(m.GetCreateNftInstructions(payer.PublicKey(), mint.PublicKey(), authority.PublicKey(), owner, args, options))
+	verify := Must1(m.GetVerifyCollectionItemsInstructions(ctx, connection, collectionMint, collectionAuthority.PublicKey(), []web3.PublicKey{mint.PublicKey()}, true, commitmentOrDefault(confirmOptions.Commitment)))
+	signers := DeDupBy([]web3.Signer{payer, mint, authority, collectionAuthority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, append(instructions, verify...), true, confirmOptions)
+}
+
+// GetSetCollectionSizeInstructions Get the instructions to set the size of an unsized collection, migrating it to a sized collection.
+// The size must be the number of its verified items, see ScanCollection
+// @param authority The update authority of the collection, or an approved collection authority (CollectionAuthorityRecord)
+func (m metaPlex) GetSetCollectionSizeInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	collectionMint, authority web3.PublicKey,
+	size uint64,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	collection := Must1(mtm.FetchMetadata(ctx, connection, collectionMint, &commitment))
+	if collection == nil {
+		return nil, CollectionNotFoundErr
+	}
+	if collection.CollectionDetails != nil {
+		return nil, AlreadySizedCollectionErr
+	}
+	var record = mtm.ProgramID
+	if authority != collection.UpdateAuthority {
+		record = Must1(mtm.FindCollectionAuthorityRecordAddress(collectionMint, authority))
+	}
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(mtm.NewSetCollectionSizeInstruction(
+		mtm.SetCollectionSizeArgs{Size: size},
+		Must1(mtm.FindAssociatedAddress(collectionMint)),
+		authority,
+		collectionMint,
+		record,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// MigrateToSizedCollection counts the verified items of an unsized collection by ScanCollection and sets its size
+func (m metaPlex) MigrateToSizedCollection(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	collectionMint web3.PublicKey,
+	filter CollectionScanFilter,
+	confirmOptions web3.ConfirmOptions,
+) (_ web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	commitment := commitmentOrDefault(confirmOptions.Commitment)
+	scan := Must1(m.ScanCollection(ctx, connection, collectionMint, filter, commitment))
+	instructions := Must1(m.GetSetCollectionSizeInstructions(ctx, connection, collectionMint, authority.PublicKey(), uint64(len(scan.Verified())), commitment))
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// CollectionScanFilter the metadata accounts scanned for the items of a collection, the collection of an item is not indexable.
+// The update authority of the collection is used if both are nil
+type CollectionScanFilter struct {
+	UpdateAuthority *web3.PublicKey
+	// the first creator, such as the candy machine creator
+	FirstCreator *web3.PublicKey
+}
+
+// CollectionItem an NFT claiming a collection
+type CollectionItem struct {
+	Mint     web3.PublicKey
+	Metadata web3.PublicKey
+	Verified bool
+	Data     *mtm.Metadata
+}
+
+// CollectionScan the items claiming a collection
+type CollectionScan struct {
+	Collection web3.PublicKey
+	// the size of a sized collection, the verified items counted by the program
+	Size  *uint64
+	Items []CollectionItem
+}
+
+func (s *CollectionScan) Verified() []CollectionItem {
+	return s.filter(true)
+}
+
+func (s *CollectionScan) Unverified() []CollectionItem {
+	return s.filter(false)
+}
+
+func (s *CollectionScan) filter(verified bool) []CollectionItem {
+	var ret []CollectionItem
+	for _, item := range s.Items {
+		if item.Verified == verified {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+// ScanCollection lists the NFTs claiming the collection among the metadata matching the filter, and reports which are verified.
+// The metadata that fail to decode are skipped
+func (m metaPlex) ScanCollection(
+	ctx context.Context,
+	connection *web3.Connection,
+	collectionMint web3.PublicKey,
+	filter CollectionScanFilter,
+	commitment web3.Commitment,
+) (_ *CollectionScan, err error) {
+	defer Recover(&err)
+
+	collection := Must1(mtm.FetchMetadata(ctx, connection, collectionMint, &commitment))
+	if collection == nil {
+		return nil, CollectionNotFoundErr
+	}
+	if filter.UpdateAuthority == nil && filter.FirstCreator == nil {
+		filter.UpdateAuthority = &collection.UpdateAuthority
+	}
+	var filters = []web3.GetProgramAccountsFilter{
+		{Memcmp: &web3.RPCFilterMemcmp{Offset: 0, Bytes: []byte{byte(mtm.KeyMetadataV1)}}},
+	}
+	if filter.UpdateAuthority != nil {
+		filters = append(filters, web3.GetProgramAccountsFilter{Memcmp: &web3.RPCFilterMemcmp{Offset: MetadataUpdateAuthorityOffset, Bytes: filter.UpdateAuthority.Bytes()}})
+	}
+	if filter.FirstCreator != nil {
+		filters = append(filters, web3.GetProgramAccountsFilter{Memcmp: &web3.RPCFilterMemcmp{Offset: MetadataFirstCreatorOffset, Bytes: filter.FirstCreator.Bytes()}})
+	}
+	response := Must1(connection.GetProgramAccounts(mtm.ProgramID, web3.GetProgramAccountsConfig{
+		Commitment: &commitment,
+		Filters:    filters,
+	}))
+
+	var ret = &CollectionScan{Collection: collectionMint}
+	if collection.CollectionDetails != nil && collection.CollectionDetails.V1 != nil {
+		ret.Size = &collection.CollectionDetails.V1.Size
+	}
+	for _, item := range response {
+		metadata, err := m.ParseMetadata(item.Account.Data.Content)
+		if err != nil || metadata == nil || metadata.Collection == nil || metadata.Collection.Key != collectionMint {
+			continue
+		}
+		ret.Items = append(ret.Items, CollectionItem{
+			Mint:     metadata.Mint,
+			Metadata: item.Pubkey,
+			Verified: metadata.Collection.Verified,
+			Data:     metadata,
+		})
+	}
+	return ret, nil
+}
+
+// GetApproveCollectionDelegateInstructions Get the instructions to approve a collection delegate of the collection,
+// it can verify and unverify the items by GetVerifyCollectionItemsInstructions
+// @param updateAuthority The update authority of the collection
+func (m metaPlex) GetApproveCollectionDelegateInstructions(
+	payer, collectionMint, updateAuthority, delegate web3.PublicKey,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(mtm.NewDelegateInstruction(
+		mtm.NewDelegateArgs_CollectionV1(nil),
+		Must1(mtm.FindMetadataDelegateRecordAddress(collectionMint, mtm.MetadataDelegateRoleCollection, updateAuthority, delegate)),
+		delegate,
+		Must1(mtm.FindAssociatedAddress(collectionMint)),
+		Must1(mtm.FindMasterEditionAddress(collectionMint)),
+		mtm.ProgramID,
+		collectionMint,
+		mtm.ProgramID,
+		updateAuthority,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		mtm.ProgramID,
+		mtm.ProgramID,
+		mtm.ProgramID,
+	)))
+	return tx.ExportIns(), nil
+}
@@ -0,0 +1,327 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"github.com/donutnomad/solana-web3/common"
+	core "github.com/donutnomad/solana-web3/mpl_core"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+var (
+	CoreAssetNotFoundErr      = errors.New("the core asset not found")
+	CoreCollectionNotFoundErr = errors.New("the core collection not found")
+	CoreAssetFrozenErr        = errors.New("the core asset is frozen")
+)
+
+// coreOptionalAccounts the absent optional accounts are passed as the program id, they are neither writable nor signers
+func coreOptionalAccounts(accounts common.AccountMetaSlice) {
+	for _, account := range accounts {
+		if account != nil && account.Pubkey == core.ProgramID {
+			account.IsWritable = false
+			account.IsSigner = false
+		}
+	}
+}
+
+func coreCollectionOrProgram(collection *web3.PublicKey) web3.PublicKey {
+	if collection != nil {
+		return *collection
+	}
+	return core.ProgramID
+}
+
+// GetCreateCoreAssetInstructions Get the instructions to create a Core asset
+// @param asset A new account, it signs the transaction
+// @param collection The collection of the asset, nil if the asset is not in a collection
+// @param authority The update authority of the collection if any, it signs the transaction
+// @param updateAuthority The update authority of an asset without collection, nil for the authority
+func (m metaPlex) GetCreateCoreAssetInstructions(
+	payer, asset, authority, owner web3.PublicKey,
+	collection, updateAuthority *web3.PublicKey,
+	name, uri string,
+	plugins []core.PluginAuthorityPair,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var newUpdateAuthority = core.ProgramID
+	if updateAuthority != nil && collection == nil {
+		newUpdateAuthority = *updateAuthority
+	}
+	builder := core.NewCreateV1Instruction(
+		core.CreateV1Args{
+			DataState: core.DataStateAccountState,
+			Name:      name,
+			Uri:       uri,
+			Plugins:   plugins,
+		},
+		asset,
+		coreCollectionOrProgram(collection),
+		authority,
+		payer,
+		owner,
+		newUpdateAuthority,
+		web3.SystemProgramID,
+		core.ProgramID,
+	)
+	coreOptionalAccounts(builder.AccountMetaSlice)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(builder))
+	return tx.ExportIns(), nil
+}
+
+// CreateCoreAsset creates a Core asset owned by the owner, see GetCreateCoreAssetInstructions
+func (m metaPlex) CreateCoreAsset(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, asset, authority web3.Signer,
+	owner web3.PublicKey,
+	collection, updateAuthority *web3.PublicKey,
+	name, uri string,
+	plugins []core.PluginAuthorityPair,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetCreateCoreAssetInstructions(payer.PublicKey(), asset.PublicKey(), authority.PublicKey(), owner, collection, updateAuthority, name, uri, plugins)
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, asset, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetCreateCoreCollectionInstructions Get the instructions to create a Core collection
+// @param collection A new account, it signs the transaction
+func (m metaPlex) GetCreateCoreCollectionInstructions(
+	payer, collection, updateAuthority web3.PublicKey,
+	name, uri string,
+	plugins []core.PluginAuthorityPair,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(core.NewCreateCollectionV1Instruction(
+		core.CreateCollectionV1Args{
+			Name:    name,
+			Uri:     uri,
+			Plugins: plugins,
+		},
+		collection,
+		updateAuthority,
+		payer,
+		web3.SystemProgramID,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// CreateCoreCollection creates a Core collection, see GetCreateCoreCollectionInstructions
+func (m metaPlex) CreateCoreCollection(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, collection web3.Signer,
+	updateAuthority web3.PublicKey,
+	name, uri string,
+	plugins []core.PluginAuthorityPair,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetCreateCoreCollectionInstructions(payer.PublicKey(), collection.PublicKey(), updateAuthority, name, uri, plugins)
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, collection}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetCoreAsset fetches the Core asset and its plugins
+func (m metaPlex) GetCoreAsset(
+	ctx context.Context,
+	connection *web3.Connection,
+	asset web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *core.AssetV1, err error) {
+	defer Recover(&err)
+
+	ret := Must1(core.FetchAsset(ctx, connection, asset, &commitment))
+	if ret == nil {
+		return nil, CoreAssetNotFoundErr
+	}
+	return ret, nil
+}
+
+// GetCoreCollection fetches the Core collection and its plugins
+func (m metaPlex) GetCoreCollection(
+	ctx context.Context,
+	connection *web3.Connection,
+	collection web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *core.CollectionV1, err error) {
+	defer Recover(&err)
+
+	ret := Must1(core.FetchCollection(ctx, connection, collection, &commitment))
+	if ret == nil {
+		return nil, CoreCollectionNotFoundErr
+	}
+	return ret, nil
+}
+
+// GetTransferCoreAssetInstructions Get the instructions to transfer the Core asset to the new owner, the collection of the asset is fetched
+// @param authority The owner, or the TransferDelegate or the PermanentTransferDelegate of the asset, only the PermanentTransferDelegate transfers a frozen asset
+func (m metaPlex) GetTransferCoreAssetInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority, asset, newOwner web3.PublicKey,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	account := Must1(m.GetCoreAsset(ctx, connection, asset, commitment))
+	var collection *core.CollectionV1
+	if key := account.Collection(); key != nil {
+		collection = Must1(m.GetCoreCollection(ctx, connection, *key, commitment))
+	}
+	Must(checkCoreTransferable(account, collection, authority))
+	builder := core.NewTransferV1Instruction(
+		core.TransferV1Args{},
+		asset,
+		coreCollectionOrProgram(account.Collection()),
+		payer,
+		authority,
+		newOwner,
+		core.ProgramID,
+		core.ProgramID,
+	)
+	// the collection is read only for a transfer
+	builder.GetCollectionAccount().IsWritable = false
+	coreOptionalAccounts(builder.AccountMetaSlice)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(builder))
+	return tx.ExportIns(), nil
+}
+
+// checkCoreTransferable returns CoreAssetFrozenErr if the asset is frozen by its plugins or by the PermanentFreezeDelegate of its collection,
+// unless the authority is the PermanentTransferDelegate of the asset or of its collection
+func checkCoreTransferable(account *core.AssetV1, collection *core.CollectionV1, authority web3.PublicKey) error {
+	frozen := account.IsFrozen() || (collection != nil && collection.IsFrozen())
+	if frozen && !account.IsPermanentTransferDelegate(authority, collection) {
+		return CoreAssetFrozenErr
+	}
+	return nil
+}
+
+// TransferCoreAsset transfers the Core asset to the new owner, see GetTransferCoreAssetInstructions
+func (m metaPlex) TransferCoreAsset(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	asset, newOwner web3.PublicKey,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetTransferCoreAssetInstructions(ctx, connection, payer.PublicKey(), authority.PublicKey(), asset, newOwner, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetUpdateCoreAssetInstructions Get the instructions to update the name, the uri or the update authority of the Core asset, the nil fields are unchanged
+// @param authority The update authority of the asset, or the update authority of its collection
+func (m metaPlex) GetUpdateCoreAssetInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority, asset web3.PublicKey,
+	newName, newUri *string,
+	newUpdateAuthority *core.UpdateAuthority,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	account := Must1(m.GetCoreAsset(ctx, connection, asset, commitment))
+	builder := core.NewUpdateV1Instruction(
+		core.UpdateV1Args{
+			NewName:            newName,
+			NewUri:             newUri,
+			NewUpdateAuthority: newUpdateAuthority,
+		},
+		asset,
+		coreCollectionOrProgram(account.Collection()),
+		payer,
+		authority,
+		web3.SystemProgramID,
+		core.ProgramID,
+	)
+	coreOptionalAccounts(builder.AccountMetaSlice)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(builder))
+	return tx.ExportIns(), nil
+}
+
+// UpdateCoreAsset updates the Core asset, see GetUpdateCoreAssetInstructions
+func (m metaPlex) UpdateCoreAsset(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	asset web3.PublicKey,
+	newName, newUri *string,
+	newUpdateAuthority *core.UpdateAuthority,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetUpdateCoreAssetInstructions(ctx, connection, payer.PublicKey(), authority.PublicKey(), asset, newName, newUri, newUpdateAuthority, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
+
+// GetAddCoreAssetPluginInstructions Get the instructions to add the plugin to the Core asset
+// @param initAuthority The authority of the plugin, nil for the default authority of the plugin type
+func (m metaPlex) GetAddCoreAssetPluginInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority, asset web3.PublicKey,
+	plugin core.Plugin,
+	initAuthority *core.Authority,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	account := Must1(m.GetCoreAsset(ctx, connection, asset, commitment))
+	builder := core.NewAddPluginV1Instruction(
+		core.AddPluginV1Args{
+			Plugin:        plugin,
+			InitAuthority: initAuthority,
+		},
+		asset,
+		coreCollectionOrProgram(account.Collection()),
+		payer,
+		authority,
+		web3.SystemProgramID,
+		core.ProgramID,
+	)
+	coreOptionalAccounts(builder.AccountMetaSlice)
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(builder))
+	return tx.ExportIns(), nil
+}
+
+// AddCoreAssetPlugin adds the plugin to the Core asset, see GetAddCoreAssetPluginInstructions
+func (m metaPlex) AddCoreAssetPlugin(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	asset web3.PublicKey,
+	plugin core.Plugin,
+	initAuthority *core.Authority,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetAddCoreAssetPluginInstructions(ctx, connection, payer.PublicKey(), authority.PublicKey(), asset, plugin, initAuthority, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
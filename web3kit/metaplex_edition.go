@@ -0,0 +1,212 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	"slices"
+)
+
+// maxMultipleAccounts the maximum accounts of a getMultipleAccounts request
+const maxMultipleAccounts = 100
+
+var (
+	MasterEditionNotFoundErr  = errors.New("the master edition not found")
+	EditionSupplyExhaustedErr = errors.New("all editions of the master edition are printed")
+	EditionPrintedErr         = errors.New("the edition is already printed")
+)
+
+// CreateMasterEdition creates an NFT whose master edition can print up to maxSupply editions, unlimited if maxSupply is nil
+func (m metaPlex) CreateMasterEdition(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, authority web3.Signer,
+	owner web3.PublicKey,
+	args NftArgs,
+	maxSupply *uint64,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	var printSupply = mtm.NewPrintSupply_Unlimited()
+	if maxSupply != nil {
+		printSupply = mtm.NewPrintSupply_Limited(*maxSupply)
+	}
+	args.PrintSupply = &printSupply
+	return m.CreateNft(ctx, connection, payer, mint, authority, owner, args, options, confirmOptions)
+}
+
+// PrintedEditions the editions printed from a master edition
+type PrintedEditions struct {
+	MasterEdition *mtm.MasterEditionV2
+	// the printed edition numbers, in ascending order
+	Editions []uint64
+	// the editions of a programmable master edition are tracked by an EditionMarkerV2
+	MarkerV2 bool
+}
+
+// IsPrinted returns true if the edition is printed
+func (p *PrintedEditions) IsPrinted(edition uint64) bool {
+	_, found := slices.BinarySearch(p.Editions, edition)
+	return found
+}
+
+// NextAvailable the smallest edition number not printed, EditionSupplyExhaustedErr if the max supply is reached
+func (p *PrintedEditions) NextAvailable() (uint64, error) {
+	var next uint64 = 1
+	for _, edition := range p.Editions {
+		if edition == next {
+			next++
+		} else if edition > next {
+			break
+		}
+	}
+	if maxSupply := p.MasterEdition.MaxSupply; maxSupply != nil && next > *maxSupply {
+		return 0, EditionSupplyExhaustedErr
+	}
+	return next, nil
+}
+
+// GetPrintedEditions reads the edition markers of the master edition to list the printed editions.
+// The EditionMarkers of a non programmable master edition are read until the supply of the master edition is found
+func (m metaPlex) GetPrintedEditions(
+	ctx context.Context,
+	connection *web3.Connection,
+	masterMint web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *PrintedEditions, err error) {
+	defer Recover(&err)
+
+	master := Must1(mtm.FetchMasterEdition(ctx, connection, masterMint, &commitment))
+	if master == nil {
+		return nil, MasterEditionNotFoundErr
+	}
+	metadata := Must1(mtm.FetchMetadata(ctx, connection, masterMint, &commitment))
+	if metadata == nil {
+		return nil, NftMetadataNotFoundErr
+	}
+	var ret = &PrintedEditions{MasterEdition: master}
+	if standard := metadata.TokenStandard; standard != nil && *standard == mtm.TokenStandardProgrammableNonFungible {
+		ret.MarkerV2 = true
+		marker := Must1(mtm.FetchAccount[mtm.EditionMarkerV2](ctx, connection, Must1(mtm.FindEditionMarkerV2Address(masterMint)), &commitment))
+		if marker != nil {
+			ret.Editions = marker.Printed()
+		}
+		return ret, nil
+	}
+
+	// the markers of the max supply, or until the supply is found
+	var lastMarker = uint64(1<<64 - 1)
+	if master.MaxSupply != nil {
+		lastMarker = mtm.EditionMarkerIndex(*master.MaxSupply)
+	}
+	for start := uint64(0); start <= lastMarker && uint64(len(ret.Editions)) < master.Supply; start += maxMultipleAccounts {
+		var addresses []web3.PublicKey
+		for index := start; index <= lastMarker && index < start+maxMultipleAccounts; index++ {
+			addresses = append(addresses, Must1(mtm.FindEditionMarkerAddress(masterMint, index*mtm.EditionMarkerBitSize)))
+		}
+		infos := Must1(connection.GetMultipleAccountsInfo(addresses, web3.GetMultipleAccountsConfig{Commitment: &commitment}))
+		var found bool
+		for i, info := range infos {
+			if info == nil || info.Owner != mtm.ProgramID {
+				continue
+			}
+			found = true
+			marker := Must1(decodeObject[*mtm.EditionMarker](info.Data.Content))
+			ret.Editions = append(ret.Editions, marker.Printed(start+uint64(i))...)
+		}
+		if !found {
+			// no marker in the range, the supply is inconsistent with the markers
+			break
+		}
+	}
+	return ret, nil
+}
+
+// GetPrintEditionInstructions Get the instructions to print an edition of the master edition by the Print instruction of Token Metadata,
+// the edition mint is created and the edition is minted to the associated token account of the edition owner
+// @param masterOwner The owner of the token of the master edition, it signs the transaction
+// @param editionMint A new account, it signs the transaction; the payer is its mint authority
+// @param edition The edition number, 0 for the next available edition
+func (m metaPlex) GetPrintEditionInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, masterMint, masterOwner, editionMint, editionOwner web3.PublicKey,
+	edition uint64,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, _ uint64, err error) {
+	defer Recover(&err)
+
+	master := Must1(m.ResolveNftAsset(ctx, connection, masterMint, commitment))
+	printed := Must1(m.GetPrintedEditions(ctx, connection, masterMint, commitment))
+	if edition == 0 {
+		edition = Must1(printed.NextAvailable())
+	} else if printed.IsPrinted(edition) {
+		return nil, 0, fmt.Errorf("%w: %d", EditionPrintedErr, edition)
+	}
+	var marker web3.PublicKey
+	if printed.MarkerV2 {
+		marker = Must1(mtm.FindEditionMarkerV2Address(masterMint))
+	} else {
+		marker = Must1(mtm.FindEditionMarkerAddress(masterMint, edition))
+	}
+	var asset = &NftAsset{
+		Mint:          editionMint,
+		TokenProgram:  master.TokenProgram,
+		Metadata:      Must1(mtm.FindAssociatedAddress(editionMint)),
+		Edition:       Must1(mtm.FindMasterEditionAddress(editionMint)),
+		TokenStandard: master.TokenStandard,
+	}
+	editionToken := Must1(asset.AssociatedToken(editionOwner))
+
+	printIns := mtm.NewPrintInstruction(
+		mtm.NewPrintArgs_V1(edition),
+		asset.Metadata,
+		asset.Edition,
+		editionMint,
+		editionOwner,
+		editionToken,
+		payer,
+		Must1(asset.TokenRecord(editionToken)),
+		master.Edition,
+		marker,
+		payer,
+		masterOwner,
+		Must1(master.AssociatedToken(masterOwner)),
+		master.Metadata,
+		master.Data.UpdateAuthority,
+		master.TokenProgram,
+		web3.SPLAssociatedTokenAccountProgramID,
+		web3.SysvarInstructions,
+		web3.SystemProgramID,
+	)
+	// the mint is created by the instruction
+	printIns.GetEditionMintAccount().SIGNER()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(master, options))...)
+	Must(tx.AddInsBuilder(printIns))
+	return tx.ExportIns(), edition, nil
+}
+
+// PrintEdition prints an edition of the master edition, see GetPrintEditionInstructions.
+// Returns the signature and the printed edition number
+func (m metaPlex) PrintEdition(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, masterOwner, editionMint web3.Signer,
+	masterMint, editionOwner web3.PublicKey,
+	edition uint64,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, uint64, error) {
+	instructions, edition, err := m.GetPrintEditionInstructions(ctx, connection, payer.PublicKey(), masterMint, masterOwner.PublicKey(), editionMint.PublicKey(), editionOwner, edition, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", 0, err
+	}
+	signers := DeDupBy([]web3.Signer{payer, masterOwner, editionMint}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	signature, err := sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+	return signature, edition, err
+}
@@ -0,0 +1,691 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	ata "github.com/donutnomad/solana-web3/associated_token_account"
+	auth "github.com/donutnomad/solana-web3/mpl_token_auth_rules"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+)
+
+// TokenAuthRulesProgramID Token Authorization Rules program of the programmable NFTs
+var TokenAuthRulesProgramID = auth.ProgramID
+
+// ProgrammableNftComputeUnits the compute unit limit of the instructions of a programmable NFT, the rule set is evaluated in them
+const ProgrammableNftComputeUnits = 400_000
+
+var (
+	NftMetadataNotFoundErr      = errors.New("the metadata of the nft not found")
+	RuleSetNotFoundErr          = errors.New("the rule set of the programmable nft not found")
+	NotTokenDelegateErr         = errors.New("the authority is neither the owner nor the token delegate")
+	UnsupportedTokenStandardErr = errors.New("the token standard is not supported by the instruction")
+	NftNoUsesErr                = errors.New("the nft has no uses")
+)
+
+// NftOptions the options of the instructions of an NFT
+type NftOptions struct {
+	// 0 for ProgrammableNftComputeUnits for a programmable NFT, no limit for the others
+	ComputeUnitLimit uint32
+	// micro lamports per compute unit, 0 for no priority fee
+	ComputeUnitPrice uint64
+	// the payload of the rule set of a programmable NFT
+	AuthorizationData *mtm.AuthorizationData
+}
+
+// NftAsset the accounts of an NFT resolved from its metadata
+type NftAsset struct {
+	Mint          web3.PublicKey
+	TokenProgram  web3.PublicKey // owner program of the mint
+	Metadata      web3.PublicKey
+	Edition       web3.PublicKey // master edition, or edition of a print
+	Data          *mtm.Metadata
+	TokenStandard mtm.TokenStandard
+	RuleSet       *web3.PublicKey // rule set of a programmable NFT
+}
+
+// IsProgrammable returns true for a programmable NFT, its token accounts are frozen and tracked by token records
+func (a *NftAsset) IsProgrammable() bool {
+	return a.TokenStandard == mtm.TokenStandardProgrammableNonFungible || a.TokenStandard == mtm.TokenStandardProgrammableNonFungibleEdition
+}
+
+// TokenRecord the token record of the token account, the program id (none) if the NFT is not programmable
+func (a *NftAsset) TokenRecord(token web3.PublicKey) (web3.PublicKey, error) {
+	if !a.IsProgrammable() {
+		return mtm.ProgramID, nil
+	}
+	return mtm.FindTokenRecordAddress(a.Mint, token)
+}
+
+// AuthorizationRules the auth rules program and the rule set, the program id (none) for both if there is no rule set
+func (a *NftAsset) AuthorizationRules() (program web3.PublicKey, ruleSet web3.PublicKey) {
+	if a.RuleSet == nil {
+		return mtm.ProgramID, mtm.ProgramID
+	}
+	return TokenAuthRulesProgramID, *a.RuleSet
+}
+
+// AssociatedToken the associated token account of the owner
+func (a *NftAsset) AssociatedToken(owner web3.PublicKey) (web3.PublicKey, error) {
+	return ata.FindAssociatedTokenAddress(owner, a.Mint, a.TokenProgram)
+}
+
+// ResolveNftAsset fetches the metadata and the mint of the NFT and derives its accounts,
+// the metadata without a token standard is a NonFungible
+func (m metaPlex) ResolveNftAsset(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *NftAsset, err error) {
+	defer Recover(&err)
+
+	metadata := Must1(mtm.FetchMetadata(ctx, connection, mint, &commitment))
+	if metadata == nil {
+		return nil, NftMetadataNotFoundErr
+	}
+	mintInfo := Must1(connection.GetAccountInfo(mint, web3.GetAccountInfoConfig{Commitment: &commitment}))
+	if mintInfo == nil {
+		return nil, TokenAccountNotFoundErr
+	}
+	var ret = &NftAsset{
+		Mint:          mint,
+		TokenProgram:  mintInfo.Owner,
+		Metadata:      Must1(mtm.FindAssociatedAddress(mint)),
+		Edition:       Must1(mtm.FindMasterEditionAddress(mint)),
+		Data:          metadata,
+		TokenStandard: mtm.TokenStandardNonFungible,
+	}
+	if metadata.TokenStandard != nil {
+		ret.TokenStandard = *metadata.TokenStandard
+	}
+	if metadata.ProgrammableConfig != nil && metadata.ProgrammableConfig.V1 != nil {
+		ret.RuleSet = metadata.ProgrammableConfig.V1.RuleSet
+	}
+	return ret, nil
+}
+
+// computeBudgetInstructions the compute budget instructions of the options
+func (m metaPlex) computeBudgetInstructions(asset *NftAsset, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var tx = web3.Transaction{}
+	var limit = options.ComputeUnitLimit
+	if limit == 0 && asset.IsProgrammable() {
+		limit = ProgrammableNftComputeUnits
+	}
+	if limit > 0 {
+		Must(tx.AddInsBuilder(computebudget.NewSetComputeUnitLimitInstruction(limit)))
+	}
+	if options.ComputeUnitPrice > 0 {
+		Must(tx.AddInsBuilder(computebudget.NewSetComputeUnitPriceInstruction(options.ComputeUnitPrice)))
+	}
+	return tx.ExportIns(), nil
+}
+
+// GetTransferNftInstructions Get the instructions to transfer an NFT or a programmable NFT by the Transfer instruction of Token Metadata,
+// the associated token account of the destination owner is created if missing
+// @param authority The owner of the token account, or its transfer delegate
+func (m metaPlex) GetTransferNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, owner, destinationOwner, authority web3.PublicKey,
+	amount uint64,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.transferNftInstructions(asset, payer, owner, destinationOwner, authority, amount, options)
+}
+
+// transferNftInstructions the instructions of GetTransferNftInstructions for the resolved asset
+func (m metaPlex) transferNftInstructions(asset *NftAsset, payer, owner, destinationOwner, authority web3.PublicKey, amount uint64, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	token := Must1(asset.AssociatedToken(owner))
+	destination := Must1(asset.AssociatedToken(destinationOwner))
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+	associatedTokenProgramId := web3.SPLAssociatedTokenAccountProgramID
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(ata.NewCreateIdempotentInstruction(
+		payer,
+		destination,
+		destinationOwner,
+		asset.Mint,
+		web3.SystemProgramID,
+		asset.TokenProgram,
+	).SetProgramId(&associatedTokenProgramId)))
+	Must(tx.AddInsBuilder(mtm.NewTransferInstruction(
+		mtm.NewTransferArgs_V1(amount, options.AuthorizationData),
+		token,
+		owner,
+		destination,
+		destinationOwner,
+		asset.Mint,
+		asset.Metadata,
+		asset.Edition,
+		Must1(asset.TokenRecord(token)),
+		Must1(asset.TokenRecord(destination)),
+		authority,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+		associatedTokenProgramId,
+		rulesProgram,
+		ruleSet,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// TransferNft transfers an NFT or a programmable NFT, see GetTransferNftInstructions
+func (m metaPlex) TransferNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner, destinationOwner web3.PublicKey,
+	amount uint64,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetTransferNftInstructions(ctx, connection, payer.PublicKey(), mint, owner, destinationOwner, authority.PublicKey(), amount, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// metadataDelegateRole the role of the metadata delegate args, nil for a token delegate
+func metadataDelegateRole(args mtm.DelegateArgs) *mtm.MetadataDelegateRole {
+	var role mtm.MetadataDelegateRole
+	switch {
+	case args.CollectionV1 != nil:
+		role = mtm.MetadataDelegateRoleCollection
+	case args.DataV1 != nil:
+		role = mtm.MetadataDelegateRoleData
+	case args.ProgrammableConfigV1 != nil:
+		role = mtm.MetadataDelegateRoleProgrammableConfig
+	case args.AuthorityItemV1 != nil:
+		role = mtm.MetadataDelegateRoleAuthorityItem
+	case args.DataItemV1 != nil:
+		role = mtm.MetadataDelegateRoleDataItem
+	case args.CollectionItemV1 != nil:
+		role = mtm.MetadataDelegateRoleCollectionItem
+	case args.ProgrammableConfigItemV1 != nil:
+		role = mtm.MetadataDelegateRoleProgrammableConfigItem
+	default:
+		return nil
+	}
+	return &role
+}
+
+// metadataRevokeRole the role of the metadata delegate revoke args, nil for a token delegate
+func metadataRevokeRole(args mtm.RevokeArgs) *mtm.MetadataDelegateRole {
+	var role mtm.MetadataDelegateRole
+	switch args {
+	case mtm.RevokeArgsCollectionV1:
+		role = mtm.MetadataDelegateRoleCollection
+	case mtm.RevokeArgsDataV1:
+		role = mtm.MetadataDelegateRoleData
+	case mtm.RevokeArgsProgrammableConfigV1:
+		role = mtm.MetadataDelegateRoleProgrammableConfig
+	case mtm.RevokeArgsAuthorityItemV1:
+		role = mtm.MetadataDelegateRoleAuthorityItem
+	case mtm.RevokeArgsDataItemV1:
+		role = mtm.MetadataDelegateRoleDataItem
+	case mtm.RevokeArgsCollectionItemV1:
+		role = mtm.MetadataDelegateRoleCollectionItem
+	case mtm.RevokeArgsProgrammableConfigItemV1:
+		role = mtm.MetadataDelegateRoleProgrammableConfigItem
+	default:
+		return nil
+	}
+	return &role
+}
+
+// delegateAccounts the delegate record, the token account and its token record of a Delegate or a Revoke.
+// A token delegate has no delegate record, a metadata delegate has no token account
+func (m metaPlex) delegateAccounts(asset *NftAsset, role *mtm.MetadataDelegateRole, owner, delegate web3.PublicKey) (delegateRecord, token, tokenRecord web3.PublicKey, err error) {
+	defer Recover(&err)
+
+	if role != nil {
+		delegateRecord = Must1(mtm.FindMetadataDelegateRecordAddress(asset.Mint, *role, asset.Data.UpdateAuthority, delegate))
+		return delegateRecord, mtm.ProgramID, mtm.ProgramID, nil
+	}
+	token = Must1(asset.AssociatedToken(owner))
+	return mtm.ProgramID, token, Must1(asset.TokenRecord(token)), nil
+}
+
+// GetDelegateNftInstructions Get the instructions to approve a token delegate (sale, transfer, utility, staking, standard, locked transfer)
+// or a metadata delegate (collection, data, programmable config and their item variants) of an NFT
+// @param owner The owner of the token account, for a token delegate
+// @param authority The token owner for a token delegate, the update authority for a metadata delegate
+func (m metaPlex) GetDelegateNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, owner, delegate, authority web3.PublicKey,
+	args mtm.DelegateArgs,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.delegateNftInstructions(asset, payer, owner, delegate, authority, args, options)
+}
+
+// delegateNftInstructions the instructions of GetDelegateNftInstructions for the resolved asset
+func (m metaPlex) delegateNftInstructions(asset *NftAsset, payer, owner, delegate, authority web3.PublicKey, args mtm.DelegateArgs, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	delegateRecord, token, tokenRecord := Must3(m.delegateAccounts(asset, metadataDelegateRole(args), owner, delegate))
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(mtm.NewDelegateInstruction(
+		args,
+		delegateRecord,
+		delegate,
+		asset.Metadata,
+		asset.Edition,
+		tokenRecord,
+		asset.Mint,
+		token,
+		authority,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+		rulesProgram,
+		ruleSet,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// DelegateNft approves a delegate of an NFT, see GetDelegateNftInstructions
+func (m metaPlex) DelegateNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner, delegate web3.PublicKey,
+	args mtm.DelegateArgs,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetDelegateNftInstructions(ctx, connection, payer.PublicKey(), mint, owner, delegate, authority.PublicKey(), args, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// GetRevokeNftInstructions Get the instructions to revoke a delegate of an NFT, see GetDelegateNftInstructions
+func (m metaPlex) GetRevokeNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, owner, delegate, authority web3.PublicKey,
+	args mtm.RevokeArgs,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.revokeNftInstructions(asset, payer, owner, delegate, authority, args, options)
+}
+
+// revokeNftInstructions the instructions of GetRevokeNftInstructions for the resolved asset
+func (m metaPlex) revokeNftInstructions(asset *NftAsset, payer, owner, delegate, authority web3.PublicKey, args mtm.RevokeArgs, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	delegateRecord, token, tokenRecord := Must3(m.delegateAccounts(asset, metadataRevokeRole(args), owner, delegate))
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(mtm.NewRevokeInstruction(
+		args,
+		delegateRecord,
+		delegate,
+		asset.Metadata,
+		asset.Edition,
+		tokenRecord,
+		asset.Mint,
+		token,
+		authority,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+		rulesProgram,
+		ruleSet,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// RevokeNft revokes a delegate of an NFT, see GetRevokeNftInstructions
+func (m metaPlex) RevokeNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner, delegate web3.PublicKey,
+	args mtm.RevokeArgs,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetRevokeNftInstructions(ctx, connection, payer.PublicKey(), mint, owner, delegate, authority.PublicKey(), args, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// GetLockNftInstructions Get the instructions to lock (or unlock) the token account of the owner,
+// a programmable NFT is locked in its token record, the others are frozen
+// @param authority The utility, staking or locked transfer delegate of a programmable NFT, the standard delegate or the freeze authority of the others
+func (m metaPlex) GetLockNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint, owner, authority web3.PublicKey,
+	lock bool,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.lockNftInstructions(asset, payer, owner, authority, lock, options)
+}
+
+// lockNftInstructions the instructions of GetLockNftInstructions for the resolved asset
+func (m metaPlex) lockNftInstructions(asset *NftAsset, payer, owner, authority web3.PublicKey, lock bool, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	token := Must1(asset.AssociatedToken(owner))
+	tokenRecord := Must1(asset.TokenRecord(token))
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	if lock {
+		Must(tx.AddInsBuilder(mtm.NewLockInstruction(
+			mtm.NewLockArgs_V1(options.AuthorizationData),
+			authority,
+			owner,
+			token,
+			asset.Mint,
+			asset.Metadata,
+			asset.Edition,
+			tokenRecord,
+			payer,
+			web3.SystemProgramID,
+			web3.SysvarInstructions,
+			asset.TokenProgram,
+			rulesProgram,
+			ruleSet,
+		)))
+	} else {
+		Must(tx.AddInsBuilder(mtm.NewUnlockInstruction(
+			mtm.NewUnlockArgs_V1(options.AuthorizationData),
+			authority,
+			owner,
+			token,
+			asset.Mint,
+			asset.Metadata,
+			asset.Edition,
+			tokenRecord,
+			payer,
+			web3.SystemProgramID,
+			web3.SysvarInstructions,
+			asset.TokenProgram,
+			rulesProgram,
+			ruleSet,
+		)))
+	}
+	return tx.ExportIns(), nil
+}
+
+// LockNft locks (or unlocks) the token account of the owner, see GetLockNftInstructions
+func (m metaPlex) LockNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner web3.PublicKey,
+	lock bool,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetLockNftInstructions(ctx, connection, payer.PublicKey(), mint, owner, authority.PublicKey(), lock, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// GetBurnNftInstructions Get the instructions to burn an NFT or a programmable NFT held by the owner,
+// the metadata, the edition and the token record are closed. The print editions are not supported
+// @param authority The owner of the token account, or its utility delegate
+func (m metaPlex) GetBurnNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint, owner, authority web3.PublicKey,
+	amount uint64,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.burnNftInstructions(asset, owner, authority, amount, options)
+}
+
+// burnNftInstructions the instructions of GetBurnNftInstructions for the resolved asset
+func (m metaPlex) burnNftInstructions(asset *NftAsset, owner, authority web3.PublicKey, amount uint64, options NftOptions) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if asset.TokenStandard == mtm.TokenStandardNonFungibleEdition || asset.TokenStandard == mtm.TokenStandardProgrammableNonFungibleEdition {
+		return nil, UnsupportedTokenStandardErr
+	}
+	token := Must1(asset.AssociatedToken(owner))
+	var collectionMetadata = mtm.ProgramID
+	if collection := asset.Data.Collection; collection != nil && collection.Verified {
+		collectionMetadata = Must1(mtm.FindAssociatedAddress(collection.Key))
+	}
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(mtm.NewBurnInstruction(
+		mtm.NewBurnArgs_V1(amount),
+		authority,
+		collectionMetadata,
+		asset.Metadata,
+		asset.Edition,
+		asset.Mint,
+		token,
+		mtm.ProgramID,
+		mtm.ProgramID,
+		mtm.ProgramID,
+		mtm.ProgramID,
+		Must1(asset.TokenRecord(token)),
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		asset.TokenProgram,
+	)))
+	return tx.ExportIns(), nil
+}
+
+// BurnNft burns an NFT or a programmable NFT held by the owner, see GetBurnNftInstructions
+func (m metaPlex) BurnNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner web3.PublicKey,
+	amount uint64,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetBurnNftInstructions(ctx, connection, mint, owner, authority.PublicKey(), amount, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// GetUseNftInstructions Get the instructions to consume uses of an NFT by the Utilize instruction of Token Metadata,
+// the NFT is burnt when its Burn uses are exhausted. The uses are deprecated by Token Metadata, the programmable NFTs are not supported
+// @param authority The owner of the token account, or a use authority approved for the mint
+func (m metaPlex) GetUseNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint, owner, authority web3.PublicKey,
+	numberOfUses uint64,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	return m.useNftInstructions(asset, owner, authority, numberOfUses)
+}
+
+// useNftInstructions the instructions of GetUseNftInstructions for the resolved asset
+func (m metaPlex) useNftInstructions(asset *NftAsset, owner, authority web3.PublicKey, numberOfUses uint64) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if asset.IsProgrammable() {
+		return nil, UnsupportedTokenStandardErr
+	}
+	if asset.Data.Uses == nil {
+		return nil, NftNoUsesErr
+	}
+	token := Must1(asset.AssociatedToken(owner))
+	var useAuthorityRecord, burner = mtm.ProgramID, mtm.ProgramID
+	if authority != owner {
+		useAuthorityRecord = Must1(mtm.FindUseAuthorityRecordAddress(asset.Mint, authority))
+		burner = Must1(mtm.FindProgramAsBurnerAddress())
+	}
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(mtm.NewUtilizeInstruction(
+		mtm.UtilizeArgs{NumberOfUses: numberOfUses},
+		asset.Metadata,
+		token,
+		asset.Mint,
+		authority,
+		owner,
+		asset.TokenProgram,
+		web3.SPLAssociatedTokenAccountProgramID,
+		web3.SystemProgramID,
+		web3.SYSVAR_RENT_PUBKEY,
+		useAuthorityRecord,
+		burner,
+	)))
+	instructions := tx.ExportIns()
+	if authority == owner {
+		// the program takes the use authority record and the burner by their presence
+		utilize := &instructions[len(instructions)-1]
+		utilize.Keys = utilize.Keys[:9]
+	}
+	return instructions, nil
+}
+
+// UseNft consumes uses of an NFT, see GetUseNftInstructions
+func (m metaPlex) UseNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint, owner web3.PublicKey,
+	numberOfUses uint64,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := m.GetUseNftInstructions(ctx, connection, mint, owner, authority.PublicKey(), numberOfUses, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, authority}, instructions, true, confirmOptions)
+}
+
+// transferOperation the operation of the rule set Token Metadata validates a transfer with
+func (m metaPlex) transferOperation(record *mtm.TokenRecord, owner, destinationOwner, authority web3.PublicKey, owners map[web3.PublicKey]web3.PublicKey) (string, error) {
+	if authority == owner {
+		isWallet := func(key web3.PublicKey) bool {
+			return owners[key] == web3.SystemProgramID && web3.IsOnCurve(key)
+		}
+		if isWallet(owner) && isWallet(destinationOwner) {
+			return auth.OperationTransferWalletToWallet, nil
+		}
+		return auth.OperationTransferOwner, nil
+	}
+	if record == nil || record.Delegate == nil || *record.Delegate != authority || record.DelegateRole == nil {
+		return "", NotTokenDelegateErr
+	}
+	switch *record.DelegateRole {
+	case mtm.TokenDelegateRoleSale:
+		return auth.OperationTransferSaleDelegate, nil
+	case mtm.TokenDelegateRoleTransfer:
+		return auth.OperationTransferTransferDelegate, nil
+	case mtm.TokenDelegateRoleLockedTransfer:
+		return auth.OperationTransferLockedTransferDelegate, nil
+	case mtm.TokenDelegateRoleMigration:
+		return auth.OperationTransferMigrationDelegate, nil
+	}
+	return "", NotTokenDelegateErr
+}
+
+// EvaluateNftTransfer evaluates a transfer of a programmable NFT against its rule set locally before signing,
+// with the operation and the payload (Amount, Authority, Source, Destination) Token Metadata builds.
+// Returns nil if the NFT has no rule set
+// @param payload The extra fields of the payload, such as the seeds or the proofs, the same as in NftOptions.AuthorizationData
+func (m metaPlex) EvaluateNftTransfer(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint, owner, destinationOwner, authority web3.PublicKey,
+	amount uint64,
+	payload auth.Payload,
+	commitment web3.Commitment,
+) (_ *auth.Evaluation, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	if !asset.IsProgrammable() || asset.RuleSet == nil {
+		return nil, nil
+	}
+	ruleSet := Must1(auth.FetchRuleSet(ctx, connection, *asset.RuleSet, &commitment))
+	if ruleSet == nil {
+		return nil, RuleSetNotFoundErr
+	}
+	token := Must1(asset.AssociatedToken(owner))
+	record := Must1(mtm.FetchTokenRecord(ctx, connection, mint, token, &commitment))
+
+	var keys = DeDupBy([]web3.PublicKey{owner, destinationOwner, authority}, func(key web3.PublicKey) web3.PublicKey { return key })
+	infos := Must1(connection.GetMultipleAccountsInfo(keys, web3.GetMultipleAccountsConfig{Commitment: &commitment}))
+	var owners = make(map[web3.PublicKey]web3.PublicKey)
+	for i, info := range infos {
+		if info != nil {
+			owners[keys[i]] = info.Owner
+		} else {
+			// an absent account is an unfunded wallet
+			owners[keys[i]] = web3.SystemProgramID
+		}
+	}
+	operation := Must1(m.transferOperation(record, owner, destinationOwner, authority, owners))
+
+	var env = auth.Environment{
+		Payload: auth.Payload{},
+		Signers: []web3.PublicKey{authority},
+		Owners:  owners,
+	}
+	for field, value := range payload {
+		env.Payload[field] = value
+	}
+	env.Payload.SetNumber(auth.PayloadAmount, amount).
+		SetPubkey(auth.PayloadAuthority, authority).
+		SetPubkey(auth.PayloadSource, owner).
+		SetPubkey(auth.PayloadDestination, destinationOwner)
+	return ruleSet.Evaluate(operation, env)
+}
@@ -0,0 +1,410 @@
+package web3kit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	ata "github.com/donutnomad/solana-web3/associated_token_account"
+	"github.com/donutnomad/solana-web3/das"
+	bubblegum "github.com/donutnomad/solana-web3/mpl_bubblegum"
+	core "github.com/donutnomad/solana-web3/mpl_core"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	cmp "github.com/donutnomad/solana-web3/spl_account_compression"
+	"github.com/donutnomad/solana-web3/web3"
+	bin "github.com/gagliardetto/binary"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMetaplexCollection(t *testing.T) {
+	creator := web3.Keypair.Generate().PublicKey()
+	collectionMint := web3.Keypair.Generate().PublicKey()
+	metadata := mtm.Metadata{
+		Key:             mtm.KeyMetadataV1,
+		UpdateAuthority: web3.Keypair.Generate().PublicKey(),
+		Mint:            web3.Keypair.Generate().PublicKey(),
+		Data: mtm.Data{
+			// the strings are padded by the program
+			Name:     "item" + strings.Repeat("\x00", 28),
+			Symbol:   "IT" + strings.Repeat("\x00", 8),
+			Uri:      "https://example.com/1.json" + strings.Repeat("\x00", 174),
+			Creators: []mtm.Creator{{Address: creator, Verified: true, Share: 100}},
+		},
+		Collection: &mtm.Collection{Key: collectionMint},
+	}
+	data := GetBytes(&metadata)
+	if web3.NewPublicKeyFromBs(data[MetadataUpdateAuthorityOffset:MetadataUpdateAuthorityOffset+32]) != metadata.UpdateAuthority {
+		t.Fatal("unexpected update authority offset")
+	}
+	if web3.NewPublicKeyFromBs(data[MetadataFirstCreatorOffset:MetadataFirstCreatorOffset+32]) != creator {
+		t.Fatal("unexpected first creator offset")
+	}
+
+	payer := web3.Keypair.Generate().PublicKey()
+	mint := web3.Keypair.Generate().PublicKey()
+	instructions, err := MetaPlex.GetCreateNftInstructions(payer, mint, payer, payer, NftArgs{
+		Name:          "item",
+		TokenStandard: mtm.TokenStandardProgrammableNonFungible,
+		Collection:    &collectionMint,
+	}, NftOptions{ComputeUnitPrice: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// compute unit limit and price, create, mint
+	if len(instructions) != 4 || instructions[2].ProgramId != mtm.ProgramID {
+		t.Fatalf("unexpected instructions %v", instructions)
+	}
+	if mintMeta := instructions[2].Keys[2]; mintMeta.Pubkey != mint || !mintMeta.IsSigner || !mintMeta.IsWritable {
+		t.Fatalf("unexpected mint account %v", mintMeta)
+	}
+	tokenRecord, _ := mtm.FindTokenRecordAddress(mint, Must1(ata.FindAssociatedTokenAddress(payer, mint, web3.TokenProgramID)))
+	if instructions[3].Keys[4].Pubkey != tokenRecord {
+		t.Fatal("unexpected token record")
+	}
+}
+
+func TestPrintedEditions(t *testing.T) {
+	// editions 1, 2 and 250 in the legacy markers 0 and 1
+	var first, second mtm.EditionMarker
+	first.Ledger[0] = 0b0110_0000
+	second.Ledger[0] = 0b0010_0000
+	if !first.IsPrinted(1) || first.IsPrinted(3) || !second.IsPrinted(250) {
+		t.Fatal("unexpected edition marker")
+	}
+	var editions = append(first.Printed(0), second.Printed(1)...)
+	if !slices.Equal(editions, []uint64{1, 2, 250}) {
+		t.Fatalf("unexpected editions %v", editions)
+	}
+	maxSupply := uint64(3)
+	printed := &PrintedEditions{MasterEdition: &mtm.MasterEditionV2{Supply: 3, MaxSupply: &maxSupply}, Editions: editions}
+	if next, err := printed.NextAvailable(); err != nil || next != 3 {
+		t.Fatalf("unexpected next edition %d %v", next, err)
+	}
+	printed.Editions = []uint64{1, 2, 3}
+	if _, err := printed.NextAvailable(); err != EditionSupplyExhaustedErr {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	marker := mtm.EditionMarkerV2{Ledger: []byte{0b0100_0000, 0b1000_0001}}
+	if !slices.Equal(marker.Printed(), []uint64{1, 8, 15}) || marker.IsPrinted(16) {
+		t.Fatalf("unexpected editions %v", marker.Printed())
+	}
+}
+
+func TestNewCompressedNft(t *testing.T) {
+	tree := web3.Keypair.Generate().PublicKey()
+	owner := web3.Keypair.Generate().PublicKey()
+	metadata := bubblegum.MetadataArgs{Name: "leaf", Uri: "https://example.com/leaf.json"}
+	var leaves []*bubblegum.LeafSchema
+	var nodes [][32]byte
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		leaf := Must1(bubblegum.NewLeafSchema(tree, nonce, owner, owner, &metadata))
+		leaves = append(leaves, leaf)
+		nodes = append(nodes, leaf.Hash())
+	}
+	merkleTree := Must1(cmp.NewMerkleTree(3, nodes...))
+
+	leaf := leaves[1]
+	asset := &das.Asset{
+		Id: leaf.Id,
+		Compression: &das.Compression{
+			Compressed:  true,
+			DataHash:    leaf.DataHash,
+			CreatorHash: leaf.CreatorHash,
+			Tree:        &tree,
+			LeafId:      1,
+		},
+		Ownership: das.Ownership{Owner: owner},
+	}
+	proof := &das.AssetProof{Root: merkleTree.Root(), NodeIndex: 1<<3 + 1, Leaf: leaf.Hash(), TreeId: tree}
+	for _, node := range Must1(merkleTree.Proof(1)) {
+		proof.Proof = append(proof.Proof, node)
+	}
+	nft, err := NewCompressedNft(asset, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nft.Index() != 1 || nft.Delegate != owner || !cmp.VerifyProof(nft.Root, nft.Hash(), nft.Proof, nft.Index()) {
+		t.Fatalf("unexpected compressed nft %+v", nft)
+	}
+	delegate := web3.Keypair.Generate().PublicKey()
+	asset.Ownership.Delegate = &delegate
+	if _, err := NewCompressedNft(asset, proof); !errors.Is(err, StaleProofErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// the metadata given to verify a creator must match the hashes of the leaf, checked before the proof is fetched
+	creator := web3.Keypair.Generate().PublicKey()
+	metadata.Creators = []bubblegum.Creator{{Address: creator, Share: 100}}
+	unverified := &CompressedNft{Tree: tree, LeafSchema: *Must1(bubblegum.NewLeafSchema(tree, 3, owner, owner, &metadata))}
+	for _, changed := range []func(m *bubblegum.MetadataArgs){
+		func(m *bubblegum.MetadataArgs) { m.Name = "other" },
+		func(m *bubblegum.MetadataArgs) { m.Creators = append(m.Creators, bubblegum.Creator{Address: owner}) },
+	} {
+		args := metadata
+		args.Creators = slices.Clone(metadata.Creators)
+		changed(&args)
+		if _, err := MetaPlex.GetVerifyCompressedCreatorInstructions(context.Background(), nil, owner, creator, unverified, args, web3.CommitmentConfirmed); !errors.Is(err, LeafMetadataErr) {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+}
+
+func TestMetadataDiff(t *testing.T) {
+	current := mtm.Metadata{
+		Key:             mtm.KeyMetadataV1,
+		UpdateAuthority: web3.Keypair.Generate().PublicKey(),
+		Mint:            web3.Keypair.Generate().PublicKey(),
+		Data: mtm.Data{
+			Name:   "item" + strings.Repeat("\x00", 28),
+			Symbol: "IT" + strings.Repeat("\x00", 8),
+			Uri:    "https://example.com/1.json" + strings.Repeat("\x00", 174),
+		},
+		IsMutable:  true,
+		Collection: &mtm.Collection{Verified: true, Key: web3.Keypair.Generate().PublicKey()},
+	}
+	// the padding is ignored
+	desired := current
+	desired.Data = mtm.Data{Name: "item", Symbol: "IT", Uri: "https://example.com/1.json"}
+	if diff := DiffMetadata(&current, &desired); !diff.IsEmpty() {
+		t.Fatalf("unexpected diff %v", diff.fields())
+	}
+
+	desired.Data.Uri = "https://example.com/1-fixed.json"
+	diff := DiffMetadata(&current, &desired)
+	if !slices.Equal(diff.fields(), []string{"data"}) || diff.Data.Uri != desired.Data.Uri || diff.Data.Name != "item" {
+		t.Fatalf("unexpected diff %v", diff.fields())
+	}
+	for _, role := range []*mtm.MetadataDelegateRole{nil, web3.Ref(mtm.MetadataDelegateRoleData), web3.Ref(mtm.MetadataDelegateRoleDataItem)} {
+		if _, err := diff.UpdateArgs(role, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	args, _ := diff.UpdateArgs(web3.Ref(mtm.MetadataDelegateRoleData), nil)
+	if args.AsDataDelegateV2 == nil || args.AsDataDelegateV2.Data.Uri != desired.Data.Uri {
+		t.Fatalf("unexpected args %v", args)
+	}
+	if _, err := diff.UpdateArgs(web3.Ref(mtm.MetadataDelegateRoleCollection), nil); !errors.Is(err, UpdateRoleNotAllowedErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	desired.Collection = nil
+	desired.TokenStandard = web3.Ref(mtm.TokenStandardProgrammableNonFungible)
+	diff = DiffMetadata(&current, &desired)
+	if !diff.Collection.IsClear() || diff.TokenStandard == nil {
+		t.Fatalf("unexpected diff %v", diff.fields())
+	}
+	if args, _ = diff.UpdateArgs(nil, nil); args.AsUpdateAuthorityV2 == nil {
+		t.Fatalf("unexpected args %v", args)
+	}
+	if _, err := bin.MarshalBorsh(&args); err != nil {
+		t.Fatal(err)
+	}
+
+	current.IsMutable = false
+	if err := DiffMetadata(&current, &desired).Validate(&current); !errors.Is(err, ImmutableMetadataErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+// the accounts of the instructions of the NFTs and the programmable NFTs, the absent optional accounts are the program id
+func TestNftInstructions(t *testing.T) {
+	generate := func() web3.PublicKey { return web3.Keypair.Generate().PublicKey() }
+	mint, ruleSet, collectionMint := generate(), generate(), generate()
+	payer, owner, destinationOwner, delegate, updateAuthority := generate(), generate(), generate(), generate(), generate()
+	none := mtm.ProgramID
+	metadata, _ := mtm.FindAssociatedAddress(mint)
+	edition, _ := mtm.FindMasterEditionAddress(mint)
+	collectionMetadata, _ := mtm.FindAssociatedAddress(collectionMint)
+
+	// the compute budget instructions of a programmable NFT come first
+	computeBudgetProgram := web3.MustPublicKey("ComputeBudget111111111111111111111111111111")
+	var budget int
+	checkCount := func(name string, instructions []web3.TransactionInstruction, err error, count int) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(instructions) != budget+count {
+			t.Fatalf("%s: unexpected instructions %d", name, len(instructions))
+		}
+		for _, ins := range instructions[:budget] {
+			if ins.ProgramId != computeBudgetProgram {
+				t.Fatalf("%s: unexpected compute budget instruction %s", name, ins.ProgramId)
+			}
+		}
+	}
+	// the last instruction, after the compute budget
+	last := func(name string, instructions []web3.TransactionInstruction, err error) web3.TransactionInstruction {
+		t.Helper()
+		checkCount(name, instructions, err, 1)
+		return instructions[budget]
+	}
+	expect := func(name string, ins web3.TransactionInstruction, discriminator uint8, want ...web3.PublicKey) {
+		t.Helper()
+		var keys []web3.PublicKey
+		for _, key := range ins.Keys {
+			keys = append(keys, key.Pubkey)
+		}
+		if ins.ProgramId != mtm.ProgramID || ins.Data[0] != discriminator {
+			t.Fatalf("%s: unexpected instruction %s %d", name, ins.ProgramId, ins.Data[0])
+		}
+		if !slices.Equal(keys, want) {
+			t.Fatalf("%s: unexpected accounts\n%v\nwant\n%v", name, keys, want)
+		}
+	}
+
+	for _, standard := range []mtm.TokenStandard{mtm.TokenStandardNonFungible, mtm.TokenStandardProgrammableNonFungible} {
+		asset := &NftAsset{
+			Mint:         mint,
+			TokenProgram: web3.TokenProgramID,
+			Metadata:     metadata,
+			Edition:      edition,
+			Data: &mtm.Metadata{
+				UpdateAuthority: updateAuthority,
+				Mint:            mint,
+				Collection:      &mtm.Collection{Key: collectionMint, Verified: true},
+				Uses:            &mtm.Uses{UseMethod: mtm.UseMethodBurn, Remaining: 2, Total: 2},
+			},
+			TokenStandard: standard,
+		}
+		token, _ := asset.AssociatedToken(owner)
+		destination, _ := asset.AssociatedToken(destinationOwner)
+		tokenRecord, destinationRecord, rulesProgram, rules := none, none, none, none
+		tokenDelegate := mtm.NewDelegateArgs_StandardV1(1)
+		budget = 0
+		if asset.IsProgrammable() {
+			budget = 1
+			asset.RuleSet = &ruleSet
+			tokenRecord, _ = mtm.FindTokenRecordAddress(mint, token)
+			destinationRecord, _ = mtm.FindTokenRecordAddress(mint, destination)
+			rulesProgram, rules = TokenAuthRulesProgramID, ruleSet
+			tokenDelegate = mtm.NewDelegateArgs_TransferV1(1, nil)
+		}
+		name := standard.String()
+
+		// the associated token account of the destination is created first
+		instructions, err := MetaPlex.transferNftInstructions(asset, payer, owner, destinationOwner, owner, 1, NftOptions{})
+		checkCount(name+" transfer", instructions, err, 2)
+		if instructions[budget].ProgramId != web3.SPLAssociatedTokenAccountProgramID {
+			t.Fatalf("%s transfer: unexpected instruction %s", name, instructions[budget].ProgramId)
+		}
+		expect(name+" transfer", instructions[budget+1], mtm.Instruction_Transfer,
+			token, owner, destination, destinationOwner, mint, metadata, edition, tokenRecord, destinationRecord,
+			owner, payer, web3.SystemProgramID, web3.SysvarInstructions, web3.TokenProgramID, web3.SPLAssociatedTokenAccountProgramID, rulesProgram, rules)
+
+		instructions, err = MetaPlex.delegateNftInstructions(asset, payer, owner, delegate, owner, tokenDelegate, NftOptions{})
+		expect(name+" token delegate", last(name, instructions, err), mtm.Instruction_Delegate,
+			none, delegate, metadata, edition, tokenRecord, mint, token,
+			owner, payer, web3.SystemProgramID, web3.SysvarInstructions, web3.TokenProgramID, rulesProgram, rules)
+
+		delegateRecord, _ := mtm.FindMetadataDelegateRecordAddress(mint, mtm.MetadataDelegateRoleData, updateAuthority, delegate)
+		instructions, err = MetaPlex.delegateNftInstructions(asset, payer, owner, delegate, updateAuthority, mtm.NewDelegateArgs_DataV1(nil), NftOptions{})
+		expect(name+" metadata delegate", last(name, instructions, err), mtm.Instruction_Delegate,
+			delegateRecord, delegate, metadata, edition, none, mint, none,
+			updateAuthority, payer, web3.SystemProgramID, web3.SysvarInstructions, web3.TokenProgramID, rulesProgram, rules)
+
+		for lock, discriminator := range map[bool]uint8{true: mtm.Instruction_Lock, false: mtm.Instruction_Unlock} {
+			instructions, err = MetaPlex.lockNftInstructions(asset, payer, owner, delegate, lock, NftOptions{})
+			expect(name+" lock", last(name, instructions, err), discriminator,
+				delegate, owner, token, mint, metadata, edition, tokenRecord,
+				payer, web3.SystemProgramID, web3.SysvarInstructions, web3.TokenProgramID, rulesProgram, rules)
+		}
+
+		instructions, err = MetaPlex.burnNftInstructions(asset, owner, owner, 1, NftOptions{})
+		expect(name+" burn", last(name, instructions, err), mtm.Instruction_Burn,
+			owner, collectionMetadata, metadata, edition, mint, token, none, none, none, none, tokenRecord,
+			web3.SystemProgramID, web3.SysvarInstructions, web3.TokenProgramID)
+
+		instructions, err = MetaPlex.useNftInstructions(asset, owner, owner, 1)
+		if asset.IsProgrammable() {
+			if !errors.Is(err, UnsupportedTokenStandardErr) {
+				t.Fatalf("%s: unexpected error %v", name, err)
+			}
+			continue
+		}
+		// the use authority record and the burner are absent for the owner
+		expect(name+" use", last(name, instructions, err), mtm.Instruction_Utilize,
+			metadata, token, mint, owner, owner, web3.TokenProgramID, web3.SPLAssociatedTokenAccountProgramID, web3.SystemProgramID, web3.SYSVAR_RENT_PUBKEY)
+		useAuthorityRecord, _ := mtm.FindUseAuthorityRecordAddress(mint, delegate)
+		burner, _ := mtm.FindProgramAsBurnerAddress()
+		instructions, err = MetaPlex.useNftInstructions(asset, owner, delegate, 1)
+		expect(name+" delegated use", last(name, instructions, err), mtm.Instruction_Utilize,
+			metadata, token, mint, delegate, owner, web3.TokenProgramID, web3.SPLAssociatedTokenAccountProgramID, web3.SystemProgramID, web3.SYSVAR_RENT_PUBKEY, useAuthorityRecord, burner)
+		asset.Data.Uses = nil
+		if _, err := MetaPlex.useNftInstructions(asset, owner, owner, 1); !errors.Is(err, NftNoUsesErr) {
+			t.Fatalf("%s: unexpected error %v", name, err)
+		}
+	}
+}
+
+func TestMetadataDelegateRecord(t *testing.T) {
+	generate := func() web3.PublicKey { return web3.Keypair.Generate().PublicKey() }
+	mint, collectionMint, updateAuthority, delegate := generate(), generate(), generate(), generate()
+	asset := &NftAsset{Mint: mint, Data: &mtm.Metadata{UpdateAuthority: updateAuthority, Mint: mint}}
+	onAsset, _ := mtm.FindMetadataDelegateRecordAddress(mint, mtm.MetadataDelegateRoleData, updateAuthority, delegate)
+	onCollection, _ := mtm.FindMetadataDelegateRecordAddress(collectionMint, mtm.MetadataDelegateRoleData, updateAuthority, delegate)
+
+	// the delegate record of the asset exists if it is in the existing accounts
+	var existing []web3.PublicKey
+	connection := newRpcStub(t, func(request rpcStubRequest) any {
+		var address string
+		_ = json.Unmarshal(request.Params[0], &address)
+		if request.Method != "getAccountInfo" || !slices.Contains(existing, web3.MustPublicKey(address)) {
+			return nil
+		}
+		return map[string]any{"data": []string{"", "base64"}, "executable": false, "lamports": 1, "owner": mtm.ProgramID.String(), "rentEpoch": 0, "space": 0}
+	})
+
+	if _, err := MetaPlex.metadataDelegateRecord(connection, asset, mtm.MetadataDelegateRoleData, delegate, web3.CommitmentConfirmed); !errors.Is(err, CollectionDelegateNoneErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	asset.Data.Collection = &mtm.Collection{Key: collectionMint, Verified: true}
+	if record, err := MetaPlex.metadataDelegateRecord(connection, asset, mtm.MetadataDelegateRoleData, delegate, web3.CommitmentConfirmed); err != nil || record != onCollection {
+		t.Fatalf("unexpected record %s %v", record, err)
+	}
+	existing = append(existing, onAsset)
+	if record, err := MetaPlex.metadataDelegateRecord(connection, asset, mtm.MetadataDelegateRoleData, delegate, web3.CommitmentConfirmed); err != nil || record != onAsset {
+		t.Fatalf("unexpected record %s %v", record, err)
+	}
+	// an item delegate is approved on the asset, its record is not fetched
+	item, _ := mtm.FindMetadataDelegateRecordAddress(mint, mtm.MetadataDelegateRoleDataItem, updateAuthority, delegate)
+	if record, err := MetaPlex.metadataDelegateRecord(nil, asset, mtm.MetadataDelegateRoleDataItem, delegate, web3.CommitmentConfirmed); err != nil || record != item {
+		t.Fatalf("unexpected record %s %v", record, err)
+	}
+}
+
+func TestCheckCoreTransferable(t *testing.T) {
+	generate := func() web3.PublicKey { return web3.Keypair.Generate().PublicKey() }
+	owner, delegate, collectionKey := generate(), generate(), generate()
+	permanent := func(pluginType core.PluginType, plugin core.Plugin, authority core.Authority) *core.Plugins {
+		return &core.Plugins{Records: []core.PluginRecord{{Type: pluginType, Authority: authority, Plugin: plugin}}}
+	}
+	asset := &core.AssetV1{BaseAssetV1: core.BaseAssetV1{Key: core.KeyAssetV1, Owner: owner, UpdateAuthority: core.NewUpdateAuthority_Collection(collectionKey)}}
+	collection := &core.CollectionV1{BaseCollectionV1: core.BaseCollectionV1{Key: core.KeyCollectionV1, UpdateAuthority: generate()}}
+	if err := checkCoreTransferable(asset, collection, owner); err != nil {
+		t.Fatal(err)
+	}
+
+	// the PermanentFreezeDelegate of the collection freezes the asset
+	collection.Plugins = permanent(core.PluginTypePermanentFreezeDelegate, core.NewPlugin_PermanentFreezeDelegate(core.PermanentFreezeDelegate{Frozen: true}), core.NewAuthority_UpdateAuthority())
+	if err := checkCoreTransferable(asset, collection, owner); !errors.Is(err, CoreAssetFrozenErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the PermanentTransferDelegate of the asset transfers it
+	asset.Plugins = permanent(core.PluginTypePermanentTransferDelegate, core.NewPlugin_PermanentTransferDelegate(core.PermanentTransferDelegate{}), core.NewAuthority_Address(delegate))
+	if err := checkCoreTransferable(asset, collection, delegate); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCoreTransferable(asset, collection, owner); !errors.Is(err, CoreAssetFrozenErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// the FreezeDelegate of the asset freezes it
+	asset.Plugins = permanent(core.PluginTypeFreezeDelegate, core.NewPlugin_FreezeDelegate(core.FreezeDelegate{Frozen: true}), core.NewAuthority_Owner())
+	collection.Plugins = nil
+	if err := checkCoreTransferable(asset, collection, owner); !errors.Is(err, CoreAssetFrozenErr) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
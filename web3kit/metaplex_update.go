@@ -0,0 +1,302 @@
+package web3kit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	mtm "github.com/donutnomad/solana-web3/mpl_token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	bin "github.com/gagliardetto/binary"
+	"strings"
+)
+
+var (
+	ImmutableMetadataErr      = errors.New("the metadata is immutable")
+	InvalidMetadataChangeErr  = errors.New("the change of the metadata is not allowed")
+	UpdateRoleNotAllowedErr   = errors.New("the role of the authority is not allowed to update the changed fields")
+	CollectionDelegateNoneErr = errors.New("the nft is not in a collection, the collection-level delegate does not apply")
+)
+
+// MetadataDiff the changes from the on-chain metadata to the desired metadata, the fields are unchanged when nil or None
+type MetadataDiff struct {
+	NewUpdateAuthority  *web3.PublicKey
+	Data                *mtm.Data
+	PrimarySaleHappened *bool
+	IsMutable           *bool
+	TokenStandard       *mtm.TokenStandard
+	Collection          mtm.CollectionToggle
+	CollectionDetails   mtm.CollectionDetailsToggle
+	Uses                mtm.UsesToggle
+	RuleSet             mtm.RuleSetToggle
+}
+
+// trimData the data without the null padding of the on-chain strings
+func trimData(data mtm.Data) mtm.Data {
+	data.Name = strings.TrimRight(data.Name, "\x00")
+	data.Symbol = strings.TrimRight(data.Symbol, "\x00")
+	data.Uri = strings.TrimRight(data.Uri, "\x00")
+	return data
+}
+
+// equalBorsh compares the borsh encodings of the values, the generated types are not comparable
+func equalBorsh(a, b any) bool {
+	x, err := bin.MarshalBorsh(a)
+	if err != nil {
+		return false
+	}
+	y, err := bin.MarshalBorsh(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
+
+func ruleSetOf(metadata *mtm.Metadata) *web3.PublicKey {
+	if metadata.ProgrammableConfig != nil && metadata.ProgrammableConfig.V1 != nil {
+		return metadata.ProgrammableConfig.V1.RuleSet
+	}
+	return nil
+}
+
+// DiffMetadata the changes from the current on-chain metadata to the desired metadata.
+// The strings of the data are compared without their null padding, the key, the mint and the edition nonce are ignored
+func DiffMetadata(current, desired *mtm.Metadata) *MetadataDiff {
+	var ret = &MetadataDiff{
+		Collection:        mtm.NewCollectionToggle_None(),
+		CollectionDetails: mtm.NewCollectionDetailsToggle_None(),
+		Uses:              mtm.NewUsesToggle_None(),
+		RuleSet:           mtm.NewRuleSetToggle_None(),
+	}
+	if desired.UpdateAuthority != current.UpdateAuthority {
+		ret.NewUpdateAuthority = &desired.UpdateAuthority
+	}
+	if data := trimData(desired.Data); !equalBorsh(&data, web3.Ref(trimData(current.Data))) {
+		ret.Data = &data
+	}
+	if desired.PrimarySaleHappened != current.PrimarySaleHappened {
+		ret.PrimarySaleHappened = &desired.PrimarySaleHappened
+	}
+	if desired.IsMutable != current.IsMutable {
+		ret.IsMutable = &desired.IsMutable
+	}
+	if desired.TokenStandard != nil && (current.TokenStandard == nil || *desired.TokenStandard != *current.TokenStandard) {
+		ret.TokenStandard = desired.TokenStandard
+	}
+	switch {
+	case desired.Collection == nil && current.Collection != nil:
+		ret.Collection = mtm.NewCollectionToggle_Clear()
+	case desired.Collection != nil && (current.Collection == nil || *desired.Collection != *current.Collection):
+		ret.Collection = mtm.NewCollectionToggle_Set(*desired.Collection)
+	}
+	switch {
+	case desired.CollectionDetails == nil && current.CollectionDetails != nil:
+		ret.CollectionDetails = mtm.NewCollectionDetailsToggle_Clear()
+	case desired.CollectionDetails != nil && (current.CollectionDetails == nil || !equalBorsh(desired.CollectionDetails, current.CollectionDetails)):
+		ret.CollectionDetails = mtm.NewCollectionDetailsToggle_Set(*desired.CollectionDetails)
+	}
+	switch {
+	case desired.Uses == nil && current.Uses != nil:
+		ret.Uses = mtm.NewUsesToggle_Clear()
+	case desired.Uses != nil && (current.Uses == nil || *desired.Uses != *current.Uses):
+		ret.Uses = mtm.NewUsesToggle_Set(*desired.Uses)
+	}
+	switch desiredRuleSet, currentRuleSet := ruleSetOf(desired), ruleSetOf(current); {
+	case desiredRuleSet == nil && currentRuleSet != nil:
+		ret.RuleSet = mtm.NewRuleSetToggle_Clear()
+	case desiredRuleSet != nil && (currentRuleSet == nil || *desiredRuleSet != *currentRuleSet):
+		ret.RuleSet = mtm.NewRuleSetToggle_Set(*desiredRuleSet)
+	}
+	return ret
+}
+
+// fields the names of the changed fields
+func (d *MetadataDiff) fields() []string {
+	var ret []string
+	for _, field := range []struct {
+		name    string
+		changed bool
+	}{
+		{"update authority", d.NewUpdateAuthority != nil},
+		{"data", d.Data != nil},
+		{"primary sale happened", d.PrimarySaleHappened != nil},
+		{"is mutable", d.IsMutable != nil},
+		{"token standard", d.TokenStandard != nil},
+		{"collection", !d.Collection.IsNone()},
+		{"collection details", !d.CollectionDetails.IsNone()},
+		{"uses", !d.Uses.IsNone()},
+		{"rule set", !d.RuleSet.IsNone()},
+	} {
+		if field.changed {
+			ret = append(ret, field.name)
+		}
+	}
+	return ret
+}
+
+// IsEmpty returns true if the metadata is unchanged
+func (d *MetadataDiff) IsEmpty() bool {
+	return len(d.fields()) == 0
+}
+
+// Validate checks the changes against the current metadata: an immutable metadata keeps its data,
+// the primary sale cannot be reset and an immutable metadata cannot become mutable
+func (d *MetadataDiff) Validate(current *mtm.Metadata) error {
+	if !current.IsMutable && (d.Data != nil || d.IsMutable != nil) {
+		return ImmutableMetadataErr
+	}
+	if d.PrimarySaleHappened != nil && !*d.PrimarySaleHappened {
+		return fmt.Errorf("%w: the primary sale cannot be reset", InvalidMetadataChangeErr)
+	}
+	if d.Collection.IsSet() && d.Collection.AsSet().Field0.Verified {
+		return fmt.Errorf("%w: the collection is verified by the VerifyCollection instruction", InvalidMetadataChangeErr)
+	}
+	return nil
+}
+
+// UpdateArgs the args of the Update instruction of the role, nil for the update authority.
+// The update authority updates by V1, or by AsUpdateAuthorityV2 to change the token standard.
+// A delegate updates only the fields of its role: the data for a (item) data delegate, the collection for a (item) collection delegate,
+// the rule set for a (item) programmable config delegate, the update authority, the primary sale, the mutability and the token standard for an authority item delegate
+func (d *MetadataDiff) UpdateArgs(role *mtm.MetadataDelegateRole, authorizationData *mtm.AuthorizationData) (mtm.UpdateArgs, error) {
+	// the fields the role can update
+	var allowed = map[string]bool{}
+	var args mtm.UpdateArgs
+	switch {
+	case role == nil:
+		if d.TokenStandard != nil {
+			args = mtm.NewUpdateArgs_AsUpdateAuthorityV2(d.NewUpdateAuthority, d.Data, d.PrimarySaleHappened, d.IsMutable, d.Collection, d.CollectionDetails, d.Uses, d.RuleSet, d.TokenStandard, authorizationData)
+		} else {
+			args = mtm.NewUpdateArgs_V1(d.NewUpdateAuthority, d.Data, d.PrimarySaleHappened, d.IsMutable, d.Collection, d.CollectionDetails, d.Uses, d.RuleSet, authorizationData)
+		}
+		return args, nil
+	case *role == mtm.MetadataDelegateRoleData:
+		allowed["data"] = true
+		args = mtm.NewUpdateArgs_AsDataDelegateV2(d.Data, authorizationData)
+	case *role == mtm.MetadataDelegateRoleDataItem:
+		allowed["data"] = true
+		args = mtm.NewUpdateArgs_AsDataItemDelegateV2(d.Data, authorizationData)
+	case *role == mtm.MetadataDelegateRoleCollection:
+		allowed["collection"] = true
+		args = mtm.NewUpdateArgs_AsCollectionDelegateV2(d.Collection, authorizationData)
+	case *role == mtm.MetadataDelegateRoleCollectionItem:
+		allowed["collection"] = true
+		args = mtm.NewUpdateArgs_AsCollectionItemDelegateV2(d.Collection, authorizationData)
+	case *role == mtm.MetadataDelegateRoleProgrammableConfig:
+		allowed["rule set"] = true
+		args = mtm.NewUpdateArgs_AsProgrammableConfigDelegateV2(d.RuleSet, authorizationData)
+	case *role == mtm.MetadataDelegateRoleProgrammableConfigItem:
+		allowed["rule set"] = true
+		args = mtm.NewUpdateArgs_AsProgrammableConfigItemDelegateV2(d.RuleSet, authorizationData)
+	case *role == mtm.MetadataDelegateRoleAuthorityItem:
+		allowed["update authority"], allowed["primary sale happened"], allowed["is mutable"], allowed["token standard"] = true, true, true, true
+		args = mtm.NewUpdateArgs_AsAuthorityItemDelegateV2(d.NewUpdateAuthority, d.PrimarySaleHappened, d.IsMutable, d.TokenStandard, authorizationData)
+	default:
+		return mtm.UpdateArgs{}, fmt.Errorf("%w: %s", UpdateRoleNotAllowedErr, role)
+	}
+	for _, field := range d.fields() {
+		if !allowed[field] {
+			return mtm.UpdateArgs{}, fmt.Errorf("%w: %s by %s", UpdateRoleNotAllowedErr, field, role)
+		}
+	}
+	return args, nil
+}
+
+// isCollectionLevelRole returns true for the delegates which may be approved on the collection NFT, they update the items of the collection
+func isCollectionLevelRole(role mtm.MetadataDelegateRole) bool {
+	return role == mtm.MetadataDelegateRoleData || role == mtm.MetadataDelegateRoleCollection || role == mtm.MetadataDelegateRoleProgrammableConfig
+}
+
+// metadataDelegateRecord the delegate record of the metadata delegate of the role.
+// A data, collection or programmable config delegate approved on the NFT itself is used if its record exists,
+// otherwise the delegate approved on the collection NFT
+func (m metaPlex) metadataDelegateRecord(
+	connection *web3.Connection,
+	asset *NftAsset,
+	role mtm.MetadataDelegateRole,
+	authority web3.PublicKey,
+	commitment web3.Commitment,
+) (_ web3.PublicKey, err error) {
+	defer Recover(&err)
+
+	record := Must1(mtm.FindMetadataDelegateRecordAddress(asset.Mint, role, asset.Data.UpdateAuthority, authority))
+	if !isCollectionLevelRole(role) {
+		return record, nil
+	}
+	if info := Must1(connection.GetAccountInfo(record, web3.GetAccountInfoConfig{Commitment: &commitment})); info != nil {
+		return record, nil
+	}
+	if asset.Data.Collection == nil {
+		return web3.PublicKey{}, CollectionDelegateNoneErr
+	}
+	return mtm.FindMetadataDelegateRecordAddress(asset.Data.Collection.Key, role, asset.Data.UpdateAuthority, authority)
+}
+
+// GetUpdateNftInstructions Get the instructions to update the metadata of an NFT to the desired metadata, no instruction if the metadata is unchanged.
+// The desired metadata is usually a copy of the on-chain metadata with the fields to change, see DiffMetadata and MetadataDiff.UpdateArgs.
+// Returns the instructions and the changes
+// @param authority The update authority, or the metadata delegate of the role
+// @param role nil for the update authority. A data, collection or programmable config delegate is approved on the NFT or on the collection NFT, its item variant on the NFT
+func (m metaPlex) GetUpdateNftInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority, mint web3.PublicKey,
+	desired *mtm.Metadata,
+	role *mtm.MetadataDelegateRole,
+	options NftOptions,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, _ *MetadataDiff, err error) {
+	defer Recover(&err)
+
+	asset := Must1(m.ResolveNftAsset(ctx, connection, mint, commitment))
+	diff := DiffMetadata(asset.Data, desired)
+	if diff.IsEmpty() {
+		return nil, diff, nil
+	}
+	Must(diff.Validate(asset.Data))
+	args := Must1(diff.UpdateArgs(role, options.AuthorizationData))
+
+	var delegateRecord = mtm.ProgramID
+	if role != nil {
+		delegateRecord = Must1(m.metadataDelegateRecord(connection, asset, *role, authority, commitment))
+	}
+	rulesProgram, ruleSet := asset.AuthorizationRules()
+
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(m.computeBudgetInstructions(asset, options))...)
+	Must(tx.AddInsBuilder(mtm.NewUpdateInstruction(
+		args,
+		authority,
+		delegateRecord,
+		mtm.ProgramID,
+		mint,
+		asset.Metadata,
+		asset.Edition,
+		payer,
+		web3.SystemProgramID,
+		web3.SysvarInstructions,
+		rulesProgram,
+		ruleSet,
+	)))
+	return tx.ExportIns(), diff, nil
+}
+
+// UpdateNft updates the metadata of an NFT to the desired metadata, see GetUpdateNftInstructions.
+// Returns an empty signature if the metadata is unchanged
+func (m metaPlex) UpdateNft(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, authority web3.Signer,
+	mint web3.PublicKey,
+	desired *mtm.Metadata,
+	role *mtm.MetadataDelegateRole,
+	options NftOptions,
+	confirmOptions web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, _, err := m.GetUpdateNftInstructions(ctx, connection, payer.PublicKey(), authority.PublicKey(), mint, desired, role, options, commitmentOrDefault(confirmOptions.Commitment))
+	if err != nil || len(instructions) == 0 {
+		return "", err
+	}
+	signers := DeDupBy([]web3.Signer{payer, authority}, func(s web3.Signer) web3.PublicKey { return s.PublicKey() })
+	return sendInstructions(ctx, connection, payer, signers, instructions, true, confirmOptions)
+}
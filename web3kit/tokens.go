@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	ata "github.com/donutnomad/solana-web3/associated_token_account"
+	offchain "github.com/donutnomad/solana-web3/offchain_metadata"
 	spltoken2022 "github.com/donutnomad/solana-web3/spl_token_2022"
 	"github.com/donutnomad/solana-web3/web3"
 	"github.com/donutnomad/solana-web3/web3kit/solanatokenlist"
@@ -57,6 +58,18 @@ func (t tokenKit) Transfer(
 	if err != nil {
 		return "", err
 	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, from}, instructions, confirm, options)
+}
+
+func sendInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	signers []web3.Signer,
+	instructions []web3.TransactionInstruction,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
 	blockhash, err := connection.GetLatestBlockhash(web3.GetLatestBlockhashConfig{
 		Commitment: &web3.CommitmentFinalized,
 	})
@@ -67,9 +80,9 @@ func (t tokenKit) Transfer(
 	transaction.SetFeePayer(payer.PublicKey())
 	transaction.AddInstructions(instructions...)
 	if confirm {
-		return connection.SendAndConfirmTransaction(ctx, *transaction, []web3.Signer{payer, from}, options)
+		return connection.SendAndConfirmTransaction(ctx, *transaction, signers, options)
 	} else {
-		return connection.SendTransaction(transaction, []web3.Signer{payer, from}, web3.SendOptions{
+		return connection.SendTransaction(transaction, signers, web3.SendOptions{
 			SkipPreflight:       options.SkipPreflight,
 			PreflightCommitment: options.PreflightCommitment,
 			MaxRetries:          options.MaxRetries,
@@ -99,6 +112,10 @@ func (tokenKit) GetTransferInstructions(
 		return tx.ExportIns(), nil
 	}
 
+	if programId == web3.TokenProgram2022ID {
+		return Token2022.GetTransferInstructions(connection, payer, from, to, mint, amount, TransferOptions{ResolveTransferHook: true}, multiSigners...)
+	}
+
 	associatedFrom := Must1(ata.FindAssociatedTokenAddress(from, mint, programId))
 	associatedTo := Must1(ata.FindAssociatedTokenAddress(to, mint, programId))
 	for _, accounts := range [][]web3.PublicKey{{associatedFrom, from}, {associatedTo, to}} {
@@ -110,21 +127,9 @@ func (tokenKit) GetTransferInstructions(
 			ata.NewCreateInstruction(payer, accounts[0], accounts[1], mint, web3.SystemProgramID, programId),
 		))
 	}
-	var ctx = context.Background()
-	if programId == web3.TokenProgram2022ID {
-		getMint, err := Token2022.GetMint(ctx, connection, mint, programId, web3.GetAccountInfoConfig{})
-		if err != nil {
-			return nil, err
-		}
-		var decimals = getMint.Decimals
-		Must(tx.AddInsBuilder(
-			spltoken2022.NewTransferCheckedInstruction(amount, decimals, associatedFrom, mint, associatedTo, from).SetAuthorityAccount(from, multiSigners...),
-		))
-	} else {
-		Must(tx.AddInsBuilder(
-			token.NewTransferInstruction(amount, associatedFrom.D(), associatedTo.D(), from.D(), Map(multiSigners, convertPublicKey))),
-		)
-	}
+	Must(tx.AddInsBuilder(
+		token.NewTransferInstruction(amount, associatedFrom.D(), associatedTo.D(), from.D(), Map(multiSigners, convertPublicKey))),
+	)
 	return tx.ExportIns(), nil
 }
 
@@ -203,6 +208,34 @@ func (t tokenKit) GetTokenName(ctx context.Context, connection *web3.Connection,
 	return "", "", "", nil
 }
 
+// GetTokenJsonMetadata fetches the JSON metadata of the uri of the Metaplex metadata of the mint, or of its Token-2022 TokenMetadata,
+// and validates it against the on-chain name and symbol. The metadata is returned along with a validation error, nil if the mint has no metadata
+func (t tokenKit) GetTokenJsonMetadata(ctx context.Context, connection *web3.Connection, fetcher *offchain.Fetcher, mint web3.PublicKey, commitment *web3.Commitment) (_ *offchain.Metadata, err error) {
+	defer Recover(&err)
+
+	var name, symbol, uri string
+	if metadata := Must1(MetaPlex.GetMetadata(ctx, connection, mint, commitment)); metadata != nil {
+		name, symbol, uri = metadata.Data.Name, metadata.Data.Symbol, metadata.Data.Uri
+	} else {
+		metadata, err := Token2022.GetTokenMetadata(ctx, connection, mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{
+			Commitment: commitment,
+		})
+		if err != nil {
+			if !(errors.Is(err, TokenAccountNotFoundErr) || errors.Is(err, TokenInvalidAccountOwnerErr)) {
+				return nil, err
+			}
+		}
+		if metadata == nil {
+			return nil, nil
+		}
+		name, symbol, uri = metadata.Name, metadata.Symbol, metadata.Uri
+	}
+	if offchain.TrimOnChain(uri) == "" {
+		return nil, nil
+	}
+	return fetcher.FetchAndValidate(ctx, uri, name, symbol)
+}
+
 var convertPublicKey = func(i int, t web3.PublicKey) solana.PublicKey {
 	return t.D()
 }
@@ -7,13 +7,21 @@ import (
 	"fmt"
 	ata "github.com/donutnomad/solana-web3/associated_token_account"
 	. "github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_mint_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer_fee"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/cpi_guard"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/default_account_state"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_member_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_pointer"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/immutable_owner"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/metadata_pointer"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/mint_close_authority"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/non_transferable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/pausable"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permanent_delegate"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permissioned_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_hook"
 	"github.com/donutnomad/solana-web3/web3"
@@ -33,6 +41,9 @@ func (t tokenKit2022) MEMO_TRANSFER_SIZE() int {
 func (t tokenKit2022) INTEREST_BEARING_MINT_CONFIG_STATE_SIZE() int {
 	return 52
 }
+func (t tokenKit2022) SCALED_UI_AMOUNT_CONFIG_SIZE() int {
+	return 56
+}
 func (t tokenKit2022) TRANSFER_FEE_AMOUNT_SIZE() int {
 	return 8
 }
@@ -75,24 +86,27 @@ func (t tokenKit2022) GetLen(extensionTypes []ExtensionType, baseSize int) (uint
 	}
 }
 
+// GetExtensionType returns the known extension types of the TLV data, the parsing stops at the first uninitialized entry.
+// The extensions unknown to this version are skipped, see ParseTlv for the raw entries
 func (t tokenKit2022) GetExtensionType(tlvData []byte) ([]ExtensionType, error) {
 	var extensionTypes []ExtensionType
 	var extensionTypeIndex uint64 = 0
-	for {
-		if extensionTypeIndex < uint64(len(tlvData)) {
-			entryType, err := t.readUint16LE(tlvData, extensionTypeIndex)
-			if err != nil {
-				return nil, err
-			}
-			extensionTypes = append(extensionTypes, ExtensionType(entryType))
-			entryLength, err := t.readUint16LE(tlvData, extensionTypeIndex+uint64(t.TYPE_SIZE()))
-			if err != nil {
-				return nil, err
-			}
-			extensionTypeIndex += t.AddTypeAndLengthToLen2(uint64(entryLength))
-		} else {
+	for t.AddTypeAndLengthToLen2(extensionTypeIndex) <= uint64(len(tlvData)) {
+		entryType, err := t.readUint16LE(tlvData, extensionTypeIndex)
+		if err != nil {
+			return nil, err
+		}
+		if ExtensionType(entryType) == ExtensionTypeUninitialized {
 			break
 		}
+		if extensionType, ok := knownExtensionType(entryType); ok {
+			extensionTypes = append(extensionTypes, extensionType)
+		}
+		entryLength, err := t.readUint16LE(tlvData, extensionTypeIndex+uint64(t.TYPE_SIZE()))
+		if err != nil {
+			return nil, err
+		}
+		extensionTypeIndex += t.AddTypeAndLengthToLen2(uint64(entryLength))
 	}
 	return extensionTypes, nil
 }
@@ -110,6 +124,9 @@ func (t tokenKit2022) GetExtensionData(extension ExtensionType, tlvData []byte)
 				return nil, err
 			}
 			var typeIndex = t.AddTypeAndLengthToLen2(extensionTypeIndex)
+			if typeIndex+uint64(entryLength) > uint64(len(tlvData)) {
+				return nil, InvalidAccountSizeErr
+			}
 			if entryType == uint16(extension) {
 				return tlvData[typeIndex : typeIndex+uint64(entryLength)], nil
 			}
@@ -132,9 +149,13 @@ func (t tokenKit2022) GetTypeLen(e ExtensionType) (int, error) {
 	case ExtensionTypeMintCloseAuthority:
 		return mint_close_authority.MINT_CLOSE_AUTHORITY_SIZE, nil
 	case ExtensionTypeConfidentialTransferMint:
-		return 97, nil
+		return confidential_transfer.CONFIDENTIAL_TRANSFER_MINT_SIZE, nil
 	case ExtensionTypeConfidentialTransferAccount:
-		return 286, nil
+		return confidential_transfer.CONFIDENTIAL_TRANSFER_ACCOUNT_SIZE, nil
+	case ExtensionTypeConfidentialTransferFeeConfig:
+		return confidential_transfer_fee.CONFIDENTIAL_TRANSFER_FEE_CONFIG_SIZE, nil
+	case ExtensionTypeConfidentialTransferFeeAmount:
+		return confidential_transfer_fee.CONFIDENTIAL_TRANSFER_FEE_AMOUNT_SIZE, nil
 	case ExtensionTypeCpiGuard:
 		return cpi_guard.CPI_GUARD_SIZE, nil
 	case ExtensionTypeDefaultAccountState:
@@ -157,6 +178,24 @@ func (t tokenKit2022) GetTypeLen(e ExtensionType) (int, error) {
 		return transfer_hook.TRANSFER_HOOK_SIZE, nil
 	case ExtensionTypeTransferHookAccount:
 		return transfer_hook.TRANSFER_HOOK_ACCOUNT_SIZE, nil
+	case ExtensionTypeGroupPointer:
+		return group_pointer.GROUP_POINTER_SIZE, nil
+	case ExtensionTypeTokenGroup:
+		return token_group.TOKEN_GROUP_SIZE, nil
+	case ExtensionTypeGroupMemberPointer:
+		return group_member_pointer.GROUP_MEMBER_POINTER_SIZE, nil
+	case ExtensionTypeTokenGroupMember:
+		return token_group.TOKEN_GROUP_MEMBER_SIZE, nil
+	case ExtensionTypeConfidentialMintBurn:
+		return confidential_mint_burn.CONFIDENTIAL_MINT_BURN_SIZE, nil
+	case ExtensionTypeScaledUiAmount:
+		return t.SCALED_UI_AMOUNT_CONFIG_SIZE(), nil
+	case ExtensionTypePausable:
+		return pausable.PAUSABLE_CONFIG_SIZE, nil
+	case ExtensionTypePausableAccount:
+		return pausable.PAUSABLE_ACCOUNT_SIZE, nil
+	case ExtensionTypePermissionedBurn:
+		return permissioned_burn.PERMISSIONED_BURN_CONFIG_SIZE, nil
 	case ExtensionTypeTokenMetadata:
 		return 0, fmt.Errorf("cannot get type length for variable extension type:%v", e)
 	default:
@@ -164,6 +203,7 @@ func (t tokenKit2022) GetTypeLen(e ExtensionType) (int, error) {
 	}
 }
 
+// IsMintExtension returns false for the account extensions and the unknown extensions
 func (t tokenKit2022) IsMintExtension(e ExtensionType) bool {
 	switch e {
 	case ExtensionTypeTransferFeeConfig:
@@ -172,6 +212,8 @@ func (t tokenKit2022) IsMintExtension(e ExtensionType) bool {
 		fallthrough
 	case ExtensionTypeConfidentialTransferMint:
 		fallthrough
+	case ExtensionTypeConfidentialTransferFeeConfig:
+		fallthrough
 	case ExtensionTypeDefaultAccountState:
 		fallthrough
 	case ExtensionTypeNonTransferable:
@@ -185,6 +227,22 @@ func (t tokenKit2022) IsMintExtension(e ExtensionType) bool {
 	case ExtensionTypeMetadataPointer:
 		fallthrough
 	case ExtensionTypeTokenMetadata:
+		fallthrough
+	case ExtensionTypeGroupPointer:
+		fallthrough
+	case ExtensionTypeTokenGroup:
+		fallthrough
+	case ExtensionTypeGroupMemberPointer:
+		fallthrough
+	case ExtensionTypeTokenGroupMember:
+		fallthrough
+	case ExtensionTypeConfidentialMintBurn:
+		fallthrough
+	case ExtensionTypeScaledUiAmount:
+		fallthrough
+	case ExtensionTypePausable:
+		fallthrough
+	case ExtensionTypePermissionedBurn:
 		return true
 	case ExtensionTypeUninitialized:
 		fallthrough
@@ -192,6 +250,8 @@ func (t tokenKit2022) IsMintExtension(e ExtensionType) bool {
 		fallthrough
 	case ExtensionTypeConfidentialTransferAccount:
 		fallthrough
+	case ExtensionTypeConfidentialTransferFeeAmount:
+		fallthrough
 	case ExtensionTypeImmutableOwner:
 		fallthrough
 	case ExtensionTypeMemoTransfer:
@@ -201,9 +261,11 @@ func (t tokenKit2022) IsMintExtension(e ExtensionType) bool {
 	case ExtensionTypeNonTransferableAccount:
 		fallthrough
 	case ExtensionTypeTransferHookAccount:
+		fallthrough
+	case ExtensionTypePausableAccount:
 		return false
 	default:
-		panic(fmt.Sprintf("Unknown extension type: %v", e))
+		return false
 	}
 }
 
@@ -0,0 +1,405 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer_fee"
+	"github.com/donutnomad/solana-web3/web3"
+	zk "github.com/donutnomad/solana-web3/zk_elgamal_proof"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+var ConfidentialTransferNotConfiguredErr = errors.New("the token account is not configured for confidential transfers")
+var ConfidentialTransferWithFeeErr = errors.New("confidential transfers of a mint with transfer fees are not supported")
+
+// ParseConfidentialTransferMint Extension: confidential_transfer
+func (t tokenKit2022) ParseConfidentialTransferMint(data []byte) (*confidential_transfer.ConfidentialTransferMint, error) {
+	return parseExtension[*confidential_transfer.ConfidentialTransferMint](spl_token_2022.ExtensionTypeConfidentialTransferMint, data)
+}
+
+// ParseConfidentialTransferAccount Extension: confidential_transfer
+func (t tokenKit2022) ParseConfidentialTransferAccount(data []byte) (*confidential_transfer.ConfidentialTransferAccount, error) {
+	return parseExtension[*confidential_transfer.ConfidentialTransferAccount](spl_token_2022.ExtensionTypeConfidentialTransferAccount, data)
+}
+
+// ParseConfidentialTransferFeeConfig Extension: confidential_transfer_fee
+func (t tokenKit2022) ParseConfidentialTransferFeeConfig(data []byte) (*confidential_transfer_fee.ConfidentialTransferFeeConfig, error) {
+	return parseExtension[*confidential_transfer_fee.ConfidentialTransferFeeConfig](spl_token_2022.ExtensionTypeConfidentialTransferFeeConfig, data)
+}
+
+// ParseConfidentialTransferFeeAmount Extension: confidential_transfer_fee
+func (t tokenKit2022) ParseConfidentialTransferFeeAmount(data []byte) (*confidential_transfer_fee.ConfidentialTransferFeeAmount, error) {
+	return parseExtension[*confidential_transfer_fee.ConfidentialTransferFeeAmount](spl_token_2022.ExtensionTypeConfidentialTransferFeeAmount, data)
+}
+
+// ConfidentialKeys the encryption keys of a token account
+type ConfidentialKeys struct {
+	ElGamal *zk.ElGamalKeypair
+	AeKey   zk.AeKey
+}
+
+// DeriveConfidentialKeys derives the keys of the token account from the signature of the owner, like the spl-token cli
+func (t tokenKit2022) DeriveConfidentialKeys(owner web3.Signer, tokenAccount web3.PublicKey) (*ConfidentialKeys, error) {
+	elgamal, aeKey, err := confidential_transfer.DeriveKeys(owner, tokenAccount)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfidentialKeys{ElGamal: elgamal, AeKey: aeKey}, nil
+}
+
+// ConfidentialBalance the decrypted confidential balances of a token account
+type ConfidentialBalance struct {
+	// the public balance of the token account
+	NonConfidential uint64
+	// the balance that can be withdrawn or transferred
+	Available uint64
+	// the balance received, applied to the available balance by ApplyPendingBalance
+	Pending                     uint64
+	PendingBalanceCreditCounter uint64
+}
+
+// GetConfidentialTransferAccount returns ConfidentialTransferNotConfiguredErr if the account has no confidential transfer extension
+func (t tokenKit2022) GetConfidentialTransferAccount(
+	ctx context.Context,
+	connection *web3.Connection,
+	tokenAccount web3.PublicKey,
+	commitment *web3.Commitment,
+) (*TokenAccount, *confidential_transfer.ConfidentialTransferAccount, error) {
+	account, err := t.GetTokenAccount(ctx, connection, tokenAccount, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: commitment})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(account.TlvData) == 0 {
+		return nil, nil, ConfidentialTransferNotConfiguredErr
+	}
+	state, err := t.ParseConfidentialTransferAccount(account.TlvData)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state == nil {
+		return nil, nil, ConfidentialTransferNotConfiguredErr
+	}
+	return account, state, nil
+}
+
+// GetConfidentialBalance decrypts the balances of the token account with the keys derived from the owner
+func (t tokenKit2022) GetConfidentialBalance(
+	ctx context.Context,
+	connection *web3.Connection,
+	owner web3.Signer,
+	tokenAccount web3.PublicKey,
+	commitment *web3.Commitment,
+) (*ConfidentialBalance, error) {
+	account, state, err := t.GetConfidentialTransferAccount(ctx, connection, tokenAccount, commitment)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := t.DeriveConfidentialKeys(owner, tokenAccount)
+	if err != nil {
+		return nil, err
+	}
+	available, err := state.DecryptAvailableBalance(keys.AeKey)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := state.DecryptPendingBalance(keys.ElGamal)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfidentialBalance{
+		NonConfidential:             account.Amount,
+		Available:                   available,
+		Pending:                     pending,
+		PendingBalanceCreditCounter: state.PendingBalanceCreditCounter,
+	}, nil
+}
+
+func confidentialTransferInstruction[T interface{ Validate() error }](inner T) (web3.TransactionInstruction, error) {
+	programId := web3.TokenProgram2022ID
+	ins, err := extension.Nested(&programId, inner, spl_token_2022.NewConfidentialTransferExtensionInstruction)
+	if err != nil {
+		return web3.TransactionInstruction{}, err
+	}
+	var tx = web3.Transaction{}
+	if err := tx.AddInstructionAny(ins); err != nil {
+		return web3.TransactionInstruction{}, err
+	}
+	return tx.ExportIns()[0], nil
+}
+
+// GetConfigureConfidentialAccountInstructions Get the instructions to configure the token account for confidential transfers,
+// the pubkey validity proof is verified in the same transaction.
+// The token account must have space for the ConfidentialTransferAccount extension.
+// @param maximumPendingBalanceCreditCounter 0 for DefaultMaximumPendingBalanceCreditCounter
+func (t tokenKit2022) GetConfigureConfidentialAccountInstructions(
+	tokenAccount, mint, owner web3.PublicKey,
+	keys *ConfidentialKeys,
+	maximumPendingBalanceCreditCounter uint64,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if maximumPendingBalanceCreditCounter == 0 {
+		maximumPendingBalanceCreditCounter = confidential_transfer.DefaultMaximumPendingBalanceCreditCounter
+	}
+	proof := zk.NewPubkeyValidityProofData(keys.ElGamal)
+	configure := Must1(confidentialTransferInstruction(confidential_transfer.NewConfigureAccountInstruction(
+		confidential_transfer.DecryptableBalance(keys.AeKey.Encrypt(0)),
+		maximumPendingBalanceCreditCounter,
+		1,
+		tokenAccount,
+		mint,
+		web3.SysvarInstructions,
+		owner,
+	)))
+	return []web3.TransactionInstruction{configure, zk.VerifyProofInstruction(proof, nil)}, nil
+}
+
+// GetConfidentialDepositInstructions Get the instructions to deposit the public balance to the pending balance
+func (t tokenKit2022) GetConfidentialDepositInstructions(
+	tokenAccount, mint, owner web3.PublicKey,
+	amount uint64, decimals uint8,
+) ([]web3.TransactionInstruction, error) {
+	deposit, err := confidentialTransferInstruction(confidential_transfer.NewDepositInstruction(amount, decimals, tokenAccount, mint, owner))
+	if err != nil {
+		return nil, err
+	}
+	return []web3.TransactionInstruction{deposit}, nil
+}
+
+// GetApplyPendingBalanceInstructions Get the instructions to apply the pending balance to the available balance
+func (t tokenKit2022) GetApplyPendingBalanceInstructions(
+	tokenAccount, owner web3.PublicKey,
+	state *confidential_transfer.ConfidentialTransferAccount,
+	keys *ConfidentialKeys,
+) ([]web3.TransactionInstruction, error) {
+	counter, decryptable, err := state.ApplyPendingBalanceArgs(keys.ElGamal, keys.AeKey)
+	if err != nil {
+		return nil, err
+	}
+	apply, err := confidentialTransferInstruction(confidential_transfer.NewApplyPendingBalanceInstruction(counter, decryptable, tokenAccount, owner))
+	if err != nil {
+		return nil, err
+	}
+	return []web3.TransactionInstruction{apply}, nil
+}
+
+// GetEmptyConfidentialAccountInstructions Get the instructions to empty the confidential balances before closing the token account,
+// the available balance must be zero, the zero ciphertext proof is verified in the same transaction
+func (t tokenKit2022) GetEmptyConfidentialAccountInstructions(
+	tokenAccount, owner web3.PublicKey,
+	state *confidential_transfer.ConfidentialTransferAccount,
+	keys *ConfidentialKeys,
+) ([]web3.TransactionInstruction, error) {
+	proof, err := state.EmptyAccountProofData(keys.ElGamal)
+	if err != nil {
+		return nil, err
+	}
+	empty, err := confidentialTransferInstruction(confidential_transfer.NewEmptyAccountInstruction(1, tokenAccount, web3.SysvarInstructions, owner))
+	if err != nil {
+		return nil, err
+	}
+	return []web3.TransactionInstruction{empty, zk.VerifyProofInstruction(proof, nil)}, nil
+}
+
+// ConfigureConfidentialAccount configures the token account for confidential transfers, see GetConfigureConfidentialAccountInstructions
+func (t tokenKit2022) ConfigureConfidentialAccount(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	owner web3.Signer,
+	tokenAccount, mint web3.PublicKey,
+	maximumPendingBalanceCreditCounter uint64,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	keys, err := t.DeriveConfidentialKeys(owner, tokenAccount)
+	if err != nil {
+		return "", err
+	}
+	instructions, err := t.GetConfigureConfidentialAccountInstructions(tokenAccount, mint, owner.PublicKey(), keys, maximumPendingBalanceCreditCounter)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, owner}, instructions, confirm, options)
+}
+
+// ConfidentialDeposit deposits the public balance to the pending balance
+func (t tokenKit2022) ConfidentialDeposit(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	owner web3.Signer,
+	tokenAccount, mint web3.PublicKey,
+	amount uint64, decimals uint8,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := t.GetConfidentialDepositInstructions(tokenAccount, mint, owner.PublicKey(), amount, decimals)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, owner}, instructions, confirm, options)
+}
+
+// ApplyPendingBalance applies the pending balance to the available balance
+func (t tokenKit2022) ApplyPendingBalance(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	owner web3.Signer,
+	tokenAccount web3.PublicKey,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	_, state, err := t.GetConfidentialTransferAccount(ctx, connection, tokenAccount, options.Commitment)
+	if err != nil {
+		return "", err
+	}
+	keys, err := t.DeriveConfidentialKeys(owner, tokenAccount)
+	if err != nil {
+		return "", err
+	}
+	instructions, err := t.GetApplyPendingBalanceInstructions(tokenAccount, owner.PublicKey(), state, keys)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, []web3.Signer{payer, owner}, instructions, confirm, options)
+}
+
+// ConfidentialWithdraw withdraws amount from the available balance to the public balance.
+// The proofs do not fit in one transaction, they are verified into context state accounts first,
+// the signatures of all the transactions are returned
+func (t tokenKit2022) ConfidentialWithdraw(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	owner web3.Signer,
+	tokenAccount web3.PublicKey,
+	amount uint64,
+	options web3.ConfirmOptions,
+) (_ []web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	account, state := Must2(t.GetConfidentialTransferAccount(ctx, connection, tokenAccount, options.Commitment))
+	mint := Must1(t.GetMint(ctx, connection, account.Mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: options.Commitment}))
+	keys := Must1(t.DeriveConfidentialKeys(owner, tokenAccount))
+	proofs := Must1(state.WithdrawProofData(keys.ElGamal, keys.AeKey, amount))
+
+	signatures, contexts := Must2(t.verifyProofsToContextStates(ctx, connection, payer, owner, []zk.ProofData{proofs.Equality, proofs.Range}, options))
+	withdraw := Must1(confidentialTransferInstruction(confidential_transfer.NewWithdrawInstructionBuilder().
+		SetAmount(amount).
+		SetDecimals(mint.Decimals).
+		SetNewDecryptableAvailableBalance(proofs.NewDecryptableAvailableBalance).
+		SetEqualityProofInstructionOffset(0).
+		SetRangeProofInstructionOffset(0).
+		SetTokenAccount(tokenAccount).
+		SetMintAccount(account.Mint).
+		SetEqualityRecordAccount(contexts[0].ContextStateAccount).
+		SetRangeRecordAccount(contexts[1].ContextStateAccount).
+		SetAuthorityAccount(owner.PublicKey()),
+	))
+	signature := Must1(sendInstructions(ctx, connection, payer, []web3.Signer{payer, owner}, closeContextStates(withdraw, contexts, payer.PublicKey()), true, options))
+	return append(signatures, signature), nil
+}
+
+// ConfidentialTransfer transfers amount from the available balance of the source token account
+// to the pending balance of the destination token account, both must be configured for confidential transfers.
+// The proofs do not fit in one transaction, they are verified into context state accounts first,
+// the signatures of all the transactions are returned
+func (t tokenKit2022) ConfidentialTransfer(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	owner web3.Signer,
+	source, destination web3.PublicKey, // token accounts
+	amount uint64,
+	options web3.ConfirmOptions,
+) (_ []web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	account, state := Must2(t.GetConfidentialTransferAccount(ctx, connection, source, options.Commitment))
+	_, destinationState := Must2(t.GetConfidentialTransferAccount(ctx, connection, destination, options.Commitment))
+	mint := Must1(t.GetMint(ctx, connection, account.Mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: options.Commitment}))
+	var auditor *zk.ElGamalPubkey
+	if len(mint.TlvData) > 0 {
+		if fee := Must1(t.ParseTransferFeeConfig(mint.TlvData)); fee != nil {
+			return nil, ConfidentialTransferWithFeeErr
+		}
+		if mintState := Must1(t.ParseConfidentialTransferMint(mint.TlvData)); mintState != nil {
+			auditor = mintState.AuditorPubkey()
+		}
+	}
+	keys := Must1(t.DeriveConfidentialKeys(owner, source))
+	proofs := Must1(state.TransferProofData(keys.ElGamal, keys.AeKey, amount, zk.ElGamalPubkey(destinationState.ElgamalPubkey), auditor))
+
+	signatures, contexts := Must2(t.verifyProofsToContextStates(ctx, connection, payer, owner, []zk.ProofData{proofs.Equality, proofs.CiphertextValidity, proofs.Range}, options))
+	transfer := Must1(confidentialTransferInstruction(confidential_transfer.NewTransferInstructionBuilder().
+		SetNewSourceDecryptableAvailableBalance(proofs.NewSourceDecryptableAvailableBalance).
+		SetTransferAmountAuditorCiphertextLo(proofs.TransferAmountAuditorCiphertextLo).
+		SetTransferAmountAuditorCiphertextHi(proofs.TransferAmountAuditorCiphertextHi).
+		SetEqualityProofInstructionOffset(0).
+		SetCiphertextValidityProofInstructionOffset(0).
+		SetRangeProofInstructionOffset(0).
+		SetSourceTokenAccount(source).
+		SetMintAccount(account.Mint).
+		SetDestinationTokenAccount(destination).
+		SetEqualityRecordAccount(contexts[0].ContextStateAccount).
+		SetCiphertextValidityRecordAccount(contexts[1].ContextStateAccount).
+		SetRangeRecordAccount(contexts[2].ContextStateAccount).
+		SetAuthorityAccount(owner.PublicKey()),
+	))
+	signature := Must1(sendInstructions(ctx, connection, payer, []web3.Signer{payer, owner}, closeContextStates(transfer, contexts, payer.PublicKey()), true, options))
+	return append(signatures, signature), nil
+}
+
+// verifyProofsToContextStates creates a context state account for each proof in one transaction,
+// then verifies each proof into its context state account in its own transaction
+func (t tokenKit2022) verifyProofsToContextStates(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	authority web3.Signer,
+	proofs []zk.ProofData,
+	options web3.ConfirmOptions,
+) (_ []web3.TransactionSignature, _ []zk.ContextStateInfo, err error) {
+	defer Recover(&err)
+
+	var signers = []web3.Signer{payer}
+	var contexts []zk.ContextStateInfo
+	var tx = web3.Transaction{}
+	for _, proof := range proofs {
+		keypair := web3.Keypair.Generate()
+		size := proof.ProofType().ContextStateAccountSize()
+		lamports := Must1(connection.GetMinimumBalanceForRentExemption(size, options.Commitment))
+		Must(tx.AddInstructionAny(system.NewCreateAccountInstruction(
+			lamports,
+			uint64(size),
+			zk.ProgramID.D(),
+			payer.PublicKey().D(),
+			keypair.PublicKey().D(),
+		).Build()))
+		signers = append(signers, keypair)
+		contexts = append(contexts, zk.ContextStateInfo{
+			ContextStateAccount:   keypair.PublicKey(),
+			ContextStateAuthority: authority.PublicKey(),
+		})
+	}
+	signatures := []web3.TransactionSignature{Must1(sendInstructions(ctx, connection, payer, signers, tx.ExportIns(), true, options))}
+	for i, proof := range proofs {
+		instruction := zk.VerifyProofInstruction(proof, &contexts[i])
+		signatures = append(signatures, Must1(sendInstructions(ctx, connection, payer, []web3.Signer{payer}, []web3.TransactionInstruction{instruction}, true, options)))
+	}
+	return signatures, contexts, nil
+}
+
+// closeContextStates appends the instructions to close the context state accounts after the token instruction
+func closeContextStates(instruction web3.TransactionInstruction, contexts []zk.ContextStateInfo, destination web3.PublicKey) []web3.TransactionInstruction {
+	var instructions = []web3.TransactionInstruction{instruction}
+	for _, context := range contexts {
+		instructions = append(instructions, zk.CloseContextStateInstruction(context, destination))
+	}
+	return instructions
+}
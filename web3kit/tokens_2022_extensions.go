@@ -3,8 +3,22 @@ package web3kit
 import (
 	"context"
 	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_mint_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/cpi_guard"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/default_account_state"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_member_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/interest_bearing_mint"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/memo_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/metadata_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/mint_close_authority"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/pausable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permanent_delegate"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permissioned_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/scaled_ui_amount"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
 	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_hook"
 	"github.com/donutnomad/solana-web3/token_metadata"
 	"github.com/donutnomad/solana-web3/web3"
 	binary "github.com/gagliardetto/binary"
@@ -20,7 +34,83 @@ func (t tokenKit2022) ParseTransferFeeConfig(data []byte) (*transfer_fee.Transfe
 	return parseExtension[*transfer_fee.TransferFeeConfig](spl_token_2022.ExtensionTypeTransferFeeConfig, data)
 }
 
+// ParseInterestBearingConfig Extension: interest_bearing_mint
+func (t tokenKit2022) ParseInterestBearingConfig(data []byte) (*interest_bearing_mint.InterestBearingConfig, error) {
+	return parseExtension[*interest_bearing_mint.InterestBearingConfig](spl_token_2022.ExtensionTypeInterestBearingConfig, data)
+}
+
+// ParseScaledUiAmountConfig Extension: scaled_ui_amount
+func (t tokenKit2022) ParseScaledUiAmountConfig(data []byte) (*scaled_ui_amount.ScaledUiAmountConfig, error) {
+	return parseExtension[*scaled_ui_amount.ScaledUiAmountConfig](spl_token_2022.ExtensionTypeScaledUiAmount, data)
+}
+
+// ParsePausableConfig Extension: pausable
+func (t tokenKit2022) ParsePausableConfig(data []byte) (*pausable.PausableConfig, error) {
+	return parseExtension[*pausable.PausableConfig](spl_token_2022.ExtensionTypePausable, data)
+}
+
+// ParsePermissionedBurnConfig Extension: permissioned_burn
+func (t tokenKit2022) ParsePermissionedBurnConfig(data []byte) (*permissioned_burn.PermissionedBurnConfig, error) {
+	return parseExtension[*permissioned_burn.PermissionedBurnConfig](spl_token_2022.ExtensionTypePermissionedBurn, data)
+}
+
+// ParseConfidentialMintBurn Extension: confidential_mint_burn
+func (t tokenKit2022) ParseConfidentialMintBurn(data []byte) (*confidential_mint_burn.ConfidentialMintBurn, error) {
+	return parseExtension[*confidential_mint_burn.ConfidentialMintBurn](spl_token_2022.ExtensionTypeConfidentialMintBurn, data)
+}
+
+// ParseMintCloseAuthority Extension: mint_close_authority
+func (t tokenKit2022) ParseMintCloseAuthority(data []byte) (*mint_close_authority.MintCloseAuthority, error) {
+	return parseExtension[*mint_close_authority.MintCloseAuthority](spl_token_2022.ExtensionTypeMintCloseAuthority, data)
+}
+
+// ParsePermanentDelegate Extension: permanent_delegate
+func (t tokenKit2022) ParsePermanentDelegate(data []byte) (*permanent_delegate.PermanentDelegate, error) {
+	return parseExtension[*permanent_delegate.PermanentDelegate](spl_token_2022.ExtensionTypePermanentDelegate, data)
+}
+
+// ParseMetadataPointer Extension: metadata_pointer
+func (t tokenKit2022) ParseMetadataPointer(data []byte) (*metadata_pointer.MetadataPointer, error) {
+	return parseExtension[*metadata_pointer.MetadataPointer](spl_token_2022.ExtensionTypeMetadataPointer, data)
+}
+
+// ParseGroupPointer Extension: group_pointer
+func (t tokenKit2022) ParseGroupPointer(data []byte) (*group_pointer.GroupPointer, error) {
+	return parseExtension[*group_pointer.GroupPointer](spl_token_2022.ExtensionTypeGroupPointer, data)
+}
+
+// ParseGroupMemberPointer Extension: group_member_pointer
+func (t tokenKit2022) ParseGroupMemberPointer(data []byte) (*group_member_pointer.GroupMemberPointer, error) {
+	return parseExtension[*group_member_pointer.GroupMemberPointer](spl_token_2022.ExtensionTypeGroupMemberPointer, data)
+}
+
+// ParseTokenGroup Extension: token_group
+func (t tokenKit2022) ParseTokenGroup(data []byte) (*token_group.TokenGroup, error) {
+	return parseExtension[*token_group.TokenGroup](spl_token_2022.ExtensionTypeTokenGroup, data)
+}
+
+// ParseTokenGroupMember Extension: token_group
+func (t tokenKit2022) ParseTokenGroupMember(data []byte) (*token_group.TokenGroupMember, error) {
+	return parseExtension[*token_group.TokenGroupMember](spl_token_2022.ExtensionTypeTokenGroupMember, data)
+}
+
+// ParseCpiGuard Extension: cpi_guard
+func (t tokenKit2022) ParseCpiGuard(data []byte) (*cpi_guard.CpiGuard, error) {
+	return parseExtension[*cpi_guard.CpiGuard](spl_token_2022.ExtensionTypeCpiGuard, data)
+}
+
+// ParseMemoTransfer Extension: memo_transfer
+func (t tokenKit2022) ParseMemoTransfer(data []byte) (*memo_transfer.MemoTransfer, error) {
+	return parseExtension[*memo_transfer.MemoTransfer](spl_token_2022.ExtensionTypeMemoTransfer, data)
+}
+
+// ParseTransferHookAccount Extension: transfer_hook
+func (t tokenKit2022) ParseTransferHookAccount(data []byte) (*transfer_hook.TransferHookAccount, error) {
+	return parseExtension[*transfer_hook.TransferHookAccount](spl_token_2022.ExtensionTypeTransferHookAccount, data)
+}
+
 // GetTokenMetadata Extension: token_metadata
+// Reads the metadata stored in the mint only, see ResolveTokenMetadata for the metadata stored elsewhere
 func (t tokenKit2022) GetTokenMetadata(
 	ctx context.Context,
 	connection *web3.Connection,
@@ -0,0 +1,372 @@
+package web3kit
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"errors"
+	ata "github.com/donutnomad/solana-web3/associated_token_account"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
+	"github.com/donutnomad/solana-web3/token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"slices"
+)
+
+var (
+	GroupPointerMismatchErr  = errors.New("the group pointer does not point to the group of the mint")
+	MemberPointerMismatchErr = errors.New("the group member pointer does not point to the member of the mint")
+	GroupMemberMismatchErr   = errors.New("the member does not belong to the group")
+	GroupSizeExceededErr     = errors.New("the size of the group exceeds its max size")
+)
+
+// TokenGroupArgs the args of a collection mint, the group is stored in the mint
+type TokenGroupArgs struct {
+	Decimals        uint8
+	FreezeAuthority *web3.PublicKey
+	UpdateAuthority *web3.PublicKey // update authority of the group, nil for an immutable group
+	MaxSize         uint64
+	// optional, stored in the mint, the Mint field is ignored
+	Metadata *token_metadata.TokenMetadata
+}
+
+// TokenGroupMemberArgs the args of a member mint, the member is stored in the mint
+type TokenGroupMemberArgs struct {
+	Decimals        uint8
+	FreezeAuthority *web3.PublicKey
+	Group           web3.PublicKey // the collection mint
+	// optional, stored in the mint, the Mint field is ignored
+	Metadata *token_metadata.TokenMetadata
+	// optional, minted to the associated token account of Owner
+	InitialSupply *uint64
+	Owner         web3.PublicKey
+}
+
+// GetCreateGroupInstructions Get the instructions to create a collection mint,
+// the mint points to itself by the GroupPointer (and the MetadataPointer if any) and stores the TokenGroup.
+// The lamports for the group and the metadata are funded at creation, the program reallocates the mint when they are initialized
+func (t tokenKit2022) GetCreateGroupInstructions(
+	connection *web3.Connection,
+	payer, mint web3.PublicKey,
+	mintAuthority web3.ComplexSigner,
+	args TokenGroupArgs,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var programId = web3.TokenProgram2022ID
+	var authority = mintAuthority.PublicKey
+	var extensions = []extension.ExtensionInitializationParams{
+		extension.NewGroupPointerParamsInitialize(authority, mint, mint),
+	}
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(t.getCreatePointedMintInstructions(connection, payer, mint, authority, args.Decimals, args.FreezeAuthority,
+		extensions, spl_token_2022.ExtensionTypeTokenGroup, args.Metadata, commitment))...)
+	var updateAuthority web3.PublicKey
+	if args.UpdateAuthority != nil {
+		updateAuthority = *args.UpdateAuthority
+	}
+	Must(tx.AddInsBuilder(token_group.NewInitializeInstructionBuilder().
+		SetUpdateAuthority(updateAuthority).
+		SetMaxSize(args.MaxSize).
+		SetGroupAccount(mint).
+		SetMintAccount(mint).
+		SetMintAuthorityAccount(authority, mintAuthority.Addresses()...).
+		SetProgramId(&programId)))
+	if args.Metadata != nil {
+		tx.AddInstructions(Must1(t.getInitializeMetadataInstructions(mint, authority, args.Metadata))...)
+	}
+	return tx.ExportIns(), nil
+}
+
+// CreateGroup creates a collection mint in one transaction, see GetCreateGroupInstructions
+func (t tokenKit2022) CreateGroup(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	mint web3.Signer,
+	mintAuthority web3.ComplexSigner,
+	args TokenGroupArgs,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := t.GetCreateGroupInstructions(connection, payer.PublicKey(), mint.PublicKey(), mintAuthority, args, commitmentOrDefault(options.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, append([]web3.Signer{payer, mint}, mintAuthority.Signers()...), instructions, confirm, options)
+}
+
+// GetCreateGroupMemberInstructions Get the instructions to create a member mint of the group,
+// the mint points to itself by the GroupMemberPointer (and the MetadataPointer if any) and stores the TokenGroupMember.
+// The member is initialized before the metadata, so that it is found by FindGroupMembers with the default layouts
+// @param groupUpdateAuthority The update authority of the group, or a multisig with its signers
+func (t tokenKit2022) GetCreateGroupMemberInstructions(
+	connection *web3.Connection,
+	payer, mint web3.PublicKey,
+	mintAuthority web3.ComplexSigner,
+	groupUpdateAuthority web3.ComplexSigner,
+	args TokenGroupMemberArgs,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var programId = web3.TokenProgram2022ID
+	var authority = mintAuthority.PublicKey
+	var extensions = []extension.ExtensionInitializationParams{
+		extension.NewGroupMemberPointerParamsInitialize(authority, mint, mint),
+	}
+	var tx = web3.Transaction{}
+	tx.AddInstructions(Must1(t.getCreatePointedMintInstructions(connection, payer, mint, authority, args.Decimals, args.FreezeAuthority,
+		extensions, spl_token_2022.ExtensionTypeTokenGroupMember, args.Metadata, commitment))...)
+	Must(tx.AddInsBuilder(token_group.NewInitializeMemberInstructionBuilder().
+		SetMemberAccount(mint).
+		SetMemberMintAccount(mint).
+		SetMemberMintAuthorityAccount(authority).
+		SetGroupAccount(args.Group).
+		SetGroupUpdateAuthorityAccount(groupUpdateAuthority.PublicKey, groupUpdateAuthority.Addresses()...).
+		SetProgramId(&programId)))
+	if args.Metadata != nil {
+		tx.AddInstructions(Must1(t.getInitializeMetadataInstructions(mint, authority, args.Metadata))...)
+	}
+	if args.InitialSupply != nil && *args.InitialSupply > 0 {
+		associatedTokenProgramId := web3.SPLAssociatedTokenAccountProgramID
+		associatedToken := Must1(ata.FindAssociatedTokenAddress(args.Owner, mint, programId))
+		Must(tx.AddInsBuilder(ata.NewCreateIdempotentInstruction(
+			payer,
+			associatedToken,
+			args.Owner,
+			mint,
+			web3.SystemProgramID,
+			programId,
+		).SetProgramId(&associatedTokenProgramId)))
+		Must(tx.AddInsBuilder(spl_token_2022.NewMintToInstruction(*args.InitialSupply, mint, associatedToken, web3.PublicKey{}).
+			SetAuthorityAccount(authority, mintAuthority.Addresses()...).
+			SetProgramId(&programId)))
+	}
+	return tx.ExportIns(), nil
+}
+
+// CreateGroupMember creates a member mint of the group in one transaction, see GetCreateGroupMemberInstructions
+func (t tokenKit2022) CreateGroupMember(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	mint web3.Signer,
+	mintAuthority web3.ComplexSigner,
+	groupUpdateAuthority web3.ComplexSigner,
+	args TokenGroupMemberArgs,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := t.GetCreateGroupMemberInstructions(connection, payer.PublicKey(), mint.PublicKey(), mintAuthority, groupUpdateAuthority, args, commitmentOrDefault(options.Commitment))
+	if err != nil {
+		return "", err
+	}
+	var signers = append([]web3.Signer{payer, mint}, mintAuthority.Signers()...)
+	signers = append(signers, groupUpdateAuthority.Signers()...)
+	return sendInstructions(ctx, connection, payer, signers, instructions, confirm, options)
+}
+
+// getCreatePointedMintInstructions the instructions to create and initialize the mint with the pointer extensions,
+// the lamports include the variable extensions initialized afterward
+func (t tokenKit2022) getCreatePointedMintInstructions(
+	connection *web3.Connection,
+	payer, mint, mintAuthority web3.PublicKey,
+	decimals uint8,
+	freezeAuthority *web3.PublicKey,
+	extensions []extension.ExtensionInitializationParams,
+	groupExtension spl_token_2022.ExtensionType,
+	metadata *token_metadata.TokenMetadata,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var programId = web3.TokenProgram2022ID
+	var addDataLength = t.AddTypeAndLengthToLen(Must1(t.GetTypeLen(groupExtension)))
+	if metadata != nil {
+		// the metadata address of spl-token-2022 is the mint address
+		extensions = append([]extension.ExtensionInitializationParams{extension.NewMetadataPointerParamsInitialize(&mintAuthority, &mint, mint)}, extensions...)
+		addDataLength += t.AddTypeAndLengthToLen(GetSize(metadata))
+	}
+	mintSize := Must1(t.GetMintLen(Map(extensions, func(_ int, t extension.ExtensionInitializationParams) spl_token_2022.ExtensionType {
+		return t.ExtensionType()
+	})))
+	lamports := Must1(connection.GetMinimumBalanceForRentExemption(int(mintSize+addDataLength), &commitment))
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInstructionAny(system.NewCreateAccountInstruction(lamports, mintSize, programId.D(), payer.D(), mint.D()).Build()))
+	for _, item := range extensions {
+		Must(tx.AddInstructionAny(Must1(extension.ExtensionInitializationParamsToInstruction(item, mint, programId))))
+	}
+	Must(tx.AddInsBuilder(spl_token_2022.NewInitializeMint2Instruction(decimals, mintAuthority, freezeAuthority, mint).SetProgramId(&programId)))
+	return tx.ExportIns(), nil
+}
+
+func (t tokenKit2022) getInitializeMetadataInstructions(mint, mintAuthority web3.PublicKey, metadata *token_metadata.TokenMetadata) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var tx = web3.Transaction{}
+	for _, ins := range Must1(TokenMeta.GetCreateIns(
+		metadata.Name,
+		metadata.Symbol,
+		metadata.Uri,
+		mint,
+		metadata.UpdateAuthority,
+		mintAuthority,
+		web3.TokenProgram2022ID,
+		metadata.AdditionalMetadata,
+	)) {
+		Must(tx.AddInstructionAny(ins))
+	}
+	return tx.ExportIns(), nil
+}
+
+// GetUpdateGroupMaxSizeInstructions Get the instructions to update the max size of the group, not less than its current size
+func (t tokenKit2022) GetUpdateGroupMaxSizeInstructions(
+	group web3.PublicKey,
+	updateAuthority web3.ComplexSigner,
+	maxSize uint64,
+) ([]web3.TransactionInstruction, error) {
+	var programId = web3.TokenProgram2022ID
+	var tx = web3.Transaction{}
+	if err := tx.AddInsBuilder(token_group.NewUpdateGroupMaxSizeInstructionBuilder().
+		SetMaxSize(maxSize).
+		SetGroupAccount(group).
+		SetUpdateAuthorityAccount(updateAuthority.PublicKey, updateAuthority.Addresses()...).
+		SetProgramId(&programId)); err != nil {
+		return nil, err
+	}
+	return tx.ExportIns(), nil
+}
+
+// GetUpdateGroupAuthorityInstructions Get the instructions to update the update authority of the group,
+// nil newAuthority makes the group immutable
+func (t tokenKit2022) GetUpdateGroupAuthorityInstructions(
+	group web3.PublicKey,
+	updateAuthority web3.ComplexSigner,
+	newAuthority *web3.PublicKey,
+) ([]web3.TransactionInstruction, error) {
+	var programId = web3.TokenProgram2022ID
+	var newUpdateAuthority web3.PublicKey
+	if newAuthority != nil {
+		newUpdateAuthority = *newAuthority
+	}
+	var tx = web3.Transaction{}
+	if err := tx.AddInsBuilder(token_group.NewUpdateGroupAuthorityInstructionBuilder().
+		SetNewAuthority(newUpdateAuthority).
+		SetGroupAccount(group).
+		SetUpdateAuthorityAccount(updateAuthority.PublicKey, updateAuthority.Addresses()...).
+		SetProgramId(&programId)); err != nil {
+		return nil, err
+	}
+	return tx.ExportIns(), nil
+}
+
+// GroupMember a member mint of a group
+type GroupMember struct {
+	Mint         web3.PublicKey
+	MemberNumber uint64
+}
+
+// DefaultGroupMemberLayouts the extensions preceding the TokenGroupMember in the member mints created by CreateGroupMember
+var DefaultGroupMemberLayouts = [][]spl_token_2022.ExtensionType{
+	{spl_token_2022.ExtensionTypeGroupMemberPointer},
+	{spl_token_2022.ExtensionTypeMetadataPointer, spl_token_2022.ExtensionTypeGroupMemberPointer},
+}
+
+// FindGroupMembers scans the member mints of the group, sorted by member number.
+// The offset of the TokenGroupMember depends on the extensions preceding it, one scan is issued per layout,
+// the members found are checked by decoding the mint
+// @param layouts The extensions preceding the TokenGroupMember in the member mints, nil for DefaultGroupMemberLayouts
+func (t tokenKit2022) FindGroupMembers(
+	ctx context.Context,
+	connection *web3.Connection,
+	group web3.PublicKey,
+	layouts [][]spl_token_2022.ExtensionType,
+	commitment web3.Commitment,
+) (_ []GroupMember, err error) {
+	defer Recover(&err)
+	_ = ctx
+
+	if layouts == nil {
+		layouts = DefaultGroupMemberLayouts
+	}
+	var header = binary.LittleEndian.AppendUint16(nil, uint16(spl_token_2022.ExtensionTypeTokenGroupMember))
+	header = binary.LittleEndian.AppendUint16(header, token_group.TOKEN_GROUP_MEMBER_SIZE)
+
+	var ret []GroupMember
+	var offsets []uint64
+	for _, layout := range layouts {
+		var offset = uint64(spl_token_2022.ACCOUNT_SIZE + ACCOUNT_TYPE_SIZE)
+		for _, extensionType := range layout {
+			offset += t.AddTypeAndLengthToLen(Must1(t.GetTypeLen(extensionType)))
+		}
+		if slices.Contains(offsets, offset) {
+			continue
+		}
+		offsets = append(offsets, offset)
+		response := Must1(connection.GetProgramAccounts(web3.TokenProgram2022ID, web3.GetProgramAccountsConfig{
+			Commitment: &commitment,
+			Filters: []web3.GetProgramAccountsFilter{
+				{Memcmp: &web3.RPCFilterMemcmp{Offset: spl_token_2022.ACCOUNT_SIZE, Bytes: []byte{1}}}, // AccountType.Mint
+				{Memcmp: &web3.RPCFilterMemcmp{Offset: offset, Bytes: header}},
+				{Memcmp: &web3.RPCFilterMemcmp{Offset: offset + uint64(len(header)) + 32, Bytes: group.Bytes()}},
+			},
+		}))
+		for _, item := range response {
+			member := Must1(t.ParseTokenGroupMember(item.Account.Data.Content[spl_token_2022.ACCOUNT_SIZE+ACCOUNT_TYPE_SIZE:]))
+			if member == nil || member.Group != group || member.Mint != item.Pubkey {
+				continue
+			}
+			ret = append(ret, GroupMember{Mint: item.Pubkey, MemberNumber: member.MemberNumber})
+		}
+	}
+	slices.SortFunc(ret, func(a, b GroupMember) int {
+		return cmp.Compare(a.MemberNumber, b.MemberNumber)
+	})
+	return ret, nil
+}
+
+// ValidateGroupPointers checks that the group and the member stored in the mint are pointed by their pointers,
+// the group and the member stored in other accounts are not checked
+func (t tokenKit2022) ValidateGroupPointers(mint *MintState) error {
+	var extensions = mint.Extensions
+	if extensions.TokenGroup != nil {
+		if extensions.GroupPointer == nil || extensions.GroupPointer.GroupAddress != mint.Address || extensions.TokenGroup.Mint != mint.Address {
+			return GroupPointerMismatchErr
+		}
+		if extensions.TokenGroup.Size > extensions.TokenGroup.MaxSize {
+			return GroupSizeExceededErr
+		}
+	} else if extensions.GroupPointer != nil && extensions.GroupPointer.GroupAddress == mint.Address {
+		return GroupPointerMismatchErr
+	}
+	if extensions.TokenGroupMember != nil {
+		if extensions.GroupMemberPointer == nil || extensions.GroupMemberPointer.MemberAddress != mint.Address || extensions.TokenGroupMember.Mint != mint.Address {
+			return MemberPointerMismatchErr
+		}
+	} else if extensions.GroupMemberPointer != nil && extensions.GroupMemberPointer.MemberAddress == mint.Address {
+		return MemberPointerMismatchErr
+	}
+	return nil
+}
+
+// ValidateGroupMember checks the pointers of the member mint and of the group mint, and that the member belongs to the group
+func (t tokenKit2022) ValidateGroupMember(member, group *MintState) error {
+	if err := t.ValidateGroupPointers(member); err != nil {
+		return err
+	}
+	if err := t.ValidateGroupPointers(group); err != nil {
+		return err
+	}
+	var tokenGroup = group.Extensions.TokenGroup
+	var tokenGroupMember = member.Extensions.TokenGroupMember
+	if tokenGroup == nil || tokenGroupMember == nil || tokenGroupMember.Group != group.Address ||
+		tokenGroupMember.MemberNumber == 0 || tokenGroupMember.MemberNumber > tokenGroup.Size {
+		return GroupMemberMismatchErr
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"slices"
+)
+
+// MaxReturnDataSize the maximum size of the return data of an instruction
+const MaxReturnDataSize = 1024
+
+var (
+	TokenMetadataNotFoundErr = errors.New("token metadata not found")
+	MetadataKeyNotFoundErr   = errors.New("the additional key of the token metadata does not exist")
+)
+
+// TokenMetadataUpdate an update of the token metadata, an UpdateField or a RemoveKey instruction
+type TokenMetadataUpdate struct {
+	Field  token_metadata.Field
+	Value  string
+	Remove bool // removes the additional key of Field, Value is ignored
+}
+
+// NewTokenMetadataFieldUpdate updates the field to value
+func NewTokenMetadataFieldUpdate(field token_metadata.Field, value string) TokenMetadataUpdate {
+	return TokenMetadataUpdate{Field: field, Value: value}
+}
+
+// NewTokenMetadataKeyRemoval removes the additional key
+func NewTokenMetadataKeyRemoval(key string) TokenMetadataUpdate {
+	return TokenMetadataUpdate{Field: token_metadata.NewField_Key(key), Remove: true}
+}
+
+// ApplyTokenMetadataUpdates applies the updates to a copy of the metadata, the same as the program does.
+// The sizes of the metadata after each update are returned, the account is reallocated to each of them
+func (t tokenKit2022) ApplyTokenMetadataUpdates(metadata *token_metadata.TokenMetadata, updates []TokenMetadataUpdate) (*token_metadata.TokenMetadata, []int, error) {
+	var ret = *metadata
+	ret.AdditionalMetadata = slices.Clone(metadata.AdditionalMetadata)
+	var sizes = make([]int, 0, len(updates))
+	for _, update := range updates {
+		if update.Remove {
+			if update.Field.Key == nil {
+				return nil, nil, fmt.Errorf("only an additional key can be removed, not %s", update.Field.String())
+			}
+			if !ret.RemoveKey(update.Field.Key.Field0) {
+				return nil, nil, MetadataKeyNotFoundErr
+			}
+		} else {
+			ret.Update(update.Field, update.Value)
+		}
+		sizes = append(sizes, ret.Size())
+	}
+	return &ret, sizes, nil
+}
+
+// GetUpdateTokenMetadataInstructions Get the instructions to apply the updates to the token metadata of the mint in one transaction.
+// If the metadata is stored in the mint, the mint is topped up by the payer to the rent of its largest size during the updates;
+// if it is stored in another account (MetadataPointer pointing elsewhere), the instructions are sent to the owner program of that account without top-up
+// @param updateAuthority The update authority of the metadata, or a multisig with its signers
+func (t tokenKit2022) GetUpdateTokenMetadataInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer, mint web3.PublicKey,
+	updateAuthority web3.ComplexSigner,
+	updates []TokenMetadataUpdate,
+	commitment web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	mintInfo := Must1(t.GetMint(ctx, connection, mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: &commitment}))
+	metadataAddress, programId := mint, web3.TokenProgram2022ID
+	if pointer := Must1(t.ParseMetadataPointer(mintInfo.TlvData)); pointer != nil && pointer.MetadataAddress != mint && !pointer.MetadataAddress.IsZero() {
+		metadataAddress = pointer.MetadataAddress
+		info := Must1(connection.GetAccountInfo(metadataAddress, web3.GetAccountInfoConfig{Commitment: &commitment}))
+		if info == nil {
+			return nil, TokenMetadataNotFoundErr
+		}
+		programId = info.Owner
+	}
+
+	var tx = web3.Transaction{}
+	if metadataAddress == mint {
+		metadata := Must1(t.ParseTokenMetadata(mintInfo.TlvData))
+		if metadata == nil {
+			return nil, TokenMetadataNotFoundErr
+		}
+		_, sizes := Must2(t.ApplyTokenMetadataUpdates(metadata, updates))
+		info := Must1(connection.GetAccountInfo(mint, web3.GetAccountInfoConfig{Commitment: &commitment}))
+		var maxLength = len(info.Data.Content)
+		for _, size := range sizes {
+			maxLength = max(maxLength, len(info.Data.Content)-metadata.Size()+size)
+		}
+		lamports := Must1(connection.GetMinimumBalanceForRentExemption(maxLength, &commitment))
+		if lamports > info.Lamports {
+			Must(tx.AddInsBuilder(system.NewTransferInstruction(lamports-info.Lamports, payer.D(), mint.D())))
+		}
+	}
+	for _, update := range updates {
+		if update.Remove {
+			Must(tx.AddInsBuilder(token_metadata.NewRemoveKeyInstructionBuilder().
+				SetIdempotent(false).
+				SetKey(update.Field.Key.Field0).
+				SetMetadataAccount(metadataAddress).
+				SetUpdateAuthorityAccount(updateAuthority.PublicKey, updateAuthority.Addresses()...).
+				SetProgramId(&programId)))
+		} else {
+			Must(tx.AddInsBuilder(token_metadata.NewUpdateFieldInstructionBuilder().
+				SetField(update.Field).
+				SetValue(update.Value).
+				SetMetadataAccount(metadataAddress).
+				SetUpdateAuthorityAccount(updateAuthority.PublicKey, updateAuthority.Addresses()...).
+				SetProgramId(&programId)))
+		}
+	}
+	return tx.ExportIns(), nil
+}
+
+// UpdateTokenMetadata applies the updates to the token metadata of the mint in one transaction, see GetUpdateTokenMetadataInstructions
+func (t tokenKit2022) UpdateTokenMetadata(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	mint web3.PublicKey,
+	updateAuthority web3.ComplexSigner,
+	updates []TokenMetadataUpdate,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := t.GetUpdateTokenMetadataInstructions(ctx, connection, payer.PublicKey(), mint, updateAuthority, updates, commitmentOrDefault(options.Commitment))
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, append([]web3.Signer{payer}, updateAuthority.Signers()...), instructions, confirm, options)
+}
+
+// ResolveTokenMetadata reads the token metadata of the mint wherever it is stored:
+// in the mint, or in the account the MetadataPointer points to, read by EmitTokenMetadata.
+// Returns nil if the mint has no metadata
+// @param feePayer An existing system account paying the simulation, it signs nothing
+func (t tokenKit2022) ResolveTokenMetadata(
+	ctx context.Context,
+	connection *web3.Connection,
+	feePayer, mint web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *token_metadata.TokenMetadata, err error) {
+	defer Recover(&err)
+
+	mintInfo := Must1(t.GetMint(ctx, connection, mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: &commitment}))
+	pointer := Must1(t.ParseMetadataPointer(mintInfo.TlvData))
+	if pointer == nil || pointer.MetadataAddress == mint || pointer.MetadataAddress.IsZero() {
+		return t.ParseTokenMetadata(mintInfo.TlvData)
+	}
+	info := Must1(connection.GetAccountInfo(pointer.MetadataAddress, web3.GetAccountInfoConfig{Commitment: &commitment}))
+	if info == nil {
+		return nil, nil
+	}
+	return t.EmitTokenMetadata(ctx, connection, feePayer, info.Owner, pointer.MetadataAddress, commitment)
+}
+
+// EmitTokenMetadata reads the token metadata through the Emit instruction of the metadata program by simulation,
+// the metadata larger than MaxReturnDataSize is read in several ranges.
+// @param feePayer An existing system account paying the simulation, it signs nothing
+func (t tokenKit2022) EmitTokenMetadata(
+	ctx context.Context,
+	connection *web3.Connection,
+	feePayer, programId, metadata web3.PublicKey,
+	commitment web3.Commitment,
+) (_ *token_metadata.TokenMetadata, err error) {
+	defer Recover(&err)
+
+	var data []byte
+	for start := uint64(0); ; start += MaxReturnDataSize {
+		end := start + MaxReturnDataSize
+		chunk, ok := Must2(t.emit(ctx, connection, feePayer, programId, metadata, start, &end, commitment))
+		if !ok {
+			// the range exceeds the metadata and nothing is returned, emit the remaining
+			tail, _ := Must2(t.emit(ctx, connection, feePayer, programId, metadata, start, nil, commitment))
+			data = append(data, tail...)
+			break
+		}
+		// the whole range is returned, restore the trailing zeros trimmed from the return data
+		data = append(data, chunk...)
+		data = append(data, make([]byte, MaxReturnDataSize-len(chunk))...)
+	}
+	if len(data) == 0 {
+		return nil, TokenMetadataNotFoundErr
+	}
+	// the length of the remaining is unknown, its trimmed zeros are restored by padding, the decoder ignores the extra ones
+	data = append(data, make([]byte, MaxReturnDataSize)...)
+	return decodeObject[*token_metadata.TokenMetadata](data)
+}
+
+// emit simulates the Emit of the range, returns false if nothing is returned, when the range exceeds the metadata
+func (t tokenKit2022) emit(
+	ctx context.Context,
+	connection *web3.Connection,
+	feePayer, programId, metadata web3.PublicKey,
+	start uint64,
+	end *uint64,
+	commitment web3.Commitment,
+) (_ []byte, _ bool, err error) {
+	defer Recover(&err)
+	_ = ctx
+
+	var tx = web3.Transaction{}
+	Must(tx.AddInsBuilder(token_metadata.NewEmitInstruction(&start, end, metadata).SetProgramId(&programId)))
+	blockhash := Must1(connection.GetLatestBlockhash(web3.GetLatestBlockhashConfig{Commitment: &commitment}))
+	message := Must1(web3.NewMessage0(web3.CompileV0Args{
+		PayerKey:        feePayer,
+		Instructions:    tx.ExportIns(),
+		RecentBlockhash: blockhash.Blockhash,
+	}))
+	transaction := Must1(web3.NewVersionedTransaction(web3.VersionedMessage{Raw: *message}, nil))
+	response := Must1(connection.SimulateTransactionV0(transaction))
+	if response.Err != nil {
+		return nil, false, fmt.Errorf("emit token metadata: %v", response.Err)
+	}
+	if response.ReturnData == nil || response.ReturnData.ProgramId != programId.String() {
+		return nil, false, nil
+	}
+	return response.ReturnData.Bytes(), true, nil
+}
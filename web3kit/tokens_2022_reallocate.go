@@ -0,0 +1,105 @@
+package web3kit
+
+import (
+	"context"
+	"errors"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/cpi_guard"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/memo_transfer"
+	"github.com/donutnomad/solana-web3/web3"
+	"slices"
+)
+
+var UnsupportedAccountExtensionErr = errors.New("the extension cannot be enabled on an existing token account")
+
+// accountExtensionEnabler returns the instruction enabling the extension on the token account,
+// nil for the extensions only reallocated, to be configured by their own instructions
+func accountExtensionEnabler(extensionType spl_token_2022.ExtensionType, account web3.PublicKey, owner web3.ComplexSigner) (web3.Instruction, error) {
+	programId := web3.TokenProgram2022ID
+	switch extensionType {
+	case spl_token_2022.ExtensionTypeMemoTransfer:
+		return extension.Nested(&programId, memo_transfer.NewEnableInstructionBuilder().
+			SetAccountAccount(account).
+			SetAccountOwnerAccount(owner.PublicKey, owner.Addresses()...), spl_token_2022.NewMemoTransferExtensionInstruction)
+	case spl_token_2022.ExtensionTypeCpiGuard:
+		return extension.Nested(&programId, cpi_guard.NewEnableInstructionBuilder().
+			SetAccountAccount(account).
+			SetOwnerAccount(owner.PublicKey, owner.Addresses()...), spl_token_2022.NewCpiGuardExtensionInstruction)
+	case spl_token_2022.ExtensionTypeConfidentialTransferAccount:
+		// configured by GetConfigureConfidentialAccountInstructions
+		return nil, nil
+	default:
+		return nil, UnsupportedAccountExtensionErr
+	}
+}
+
+// GetEnableAccountExtensionsInstructions Get the instructions to enable the extensions on an existing token account,
+// a Reallocate is issued first if the account has no space for the extensions.
+// The token program computes the new size and charges the payer (a signer) the rent-exempt difference within the Reallocate,
+// no transfer is added, the payer must hold the lamports.
+// Supports MemoTransfer, CpiGuard and ConfidentialTransferAccount (reallocated only, configure it afterward)
+// @param owner The owner of the token account, or a multisig owner with its signers
+func (t tokenKit2022) GetEnableAccountExtensionsInstructions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.PublicKey,
+	account web3.PublicKey, // token account
+	owner web3.ComplexSigner,
+	extensionTypes []spl_token_2022.ExtensionType,
+	commitment *web3.Commitment,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	tokenAccount := Must1(t.GetTokenAccount(ctx, connection, account, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: commitment}))
+	existing := Must1(t.GetExtensionType(tokenAccount.TlvData))
+
+	var tx = web3.Transaction{}
+	var programId = web3.TokenProgram2022ID
+	var newExtensionTypes []spl_token_2022.ExtensionType
+	for _, extensionType := range extensionTypes {
+		if t.IsMintExtension(extensionType) {
+			return nil, UnsupportedAccountExtensionErr
+		}
+		if !slices.Contains(existing, extensionType) && !slices.Contains(newExtensionTypes, extensionType) {
+			newExtensionTypes = append(newExtensionTypes, extensionType)
+		}
+	}
+	if len(newExtensionTypes) > 0 {
+		Must(tx.AddInsBuilder(spl_token_2022.NewReallocateInstructionBuilder().
+			SetExtensionTypes(newExtensionTypes).
+			SetAccountAccount(account).
+			SetPayerAccount(payer).
+			SetSystemAccount(web3.SystemProgramID).
+			SetAccountOwnerAccount(owner.PublicKey, owner.Addresses()...).
+			SetProgramId(&programId)))
+	}
+	for _, extensionType := range DeDupBy(extensionTypes, func(t spl_token_2022.ExtensionType) spl_token_2022.ExtensionType {
+		return t
+	}) {
+		enable := Must1(accountExtensionEnabler(extensionType, account, owner))
+		if enable != nil {
+			Must(tx.AddInstructionAny(enable))
+		}
+	}
+	return tx.ExportIns(), nil
+}
+
+// EnableAccountExtensions enables the extensions on an existing token account in one transaction,
+// the payer is charged the rent of the reallocation, see GetEnableAccountExtensionsInstructions
+func (t tokenKit2022) EnableAccountExtensions(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	account web3.PublicKey, // token account
+	owner web3.ComplexSigner,
+	extensionTypes []spl_token_2022.ExtensionType,
+	confirm bool,
+	options web3.ConfirmOptions,
+) (web3.TransactionSignature, error) {
+	instructions, err := t.GetEnableAccountExtensionsInstructions(ctx, connection, payer.PublicKey(), account, owner, extensionTypes, options.Commitment)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, append([]web3.Signer{payer}, owner.Signers()...), instructions, confirm, options)
+}
@@ -0,0 +1,239 @@
+package web3kit
+
+import (
+	"context"
+	. "github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_mint_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/confidential_transfer_fee"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/cpi_guard"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/default_account_state"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_member_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/immutable_owner"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/interest_bearing_mint"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/memo_transfer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/metadata_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/mint_close_authority"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/non_transferable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/pausable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permanent_delegate"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/permissioned_burn"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/scaled_ui_amount"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_hook"
+	"github.com/donutnomad/solana-web3/token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	bin "github.com/gagliardetto/binary"
+)
+
+// Extensions the decoded extensions of a mint or a token account, nil if the extension is absent
+type Extensions struct {
+	// mint extensions
+	TransferFeeConfig             *transfer_fee.TransferFeeConfig                          `json:",omitempty"`
+	MintCloseAuthority            *mint_close_authority.MintCloseAuthority                 `json:",omitempty"`
+	ConfidentialTransferMint      *confidential_transfer.ConfidentialTransferMint          `json:",omitempty"`
+	DefaultAccountState           *default_account_state.DefaultAccountState               `json:",omitempty"`
+	NonTransferable               *non_transferable.NonTransferable                        `json:",omitempty"`
+	InterestBearingConfig         *interest_bearing_mint.InterestBearingConfig             `json:",omitempty"`
+	PermanentDelegate             *permanent_delegate.PermanentDelegate                    `json:",omitempty"`
+	TransferHook                  *transfer_hook.TransferHook                              `json:",omitempty"`
+	ConfidentialTransferFeeConfig *confidential_transfer_fee.ConfidentialTransferFeeConfig `json:",omitempty"`
+	MetadataPointer               *metadata_pointer.MetadataPointer                        `json:",omitempty"`
+	TokenMetadata                 *token_metadata.TokenMetadata                            `json:",omitempty"`
+	GroupPointer                  *group_pointer.GroupPointer                              `json:",omitempty"`
+	TokenGroup                    *token_group.TokenGroup                                  `json:",omitempty"`
+	GroupMemberPointer            *group_member_pointer.GroupMemberPointer                 `json:",omitempty"`
+	TokenGroupMember              *token_group.TokenGroupMember                            `json:",omitempty"`
+	ConfidentialMintBurn          *confidential_mint_burn.ConfidentialMintBurn             `json:",omitempty"`
+	ScaledUiAmountConfig          *scaled_ui_amount.ScaledUiAmountConfig                   `json:",omitempty"`
+	PausableConfig                *pausable.PausableConfig                                 `json:",omitempty"`
+	PermissionedBurnConfig        *permissioned_burn.PermissionedBurnConfig                `json:",omitempty"`
+	// account extensions
+	TransferFeeAmount             *transfer_fee.TransferFeeAmount                          `json:",omitempty"`
+	ConfidentialTransferAccount   *confidential_transfer.ConfidentialTransferAccount       `json:",omitempty"`
+	ImmutableOwner                *immutable_owner.ImmutableOwner                          `json:",omitempty"`
+	MemoTransfer                  *memo_transfer.MemoTransfer                              `json:",omitempty"`
+	NonTransferableAccount        *non_transferable.NonTransferableAccount                 `json:",omitempty"`
+	CpiGuard                      *cpi_guard.CpiGuard                                      `json:",omitempty"`
+	TransferHookAccount           *transfer_hook.TransferHookAccount                       `json:",omitempty"`
+	ConfidentialTransferFeeAmount *confidential_transfer_fee.ConfidentialTransferFeeAmount `json:",omitempty"`
+	PausableAccount               *pausable.PausableAccount                                `json:",omitempty"`
+	// extensions unknown to this version, kept undecoded
+	Unknown []UnknownExtension `json:",omitempty"`
+}
+
+// UnknownExtension an extension unknown to this version
+type UnknownExtension struct {
+	Type uint16
+	Data []byte
+}
+
+// MintState a mint with all its extensions decoded
+type MintState struct {
+	Mint
+	Address        web3.PublicKey  // address of the mint
+	ExtensionTypes []ExtensionType // the known extension types, in the order of the account data
+	Extensions     Extensions
+}
+
+// TokenAccountState a token account with all its extensions decoded
+type TokenAccountState struct {
+	Account
+	Address        web3.PublicKey  // address of the token account
+	ExtensionTypes []ExtensionType // the known extension types, in the order of the account data
+	Extensions     Extensions
+}
+
+// GetMintState fetches the mint and decodes all its extensions
+func (t tokenKit2022) GetMintState(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint web3.PublicKey,
+	config web3.GetAccountInfoConfig,
+) (*MintState, error) {
+	info, err := t.GetMint(ctx, connection, mint, web3.TokenProgram2022ID, config)
+	if err != nil {
+		return nil, err
+	}
+	return t.DecodeMintState(info)
+}
+
+// GetTokenAccountState fetches the token account and decodes all its extensions
+func (t tokenKit2022) GetTokenAccountState(
+	ctx context.Context,
+	connection *web3.Connection,
+	account web3.PublicKey,
+	config web3.GetAccountInfoConfig,
+) (*TokenAccountState, error) {
+	info, err := t.GetTokenAccount(ctx, connection, account, web3.TokenProgram2022ID, config)
+	if err != nil {
+		return nil, err
+	}
+	return t.DecodeTokenAccountState(info)
+}
+
+// DecodeMintState decodes all the extensions of the mint, see UnpackMint
+func (t tokenKit2022) DecodeMintState(mint *MintInfo) (*MintState, error) {
+	extensionTypes, extensions, err := t.DecodeExtensions(mint.TlvData)
+	if err != nil {
+		return nil, err
+	}
+	return &MintState{
+		Mint:           mint.Mint,
+		Address:        mint.Address,
+		ExtensionTypes: extensionTypes,
+		Extensions:     *extensions,
+	}, nil
+}
+
+// DecodeTokenAccountState decodes all the extensions of the token account, see UnpackTokenAccount
+func (t tokenKit2022) DecodeTokenAccountState(account *TokenAccount) (*TokenAccountState, error) {
+	extensionTypes, extensions, err := t.DecodeExtensions(account.TlvData)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenAccountState{
+		Account:        account.Account,
+		Address:        account.Address,
+		ExtensionTypes: extensionTypes,
+		Extensions:     *extensions,
+	}, nil
+}
+
+// DecodeExtensions decodes every extension of the tlv data,
+// the extensions unknown to this version are returned undecoded in Extensions.Unknown
+func (t tokenKit2022) DecodeExtensions(tlvData []byte) (_ []ExtensionType, _ *Extensions, err error) {
+	defer Recover(&err)
+
+	var extensionTypes []ExtensionType
+	var ret = &Extensions{}
+	for _, entry := range Must1(t.ParseTlv(tlvData)).Entries {
+		extensionType, ok := knownExtensionType(entry.Type)
+		if !ok || !decodeKnownExtension(extensionType, entry.Data, ret) {
+			ret.Unknown = append(ret.Unknown, UnknownExtension(entry))
+			continue
+		}
+		extensionTypes = append(extensionTypes, extensionType)
+	}
+	return extensionTypes, ret, nil
+}
+
+// decodeKnownExtension decodes the extension into its field of extensions, returns false if the extension type is unknown.
+// Panics if the data of a known extension is invalid
+func decodeKnownExtension(extensionType ExtensionType, data []byte, extensions *Extensions) bool {
+	switch extensionType {
+	case ExtensionTypeTransferFeeConfig:
+		decodeExtension(data, &extensions.TransferFeeConfig)
+	case ExtensionTypeTransferFeeAmount:
+		decodeExtension(data, &extensions.TransferFeeAmount)
+	case ExtensionTypeMintCloseAuthority:
+		decodeExtension(data, &extensions.MintCloseAuthority)
+	case ExtensionTypeConfidentialTransferMint:
+		decodeExtension(data, &extensions.ConfidentialTransferMint)
+	case ExtensionTypeConfidentialTransferAccount:
+		decodeExtension(data, &extensions.ConfidentialTransferAccount)
+	case ExtensionTypeDefaultAccountState:
+		decodeExtension(data, &extensions.DefaultAccountState)
+	case ExtensionTypeImmutableOwner:
+		decodeExtension(data, &extensions.ImmutableOwner)
+	case ExtensionTypeMemoTransfer:
+		decodeExtension(data, &extensions.MemoTransfer)
+	case ExtensionTypeNonTransferable:
+		decodeExtension(data, &extensions.NonTransferable)
+	case ExtensionTypeInterestBearingConfig:
+		decodeExtension(data, &extensions.InterestBearingConfig)
+	case ExtensionTypeCpiGuard:
+		decodeExtension(data, &extensions.CpiGuard)
+	case ExtensionTypePermanentDelegate:
+		decodeExtension(data, &extensions.PermanentDelegate)
+	case ExtensionTypeNonTransferableAccount:
+		decodeExtension(data, &extensions.NonTransferableAccount)
+	case ExtensionTypeTransferHook:
+		decodeExtension(data, &extensions.TransferHook)
+	case ExtensionTypeTransferHookAccount:
+		decodeExtension(data, &extensions.TransferHookAccount)
+	case ExtensionTypeConfidentialTransferFeeConfig:
+		decodeExtension(data, &extensions.ConfidentialTransferFeeConfig)
+	case ExtensionTypeConfidentialTransferFeeAmount:
+		decodeExtension(data, &extensions.ConfidentialTransferFeeAmount)
+	case ExtensionTypeMetadataPointer:
+		decodeExtension(data, &extensions.MetadataPointer)
+	case ExtensionTypeTokenMetadata:
+		decodeExtension(data, &extensions.TokenMetadata)
+	case ExtensionTypeGroupPointer:
+		decodeExtension(data, &extensions.GroupPointer)
+	case ExtensionTypeTokenGroup:
+		decodeExtension(data, &extensions.TokenGroup)
+	case ExtensionTypeGroupMemberPointer:
+		decodeExtension(data, &extensions.GroupMemberPointer)
+	case ExtensionTypeTokenGroupMember:
+		decodeExtension(data, &extensions.TokenGroupMember)
+	case ExtensionTypeConfidentialMintBurn:
+		decodeExtension(data, &extensions.ConfidentialMintBurn)
+	case ExtensionTypeScaledUiAmount:
+		decodeExtension(data, &extensions.ScaledUiAmountConfig)
+	case ExtensionTypePausable:
+		decodeExtension(data, &extensions.PausableConfig)
+	case ExtensionTypePausableAccount:
+		decodeExtension(data, &extensions.PausableAccount)
+	case ExtensionTypePermissionedBurn:
+		decodeExtension(data, &extensions.PermissionedBurnConfig)
+	default:
+		return false
+	}
+	return true
+}
+
+// decodeExtension decodes the data into a new T, the extensions without data are decoded into an empty T
+func decodeExtension[T any, PT interface {
+	*T
+	bin.BinaryUnmarshaler
+}](data []byte, field *PT) {
+	if len(data) == 0 {
+		*field = new(T)
+		return
+	}
+	*field = Must1(decodeObject[PT](data))
+}
@@ -0,0 +1,507 @@
+package web3kit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	. "github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_member_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/group_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/immutable_owner"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/interest_bearing_mint"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/metadata_pointer"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/mint_close_authority"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/pausable"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/scaled_ui_amount"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/token_group"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
+	"github.com/donutnomad/solana-web3/token_metadata"
+	"github.com/donutnomad/solana-web3/web3"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gorilla/websocket"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTlvUnknownExtension(t *testing.T) {
+	var authority = web3.Keypair.Generate().PublicKey()
+	var tlv []byte
+	for _, entry := range []struct {
+		extension uint16
+		data      []byte
+	}{
+		{200, []byte{1, 2, 3}}, // extensions unknown to this version
+		{0x1234, nil},
+		{uint16(ExtensionTypePausable), append(authority.Bytes(), 1)},
+	} {
+		tlv = binary.LittleEndian.AppendUint16(tlv, entry.extension)
+		tlv = binary.LittleEndian.AppendUint16(tlv, uint16(len(entry.data)))
+		tlv = append(tlv, entry.data...)
+	}
+	tlv = append(tlv, make([]byte, 6)...) // padding
+
+	extensions, err := Token2022.GetExtensionType(tlv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extensions) != 1 || extensions[0] != ExtensionTypePausable {
+		t.Fatalf("unexpected extensions %v", extensions)
+	}
+	// the unknown extensions are kept as raw entries whatever their type
+	raw, err := Token2022.ParseTlv(tlv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(raw.Entries) != 3 || raw.Entries[0].Type != 200 || raw.Entries[1].Type != 0x1234 || len(raw.ExtensionTypes()) != 1 {
+		t.Fatalf("unexpected entries %+v", raw.Entries)
+	}
+	if _, decoded, err := Token2022.DecodeExtensions(tlv); err != nil || len(decoded.Unknown) != 2 {
+		t.Fatalf("unexpected unknown extensions %+v %v", decoded, err)
+	}
+	if Token2022.IsMintExtension(ExtensionType(200)) || !Token2022.IsMintExtension(ExtensionTypePausable) {
+		t.Fatal("unexpected mint extension")
+	}
+	config, err := Token2022.ParsePausableConfig(tlv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config == nil || config.Authority != authority || !config.Paused {
+		t.Fatalf("unexpected config %+v", config)
+	}
+	if burn, err := Token2022.ParsePermissionedBurnConfig(tlv); burn != nil || err != nil {
+		t.Fatalf("unexpected permissioned burn %v %v", burn, err)
+	}
+	if _, err := Token2022.GetExtensionData(ExtensionTypePausable, tlv[:20]); err == nil {
+		t.Fatal("expected an error for truncated data")
+	}
+}
+
+func TestUiAmount(t *testing.T) {
+	for _, entry := range []struct {
+		amount   uint64
+		decimals uint8
+		ui       string
+	}{
+		{0, 0, "0"}, {1230, 3, "1.23"}, {5, 9, "0.000000005"}, {1000, 2, "10"},
+	} {
+		if ui := AmountToUiAmountStringTrimmed(entry.amount, entry.decimals); ui != entry.ui {
+			t.Fatalf("unexpected ui amount %s, expected %s", ui, entry.ui)
+		}
+		if amount, err := ParseUiAmount(entry.ui, entry.decimals); err != nil || amount != entry.amount {
+			t.Fatalf("unexpected amount %d %v", amount, err)
+		}
+	}
+	for _, ui := range []string{"", ".", "1.2.3", "0.0001", "-1"} {
+		if _, err := ParseUiAmount(ui, 3); err == nil {
+			t.Fatalf("expected an error for %q", ui)
+		}
+	}
+
+	// a constant 5% for one year
+	const year = 31556736
+	interest := &interest_bearing_mint.InterestBearingConfig{
+		PreUpdateAverageRate: 500,
+		LastUpdateTimestamp:  year,
+		CurrentRate:          500,
+	}
+	for _, entry := range []struct {
+		amount   uint64
+		decimals uint8
+		ui       string
+	}{
+		{1, 0, "1"}, {1, 1, "0.1"}, {1, 10, "0.0000000001"}, {10_000_000_000, 10, "1.0512710964"},
+	} {
+		ui, err := interest.AmountToUiAmount(entry.amount, entry.decimals, year)
+		if err != nil || ui != entry.ui {
+			t.Fatalf("unexpected ui amount %s %v, expected %s", ui, err, entry.ui)
+		}
+	}
+	if amount, err := interest.UiAmountToAmount("1.0512710964", 10, year); err != nil || amount != 10_000_000_000 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+	if _, err := interest.UiAmountToAmount("inf", 10, year); err == nil {
+		t.Fatal("expected an error for inf")
+	}
+
+	scaled := &scaled_ui_amount.ScaledUiAmountConfig{Multiplier: 2, NewMultiplierEffectiveTimestamp: 100, NewMultiplier: 0.5}
+	if ui := scaled.AmountToUiAmount(1234, 2, 99); ui != "24.68" {
+		t.Fatalf("unexpected ui amount %s", ui)
+	}
+	if ui := scaled.AmountToUiAmount(3, 0, 100); ui != "1" {
+		t.Fatalf("unexpected ui amount %s", ui)
+	}
+	if amount, err := scaled.UiAmountToAmount("24.68", 2, 99); err != nil || amount != 1234 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+}
+
+func TestTransferFee(t *testing.T) {
+	config := &transfer_fee.TransferFeeConfig{
+		OlderTransferFee: transfer_fee.TransferFee{Epoch: 0, MaximumFee: 0, TransferFeeBasisPoints: 0},
+		NewerTransferFee: transfer_fee.TransferFee{Epoch: 10, MaximumFee: 5000, TransferFeeBasisPoints: 100},
+	}
+	for _, entry := range []struct {
+		epoch  uint64
+		amount uint64
+		fee    uint64
+	}{
+		{9, 1_000_000, 0}, {10, 0, 0}, {10, 1, 1}, {10, 10_000, 100}, {11, 1_000_000, 5000},
+	} {
+		if fee, err := config.CalculateEpochFee(entry.epoch, entry.amount); err != nil || fee != entry.fee {
+			t.Fatalf("unexpected fee %d %v, expected %d", fee, err, entry.fee)
+		}
+	}
+	for _, entry := range []struct {
+		net   uint64
+		gross uint64
+	}{
+		{0, 0}, {1, 2}, {99, 100}, {9900, 10_000}, {1_000_000, 1_005_000},
+	} {
+		gross, err := config.CalculateEpochPreFeeAmount(10, entry.net)
+		if err != nil || gross != entry.gross {
+			t.Fatalf("unexpected gross amount %d %v, expected %d", gross, err, entry.gross)
+		}
+		if net, _ := config.NewerTransferFee.CalculatePostFeeAmount(gross); net != entry.net {
+			t.Fatalf("unexpected net amount %d, expected %d", net, entry.net)
+		}
+	}
+
+	full := &transfer_fee.TransferFee{MaximumFee: math.MaxUint64, TransferFeeBasisPoints: transfer_fee.MaxFeeBasisPoints}
+	if fee, err := full.CalculateFee(math.MaxUint64); err != nil || fee != math.MaxUint64 {
+		t.Fatalf("unexpected fee %d %v", fee, err)
+	}
+	if _, err := full.CalculatePreFeeAmount(1); err != transfer_fee.ErrFeeOverflow {
+		t.Fatalf("expected an overflow, got %v", err)
+	}
+}
+
+func TestDecodeExtensions(t *testing.T) {
+	config := &transfer_fee.TransferFeeConfig{
+		WithdrawWithheldAuthority: web3.Keypair.Generate().PublicKey(),
+		WithheldAmount:            7,
+		NewerTransferFee:          transfer_fee.TransferFee{Epoch: 3, MaximumFee: 10, TransferFeeBasisPoints: 50},
+	}
+	var tlv []byte
+	for _, entry := range []struct {
+		extension uint16
+		data      []byte
+	}{
+		{uint16(ExtensionTypeTransferFeeConfig), GetBytes(config)},
+		{200, []byte{1, 2, 3}},
+		{uint16(ExtensionTypeImmutableOwner), GetBytes(&immutable_owner.ImmutableOwner{})},
+		{0x1234, []byte{4}},
+	} {
+		tlv = binary.LittleEndian.AppendUint16(tlv, entry.extension)
+		tlv = binary.LittleEndian.AppendUint16(tlv, uint16(len(entry.data)))
+		tlv = append(tlv, entry.data...)
+	}
+
+	extensionTypes, extensions, err := Token2022.DecodeExtensions(tlv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extensionTypes) != 2 || extensionTypes[0] != ExtensionTypeTransferFeeConfig || extensionTypes[1] != ExtensionTypeImmutableOwner {
+		t.Fatalf("unexpected extensions %v", extensionTypes)
+	}
+	if *extensions.TransferFeeConfig != *config || extensions.ImmutableOwner == nil || extensions.MemoTransfer != nil {
+		t.Fatalf("unexpected extensions %+v", extensions)
+	}
+	if len(extensions.Unknown) != 2 || extensions.Unknown[0].Type != 200 || extensions.Unknown[1].Type != 0x1234 {
+		t.Fatalf("unexpected unknown extensions %+v", extensions.Unknown)
+	}
+	content, err := json.Marshal(extensions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `"TransferFeeConfig"`) || strings.Contains(string(content), `"MemoTransfer"`) {
+		t.Fatalf("unexpected json %s", content)
+	}
+
+	if _, _, err := Token2022.DecodeExtensions(tlv[:10]); err == nil {
+		t.Fatal("expected an error for truncated data")
+	}
+	if _, _, err := Token2022.DecodeExtensions(append(binary.LittleEndian.AppendUint16(nil, uint16(ExtensionTypeTransferFeeConfig)), 1, 0, 0)); err == nil {
+		t.Fatal("expected an error for invalid data")
+	}
+}
+
+func TestTlvEditor(t *testing.T) {
+	var authority = web3.Keypair.Generate().PublicKey()
+	var tlv = &Tlv{}
+	tlv.Set(ExtensionTypeMintCloseAuthority, &mint_close_authority.MintCloseAuthority{CloseAuthority: authority}).
+		Set(ExtensionTypePausable, &pausable.PausableConfig{Authority: authority}).
+		SetRaw(300, []byte{9, 9})
+	tlv.Set(ExtensionTypePausable, &pausable.PausableConfig{Authority: authority, Paused: true})
+
+	data := Token2022.PackMint(&Mint{Decimals: 6, IsInitialized: true, MintAuthority: &authority}, tlv)
+	length, err := Token2022.GetMintLen([]ExtensionType{ExtensionTypeMintCloseAuthority, ExtensionTypePausable})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint64(len(data)) != length+2+2+2 {
+		t.Fatalf("unexpected length %d", len(data))
+	}
+	mint, err := Token2022.UnpackMint(authority, &web3.AccountInfoD{Owner: web3.TokenProgram2022ID, Data: web3.EncodingData{Content: data}}, web3.TokenProgram2022ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mint.Decimals != 6 || *mint.MintAuthority != authority {
+		t.Fatalf("unexpected mint %+v", mint.Mint)
+	}
+	config, err := Token2022.ParsePausableConfig(mint.TlvData)
+	if err != nil || config == nil || !config.Paused {
+		t.Fatalf("unexpected config %+v %v", config, err)
+	}
+
+	edited, err := Token2022.ParseTlv(mint.TlvData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !edited.Remove(ExtensionTypeMintCloseAuthority) || edited.Remove(ExtensionTypeMintCloseAuthority) {
+		t.Fatal("unexpected remove")
+	}
+	if types := edited.ExtensionTypes(); len(types) != 1 || types[0] != ExtensionTypePausable || len(edited.Entries) != 2 {
+		t.Fatalf("unexpected extensions %v", types)
+	}
+
+	account := Token2022.PackTokenAccount(&Account{Mint: authority, Amount: 5, State: AccountStateInitialized}, (&Tlv{}).Set(ExtensionTypeImmutableOwner, &immutable_owner.ImmutableOwner{}))
+	state, err := Token2022.UnpackTokenAccount(authority, &web3.AccountInfoD{Owner: web3.TokenProgram2022ID, Data: web3.EncodingData{Content: account}}, web3.TokenProgram2022ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if extensions, err := Token2022.GetExtensionType(state.TlvData); err != nil || len(extensions) != 1 || extensions[0] != ExtensionTypeImmutableOwner {
+		t.Fatalf("unexpected extensions %v %v", extensions, err)
+	}
+}
+
+func TestReallocateData(t *testing.T) {
+	var key = web3.Keypair.Generate().PublicKey()
+	data, err := NewReallocateInstruction([]ExtensionType{ExtensionTypeMemoTransfer, ExtensionTypeCpiGuard}, key, key, web3.SystemProgramID, key).Build().Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte{Instruction_Reallocate, 8, 0, 11, 0}) {
+		t.Fatalf("unexpected data %v", data)
+	}
+	var decoded Reallocate
+	if err := decoded.UnmarshalWithDecoder(bin.NewBorshDecoder(data[1:])); err != nil || len(decoded.ExtensionTypes) != 2 || decoded.ExtensionTypes[1] != ExtensionTypeCpiGuard {
+		t.Fatalf("unexpected extension types %v %v", decoded.ExtensionTypes, err)
+	}
+}
+
+func TestTokenGroup(t *testing.T) {
+	var authority = web3.Keypair.Generate().PublicKey()
+	var groupMint = web3.Keypair.Generate().PublicKey()
+	var memberMint = web3.Keypair.Generate().PublicKey()
+	if size := GetSize(&token_group.TokenGroup{}); size != token_group.TOKEN_GROUP_SIZE {
+		t.Fatalf("unexpected group size %d", size)
+	}
+
+	groupTlv := (&Tlv{}).Set(ExtensionTypeGroupPointer, &group_pointer.GroupPointer{Authority: authority, GroupAddress: groupMint}).
+		Set(ExtensionTypeTokenGroup, &token_group.TokenGroup{UpdateAuthority: authority, Mint: groupMint, Size: 1, MaxSize: 10})
+	group, err := Token2022.DecodeMintState(&MintInfo{Address: groupMint, TlvData: groupTlv.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	memberTlv := (&Tlv{}).Set(ExtensionTypeMetadataPointer, &metadata_pointer.MetadataPointer{Authority: authority, MetadataAddress: memberMint}).
+		Set(ExtensionTypeGroupMemberPointer, &group_member_pointer.GroupMemberPointer{Authority: authority, MemberAddress: memberMint}).
+		Set(ExtensionTypeTokenGroupMember, &token_group.TokenGroupMember{Mint: memberMint, Group: groupMint, MemberNumber: 1})
+	member, err := Token2022.DecodeMintState(&MintInfo{Address: memberMint, TlvData: memberTlv.Bytes()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Token2022.ValidateGroupMember(member, group); err != nil {
+		t.Fatal(err)
+	}
+	if member.Extensions.TokenGroupMember.MemberNumber != 1 || group.Extensions.TokenGroup.MaxSize != 10 {
+		t.Fatalf("unexpected group %+v member %+v", group.Extensions.TokenGroup, member.Extensions.TokenGroupMember)
+	}
+
+	// the member entry is at the offset scanned by FindGroupMembers
+	data := Token2022.PackMint(&Mint{IsInitialized: true}, memberTlv)
+	offset, err := Token2022.GetMintLen(DefaultGroupMemberLayouts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data[offset+4+32:offset+4+64], groupMint.Bytes()) {
+		t.Fatal("unexpected member offset")
+	}
+
+	member.Extensions.GroupMemberPointer.MemberAddress = authority
+	if err := Token2022.ValidateGroupPointers(member); err != MemberPointerMismatchErr {
+		t.Fatalf("unexpected error %v", err)
+	}
+	group.Address = authority
+	if err := Token2022.ValidateGroupPointers(group); err != GroupPointerMismatchErr {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestTokenMetadataUpdates(t *testing.T) {
+	var metadata = &token_metadata.TokenMetadata{Name: "name", Symbol: "SYM", Uri: "uri"}
+	metadata.Update(token_metadata.NewField_Key("a"), "1")
+	updated, sizes, err := Token2022.ApplyTokenMetadataUpdates(metadata, []TokenMetadataUpdate{
+		NewTokenMetadataFieldUpdate(token_metadata.NewField_Name(), "a longer name"),
+		NewTokenMetadataFieldUpdate(token_metadata.NewField_Key("b"), "2"),
+		NewTokenMetadataFieldUpdate(token_metadata.NewField_Key("a"), "11"),
+		NewTokenMetadataKeyRemoval("b"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metadata.AdditionalMetadata) != 1 || metadata.Name != "name" {
+		t.Fatalf("the metadata is modified %+v", metadata)
+	}
+	if value, ok := updated.Get(token_metadata.NewField_Key("a")); !ok || value != "11" || updated.Name != "a longer name" || len(updated.AdditionalMetadata) != 1 {
+		t.Fatalf("unexpected metadata %+v", updated)
+	}
+	if len(sizes) != 4 || sizes[3] != GetSize(updated) || sizes[1] != sizes[3]-1+4+1+4+1 {
+		t.Fatalf("unexpected sizes %v", sizes)
+	}
+	if _, _, err := Token2022.ApplyTokenMetadataUpdates(metadata, []TokenMetadataUpdate{NewTokenMetadataKeyRemoval("c")}); err != MetadataKeyNotFoundErr {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+type rpcStubRequest struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// newRpcStub serves the JSON-RPC requests by the handler, an error of the handler is returned as a JSON-RPC error.
+// NewConnection dials the websocket of the connection, which is accepted and idle
+func newRpcStub(t *testing.T, handler func(request rpcStubRequest) any) *web3.Connection {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if websocket.IsWebSocketUpgrade(r) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+		var request rpcStubRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch result := handler(request).(type) {
+		case error:
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 0, "error": map[string]any{"code": -32000, "message": result.Error()}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 0, "result": map[string]any{
+				"context": map[string]any{"slot": 1},
+				"value":   result,
+			}})
+		}
+	}))
+	t.Cleanup(server.Close)
+	wsEndpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+	connection, err := web3.NewConnection(server.URL, &web3.ConnectionConfig{WsEndpoint: &wsEndpoint})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return connection
+}
+
+func TestEmitTokenMetadata(t *testing.T) {
+	programId := web3.TokenProgram2022ID
+	metadata := &token_metadata.TokenMetadata{
+		// the first range ends with zeros, trimmed from the return data
+		Name:   strings.Repeat("n", 900) + strings.Repeat("\x00", 300),
+		Symbol: "SYM",
+		Uri:    strings.Repeat("u", 1000),
+	}
+	// the stub runs on the goroutines of the server, it records its errors for the test
+	var mu sync.Mutex
+	var emits int
+	var stubErrs []error
+	fail := func(err error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		stubErrs = append(stubErrs, err)
+		return err
+	}
+	// emit (a Token-2022 mint) sets no return data if the range exceeds the metadata, and the trailing zeros are trimmed
+	emitter := func(data []byte) func(request rpcStubRequest) any {
+		return func(request rpcStubRequest) any {
+			switch request.Method {
+			case "getLatestBlockhash":
+				return map[string]any{"blockhash": web3.SystemProgramID.String(), "lastValidBlockHeight": 100}
+			case "simulateTransaction":
+				mu.Lock()
+				emits++
+				mu.Unlock()
+				var encoded string
+				_ = json.Unmarshal(request.Params[0], &encoded)
+				raw, _ := base64.StdEncoding.DecodeString(encoded)
+				var tx web3.VersionedTransaction
+				if err := tx.Deserialize(raw); err != nil {
+					return fail(err)
+				}
+				args := tx.Message.CompiledInstructions()[0].Data[8:]
+				start, end := uint64(0), uint64(len(data))
+				if args[0] == 1 {
+					start, args = binary.LittleEndian.Uint64(args[1:]), args[9:]
+				} else {
+					args = args[1:]
+				}
+				if args[0] == 1 {
+					end = binary.LittleEndian.Uint64(args[1:])
+				}
+				if data == nil || start > end || end > uint64(len(data)) {
+					return map[string]any{"err": nil}
+				}
+				returned := bytes.TrimRight(data[start:end], "\x00")
+				return map[string]any{"err": nil, "returnData": map[string]any{
+					"programId": programId.String(),
+					"data":      []string{base64.StdEncoding.EncodeToString(returned), "base64"},
+				}}
+			}
+			return fail(fmt.Errorf("unexpected method %s", request.Method))
+		}
+	}
+	checkStub := func() {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		if len(stubErrs) > 0 {
+			t.Fatalf("unexpected stub errors %v", stubErrs)
+		}
+	}
+
+	connection := newRpcStub(t, emitter(GetBytes(metadata)))
+	emitted, err := Token2022.EmitTokenMetadata(context.Background(), connection, web3.SystemProgramID, programId, web3.SystemProgramID, web3.CommitmentConfirmed)
+	checkStub()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emitted.Name != metadata.Name || emitted.Symbol != metadata.Symbol || emitted.Uri != metadata.Uri || len(emitted.AdditionalMetadata) != 0 {
+		t.Fatalf("unexpected metadata %+v", emitted)
+	}
+	// two full ranges, the third one exceeds the metadata, then the remaining
+	mu.Lock()
+	count := emits
+	mu.Unlock()
+	if count != 4 {
+		t.Fatalf("unexpected emits %d", count)
+	}
+
+	connection = newRpcStub(t, emitter(nil))
+	_, err = Token2022.EmitTokenMetadata(context.Background(), connection, web3.SystemProgramID, programId, web3.SystemProgramID, web3.CommitmentConfirmed)
+	checkStub()
+	if err != TokenMetadataNotFoundErr {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package web3kit
+
+import (
+	"encoding/binary"
+	. "github.com/donutnomad/solana-web3/spl_token_2022"
+	bin "github.com/gagliardetto/binary"
+)
+
+// TlvEntry an extension entry of the tlv data
+type TlvEntry struct {
+	Type uint16
+	Data []byte
+}
+
+// Tlv an editor of the tlv data of a mint or a token account, the lengths are recomputed by Bytes
+type Tlv struct {
+	Entries []TlvEntry
+}
+
+// ParseTlv reads the entries of the tlv data until the end or an uninitialized entry,
+// the extensions unknown to this version are kept
+func (t tokenKit2022) ParseTlv(tlvData []byte) (*Tlv, error) {
+	var ret = &Tlv{}
+	var index = 0
+	for index+t.TYPE_SIZE()+t.LENGTH_SIZE() <= len(tlvData) {
+		entryType := binary.LittleEndian.Uint16(tlvData[index:])
+		if ExtensionType(entryType) == ExtensionTypeUninitialized {
+			break
+		}
+		entryLength := int(binary.LittleEndian.Uint16(tlvData[index+t.TYPE_SIZE():]))
+		index += t.TYPE_SIZE() + t.LENGTH_SIZE()
+		if index+entryLength > len(tlvData) {
+			return nil, InvalidAccountSizeErr
+		}
+		ret.Entries = append(ret.Entries, TlvEntry{Type: entryType, Data: tlvData[index : index+entryLength]})
+		index += entryLength
+	}
+	return ret, nil
+}
+
+// Get returns the data of the extension, nil if the extension is absent
+func (tlv *Tlv) Get(extension ExtensionType) []byte {
+	if i := tlv.index(uint16(extension)); i >= 0 {
+		return tlv.Entries[i].Data
+	}
+	return nil
+}
+
+// Has returns true if the extension is present
+func (tlv *Tlv) Has(extension ExtensionType) bool {
+	return tlv.index(uint16(extension)) >= 0
+}
+
+// SetRaw replaces the data of the entry, or appends a new entry if absent
+func (tlv *Tlv) SetRaw(entryType uint16, data []byte) *Tlv {
+	if i := tlv.index(entryType); i >= 0 {
+		tlv.Entries[i].Data = data
+	} else {
+		tlv.Entries = append(tlv.Entries, TlvEntry{Type: entryType, Data: data})
+	}
+	return tlv
+}
+
+// Set replaces the extension, or appends it if absent
+func (tlv *Tlv) Set(extension ExtensionType, value bin.BinaryMarshaler) *Tlv {
+	return tlv.SetRaw(uint16(extension), GetBytes(value))
+}
+
+// Remove removes the extension, returns false if the extension is absent
+func (tlv *Tlv) Remove(extension ExtensionType) bool {
+	i := tlv.index(uint16(extension))
+	if i < 0 {
+		return false
+	}
+	tlv.Entries = append(tlv.Entries[:i], tlv.Entries[i+1:]...)
+	return true
+}
+
+// ExtensionTypes returns the known extension types, in order
+func (tlv *Tlv) ExtensionTypes() []ExtensionType {
+	var ret []ExtensionType
+	for _, entry := range tlv.Entries {
+		if extensionType, ok := knownExtensionType(entry.Type); ok {
+			ret = append(ret, extensionType)
+		}
+	}
+	return ret
+}
+
+// knownExtensionType returns the extension type of the entry type, false if the type is unknown to this version
+func knownExtensionType(entryType uint16) (ExtensionType, bool) {
+	if entryType > 0xff || ExtensionType(entryType).String() == "" {
+		return 0, false
+	}
+	return ExtensionType(entryType), true
+}
+
+// Bytes encodes the entries with their lengths
+func (tlv *Tlv) Bytes() []byte {
+	var ret []byte
+	for _, entry := range tlv.Entries {
+		ret = binary.LittleEndian.AppendUint16(ret, entry.Type)
+		ret = binary.LittleEndian.AppendUint16(ret, uint16(len(entry.Data)))
+		ret = append(ret, entry.Data...)
+	}
+	return ret
+}
+
+func (tlv *Tlv) index(entryType uint16) int {
+	for i, entry := range tlv.Entries {
+		if entry.Type == entryType {
+			return i
+		}
+	}
+	return -1
+}
+
+// PackMint encodes the mint and its extensions to the account data, the same layout as the Token-2022 program
+func (t tokenKit2022) PackMint(mint *Mint, tlv *Tlv) []byte {
+	return t.packAccount(GetBytes(mint), 1 /*AccountType.Mint*/, tlv)
+}
+
+// PackTokenAccount encodes the token account and its extensions to the account data, the same layout as the Token-2022 program
+func (t tokenKit2022) PackTokenAccount(account *Account, tlv *Tlv) []byte {
+	return t.packAccount(GetBytes(account), 2 /*AccountType.Account*/, tlv)
+}
+
+func (t tokenKit2022) packAccount(base []byte, accountType byte, tlv *Tlv) []byte {
+	if tlv == nil || len(tlv.Entries) == 0 {
+		return base
+	}
+	var tlvData = tlv.Bytes()
+	var ret = make([]byte, ACCOUNT_SIZE, ACCOUNT_SIZE+ACCOUNT_TYPE_SIZE+len(tlvData))
+	copy(ret, base)
+	ret = append(ret, accountType)
+	ret = append(ret, tlvData...)
+	// avoid the size of a multisig, the same as GetLen
+	if len(ret) == MULTISIG_SIZE {
+		ret = append(ret, make([]byte, t.TYPE_SIZE())...)
+	}
+	return ret
+}
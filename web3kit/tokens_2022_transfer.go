@@ -0,0 +1,126 @@
+package web3kit
+
+import (
+	"context"
+	ata "github.com/donutnomad/solana-web3/associated_token_account"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_hook"
+	"github.com/donutnomad/solana-web3/transfer_hook_interface"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// TransferOptions options of the Token-2022 transfers
+type TransferOptions struct {
+	// Append the extra accounts required by the transfer hook program of the mint
+	ResolveTransferHook bool
+	// Commitment used to fetch the mint and the accounts, defaults to the connection commitment
+	Commitment *web3.Commitment
+}
+
+// ParseTransferHook Extension: transfer_hook
+func (t tokenKit2022) ParseTransferHook(data []byte) (*transfer_hook.TransferHook, error) {
+	return parseExtension[*transfer_hook.TransferHook](spl_token_2022.ExtensionTypeTransferHook, data)
+}
+
+// AccountDataFetcher returns a fetcher of account data backed by the connection, used to resolve the transfer hook accounts
+func (t tokenKit2022) AccountDataFetcher(connection *web3.Connection, commitment *web3.Commitment) transfer_hook_interface.AccountDataFetcher {
+	return func(address web3.PublicKey) ([]byte, error) {
+		info, err := connection.GetAccountInfo(address, web3.GetAccountInfoConfig{Commitment: commitment})
+		if err != nil || info == nil {
+			return nil, err
+		}
+		return info.Data.Content, nil
+	}
+}
+
+// GetTransferHookAccounts resolves the accounts to append to a TransferChecked instruction of a mint with a transfer hook,
+// returns nil if the mint has no transfer hook program.
+// source and destination are token accounts, authority is the owner or the delegate of the source
+func (t tokenKit2022) GetTransferHookAccounts(
+	connection *web3.Connection,
+	mint *MintInfo,
+	source, destination, authority web3.PublicKey,
+	amount uint64,
+	commitment *web3.Commitment,
+) ([]*web3.AccountMeta, error) {
+	if len(mint.TlvData) == 0 {
+		return nil, nil
+	}
+	hook, err := t.ParseTransferHook(mint.TlvData)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil || hook.ProgramId.IsZero() {
+		return nil, nil
+	}
+	return transfer_hook_interface.ResolveExtraAccountMetasForExecute(
+		hook.ProgramId, source, mint.Address, destination, authority, amount,
+		t.AccountDataFetcher(connection, commitment),
+	)
+}
+
+// GetTransferInstructions Get the transfer instructions of a Token-2022 token,
+// the associated token account of the destination is created if it does not exist
+// @param payer Pay the fee
+// @param from Source (wallet address)
+// @param to Destination (wallet address)
+// @param amount Transferred
+func (t tokenKit2022) GetTransferInstructions(
+	connection *web3.Connection,
+	payer web3.PublicKey, from web3.PublicKey, to web3.PublicKey,
+	mint web3.PublicKey, amount uint64,
+	options TransferOptions,
+	multiSigners ...web3.PublicKey,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	var tx = web3.Transaction{}
+	var programId = web3.TokenProgram2022ID
+	var config = web3.GetAccountInfoConfig{Commitment: options.Commitment}
+
+	associatedFrom := Must1(ata.FindAssociatedTokenAddress(from, mint, programId))
+	associatedTo := Must1(ata.FindAssociatedTokenAddress(to, mint, programId))
+	for _, accounts := range [][]web3.PublicKey{{associatedFrom, from}, {associatedTo, to}} {
+		tokenAccountInfo := Must1(connection.GetAccountInfo(accounts[0], config))
+		if tokenAccountInfo != nil {
+			continue
+		}
+		Must(tx.AddInsBuilder(
+			ata.NewCreateInstruction(payer, accounts[0], accounts[1], mint, web3.SystemProgramID, programId),
+		))
+	}
+	mintInfo := Must1(t.GetMint(context.Background(), connection, mint, programId, config))
+	transfer := spl_token_2022.NewTransferCheckedInstruction(amount, mintInfo.Decimals, associatedFrom, mint, associatedTo, from).
+		SetAuthorityAccount(from, multiSigners...)
+	if options.ResolveTransferHook {
+		extraAccounts := Must1(t.GetTransferHookAccounts(connection, mintInfo, associatedFrom, associatedTo, from, amount, options.Commitment))
+		for _, account := range extraAccounts {
+			transfer.AccountMetaSlice.Append(account)
+		}
+	}
+	Must(tx.AddInsBuilder(transfer))
+	return tx.ExportIns(), nil
+}
+
+// Transfer executes a Token-2022 transfer, see tokenKit.Transfer
+func (t tokenKit2022) Transfer(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	from web3.Signer, // wallet address, not associated token address
+	to web3.PublicKey, // wallet address, not associated token address
+	mint web3.PublicKey,
+	amount uint64,
+	transferOptions TransferOptions,
+	confirm bool,
+	options web3.ConfirmOptions,
+	multiSigners ...web3.Signer,
+) (web3.TransactionSignature, error) {
+	var instructions, err = t.GetTransferInstructions(connection, payer.PublicKey(), from.PublicKey(), to, mint, amount, transferOptions, Map(multiSigners, func(i int, t web3.Signer) web3.PublicKey {
+		return t.PublicKey()
+	})...)
+	if err != nil {
+		return "", err
+	}
+	return sendInstructions(ctx, connection, payer, append([]web3.Signer{payer, from}, multiSigners...), instructions, confirm, options)
+}
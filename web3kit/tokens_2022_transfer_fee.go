@@ -0,0 +1,250 @@
+package web3kit
+
+import (
+	"context"
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension"
+	"github.com/donutnomad/solana-web3/spl_token_2022/extension/transfer_fee"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+const (
+	// MaxHarvestAccountsPerTransaction token accounts harvested by one transaction signed by the payer only
+	MaxHarvestAccountsPerTransaction = 30
+	// MaxWithdrawAccountsPerTransaction token accounts withdrawn by one transaction signed by the payer and the withdraw authority
+	MaxWithdrawAccountsPerTransaction = 26
+)
+
+// ParseTransferFeeAmount Extension: transfer_fee
+func (t tokenKit2022) ParseTransferFeeAmount(data []byte) (*transfer_fee.TransferFeeAmount, error) {
+	return parseExtension[*transfer_fee.TransferFeeAmount](spl_token_2022.ExtensionTypeTransferFeeAmount, data)
+}
+
+// CalculateTransferFee the fee withheld from the transfer of amount at epoch, 0 if the mint has no transfer fee
+func (t tokenKit2022) CalculateTransferFee(mint *MintInfo, epoch, amount uint64) (uint64, error) {
+	config, err := t.ParseTransferFeeConfig(mint.TlvData)
+	if err != nil || config == nil {
+		return 0, err
+	}
+	return config.CalculateEpochFee(epoch, amount)
+}
+
+// CalculateTransferAmount the amount to transfer at epoch so that the destination receives netAmount after the fee
+func (t tokenKit2022) CalculateTransferAmount(mint *MintInfo, epoch, netAmount uint64) (uint64, error) {
+	config, err := t.ParseTransferFeeConfig(mint.TlvData)
+	if err != nil || config == nil {
+		return netAmount, err
+	}
+	return config.CalculateEpochPreFeeAmount(epoch, netAmount)
+}
+
+// WithheldTokenAccount a token account with withheld transfer fees
+type WithheldTokenAccount struct {
+	Address        web3.PublicKey
+	WithheldAmount uint64
+}
+
+// FindWithheldTokenAccounts scans all the token accounts of the mint, returns the ones with withheld transfer fees
+func (t tokenKit2022) FindWithheldTokenAccounts(
+	ctx context.Context,
+	connection *web3.Connection,
+	mint web3.PublicKey,
+	commitment web3.Commitment,
+) ([]WithheldTokenAccount, error) {
+	_ = ctx
+	filters := GetProgramAccountFilters(GetProgramAccountsOption{Mint: web3.Ref(mint)})
+	filters = append(filters, web3.GetProgramAccountsFilter{
+		Memcmp: &web3.RPCFilterMemcmp{
+			Offset: spl_token_2022.ACCOUNT_SIZE,
+			Bytes:  []byte{2}, // AccountType.Account
+		},
+	})
+	response, err := connection.GetProgramAccounts(web3.TokenProgram2022ID, web3.GetProgramAccountsConfig{
+		Commitment: &commitment,
+		Filters:    filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ret []WithheldTokenAccount
+	for _, item := range response {
+		data := item.Account.Data.Content
+		if len(data) <= spl_token_2022.ACCOUNT_SIZE+ACCOUNT_TYPE_SIZE {
+			continue
+		}
+		amount, err := t.ParseTransferFeeAmount(data[spl_token_2022.ACCOUNT_SIZE+ACCOUNT_TYPE_SIZE:])
+		if err != nil {
+			return nil, err
+		}
+		if amount == nil || amount.WithheldAmount == 0 {
+			continue
+		}
+		ret = append(ret, WithheldTokenAccount{
+			Address:        item.Pubkey,
+			WithheldAmount: amount.WithheldAmount,
+		})
+	}
+	return ret, nil
+}
+
+func transferFeeInstruction[T interface{ Validate() error }](inner T) (web3.TransactionInstruction, error) {
+	programId := web3.TokenProgram2022ID
+	ins, err := extension.Nested(&programId, inner, spl_token_2022.NewTransferFeeExtensionInstruction)
+	if err != nil {
+		return web3.TransactionInstruction{}, err
+	}
+	var tx = web3.Transaction{}
+	if err := tx.AddInstructionAny(ins); err != nil {
+		return web3.TransactionInstruction{}, err
+	}
+	return tx.ExportIns()[0], nil
+}
+
+// batches splits the accounts into batches of batchSize at most
+func batches(accounts []web3.PublicKey, batchSize int) [][]web3.PublicKey {
+	var ret [][]web3.PublicKey
+	for len(accounts) > 0 {
+		n := min(batchSize, len(accounts))
+		ret = append(ret, accounts[:n])
+		accounts = accounts[n:]
+	}
+	return ret
+}
+
+// GetHarvestWithheldTokensInstructions Get the instructions to harvest the withheld fees of the token accounts to the mint,
+// one instruction of batchSize token accounts at most, to be sent in its own transaction.
+// Harvesting is permissionless, the token accounts failing to harvest are skipped by the program
+// @param batchSize 0 for MaxHarvestAccountsPerTransaction
+func (t tokenKit2022) GetHarvestWithheldTokensInstructions(
+	mint web3.PublicKey,
+	sources []web3.PublicKey, // token accounts
+	batchSize int,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if batchSize <= 0 {
+		batchSize = MaxHarvestAccountsPerTransaction
+	}
+	var instructions []web3.TransactionInstruction
+	for _, batch := range batches(sources, batchSize) {
+		harvest := transfer_fee.NewHarvestWithheldTokensToMintInstruction(mint)
+		for _, source := range batch {
+			harvest.AccountMetaSlice.Append(web3.Meta(source).WRITE())
+		}
+		instructions = append(instructions, Must1(transferFeeInstruction(harvest)))
+	}
+	return instructions, nil
+}
+
+// GetWithdrawWithheldTokensFromAccountsInstructions Get the instructions to withdraw the withheld fees of the token accounts to the treasury,
+// one instruction of batchSize token accounts at most, to be sent in its own transaction
+// @param treasury The token account receiving the fees
+// @param authority The withdraw withheld authority of the mint
+// @param batchSize 0 for MaxWithdrawAccountsPerTransaction
+func (t tokenKit2022) GetWithdrawWithheldTokensFromAccountsInstructions(
+	mint, treasury, authority web3.PublicKey,
+	sources []web3.PublicKey, // token accounts
+	batchSize int,
+	multiSigners ...web3.PublicKey,
+) (_ []web3.TransactionInstruction, err error) {
+	defer Recover(&err)
+
+	if batchSize <= 0 {
+		batchSize = MaxWithdrawAccountsPerTransaction
+	}
+	var instructions []web3.TransactionInstruction
+	for _, batch := range batches(sources, min(batchSize, 0xff)) {
+		withdraw := transfer_fee.NewWithdrawWithheldTokensFromAccountsInstruction(uint8(len(batch)), mint, treasury, authority).
+			SetAuthorityAccount(authority, multiSigners...)
+		for _, source := range batch {
+			withdraw.AccountMetaSlice.Append(web3.Meta(source).WRITE())
+		}
+		instructions = append(instructions, Must1(transferFeeInstruction(withdraw)))
+	}
+	return instructions, nil
+}
+
+// GetWithdrawWithheldTokensFromMintInstructions Get the instructions to withdraw the fees harvested to the mint to the treasury
+// @param treasury The token account receiving the fees
+// @param authority The withdraw withheld authority of the mint
+func (t tokenKit2022) GetWithdrawWithheldTokensFromMintInstructions(
+	mint, treasury, authority web3.PublicKey,
+	multiSigners ...web3.PublicKey,
+) ([]web3.TransactionInstruction, error) {
+	withdraw, err := transferFeeInstruction(transfer_fee.NewWithdrawWithheldTokensFromMintInstruction(mint, treasury, authority).
+		SetAuthorityAccount(authority, multiSigners...))
+	if err != nil {
+		return nil, err
+	}
+	return []web3.TransactionInstruction{withdraw}, nil
+}
+
+// HarvestWithheldTokens scans the token accounts of the mint and harvests their withheld fees to the mint,
+// the signatures of all the transactions are returned
+// @param batchSize 0 for MaxHarvestAccountsPerTransaction
+func (t tokenKit2022) HarvestWithheldTokens(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	mint web3.PublicKey,
+	batchSize int,
+	options web3.ConfirmOptions,
+) (_ []web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	accounts := Must1(t.FindWithheldTokenAccounts(ctx, connection, mint, commitmentOrDefault(options.Commitment)))
+	instructions := Must1(t.GetHarvestWithheldTokensInstructions(mint, Map(accounts, withheldTokenAccountAddress), batchSize))
+	var signatures []web3.TransactionSignature
+	for _, instruction := range instructions {
+		signatures = append(signatures, Must1(sendInstructions(ctx, connection, payer, []web3.Signer{payer}, []web3.TransactionInstruction{instruction}, true, options)))
+	}
+	return signatures, nil
+}
+
+// WithdrawWithheldTokens scans the token accounts of the mint and withdraws their withheld fees to the treasury,
+// then withdraws the fees already harvested to the mint, the signatures of all the transactions are returned
+// @param treasury The token account receiving the fees
+// @param authority The withdraw withheld authority of the mint
+// @param batchSize 0 for MaxWithdrawAccountsPerTransaction
+func (t tokenKit2022) WithdrawWithheldTokens(
+	ctx context.Context,
+	connection *web3.Connection,
+	payer web3.Signer,
+	authority web3.Signer,
+	mint, treasury web3.PublicKey,
+	batchSize int,
+	options web3.ConfirmOptions,
+	multiSigners ...web3.Signer,
+) (_ []web3.TransactionSignature, err error) {
+	defer Recover(&err)
+
+	var signers = append([]web3.Signer{payer, authority}, multiSigners...)
+	var multiSignerKeys = Map(multiSigners, func(i int, t web3.Signer) web3.PublicKey {
+		return t.PublicKey()
+	})
+	accounts := Must1(t.FindWithheldTokenAccounts(ctx, connection, mint, commitmentOrDefault(options.Commitment)))
+	instructions := Must1(t.GetWithdrawWithheldTokensFromAccountsInstructions(mint, treasury, authority.PublicKey(), Map(accounts, withheldTokenAccountAddress), batchSize, multiSignerKeys...))
+	var signatures []web3.TransactionSignature
+	for _, instruction := range instructions {
+		signatures = append(signatures, Must1(sendInstructions(ctx, connection, payer, signers, []web3.TransactionInstruction{instruction}, true, options)))
+	}
+
+	mintInfo := Must1(t.GetMint(ctx, connection, mint, web3.TokenProgram2022ID, web3.GetAccountInfoConfig{Commitment: options.Commitment}))
+	config := Must1(t.ParseTransferFeeConfig(mintInfo.TlvData))
+	if config == nil || config.WithheldAmount == 0 {
+		return signatures, nil
+	}
+	instructions = Must1(t.GetWithdrawWithheldTokensFromMintInstructions(mint, treasury, authority.PublicKey(), multiSignerKeys...))
+	return append(signatures, Must1(sendInstructions(ctx, connection, payer, signers, instructions, true, options))), nil
+}
+
+func withheldTokenAccountAddress(_ int, account WithheldTokenAccount) web3.PublicKey {
+	return account.Address
+}
+
+func commitmentOrDefault(commitment *web3.Commitment) web3.Commitment {
+	if commitment == nil {
+		return web3.CommitmentConfirmed
+	}
+	return *commitment
+}
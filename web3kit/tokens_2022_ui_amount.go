@@ -0,0 +1,49 @@
+package web3kit
+
+import (
+	"github.com/donutnomad/solana-web3/spl_token_2022"
+)
+
+// AmountToUiAmount converts the raw amount to the ui amount string of the mint at unixTimestamp without a simulation,
+// the interest-bearing or the scaled ui amount extension of the mint is applied, the same as the AmountToUiAmount instruction
+func (t tokenKit2022) AmountToUiAmount(mint *MintInfo, amount uint64, unixTimestamp int64) (string, error) {
+	if len(mint.TlvData) > 0 {
+		interest, err := t.ParseInterestBearingConfig(mint.TlvData)
+		if err != nil {
+			return "", err
+		}
+		if interest != nil {
+			return interest.AmountToUiAmount(amount, mint.Decimals, unixTimestamp)
+		}
+		scaled, err := t.ParseScaledUiAmountConfig(mint.TlvData)
+		if err != nil {
+			return "", err
+		}
+		if scaled != nil {
+			return scaled.AmountToUiAmount(amount, mint.Decimals, unixTimestamp), nil
+		}
+	}
+	return spl_token_2022.AmountToUiAmountStringTrimmed(amount, mint.Decimals), nil
+}
+
+// UiAmountToAmount converts the ui amount string of the mint at unixTimestamp to the raw amount without a simulation,
+// the inverse of AmountToUiAmount, the same as the UiAmountToAmount instruction
+func (t tokenKit2022) UiAmountToAmount(mint *MintInfo, uiAmount string, unixTimestamp int64) (uint64, error) {
+	if len(mint.TlvData) > 0 {
+		interest, err := t.ParseInterestBearingConfig(mint.TlvData)
+		if err != nil {
+			return 0, err
+		}
+		if interest != nil {
+			return interest.UiAmountToAmount(uiAmount, mint.Decimals, unixTimestamp)
+		}
+		scaled, err := t.ParseScaledUiAmountConfig(mint.TlvData)
+		if err != nil {
+			return 0, err
+		}
+		if scaled != nil {
+			return scaled.UiAmountToAmount(uiAmount, mint.Decimals, unixTimestamp)
+		}
+	}
+	return spl_token_2022.ParseUiAmount(uiAmount, mint.Decimals)
+}
@@ -0,0 +1,198 @@
+package zk_elgamal_proof
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha3"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+var ErrAeDecryption = errors.New("zk_elgamal_proof: authenticated decryption failed")
+
+// AeKey the AES-128-GCM-SIV key used to encrypt the decryptable balances
+type AeKey [16]byte
+
+// AeCiphertext the 12 bytes nonce followed by the encrypted u64 and the 16 bytes tag
+type AeCiphertext [36]byte
+
+// NewAeKey returns a random key
+func NewAeKey() AeKey {
+	var key AeKey
+	if _, err := rand.Read(key[:]); err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// NewAeKeyFromSeed derives the key from a seed of 16 to 65535 bytes
+func NewAeKeyFromSeed(seed []byte) (AeKey, error) {
+	if len(seed) < 16 || len(seed) > 65535 {
+		return AeKey{}, fmt.Errorf("zk_elgamal_proof: invalid seed length %d", len(seed))
+	}
+	hash := sha3.Sum512(seed)
+	return AeKey(hash[:16]), nil
+}
+
+// NewAeKeyFromSigner derives the key from the signature of "AeKey" || publicSeed,
+// the same derivation as the spl-token cli, where the public seed is the address of the token account
+func NewAeKeyFromSigner(signer web3.Signer, publicSeed []byte) (AeKey, error) {
+	seed, err := seedFromSigner(signer, "AeKey", publicSeed)
+	if err != nil {
+		return AeKey{}, err
+	}
+	return NewAeKeyFromSeed(seed)
+}
+
+// Encrypt encrypts the amount with a random nonce
+func (k AeKey) Encrypt(amount uint64) AeCiphertext {
+	var nonce [12]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		panic(err)
+	}
+	var out AeCiphertext
+	copy(out[:12], nonce[:])
+	copy(out[12:], aesGcmSivSeal(k, nonce, binary.LittleEndian.AppendUint64(nil, amount)))
+	return out
+}
+
+// Decrypt decrypts the amount, fails if the ciphertext was not encrypted with the key
+func (k AeKey) Decrypt(ciphertext AeCiphertext) (uint64, error) {
+	plaintext, err := aesGcmSivOpen(k, [12]byte(ciphertext[:12]), ciphertext[12:])
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(plaintext), nil
+}
+
+// AES-GCM-SIV (RFC 8452) without associated data
+
+func aesGcmSivKeys(key AeKey, nonce [12]byte) (authKey [16]byte, encKey [16]byte) {
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err)
+	}
+	var in, out [16]byte
+	copy(in[4:], nonce[:])
+	for i := uint32(0); i < 4; i++ {
+		binary.LittleEndian.PutUint32(in[:4], i)
+		block.Encrypt(out[:], in[:])
+		if i < 2 {
+			copy(authKey[8*i:], out[:8])
+		} else {
+			copy(encKey[8*(i-2):], out[:8])
+		}
+	}
+	return
+}
+
+func aesGcmSivTag(authKey [16]byte, block cipher.Block, nonce [12]byte, plaintext []byte) [16]byte {
+	var lengths [16]byte
+	binary.LittleEndian.PutUint64(lengths[8:], uint64(len(plaintext))*8)
+	s := polyval(authKey, plaintext, lengths[:])
+	for i := range nonce {
+		s[i] ^= nonce[i]
+	}
+	s[15] &= 0x7f
+	var tag [16]byte
+	block.Encrypt(tag[:], s[:])
+	return tag
+}
+
+func aesGcmSivCtr(block cipher.Block, tag [16]byte, in []byte) []byte {
+	out := make([]byte, len(in))
+	counter := tag
+	counter[15] |= 0x80
+	var keystream [16]byte
+	for offset := 0; offset < len(in); offset += 16 {
+		block.Encrypt(keystream[:], counter[:])
+		subtle.XORBytes(out[offset:], in[offset:], keystream[:])
+		binary.LittleEndian.PutUint32(counter[:4], binary.LittleEndian.Uint32(counter[:4])+1)
+	}
+	return out
+}
+
+func aesGcmSivSeal(key AeKey, nonce [12]byte, plaintext []byte) []byte {
+	authKey, encKey := aesGcmSivKeys(key, nonce)
+	block, err := aes.NewCipher(encKey[:])
+	if err != nil {
+		panic(err)
+	}
+	tag := aesGcmSivTag(authKey, block, nonce, plaintext)
+	return append(aesGcmSivCtr(block, tag, plaintext), tag[:]...)
+}
+
+func aesGcmSivOpen(key AeKey, nonce [12]byte, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < 16 {
+		return nil, ErrAeDecryption
+	}
+	authKey, encKey := aesGcmSivKeys(key, nonce)
+	block, err := aes.NewCipher(encKey[:])
+	if err != nil {
+		panic(err)
+	}
+	tag := [16]byte(ciphertext[len(ciphertext)-16:])
+	plaintext := aesGcmSivCtr(block, tag, ciphertext[:len(ciphertext)-16])
+	expected := aesGcmSivTag(authKey, block, nonce, plaintext)
+	if subtle.ConstantTimeCompare(expected[:], tag[:]) != 1 {
+		return nil, ErrAeDecryption
+	}
+	return plaintext, nil
+}
+
+// polyval computes POLYVAL over the zero padded inputs through GHASH (RFC 8452, appendix A)
+func polyval(key [16]byte, inputs ...[]byte) [16]byte {
+	h := ghashMulX(reverse16(key))
+	var s [16]byte
+	for _, input := range inputs {
+		for offset := 0; offset < len(input); offset += 16 {
+			var block [16]byte
+			copy(block[:], input[offset:])
+			block = reverse16(block)
+			subtle.XORBytes(s[:], s[:], block[:])
+			s = ghashMul(s, h)
+		}
+	}
+	return reverse16(s)
+}
+
+func reverse16(in [16]byte) [16]byte {
+	var out [16]byte
+	for i := range in {
+		out[i] = in[15-i]
+	}
+	return out
+}
+
+func ghashMulX(v [16]byte) [16]byte {
+	hi, lo := binary.BigEndian.Uint64(v[:8]), binary.BigEndian.Uint64(v[8:])
+	mask := -(lo & 1)
+	lo = lo>>1 | hi<<63
+	hi = hi>>1 ^ (0xe1<<56)&mask
+	var out [16]byte
+	binary.BigEndian.PutUint64(out[:8], hi)
+	binary.BigEndian.PutUint64(out[8:], lo)
+	return out
+}
+
+// ghashMul multiplication in GF(2^128) with the GCM bit order (NIST SP 800-38D, algorithm 1)
+func ghashMul(x, y [16]byte) [16]byte {
+	var zHi, zLo uint64
+	vHi, vLo := binary.BigEndian.Uint64(y[:8]), binary.BigEndian.Uint64(y[8:])
+	for i := 0; i < 128; i++ {
+		mask := -uint64(x[i/8] >> (7 - i%8) & 1)
+		zHi ^= vHi & mask
+		zLo ^= vLo & mask
+		reduce := -(vLo & 1)
+		vLo = vLo>>1 | vHi<<63
+		vHi = vHi>>1 ^ (0xe1<<56)&reduce
+	}
+	var out [16]byte
+	binary.BigEndian.PutUint64(out[:8], zHi)
+	binary.BigEndian.PutUint64(out[8:], zLo)
+	return out
+}
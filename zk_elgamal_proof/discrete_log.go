@@ -0,0 +1,34 @@
+package zk_elgamal_proof
+
+import (
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+	"sync"
+)
+
+// baby-step giant-step over [0, 2^32): 2^16 baby steps are precomputed once
+const discreteLogStep = 1 << 16
+
+var discreteLogTable = sync.OnceValue(func() map[[32]byte]uint32 {
+	table := make(map[[32]byte]uint32, discreteLogStep)
+	point := curve.NewRistrettoPoint().Identity()
+	for i := uint32(0); i < discreteLogStep; i++ {
+		table[compress(point)] = i
+		point = curve.NewRistrettoPoint().Add(point, G)
+	}
+	return table
+})
+
+// discreteLog returns x if point = x*G and x < 2^32
+func discreteLog(point *curve.RistrettoPoint) (uint64, bool) {
+	table := discreteLogTable()
+	giantStep := curve.NewRistrettoPoint().Mul(G, scalar.NewFromUint64(discreteLogStep))
+	current := curve.NewRistrettoPoint().Set(point)
+	for i := uint64(0); i < discreteLogStep; i++ {
+		if j, ok := table[compress(current)]; ok {
+			return i*discreteLogStep + uint64(j), true
+		}
+		current = curve.NewRistrettoPoint().Sub(current, giantStep)
+	}
+	return 0, false
+}
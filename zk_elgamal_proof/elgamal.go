@@ -0,0 +1,225 @@
+package zk_elgamal_proof
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+)
+
+var ErrDefaultSignature = errors.New("zk_elgamal_proof: rejecting default signature")
+
+// ElGamalPubkey compressed ElGamal public key: secret^-1 * H, the zero value is the identity
+type ElGamalPubkey [32]byte
+
+// ElGamalSecretKey the ElGamal secret scalar
+type ElGamalSecretKey struct {
+	scalar *scalar.Scalar
+}
+
+// Bytes returns the canonical encoding of the secret key
+func (s *ElGamalSecretKey) Bytes() [32]byte {
+	var out [32]byte
+	copy(out[:], scalarBytes(s.scalar))
+	return out
+}
+
+// ElGamalKeypair the twisted ElGamal keypair used by confidential transfers
+type ElGamalKeypair struct {
+	Pubkey ElGamalPubkey
+	Secret *ElGamalSecretKey
+}
+
+// NewElGamalKeypair returns a random keypair
+func NewElGamalKeypair() *ElGamalKeypair {
+	return newElGamalKeypair(randomScalar())
+}
+
+// NewElGamalKeypairFromSeed derives the keypair from a seed of 32 to 65535 bytes
+func NewElGamalKeypairFromSeed(seed []byte) (*ElGamalKeypair, error) {
+	if len(seed) < 32 || len(seed) > 65535 {
+		return nil, fmt.Errorf("zk_elgamal_proof: invalid seed length %d", len(seed))
+	}
+	hash := sha3.Sum512(seed)
+	s, err := scalar.NewFromBytesModOrderWide(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	return newElGamalKeypair(s), nil
+}
+
+// NewElGamalKeypairFromSigner derives the keypair from the signature of "ElGamalSecretKey" || publicSeed,
+// the same derivation as the spl-token cli, where the public seed is the address of the token account
+func NewElGamalKeypairFromSigner(signer web3.Signer, publicSeed []byte) (*ElGamalKeypair, error) {
+	seed, err := seedFromSigner(signer, "ElGamalSecretKey", publicSeed)
+	if err != nil {
+		return nil, err
+	}
+	return NewElGamalKeypairFromSeed(seed)
+}
+
+// NewElGamalKeypairFromSecretKey restores the keypair from the canonical secret key
+func NewElGamalKeypairFromSecretKey(secret [32]byte) (*ElGamalKeypair, error) {
+	s, err := scalarFromBytes(secret[:])
+	if err != nil {
+		return nil, err
+	}
+	return newElGamalKeypair(s), nil
+}
+
+func newElGamalKeypair(s *scalar.Scalar) *ElGamalKeypair {
+	inv := scalar.New().Invert(s)
+	return &ElGamalKeypair{
+		Pubkey: ElGamalPubkey(compress(curve.NewRistrettoPoint().Mul(H, inv))),
+		Secret: &ElGamalSecretKey{scalar: s},
+	}
+}
+
+func seedFromSigner(signer web3.Signer, domain string, publicSeed []byte) ([]byte, error) {
+	signature, err := signer.Sign(append([]byte(domain), publicSeed...))
+	if err != nil {
+		return nil, err
+	}
+	if signature == [64]byte{} {
+		return nil, ErrDefaultSignature
+	}
+	return signature[:], nil
+}
+
+// Encrypt encrypts the amount under the public key
+func (p ElGamalPubkey) Encrypt(amount uint64) (ElGamalCiphertext, error) {
+	ciphertext, _, err := p.EncryptWithOpening(amount, NewPedersenOpening())
+	return ciphertext, err
+}
+
+// EncryptWithOpening encrypts the amount under the public key with the opening
+func (p ElGamalPubkey) EncryptWithOpening(amount uint64, opening *PedersenOpening) (ElGamalCiphertext, *PedersenOpening, error) {
+	handle, err := p.DecryptHandle(opening)
+	if err != nil {
+		return ElGamalCiphertext{}, nil, err
+	}
+	return NewElGamalCiphertext(NewPedersenCommitmentWithOpening(amount, opening), handle), opening, nil
+}
+
+// DecryptHandle returns opening * pubkey
+func (p ElGamalPubkey) DecryptHandle(opening *PedersenOpening) (DecryptHandle, error) {
+	point, err := decompress(p)
+	if err != nil {
+		return DecryptHandle{}, err
+	}
+	return DecryptHandle(compress(curve.NewRistrettoPoint().Mul(point, opening.scalar))), nil
+}
+
+// String returns the base64 encoding, the format of the spl-token cli
+func (p ElGamalPubkey) String() string {
+	return base64.StdEncoding.EncodeToString(p[:])
+}
+
+// DecryptHandle compressed decrypt handle: opening * pubkey
+type DecryptHandle [32]byte
+
+// ElGamalCiphertext the Pedersen commitment followed by the decrypt handle
+type ElGamalCiphertext [64]byte
+
+func NewElGamalCiphertext(commitment PedersenCommitment, handle DecryptHandle) ElGamalCiphertext {
+	var out ElGamalCiphertext
+	copy(out[:32], commitment[:])
+	copy(out[32:], handle[:])
+	return out
+}
+
+// EncodeElGamalCiphertext the ciphertext of the amount with zero opening, used to add or subtract plain amounts
+func EncodeElGamalCiphertext(amount uint64) ElGamalCiphertext {
+	return NewElGamalCiphertext(PedersenCommitment(compress(curve.NewRistrettoPoint().Mul(G, scalar.NewFromUint64(amount)))), DecryptHandle{})
+}
+
+func (c ElGamalCiphertext) Commitment() PedersenCommitment {
+	return PedersenCommitment(c[:32])
+}
+
+func (c ElGamalCiphertext) Handle() DecryptHandle {
+	return DecryptHandle(c[32:])
+}
+
+func (c ElGamalCiphertext) points() (commitment, handle *curve.RistrettoPoint, err error) {
+	if commitment, err = decompress(c.Commitment()); err != nil {
+		return
+	}
+	handle, err = decompress(c.Handle())
+	return
+}
+
+func (c ElGamalCiphertext) combine(other ElGamalCiphertext, f func(a, b *curve.RistrettoPoint) *curve.RistrettoPoint) (ElGamalCiphertext, error) {
+	c1, h1, err := c.points()
+	if err != nil {
+		return ElGamalCiphertext{}, err
+	}
+	c2, h2, err := other.points()
+	if err != nil {
+		return ElGamalCiphertext{}, err
+	}
+	return NewElGamalCiphertext(PedersenCommitment(compress(f(c1, c2))), DecryptHandle(compress(f(h1, h2)))), nil
+}
+
+// Add returns the ciphertext of the sum of the amounts
+func (c ElGamalCiphertext) Add(other ElGamalCiphertext) (ElGamalCiphertext, error) {
+	return c.combine(other, func(a, b *curve.RistrettoPoint) *curve.RistrettoPoint {
+		return curve.NewRistrettoPoint().Add(a, b)
+	})
+}
+
+// Sub returns the ciphertext of the difference of the amounts
+func (c ElGamalCiphertext) Sub(other ElGamalCiphertext) (ElGamalCiphertext, error) {
+	return c.combine(other, func(a, b *curve.RistrettoPoint) *curve.RistrettoPoint {
+		return curve.NewRistrettoPoint().Sub(a, b)
+	})
+}
+
+// MulU64 returns the ciphertext of the amount multiplied by value
+func (c ElGamalCiphertext) MulU64(value uint64) (ElGamalCiphertext, error) {
+	return c.combine(c, func(a, _ *curve.RistrettoPoint) *curve.RistrettoPoint {
+		return curve.NewRistrettoPoint().Mul(a, scalar.NewFromUint64(value))
+	})
+}
+
+// IsZeroBytes returns true for the all-zero ciphertext, the initial balance of an account
+func (c ElGamalCiphertext) IsZeroBytes() bool {
+	return c == ElGamalCiphertext{}
+}
+
+// decryptPoint returns amount*G
+func (k *ElGamalKeypair) decryptPoint(c ElGamalCiphertext) (*curve.RistrettoPoint, error) {
+	commitment, handle, err := c.points()
+	if err != nil {
+		return nil, err
+	}
+	return curve.NewRistrettoPoint().Sub(commitment, curve.NewRistrettoPoint().Mul(handle, k.Secret.scalar)), nil
+}
+
+// DecryptU32 decrypts a ciphertext of an amount less than 2^32, solving the discrete log.
+func (k *ElGamalKeypair) DecryptU32(c ElGamalCiphertext) (uint64, error) {
+	point, err := k.decryptPoint(c)
+	if err != nil {
+		return 0, err
+	}
+	amount, ok := discreteLog(point)
+	if !ok {
+		return 0, errors.New("zk_elgamal_proof: the ciphertext does not decrypt to a u32 amount")
+	}
+	return amount, nil
+}
+
+// CheckAmount returns true if the ciphertext is an encryption of amount, it works for any amount
+func (k *ElGamalKeypair) CheckAmount(c ElGamalCiphertext, amount uint64) (bool, error) {
+	point, err := k.decryptPoint(c)
+	if err != nil {
+		return false, err
+	}
+	expected := compress(curve.NewRistrettoPoint().Mul(G, scalar.NewFromUint64(amount)))
+	actual := compress(point)
+	return bytes.Equal(expected[:], actual[:]), nil
+}
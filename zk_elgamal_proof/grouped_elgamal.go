@@ -0,0 +1,37 @@
+package zk_elgamal_proof
+
+import (
+	"fmt"
+)
+
+// GroupedElGamalCiphertext3Handles one Pedersen commitment followed by the decrypt handles of three public keys
+type GroupedElGamalCiphertext3Handles [128]byte
+
+// NewGroupedElGamalCiphertext3Handles encrypts the amount under the three public keys with the same opening
+func NewGroupedElGamalCiphertext3Handles(pubkeys [3]ElGamalPubkey, amount uint64, opening *PedersenOpening) (GroupedElGamalCiphertext3Handles, error) {
+	var out GroupedElGamalCiphertext3Handles
+	commitment := NewPedersenCommitmentWithOpening(amount, opening)
+	copy(out[:32], commitment[:])
+	for i, pubkey := range pubkeys {
+		handle, err := pubkey.DecryptHandle(opening)
+		if err != nil {
+			return out, fmt.Errorf("pubkey %d: %w", i, err)
+		}
+		copy(out[32+32*i:], handle[:])
+	}
+	return out, nil
+}
+
+func (c GroupedElGamalCiphertext3Handles) Commitment() PedersenCommitment {
+	return PedersenCommitment(c[:32])
+}
+
+// Handle returns the decrypt handle of the index-th public key
+func (c GroupedElGamalCiphertext3Handles) Handle(index int) DecryptHandle {
+	return DecryptHandle(c[32+32*index : 64+32*index])
+}
+
+// Ciphertext returns the ElGamal ciphertext of the index-th public key
+func (c GroupedElGamalCiphertext3Handles) Ciphertext(index int) ElGamalCiphertext {
+	return NewElGamalCiphertext(c.Commitment(), c.Handle(index))
+}
@@ -0,0 +1,92 @@
+package zk_elgamal_proof
+
+import (
+	"crypto/sha3"
+	"errors"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+)
+
+var ErrInvalidPoint = errors.New("zk_elgamal_proof: invalid ristretto point")
+
+var (
+	// G the ristretto basepoint, the base of the committed message
+	G = curve.RISTRETTO_BASEPOINT_POINT
+	// H the base of the Pedersen opening, hash of the compressed basepoint
+	H = func() *curve.RistrettoPoint {
+		hash := sha3.Sum512(curve.RISTRETTO_BASEPOINT_COMPRESSED[:])
+		point, err := curve.NewRistrettoPoint().SetUniformBytes(hash[:])
+		if err != nil {
+			panic(err)
+		}
+		return point
+	}()
+)
+
+// PedersenOpening the random opening (blinding factor) of a Pedersen commitment
+type PedersenOpening struct {
+	scalar *scalar.Scalar
+}
+
+// NewPedersenOpening returns a random opening
+func NewPedersenOpening() *PedersenOpening {
+	return &PedersenOpening{scalar: randomScalar()}
+}
+
+// Bytes returns the canonical encoding of the opening
+func (o *PedersenOpening) Bytes() [32]byte {
+	var out [32]byte
+	copy(out[:], scalarBytes(o.scalar))
+	return out
+}
+
+// PedersenCommitment compressed Pedersen commitment: amount*G + opening*H
+type PedersenCommitment [32]byte
+
+// NewPedersenCommitment commits to the amount with a random opening
+func NewPedersenCommitment(amount uint64) (PedersenCommitment, *PedersenOpening) {
+	opening := NewPedersenOpening()
+	return NewPedersenCommitmentWithOpening(amount, opening), opening
+}
+
+// NewPedersenCommitmentWithOpening commits to the amount with the opening
+func NewPedersenCommitmentWithOpening(amount uint64, opening *PedersenOpening) PedersenCommitment {
+	return PedersenCommitment(compress(pedersenCommit(scalar.NewFromUint64(amount), opening.scalar)))
+}
+
+func pedersenCommit(amount, opening *scalar.Scalar) *curve.RistrettoPoint {
+	return curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{amount, opening}, []*curve.RistrettoPoint{G, H})
+}
+
+func randomScalar() *scalar.Scalar {
+	s, err := scalar.New().SetRandom(nil)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func scalarBytes(s *scalar.Scalar) []byte {
+	out := make([]byte, scalar.ScalarSize)
+	if err := s.ToBytes(out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func scalarFromBytes(data []byte) (*scalar.Scalar, error) {
+	return scalar.NewFromCanonicalBytes(data)
+}
+
+func compress(p *curve.RistrettoPoint) [32]byte {
+	return *curve.NewCompressedRistretto().SetRistrettoPoint(p)
+}
+
+func decompress(data [32]byte) (*curve.RistrettoPoint, error) {
+	compressed := curve.CompressedRistretto(data)
+	point, err := curve.NewRistrettoPoint().SetCompressed(&compressed)
+	if err != nil {
+		return nil, ErrInvalidPoint
+	}
+	return point, nil
+}
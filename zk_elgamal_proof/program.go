@@ -0,0 +1,156 @@
+package zk_elgamal_proof
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/donutnomad/solana-web3/web3"
+)
+
+// ProgramID the native ZK ElGamal proof program
+var ProgramID = web3.MustPublicKey("ZkE1Gama1Proof11111111111111111111111111111")
+
+// ProofType the type of the proof, stored in the context state account
+type ProofType uint8
+
+const (
+	ProofTypeUninitialized ProofType = iota
+	ProofTypeZeroCiphertext
+	ProofTypeCiphertextCiphertextEquality
+	ProofTypeCiphertextCommitmentEquality
+	ProofTypePubkeyValidity
+	ProofTypePercentageWithCap
+	ProofTypeBatchedRangeProofU64
+	ProofTypeBatchedRangeProofU128
+	ProofTypeBatchedRangeProofU256
+	ProofTypeGroupedCiphertext2HandlesValidity
+	ProofTypeBatchedGroupedCiphertext2HandlesValidity
+	ProofTypeGroupedCiphertext3HandlesValidity
+	ProofTypeBatchedGroupedCiphertext3HandlesValidity
+)
+
+// Instruction discriminators of the ZK ElGamal proof program
+const (
+	InstructionCloseContextState uint8 = iota
+	InstructionVerifyZeroCiphertext
+	InstructionVerifyCiphertextCiphertextEquality
+	InstructionVerifyCiphertextCommitmentEquality
+	InstructionVerifyPubkeyValidity
+	InstructionVerifyPercentageWithCap
+	InstructionVerifyBatchedRangeProofU64
+	InstructionVerifyBatchedRangeProofU128
+	InstructionVerifyBatchedRangeProofU256
+	InstructionVerifyGroupedCiphertext2HandlesValidity
+	InstructionVerifyBatchedGroupedCiphertext2HandlesValidity
+	InstructionVerifyGroupedCiphertext3HandlesValidity
+	InstructionVerifyBatchedGroupedCiphertext3HandlesValidity
+)
+
+// ContextStateAccountMetadataSize authority (32) + proof type (1)
+const ContextStateAccountMetadataSize = 33
+
+// ContextSize returns the size of the public context of the proof type
+func (p ProofType) ContextSize() int {
+	switch p {
+	case ProofTypeZeroCiphertext:
+		return 96
+	case ProofTypeCiphertextCiphertextEquality:
+		return 192
+	case ProofTypeCiphertextCommitmentEquality:
+		return 128
+	case ProofTypePubkeyValidity:
+		return 32
+	case ProofTypePercentageWithCap:
+		return 136
+	case ProofTypeBatchedRangeProofU64, ProofTypeBatchedRangeProofU128, ProofTypeBatchedRangeProofU256:
+		return 264
+	case ProofTypeGroupedCiphertext2HandlesValidity:
+		return 160
+	case ProofTypeBatchedGroupedCiphertext2HandlesValidity:
+		return 256
+	case ProofTypeGroupedCiphertext3HandlesValidity:
+		return 224
+	case ProofTypeBatchedGroupedCiphertext3HandlesValidity:
+		return 352
+	default:
+		return 0
+	}
+}
+
+// ContextStateAccountSize returns the space of the context state account of the proof type
+func (p ProofType) ContextStateAccountSize() int {
+	return ContextStateAccountMetadataSize + p.ContextSize()
+}
+
+// ContextStateInfo the context state account where the verified context is stored, and its authority
+type ContextStateInfo struct {
+	ContextStateAccount   web3.PublicKey
+	ContextStateAuthority web3.PublicKey
+}
+
+func (info *ContextStateInfo) keys(keys []web3.AccountMeta) []web3.AccountMeta {
+	if info == nil {
+		return keys
+	}
+	return append(keys,
+		web3.AccountMeta{Pubkey: info.ContextStateAccount, IsWritable: true},
+		web3.AccountMeta{Pubkey: info.ContextStateAuthority},
+	)
+}
+
+// VerifyProofInstruction verifies the proof in the instruction data,
+// the context is stored in the context state account if contextState is not nil.
+// The instruction discriminator is the proof type
+func VerifyProofInstruction(proof ProofData, contextState *ContextStateInfo) web3.TransactionInstruction {
+	return web3.TransactionInstruction{
+		Keys:      contextState.keys(nil),
+		ProgramId: ProgramID,
+		Data:      append([]byte{uint8(proof.ProofType())}, proof.Bytes()...),
+	}
+}
+
+// VerifyProofFromAccountInstruction verifies the proof stored at the offset of the proof account (e.g. a record account)
+func VerifyProofFromAccountInstruction(proofType ProofType, proofAccount web3.PublicKey, offset uint32, contextState *ContextStateInfo) web3.TransactionInstruction {
+	return web3.TransactionInstruction{
+		Keys:      contextState.keys([]web3.AccountMeta{{Pubkey: proofAccount}}),
+		ProgramId: ProgramID,
+		Data:      binary.LittleEndian.AppendUint32([]byte{uint8(proofType)}, offset),
+	}
+}
+
+// CloseContextStateInstruction closes the context state account, the lamports are sent to destination
+func CloseContextStateInstruction(contextState ContextStateInfo, destination web3.PublicKey) web3.TransactionInstruction {
+	return web3.TransactionInstruction{
+		Keys: []web3.AccountMeta{
+			{Pubkey: contextState.ContextStateAccount, IsWritable: true},
+			{Pubkey: destination, IsWritable: true},
+			{Pubkey: contextState.ContextStateAuthority, IsSigner: true},
+		},
+		ProgramId: ProgramID,
+		Data:      []byte{InstructionCloseContextState},
+	}
+}
+
+// ContextStateAccount the state of a context state account
+type ContextStateAccount struct {
+	// the authority that can close the account
+	Authority web3.PublicKey
+	ProofType ProofType
+	// the verified public context
+	Context []byte
+}
+
+// ContextStateAccountFromAccountData Deserialize ContextStateAccount from the account data.
+func ContextStateAccountFromAccountData(data []byte) (*ContextStateAccount, error) {
+	if len(data) < ContextStateAccountMetadataSize {
+		return nil, fmt.Errorf("invalid context state account: %d bytes", len(data))
+	}
+	state := &ContextStateAccount{
+		Authority: web3.NewPublicKeyFromBs(data[:32]),
+		ProofType: ProofType(data[32]),
+		Context:   data[ContextStateAccountMetadataSize:],
+	}
+	if size := state.ProofType.ContextSize(); size == 0 || len(state.Context) != size {
+		return nil, fmt.Errorf("invalid context state account: proof type %d with %d bytes of context", state.ProofType, len(state.Context))
+	}
+	return state, nil
+}
@@ -0,0 +1,287 @@
+package zk_elgamal_proof
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ProofData the context and the proof of a verify instruction
+type ProofData interface {
+	ProofType() ProofType
+	// ContextBytes the public context, stored in the context state account
+	ContextBytes() []byte
+	// Bytes the context followed by the proof, the instruction data after the discriminator
+	Bytes() []byte
+	// Verify verifies the proof locally
+	Verify() error
+}
+
+// PubkeyValidityProofData proves that the owner knows the secret key of the public key
+type PubkeyValidityProofData struct {
+	Pubkey ElGamalPubkey
+	Proof  PubkeyValidityProof
+}
+
+func NewPubkeyValidityProofData(keypair *ElGamalKeypair) *PubkeyValidityProofData {
+	data := &PubkeyValidityProofData{Pubkey: keypair.Pubkey}
+	data.Proof = newPubkeyValidityProof(keypair, data.transcript())
+	return data
+}
+
+func (d *PubkeyValidityProofData) transcript() *transcript {
+	t := newTranscript("pubkey-validity-instruction")
+	t.AppendMessage("pubkey", d.Pubkey[:])
+	return t
+}
+
+func (d *PubkeyValidityProofData) ProofType() ProofType {
+	return ProofTypePubkeyValidity
+}
+
+func (d *PubkeyValidityProofData) ContextBytes() []byte {
+	return d.Pubkey[:]
+}
+
+func (d *PubkeyValidityProofData) Bytes() []byte {
+	return append(d.ContextBytes(), d.Proof[:]...)
+}
+
+func (d *PubkeyValidityProofData) Verify() error {
+	return d.Proof.verify(d.Pubkey, d.transcript())
+}
+
+// ZeroCiphertextProofData proves that the ciphertext encrypts zero
+type ZeroCiphertextProofData struct {
+	Pubkey     ElGamalPubkey
+	Ciphertext ElGamalCiphertext
+	Proof      ZeroCiphertextProof
+}
+
+func NewZeroCiphertextProofData(keypair *ElGamalKeypair, ciphertext ElGamalCiphertext) (*ZeroCiphertextProofData, error) {
+	data := &ZeroCiphertextProofData{Pubkey: keypair.Pubkey, Ciphertext: ciphertext}
+	proof, err := newZeroCiphertextProof(keypair, ciphertext, data.transcript())
+	if err != nil {
+		return nil, err
+	}
+	data.Proof = proof
+	return data, nil
+}
+
+func (d *ZeroCiphertextProofData) transcript() *transcript {
+	t := newTranscript("zero-ciphertext-instruction")
+	t.AppendMessage("pubkey", d.Pubkey[:])
+	t.AppendMessage("ciphertext", d.Ciphertext[:])
+	return t
+}
+
+func (d *ZeroCiphertextProofData) ProofType() ProofType {
+	return ProofTypeZeroCiphertext
+}
+
+func (d *ZeroCiphertextProofData) ContextBytes() []byte {
+	return append(append([]byte{}, d.Pubkey[:]...), d.Ciphertext[:]...)
+}
+
+func (d *ZeroCiphertextProofData) Bytes() []byte {
+	return append(d.ContextBytes(), d.Proof[:]...)
+}
+
+func (d *ZeroCiphertextProofData) Verify() error {
+	return d.Proof.verify(d.Pubkey, d.Ciphertext, d.transcript())
+}
+
+// CiphertextCommitmentEqualityProofData proves that the ciphertext and the commitment hold the same amount
+type CiphertextCommitmentEqualityProofData struct {
+	Pubkey     ElGamalPubkey
+	Ciphertext ElGamalCiphertext
+	Commitment PedersenCommitment
+	Proof      CiphertextCommitmentEqualityProof
+}
+
+func NewCiphertextCommitmentEqualityProofData(
+	keypair *ElGamalKeypair,
+	ciphertext ElGamalCiphertext,
+	commitment PedersenCommitment,
+	opening *PedersenOpening,
+	amount uint64,
+) (*CiphertextCommitmentEqualityProofData, error) {
+	data := &CiphertextCommitmentEqualityProofData{Pubkey: keypair.Pubkey, Ciphertext: ciphertext, Commitment: commitment}
+	proof, err := newCiphertextCommitmentEqualityProof(keypair, ciphertext, opening, amount, data.transcript())
+	if err != nil {
+		return nil, err
+	}
+	data.Proof = proof
+	return data, nil
+}
+
+func (d *CiphertextCommitmentEqualityProofData) transcript() *transcript {
+	t := newTranscript("ciphertext-commitment-equality-instruction")
+	t.AppendMessage("pubkey", d.Pubkey[:])
+	t.AppendMessage("ciphertext", d.Ciphertext[:])
+	t.AppendMessage("commitment", d.Commitment[:])
+	return t
+}
+
+func (d *CiphertextCommitmentEqualityProofData) ProofType() ProofType {
+	return ProofTypeCiphertextCommitmentEquality
+}
+
+func (d *CiphertextCommitmentEqualityProofData) ContextBytes() []byte {
+	return append(append(append([]byte{}, d.Pubkey[:]...), d.Ciphertext[:]...), d.Commitment[:]...)
+}
+
+func (d *CiphertextCommitmentEqualityProofData) Bytes() []byte {
+	return append(d.ContextBytes(), d.Proof[:]...)
+}
+
+func (d *CiphertextCommitmentEqualityProofData) Verify() error {
+	return d.Proof.verify(d.Pubkey, d.Ciphertext, d.Commitment, d.transcript())
+}
+
+// BatchedGroupedCiphertext3HandlesValidityProofData proves that the lo and hi grouped ciphertexts are well-formed
+type BatchedGroupedCiphertext3HandlesValidityProofData struct {
+	FirstPubkey         ElGamalPubkey
+	SecondPubkey        ElGamalPubkey
+	ThirdPubkey         ElGamalPubkey
+	GroupedCiphertextLo GroupedElGamalCiphertext3Handles
+	GroupedCiphertextHi GroupedElGamalCiphertext3Handles
+	Proof               GroupedCiphertext3HandlesValidityProof
+}
+
+func NewBatchedGroupedCiphertext3HandlesValidityProofData(
+	pubkeys [3]ElGamalPubkey,
+	groupedCiphertextLo, groupedCiphertextHi GroupedElGamalCiphertext3Handles,
+	amountLo, amountHi uint64,
+	openingLo, openingHi *PedersenOpening,
+) (*BatchedGroupedCiphertext3HandlesValidityProofData, error) {
+	data := &BatchedGroupedCiphertext3HandlesValidityProofData{
+		FirstPubkey:         pubkeys[0],
+		SecondPubkey:        pubkeys[1],
+		ThirdPubkey:         pubkeys[2],
+		GroupedCiphertextLo: groupedCiphertextLo,
+		GroupedCiphertextHi: groupedCiphertextHi,
+	}
+	proof, err := newBatchedGroupedCiphertext3HandlesValidityProof(pubkeys, amountLo, amountHi, openingLo, openingHi, data.transcript())
+	if err != nil {
+		return nil, err
+	}
+	data.Proof = proof
+	return data, nil
+}
+
+func (d *BatchedGroupedCiphertext3HandlesValidityProofData) transcript() *transcript {
+	t := newTranscript("batched-grouped-ciphertext-validity-3-handles-instruction")
+	t.AppendMessage("first-pubkey", d.FirstPubkey[:])
+	t.AppendMessage("second-pubkey", d.SecondPubkey[:])
+	t.AppendMessage("third-pubkey", d.ThirdPubkey[:])
+	t.AppendMessage("grouped-ciphertext-lo", d.GroupedCiphertextLo[:])
+	t.AppendMessage("grouped-ciphertext-hi", d.GroupedCiphertextHi[:])
+	return t
+}
+
+func (d *BatchedGroupedCiphertext3HandlesValidityProofData) ProofType() ProofType {
+	return ProofTypeBatchedGroupedCiphertext3HandlesValidity
+}
+
+func (d *BatchedGroupedCiphertext3HandlesValidityProofData) ContextBytes() []byte {
+	out := make([]byte, 0, 352)
+	out = append(out, d.FirstPubkey[:]...)
+	out = append(out, d.SecondPubkey[:]...)
+	out = append(out, d.ThirdPubkey[:]...)
+	out = append(out, d.GroupedCiphertextLo[:]...)
+	return append(out, d.GroupedCiphertextHi[:]...)
+}
+
+func (d *BatchedGroupedCiphertext3HandlesValidityProofData) Bytes() []byte {
+	return append(d.ContextBytes(), d.Proof[:]...)
+}
+
+func (d *BatchedGroupedCiphertext3HandlesValidityProofData) Verify() error {
+	return d.Proof.verifyBatched([3]ElGamalPubkey{d.FirstPubkey, d.SecondPubkey, d.ThirdPubkey}, d.GroupedCiphertextLo, d.GroupedCiphertextHi, d.transcript())
+}
+
+// maxRangeProofCommitments the number of commitments of the batched range proof context
+const maxRangeProofCommitments = 8
+
+// BatchedRangeProofData proves that each committed amount is in the range of its bit length,
+// the sum of the bit lengths is 64, 128 or 256
+type BatchedRangeProofData struct {
+	Commitments [maxRangeProofCommitments]PedersenCommitment
+	BitLengths  [maxRangeProofCommitments]uint8
+	Proof       RangeProof
+}
+
+func NewBatchedRangeProofData(commitments []PedersenCommitment, amounts []uint64, bitLengths []int, openings []*PedersenOpening) (*BatchedRangeProofData, error) {
+	if len(commitments) > maxRangeProofCommitments || len(commitments) != len(bitLengths) {
+		return nil, errors.New("zk_elgamal_proof: invalid number of range proof commitments")
+	}
+	data := &BatchedRangeProofData{}
+	for i := range commitments {
+		data.Commitments[i] = commitments[i]
+		data.BitLengths[i] = uint8(bitLengths[i])
+	}
+	proof, err := newRangeProof(amounts, bitLengths, openings, data.transcript())
+	if err != nil {
+		return nil, err
+	}
+	data.Proof = proof
+	return data, nil
+}
+
+func (d *BatchedRangeProofData) transcript() *transcript {
+	t := newTranscript("batched-range-proof-instruction")
+	t.AppendMessage("commitments", d.commitmentBytes())
+	t.AppendMessage("bit-lengths", d.BitLengths[:])
+	return t
+}
+
+func (d *BatchedRangeProofData) commitmentBytes() []byte {
+	out := make([]byte, 0, 32*maxRangeProofCommitments)
+	for _, commitment := range d.Commitments {
+		out = append(out, commitment[:]...)
+	}
+	return out
+}
+
+func (d *BatchedRangeProofData) bitLength() int {
+	total := 0
+	for _, n := range d.BitLengths {
+		total += int(n)
+	}
+	return total
+}
+
+func (d *BatchedRangeProofData) ProofType() ProofType {
+	switch d.bitLength() {
+	case 64:
+		return ProofTypeBatchedRangeProofU64
+	case 128:
+		return ProofTypeBatchedRangeProofU128
+	default:
+		return ProofTypeBatchedRangeProofU256
+	}
+}
+
+func (d *BatchedRangeProofData) ContextBytes() []byte {
+	return append(d.commitmentBytes(), d.BitLengths[:]...)
+}
+
+func (d *BatchedRangeProofData) Bytes() []byte {
+	return append(d.ContextBytes(), d.Proof...)
+}
+
+func (d *BatchedRangeProofData) Verify() error {
+	var commitments []PedersenCommitment
+	var bitLengths []int
+	for i, n := range d.BitLengths {
+		if n == 0 {
+			break
+		}
+		commitments = append(commitments, d.Commitments[i])
+		bitLengths = append(bitLengths, int(n))
+	}
+	if total := d.bitLength(); total != 64 && total != 128 && total != 256 {
+		return fmt.Errorf("zk_elgamal_proof: invalid aggregated bit length %d", total)
+	}
+	return d.Proof.verify(commitments, bitLengths, d.transcript())
+}
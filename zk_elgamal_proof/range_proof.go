@@ -0,0 +1,414 @@
+package zk_elgamal_proof
+
+import (
+	"crypto/sha3"
+	"errors"
+	"fmt"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+	"math/bits"
+	"sync"
+)
+
+// maxRangeProofBits the largest aggregated bit length, BatchedRangeProofU256
+const maxRangeProofBits = 256
+
+// the bulletproof generators: SHAKE256("GeneratorsChain" || label) read 64 bytes at a time
+var rangeProofGenerators = sync.OnceValue(func() (gens [2][]*curve.RistrettoPoint) {
+	for i, label := range []string{"G", "H"} {
+		shake := sha3.NewSHAKE256()
+		_, _ = shake.Write([]byte("GeneratorsChain"))
+		_, _ = shake.Write([]byte(label))
+		var uniform [64]byte
+		for j := 0; j < maxRangeProofBits; j++ {
+			_, _ = shake.Read(uniform[:])
+			point, err := curve.NewRistrettoPoint().SetUniformBytes(uniform[:])
+			if err != nil {
+				panic(err)
+			}
+			gens[i] = append(gens[i], point)
+		}
+	}
+	return gens
+})
+
+// RangeProof an aggregated bulletproof:
+// A || S || T_1 || T_2 || t_x || t_x_blinding || e_blinding || (L_i || R_i)... || a || b
+type RangeProof []byte
+
+// RangeProofSize returns the size of the proof for the sum of the bit lengths
+func RangeProofSize(bitLength int) int {
+	return 7*32 + 2*32*bits.TrailingZeros(uint(bitLength)) + 2*32
+}
+
+func newRangeProof(amounts []uint64, bitLengths []int, openings []*PedersenOpening, t *transcript) (RangeProof, error) {
+	if len(amounts) != len(bitLengths) || len(amounts) != len(openings) {
+		return nil, errors.New("zk_elgamal_proof: amounts, bit lengths and openings have different lengths")
+	}
+	nm := 0
+	for i, n := range bitLengths {
+		if n < 0 || n > 64 || (n < 64 && amounts[i]>>n != 0) {
+			return nil, fmt.Errorf("zk_elgamal_proof: amount %d does not fit in %d bits", amounts[i], n)
+		}
+		nm += n
+	}
+	if nm == 0 || nm > maxRangeProofBits || nm&(nm-1) != 0 {
+		return nil, fmt.Errorf("zk_elgamal_proof: invalid aggregated bit length %d", nm)
+	}
+	gens := rangeProofGenerators()
+	Gs, Hs := gens[0][:nm], gens[1][:nm]
+	t.appendDomainSeparator("range-proof")
+	t.appendU64("n", uint64(nm))
+
+	// A = a_blinding*H + sum(bit ? G_i : -H_i)
+	aBlinding := randomScalar()
+	A := curve.NewRistrettoPoint().Mul(H, aBlinding)
+	bitsOf := make([]bool, 0, nm)
+	for i, n := range bitLengths {
+		for j := 0; j < n; j++ {
+			bit := amounts[i]>>j&1 == 1
+			bitsOf = append(bitsOf, bit)
+			k := len(bitsOf) - 1
+			if bit {
+				A.Add(A, Gs[k])
+			} else {
+				A.Sub(A, Hs[k])
+			}
+		}
+	}
+	// S = s_blinding*H + <s_L, G> + <s_R, H>
+	sL, sR := make([]*scalar.Scalar, nm), make([]*scalar.Scalar, nm)
+	for i := range sL {
+		sL[i], sR[i] = randomScalar(), randomScalar()
+	}
+	sBlinding := randomScalar()
+	S := curve.NewRistrettoPoint().MultiscalarMul(
+		append(append([]*scalar.Scalar{sBlinding}, sL...), sR...),
+		append(append([]*curve.RistrettoPoint{H}, Gs...), Hs...),
+	)
+	compressedA, compressedS := compress(A), compress(S)
+	t.appendPoint("A", (*curve.CompressedRistretto)(&compressedA))
+	t.appendPoint("S", (*curve.CompressedRistretto)(&compressedS))
+	y := t.challengeScalar("y")
+	z := t.challengeScalar("z")
+
+	// l(x) = l0 + l1*x, r(x) = r0 + r1*x
+	one := scalar.One()
+	l0, l1, r0, r1 := make([]*scalar.Scalar, nm), make([]*scalar.Scalar, nm), make([]*scalar.Scalar, nm), make([]*scalar.Scalar, nm)
+	expZ := scalar.New().Mul(z, z)
+	expY := scalar.One()
+	k := 0
+	for _, n := range bitLengths {
+		exp2 := scalar.One()
+		for j := 0; j < n; j++ {
+			aL := scalar.New()
+			if bitsOf[k] {
+				aL.Set(one)
+			}
+			aR := scalar.New().Sub(aL, one)
+			l0[k] = scalar.New().Sub(aL, z)
+			l1[k] = sL[k]
+			r0[k] = scalar.New().Add(scalar.New().Mul(expY, scalar.New().Add(aR, z)), scalar.New().Mul(expZ, exp2))
+			r1[k] = scalar.New().Mul(expY, sR[k])
+			expY = scalar.New().Mul(expY, y)
+			exp2 = scalar.New().Add(exp2, exp2)
+			k++
+		}
+		expZ = scalar.New().Mul(expZ, z)
+	}
+	// t(x) = <l(x), r(x)> = t0 + t1*x + t2*x^2
+	t1 := scalar.New().Add(innerProduct(l0, r1), innerProduct(l1, r0))
+	t2 := innerProduct(l1, r1)
+	t0 := innerProduct(l0, r0)
+	t1Blinding, t2Blinding := randomScalar(), randomScalar()
+	T1 := compress(pedersenCommit(t1, t1Blinding))
+	T2 := compress(pedersenCommit(t2, t2Blinding))
+	t.appendPoint("T_1", (*curve.CompressedRistretto)(&T1))
+	t.appendPoint("T_2", (*curve.CompressedRistretto)(&T2))
+	x := t.challengeScalar("x")
+
+	aggOpening := scalar.New()
+	expZ = scalar.New().Set(z)
+	for _, opening := range openings {
+		expZ = scalar.New().Mul(expZ, z)
+		aggOpening.Add(aggOpening, scalar.New().Mul(expZ, opening.scalar))
+	}
+	xx := scalar.New().Mul(x, x)
+	tX := scalar.New().Add(t0, scalar.New().Add(scalar.New().Mul(t1, x), scalar.New().Mul(t2, xx)))
+	tXBlinding := scalar.New().Add(aggOpening, scalar.New().Add(scalar.New().Mul(t1Blinding, x), scalar.New().Mul(t2Blinding, xx)))
+	t.appendScalar("t_x", tX)
+	t.appendScalar("t_x_blinding", tXBlinding)
+	eBlinding := scalar.New().Add(aBlinding, scalar.New().Mul(sBlinding, x))
+	t.appendScalar("e_blinding", eBlinding)
+
+	lVec, rVec := make([]*scalar.Scalar, nm), make([]*scalar.Scalar, nm)
+	for i := range lVec {
+		lVec[i] = scalar.New().Add(l0[i], scalar.New().Mul(l1[i], x))
+		rVec[i] = scalar.New().Add(r0[i], scalar.New().Mul(r1[i], x))
+	}
+	w := t.challengeScalar("w")
+	Q := curve.NewRistrettoPoint().Mul(G, w)
+	// the challenge c is used by the verifier to batch the checks
+	t.challengeScalar("c")
+	hFactors := expIter(scalar.New().Invert(y), nm)
+
+	out := make(RangeProof, 0, RangeProofSize(nm))
+	out = append(out, compressedA[:]...)
+	out = append(out, compressedS[:]...)
+	out = append(out, T1[:]...)
+	out = append(out, T2[:]...)
+	out = append(out, scalarBytes(tX)...)
+	out = append(out, scalarBytes(tXBlinding)...)
+	out = append(out, scalarBytes(eBlinding)...)
+	return append(out, newInnerProductProof(Q, hFactors, Gs, Hs, lVec, rVec, t)...), nil
+}
+
+// newInnerProductProof the inner product argument with G factors of 1
+func newInnerProductProof(Q *curve.RistrettoPoint, hFactors []*scalar.Scalar, Gs, Hs []*curve.RistrettoPoint, a, b []*scalar.Scalar, t *transcript) []byte {
+	n := len(Gs)
+	G, H := append([]*curve.RistrettoPoint(nil), Gs...), append([]*curve.RistrettoPoint(nil), Hs...)
+	a, b = append([]*scalar.Scalar(nil), a...), append([]*scalar.Scalar(nil), b...)
+	t.appendDomainSeparator("inner-product")
+	t.appendU64("n", uint64(n))
+
+	var out []byte
+	first := true
+	for n != 1 {
+		n /= 2
+		aL, aR, bL, bR := a[:n], a[n:], b[:n], b[n:]
+		GL, GR, HL, HR := G[:n], G[n:], H[:n], H[n:]
+		cL, cR := innerProduct(aL, bR), innerProduct(aR, bL)
+
+		var L, R *curve.RistrettoPoint
+		if first {
+			// H is scaled by the factors in the first round
+			lScalars := append(append([]*scalar.Scalar{}, aL...), cL)
+			rScalars := append(append([]*scalar.Scalar{}, aR...), cR)
+			for i := 0; i < n; i++ {
+				lScalars = append(lScalars, scalar.New().Mul(bR[i], hFactors[i]))
+				rScalars = append(rScalars, scalar.New().Mul(bL[i], hFactors[n+i]))
+			}
+			L = curve.NewRistrettoPoint().MultiscalarMul(lScalars, append(append(append([]*curve.RistrettoPoint{}, GR...), Q), HL...))
+			R = curve.NewRistrettoPoint().MultiscalarMul(rScalars, append(append(append([]*curve.RistrettoPoint{}, GL...), Q), HR...))
+		} else {
+			L = curve.NewRistrettoPoint().MultiscalarMul(append(append(append([]*scalar.Scalar{}, aL...), bR...), cL), append(append(append([]*curve.RistrettoPoint{}, GR...), HL...), Q))
+			R = curve.NewRistrettoPoint().MultiscalarMul(append(append(append([]*scalar.Scalar{}, aR...), bL...), cR), append(append(append([]*curve.RistrettoPoint{}, GL...), HR...), Q))
+		}
+		compressedL, compressedR := compress(L), compress(R)
+		out = append(append(out, compressedL[:]...), compressedR[:]...)
+		t.appendPoint("L", (*curve.CompressedRistretto)(&compressedL))
+		t.appendPoint("R", (*curve.CompressedRistretto)(&compressedR))
+		u := t.challengeScalar("u")
+		uInv := scalar.New().Invert(u)
+		for i := 0; i < n; i++ {
+			aL[i] = scalar.New().Add(scalar.New().Mul(aL[i], u), scalar.New().Mul(uInv, aR[i]))
+			bL[i] = scalar.New().Add(scalar.New().Mul(bL[i], uInv), scalar.New().Mul(u, bR[i]))
+			GL[i] = curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{uInv, u}, []*curve.RistrettoPoint{GL[i], GR[i]})
+			hL, hR := u, uInv
+			if first {
+				hL, hR = scalar.New().Mul(u, hFactors[i]), scalar.New().Mul(uInv, hFactors[n+i])
+			}
+			HL[i] = curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{hL, hR}, []*curve.RistrettoPoint{HL[i], HR[i]})
+		}
+		a, b, G, H = aL, bL, GL, HL
+		first = false
+	}
+	out = append(out, scalarBytes(a[0])...)
+	return append(out, scalarBytes(b[0])...)
+}
+
+func (proof RangeProof) verify(commitments []PedersenCommitment, bitLengths []int, t *transcript) error {
+	nm := 0
+	for _, n := range bitLengths {
+		nm += n
+	}
+	if nm == 0 || nm > maxRangeProofBits || nm&(nm-1) != 0 || len(commitments) != len(bitLengths) {
+		return fmt.Errorf("zk_elgamal_proof: invalid aggregated bit length %d", nm)
+	}
+	if len(proof) != RangeProofSize(nm) {
+		return fmt.Errorf("zk_elgamal_proof: invalid range proof size %d", len(proof))
+	}
+	gens := rangeProofGenerators()
+	Gs, Hs := gens[0][:nm], gens[1][:nm]
+	t.appendDomainSeparator("range-proof")
+	t.appendU64("n", uint64(nm))
+
+	point := func(i int) curve.CompressedRistretto {
+		return curve.CompressedRistretto(proof[32*i : 32*i+32])
+	}
+	A, S, T1, T2 := point(0), point(1), point(2), point(3)
+	for _, item := range []struct {
+		label string
+		point curve.CompressedRistretto
+	}{{"A", A}, {"S", S}} {
+		if err := t.validateAndAppendPoint(item.label, &item.point); err != nil {
+			return err
+		}
+	}
+	y := t.challengeScalar("y")
+	z := t.challengeScalar("z")
+	if err := t.validateAndAppendPoint("T_1", &T1); err != nil {
+		return err
+	}
+	if err := t.validateAndAppendPoint("T_2", &T2); err != nil {
+		return err
+	}
+	x := t.challengeScalar("x")
+	var proofScalars [3]*scalar.Scalar
+	for i, label := range []string{"t_x", "t_x_blinding", "e_blinding"} {
+		s, err := scalarFromBytes(proof[32*(4+i) : 32*(5+i)])
+		if err != nil {
+			return err
+		}
+		proofScalars[i] = s
+		t.appendScalar(label, s)
+	}
+	tX, tXBlinding, eBlinding := proofScalars[0], proofScalars[1], proofScalars[2]
+	w := t.challengeScalar("w")
+	c := t.challengeScalar("c")
+
+	// inner product verification scalars
+	ipp := proof[7*32:]
+	lgN := bits.TrailingZeros(uint(nm))
+	t.appendDomainSeparator("inner-product")
+	t.appendU64("n", uint64(nm))
+	challenges := make([]*scalar.Scalar, lgN)
+	Ls, Rs := make([]*curve.RistrettoPoint, lgN), make([]*curve.RistrettoPoint, lgN)
+	for i := 0; i < lgN; i++ {
+		L, R := curve.CompressedRistretto(ipp[64*i:64*i+32]), curve.CompressedRistretto(ipp[64*i+32:64*i+64])
+		if err := t.validateAndAppendPoint("L", &L); err != nil {
+			return err
+		}
+		if err := t.validateAndAppendPoint("R", &R); err != nil {
+			return err
+		}
+		challenges[i] = t.challengeScalar("u")
+		var err error
+		if Ls[i], err = decompress(L); err != nil {
+			return err
+		}
+		if Rs[i], err = decompress(R); err != nil {
+			return err
+		}
+	}
+	a, err := scalarFromBytes(ipp[64*lgN : 64*lgN+32])
+	if err != nil {
+		return err
+	}
+	b, err := scalarFromBytes(ipp[64*lgN+32:])
+	if err != nil {
+		return err
+	}
+	allInv := scalar.One()
+	challengesSq, challengesInvSq := make([]*scalar.Scalar, lgN), make([]*scalar.Scalar, lgN)
+	for i, u := range challenges {
+		uInv := scalar.New().Invert(u)
+		allInv.Mul(allInv, uInv)
+		challengesSq[i] = scalar.New().Mul(u, u)
+		challengesInvSq[i] = scalar.New().Mul(uInv, uInv)
+	}
+	s := make([]*scalar.Scalar, nm)
+	s[0] = allInv
+	for i := 1; i < nm; i++ {
+		lgI := 31 - bits.LeadingZeros32(uint32(i))
+		s[i] = scalar.New().Mul(s[i-(1<<lgI)], challengesSq[lgN-1-lgI])
+	}
+
+	// the single multiscalar check of the bulletproof
+	zz := scalar.New().Mul(z, z)
+	minusZ := scalar.New().Neg(z)
+	yInv := expIter(scalar.New().Invert(y), nm)
+	var concatZAnd2 []*scalar.Scalar
+	expZ := scalar.One()
+	for _, n := range bitLengths {
+		exp2 := scalar.One()
+		for j := 0; j < n; j++ {
+			concatZAnd2 = append(concatZAnd2, scalar.New().Mul(exp2, expZ))
+			exp2 = scalar.New().Add(exp2, exp2)
+		}
+		expZ = scalar.New().Mul(expZ, z)
+	}
+	scalars := []*scalar.Scalar{
+		scalar.One(),
+		x,
+		scalar.New().Mul(c, x),
+		scalar.New().Mul(c, scalar.New().Mul(x, x)),
+		scalar.New().Neg(scalar.New().Add(eBlinding, scalar.New().Mul(c, tXBlinding))),
+		scalar.New().Add(
+			scalar.New().Mul(w, scalar.New().Sub(tX, scalar.New().Mul(a, b))),
+			scalar.New().Mul(c, scalar.New().Sub(rangeProofDelta(bitLengths, y, z), tX)),
+		),
+	}
+	points := make([]*curve.RistrettoPoint, 0, 6+2*lgN+2*nm+len(commitments))
+	for _, compressed := range []curve.CompressedRistretto{A, S, T1, T2} {
+		p, err := decompress(compressed)
+		if err != nil {
+			return err
+		}
+		points = append(points, p)
+	}
+	points = append(points, H, G)
+	scalars = append(append(scalars, challengesSq...), challengesInvSq...)
+	points = append(append(points, Ls...), Rs...)
+	for i := 0; i < nm; i++ {
+		scalars = append(scalars, scalar.New().Sub(minusZ, scalar.New().Mul(a, s[i])))
+	}
+	points = append(points, Gs...)
+	for i := 0; i < nm; i++ {
+		// z + y^-i * (z^2 * concat_z_and_2_i - b * s_i^-1), s_i^-1 = s_{nm-1-i}
+		inner := scalar.New().Sub(scalar.New().Mul(zz, concatZAnd2[i]), scalar.New().Mul(b, s[nm-1-i]))
+		scalars = append(scalars, scalar.New().Add(z, scalar.New().Mul(yInv[i], inner)))
+	}
+	points = append(points, Hs...)
+	expZ = scalar.One()
+	for _, commitment := range commitments {
+		p, err := decompress(commitment)
+		if err != nil {
+			return err
+		}
+		scalars = append(scalars, scalar.New().Mul(c, scalar.New().Mul(zz, expZ)))
+		points = append(points, p)
+		expZ = scalar.New().Mul(expZ, z)
+	}
+	if !curve.NewRistrettoPoint().MultiscalarMulVartime(scalars, points).IsIdentity() {
+		return ErrVerification
+	}
+	return nil
+}
+
+// rangeProofDelta (z - z^2) * <1, y^nm> - sum_j z^(j+3) * (2^n_j - 1)
+func rangeProofDelta(bitLengths []int, y, z *scalar.Scalar) *scalar.Scalar {
+	nm := 0
+	for _, n := range bitLengths {
+		nm += n
+	}
+	sumY := scalar.New().Sum(expIter(y, nm))
+	zz := scalar.New().Mul(z, z)
+	out := scalar.New().Mul(scalar.New().Sub(z, zz), sumY)
+	expZ := scalar.New().Mul(zz, z)
+	for _, n := range bitLengths {
+		sum2 := scalar.New().Sum(expIter(scalar.NewFromUint64(2), n))
+		out.Sub(out, scalar.New().Mul(expZ, sum2))
+		expZ = scalar.New().Mul(expZ, z)
+	}
+	return out
+}
+
+// expIter returns [1, x, x^2, ..., x^(n-1)]
+func expIter(x *scalar.Scalar, n int) []*scalar.Scalar {
+	out := make([]*scalar.Scalar, n)
+	current := scalar.One()
+	for i := range out {
+		out[i] = current
+		current = scalar.New().Mul(current, x)
+	}
+	return out
+}
+
+func innerProduct(a, b []*scalar.Scalar) *scalar.Scalar {
+	out := scalar.New()
+	for i := range a {
+		out.Add(out, scalar.New().Mul(a[i], b[i]))
+	}
+	return out
+}
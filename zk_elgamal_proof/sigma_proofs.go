@@ -0,0 +1,337 @@
+package zk_elgamal_proof
+
+import (
+	"errors"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+)
+
+var ErrVerification = errors.New("zk_elgamal_proof: proof verification failed")
+
+// PubkeyValidityProof proves the knowledge of the secret key of an ElGamal public key: Y || z
+type PubkeyValidityProof [64]byte
+
+func newPubkeyValidityProof(keypair *ElGamalKeypair, t *transcript) PubkeyValidityProof {
+	t.appendDomainSeparator("pubkey-proof")
+	y := randomScalar()
+	Y := compress(curve.NewRistrettoPoint().Mul(H, y))
+	t.appendPoint("Y", (*curve.CompressedRistretto)(&Y))
+	c := t.challengeScalar("c")
+	z := scalar.New().Add(scalar.New().Mul(c, scalar.New().Invert(keypair.Secret.scalar)), y)
+
+	var proof PubkeyValidityProof
+	copy(proof[:32], Y[:])
+	copy(proof[32:], scalarBytes(z))
+	return proof
+}
+
+func (proof PubkeyValidityProof) verify(pubkey ElGamalPubkey, t *transcript) error {
+	t.appendDomainSeparator("pubkey-proof")
+	points, scalars, err := parseSigmaProof(proof[:], 1, 1)
+	if err != nil {
+		return err
+	}
+	if err = t.validateAndAppendPoint("Y", &points[0]); err != nil {
+		return err
+	}
+	c := t.challengeScalar("c")
+	P, err := decompress(pubkey)
+	if err != nil {
+		return err
+	}
+	// z*H == c*P + Y
+	return checkEqual(
+		[]*scalar.Scalar{scalars[0]}, []*curve.RistrettoPoint{H},
+		[]*scalar.Scalar{c}, []*curve.RistrettoPoint{P}, points[0],
+	)
+}
+
+// ZeroCiphertextProof proves that a ciphertext encrypts zero: Y_P || Y_D || z
+type ZeroCiphertextProof [96]byte
+
+func newZeroCiphertextProof(keypair *ElGamalKeypair, ciphertext ElGamalCiphertext, t *transcript) (ZeroCiphertextProof, error) {
+	t.appendDomainSeparator("zero-ciphertext-proof")
+	P, err := decompress(keypair.Pubkey)
+	if err != nil {
+		return ZeroCiphertextProof{}, err
+	}
+	D, err := decompress(ciphertext.Handle())
+	if err != nil {
+		return ZeroCiphertextProof{}, err
+	}
+	y := randomScalar()
+	YP := compress(curve.NewRistrettoPoint().Mul(P, y))
+	YD := compress(curve.NewRistrettoPoint().Mul(D, y))
+	t.appendPoint("Y_P", (*curve.CompressedRistretto)(&YP))
+	t.appendPoint("Y_D", (*curve.CompressedRistretto)(&YD))
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+	z := scalar.New().Add(scalar.New().Mul(c, keypair.Secret.scalar), y)
+
+	var proof ZeroCiphertextProof
+	copy(proof[:32], YP[:])
+	copy(proof[32:64], YD[:])
+	copy(proof[64:], scalarBytes(z))
+	return proof, nil
+}
+
+func (proof ZeroCiphertextProof) verify(pubkey ElGamalPubkey, ciphertext ElGamalCiphertext, t *transcript) error {
+	t.appendDomainSeparator("zero-ciphertext-proof")
+	points, scalars, err := parseSigmaProof(proof[:], 2, 1)
+	if err != nil {
+		return err
+	}
+	if err = t.validateAndAppendPoint("Y_P", &points[0]); err != nil {
+		return err
+	}
+	t.appendPoint("Y_D", &points[1])
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+	P, err := decompress(pubkey)
+	if err != nil {
+		return err
+	}
+	C, D, err := ciphertext.points()
+	if err != nil {
+		return err
+	}
+	z := scalars[0]
+	// z*P == c*H + Y_P
+	if err = checkEqual([]*scalar.Scalar{z}, []*curve.RistrettoPoint{P}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{H}, points[0]); err != nil {
+		return err
+	}
+	// z*D == c*C + Y_D
+	return checkEqual([]*scalar.Scalar{z}, []*curve.RistrettoPoint{D}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{C}, points[1])
+}
+
+// CiphertextCommitmentEqualityProof proves that a ciphertext and a commitment hold the same amount:
+// Y_0 || Y_1 || Y_2 || z_s || z_x || z_r
+type CiphertextCommitmentEqualityProof [192]byte
+
+func newCiphertextCommitmentEqualityProof(
+	keypair *ElGamalKeypair,
+	ciphertext ElGamalCiphertext,
+	opening *PedersenOpening,
+	amount uint64,
+	t *transcript,
+) (CiphertextCommitmentEqualityProof, error) {
+	t.appendDomainSeparator("ciphertext-commitment-equality-proof")
+	P, err := decompress(keypair.Pubkey)
+	if err != nil {
+		return CiphertextCommitmentEqualityProof{}, err
+	}
+	D, err := decompress(ciphertext.Handle())
+	if err != nil {
+		return CiphertextCommitmentEqualityProof{}, err
+	}
+	s, x, r := keypair.Secret.scalar, scalar.NewFromUint64(amount), opening.scalar
+	ys, yx, yr := randomScalar(), randomScalar(), randomScalar()
+	Y0 := compress(curve.NewRistrettoPoint().Mul(P, ys))
+	Y1 := compress(curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{yx, ys}, []*curve.RistrettoPoint{G, D}))
+	Y2 := compress(curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{yx, yr}, []*curve.RistrettoPoint{G, H}))
+	t.appendPoint("Y_0", (*curve.CompressedRistretto)(&Y0))
+	t.appendPoint("Y_1", (*curve.CompressedRistretto)(&Y1))
+	t.appendPoint("Y_2", (*curve.CompressedRistretto)(&Y2))
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+
+	var proof CiphertextCommitmentEqualityProof
+	copy(proof[:32], Y0[:])
+	copy(proof[32:64], Y1[:])
+	copy(proof[64:96], Y2[:])
+	copy(proof[96:128], scalarBytes(scalar.New().Add(scalar.New().Mul(c, s), ys)))
+	copy(proof[128:160], scalarBytes(scalar.New().Add(scalar.New().Mul(c, x), yx)))
+	copy(proof[160:], scalarBytes(scalar.New().Add(scalar.New().Mul(c, r), yr)))
+	return proof, nil
+}
+
+func (proof CiphertextCommitmentEqualityProof) verify(pubkey ElGamalPubkey, ciphertext ElGamalCiphertext, commitment PedersenCommitment, t *transcript) error {
+	t.appendDomainSeparator("ciphertext-commitment-equality-proof")
+	points, scalars, err := parseSigmaProof(proof[:], 3, 3)
+	if err != nil {
+		return err
+	}
+	for i, label := range []string{"Y_0", "Y_1", "Y_2"} {
+		if err = t.validateAndAppendPoint(label, &points[i]); err != nil {
+			return err
+		}
+	}
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+	P, err := decompress(pubkey)
+	if err != nil {
+		return err
+	}
+	C, D, err := ciphertext.points()
+	if err != nil {
+		return err
+	}
+	CP, err := decompress(commitment)
+	if err != nil {
+		return err
+	}
+	zs, zx, zr := scalars[0], scalars[1], scalars[2]
+	// z_s*P == c*H + Y_0
+	if err = checkEqual([]*scalar.Scalar{zs}, []*curve.RistrettoPoint{P}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{H}, points[0]); err != nil {
+		return err
+	}
+	// z_x*G + z_s*D == c*C + Y_1
+	if err = checkEqual([]*scalar.Scalar{zx, zs}, []*curve.RistrettoPoint{G, D}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{C}, points[1]); err != nil {
+		return err
+	}
+	// z_x*G + z_r*H == c*C' + Y_2
+	return checkEqual([]*scalar.Scalar{zx, zr}, []*curve.RistrettoPoint{G, H}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{CP}, points[2])
+}
+
+// GroupedCiphertext3HandlesValidityProof proves that a grouped ciphertext is well-formed:
+// Y_0 || Y_1 || Y_2 || Y_3 || z_r || z_x
+type GroupedCiphertext3HandlesValidityProof [192]byte
+
+func newGroupedCiphertext3HandlesValidityProof(
+	pubkeys [3]ElGamalPubkey,
+	amount *scalar.Scalar,
+	opening *scalar.Scalar,
+	t *transcript,
+) (GroupedCiphertext3HandlesValidityProof, error) {
+	t.appendDomainSeparator("validity-proof")
+	t.appendU64("handles", 3)
+	yr, yx := randomScalar(), randomScalar()
+	var proof GroupedCiphertext3HandlesValidityProof
+	Y0 := compress(curve.NewRistrettoPoint().MultiscalarMul([]*scalar.Scalar{yr, yx}, []*curve.RistrettoPoint{H, G}))
+	copy(proof[:32], Y0[:])
+	t.appendPoint("Y_0", (*curve.CompressedRistretto)(&Y0))
+	for i, pubkey := range pubkeys {
+		P, err := decompress(pubkey)
+		if err != nil {
+			return proof, err
+		}
+		Y := compress(curve.NewRistrettoPoint().Mul(P, yr))
+		copy(proof[32+32*i:], Y[:])
+	}
+	for i, label := range []string{"Y_1", "Y_2", "Y_3"} {
+		t.AppendMessage(label, proof[32+32*i:64+32*i])
+	}
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+	copy(proof[128:160], scalarBytes(scalar.New().Add(scalar.New().Mul(c, opening), yr)))
+	copy(proof[160:], scalarBytes(scalar.New().Add(scalar.New().Mul(c, amount), yx)))
+	return proof, nil
+}
+
+func (proof GroupedCiphertext3HandlesValidityProof) verify(
+	pubkeys [3]ElGamalPubkey,
+	commitment *curve.RistrettoPoint,
+	handles [3]*curve.RistrettoPoint,
+	t *transcript,
+) error {
+	t.appendDomainSeparator("validity-proof")
+	t.appendU64("handles", 3)
+	points, scalars, err := parseSigmaProof(proof[:], 4, 2)
+	if err != nil {
+		return err
+	}
+	for i, label := range []string{"Y_0", "Y_1", "Y_2"} {
+		if err = t.validateAndAppendPoint(label, &points[i]); err != nil {
+			return err
+		}
+	}
+	// the third public key (the auditor) may be the identity
+	t.appendPoint("Y_3", &points[3])
+	c := t.challengeScalar("c")
+	t.challengeScalar("w")
+	zr, zx := scalars[0], scalars[1]
+	// z_r*H + z_x*G == c*C + Y_0
+	if err = checkEqual([]*scalar.Scalar{zr, zx}, []*curve.RistrettoPoint{H, G}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{commitment}, points[0]); err != nil {
+		return err
+	}
+	// z_r*P_i == c*D_i + Y_i
+	for i, pubkey := range pubkeys {
+		P, err := decompress(pubkey)
+		if err != nil {
+			return err
+		}
+		if err = checkEqual([]*scalar.Scalar{zr}, []*curve.RistrettoPoint{P}, []*scalar.Scalar{c}, []*curve.RistrettoPoint{handles[i]}, points[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newBatchedGroupedCiphertext3HandlesValidityProof proves the validity of two grouped ciphertexts with the same public keys,
+// the amounts and the openings are batched as lo + t*hi
+func newBatchedGroupedCiphertext3HandlesValidityProof(
+	pubkeys [3]ElGamalPubkey,
+	amountLo, amountHi uint64,
+	openingLo, openingHi *PedersenOpening,
+	t *transcript,
+) (GroupedCiphertext3HandlesValidityProof, error) {
+	t.appendDomainSeparator("batched-validity-proof")
+	t.appendU64("handles", 3)
+	challenge := t.challengeScalar("t")
+	amount := scalar.New().Add(scalar.NewFromUint64(amountLo), scalar.New().Mul(scalar.NewFromUint64(amountHi), challenge))
+	opening := scalar.New().Add(openingLo.scalar, scalar.New().Mul(openingHi.scalar, challenge))
+	return newGroupedCiphertext3HandlesValidityProof(pubkeys, amount, opening, t)
+}
+
+func (proof GroupedCiphertext3HandlesValidityProof) verifyBatched(
+	pubkeys [3]ElGamalPubkey,
+	ciphertextLo, ciphertextHi GroupedElGamalCiphertext3Handles,
+	t *transcript,
+) error {
+	t.appendDomainSeparator("batched-validity-proof")
+	t.appendU64("handles", 3)
+	challenge := t.challengeScalar("t")
+	batch := func(lo, hi [32]byte) (*curve.RistrettoPoint, error) {
+		pointLo, err := decompress(lo)
+		if err != nil {
+			return nil, err
+		}
+		pointHi, err := decompress(hi)
+		if err != nil {
+			return nil, err
+		}
+		return curve.NewRistrettoPoint().Add(pointLo, curve.NewRistrettoPoint().Mul(pointHi, challenge)), nil
+	}
+	commitment, err := batch(ciphertextLo.Commitment(), ciphertextHi.Commitment())
+	if err != nil {
+		return err
+	}
+	var handles [3]*curve.RistrettoPoint
+	for i := range handles {
+		if handles[i], err = batch(ciphertextLo.Handle(i), ciphertextHi.Handle(i)); err != nil {
+			return err
+		}
+	}
+	return proof.verify(pubkeys, commitment, handles, t)
+}
+
+func parseSigmaProof(data []byte, numPoints, numScalars int) ([]curve.CompressedRistretto, []*scalar.Scalar, error) {
+	points := make([]curve.CompressedRistretto, numPoints)
+	for i := range points {
+		copy(points[i][:], data[32*i:])
+	}
+	scalars := make([]*scalar.Scalar, numScalars)
+	for i := range scalars {
+		offset := 32 * (numPoints + i)
+		s, err := scalarFromBytes(data[offset : offset+32])
+		if err != nil {
+			return nil, nil, err
+		}
+		scalars[i] = s
+	}
+	return points, scalars, nil
+}
+
+// checkEqual checks <a, A> == <b, B> + Y
+func checkEqual(a []*scalar.Scalar, A []*curve.RistrettoPoint, b []*scalar.Scalar, B []*curve.RistrettoPoint, Y curve.CompressedRistretto) error {
+	point, err := decompress(Y)
+	if err != nil {
+		return err
+	}
+	left := curve.NewRistrettoPoint().MultiscalarMulVartime(a, A)
+	right := curve.NewRistrettoPoint().Add(curve.NewRistrettoPoint().MultiscalarMulVartime(b, B), point)
+	if left.Equal(right) != 1 {
+		return ErrVerification
+	}
+	return nil
+}
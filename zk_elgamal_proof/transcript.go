@@ -0,0 +1,55 @@
+package zk_elgamal_proof
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"github.com/oasisprotocol/curve25519-voi/curve/scalar"
+	"github.com/oasisprotocol/curve25519-voi/primitives/merlin"
+)
+
+var ErrIdentityPoint = errors.New("zk_elgamal_proof: identity point in the proof")
+
+// transcript the merlin transcript with the helpers of the solana-zk-sdk
+type transcript struct {
+	*merlin.Transcript
+}
+
+func newTranscript(label string) *transcript {
+	return &transcript{Transcript: merlin.NewTranscript(label)}
+}
+
+func (t *transcript) appendDomainSeparator(name string) {
+	t.AppendMessage("dom-sep", []byte(name))
+}
+
+func (t *transcript) appendU64(label string, value uint64) {
+	t.AppendMessage(label, binary.LittleEndian.AppendUint64(nil, value))
+}
+
+func (t *transcript) appendScalar(label string, s *scalar.Scalar) {
+	t.AppendMessage(label, scalarBytes(s))
+}
+
+func (t *transcript) appendPoint(label string, p *curve.CompressedRistretto) {
+	t.AppendMessage(label, p[:])
+}
+
+// validateAndAppendPoint used by the verifiers, rejects the identity point
+func (t *transcript) validateAndAppendPoint(label string, p *curve.CompressedRistretto) error {
+	if p.Equal(curve.NewCompressedRistretto().Identity()) == 1 {
+		return ErrIdentityPoint
+	}
+	t.appendPoint(label, p)
+	return nil
+}
+
+func (t *transcript) challengeScalar(label string) *scalar.Scalar {
+	var buf [64]byte
+	t.ExtractBytes(buf[:], label)
+	s, err := scalar.NewFromBytesModOrderWide(buf[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
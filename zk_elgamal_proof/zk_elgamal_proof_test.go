@@ -0,0 +1,211 @@
+package zk_elgamal_proof
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/oasisprotocol/curve25519-voi/curve"
+	"testing"
+)
+
+func TestAesGcmSiv(t *testing.T) {
+	// RFC 8452, appendix C.1
+	key := AeKey{1}
+	nonce := [12]byte{3}
+	for plaintext, expected := range map[string]string{
+		"":                 "dc20e2d83f25705bb49e439eca56de25",
+		"0100000000000000": "b5d839330ac7b786578782fff6013b815b287c22493a364c",
+	} {
+		in, _ := hex.DecodeString(plaintext)
+		sealed := aesGcmSivSeal(key, nonce, in)
+		if hex.EncodeToString(sealed) != expected {
+			t.Fatalf("plaintext %q: got %x, expected %s", plaintext, sealed, expected)
+		}
+		opened, err := aesGcmSivOpen(key, nonce, sealed)
+		if err != nil || hex.EncodeToString(opened) != plaintext {
+			t.Fatalf("plaintext %q: opened %x %v", plaintext, opened, err)
+		}
+	}
+
+	ciphertext := key.Encrypt(1234567)
+	if amount, err := key.Decrypt(ciphertext); err != nil || amount != 1234567 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+	ciphertext[20] ^= 1
+	if _, err := key.Decrypt(ciphertext); err == nil {
+		t.Fatal("expected an error for a tampered ciphertext")
+	}
+}
+
+func TestElGamal(t *testing.T) {
+	keypair := NewElGamalKeypair()
+	ciphertext, err := keypair.Pubkey.Encrypt(70000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := keypair.Pubkey.Encrypt(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := ciphertext.Add(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount, err := keypair.DecryptU32(sum); err != nil || amount != 70005 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+	diff, err := sum.Sub(EncodeElGamalCiphertext(70005))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount, err := keypair.DecryptU32(diff); err != nil || amount != 0 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+	if ok, err := keypair.CheckAmount(sum, 70005); err != nil || !ok {
+		t.Fatal("expected the amount to match")
+	}
+}
+
+func TestSigmaProofs(t *testing.T) {
+	keypair := NewElGamalKeypair()
+	pubkeyValidity := NewPubkeyValidityProofData(keypair)
+	if err := pubkeyValidity.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	pubkeyValidity.Pubkey = NewElGamalKeypair().Pubkey
+	if err := pubkeyValidity.Verify(); err == nil {
+		t.Fatal("expected an error for another pubkey")
+	}
+
+	zero, _ := keypair.Pubkey.Encrypt(0)
+	zeroCiphertext, err := NewZeroCiphertextProofData(keypair, zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := zeroCiphertext.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	zeroCiphertext.Ciphertext, _ = keypair.Pubkey.Encrypt(1)
+	if err := zeroCiphertext.Verify(); err == nil {
+		t.Fatal("expected an error for a non-zero ciphertext")
+	}
+
+	ciphertext, _ := keypair.Pubkey.Encrypt(55)
+	commitment, opening := NewPedersenCommitment(55)
+	equality, err := NewCiphertextCommitmentEqualityProofData(keypair, ciphertext, commitment, opening, 55)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := equality.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	equality.Commitment = NewPedersenCommitmentWithOpening(56, opening)
+	if err := equality.Verify(); err == nil {
+		t.Fatal("expected an error for another amount")
+	}
+
+	// the auditor is optional, the zero pubkey
+	pubkeys := [3]ElGamalPubkey{keypair.Pubkey, NewElGamalKeypair().Pubkey, {}}
+	openingLo, openingHi := NewPedersenOpening(), NewPedersenOpening()
+	lo, err := NewGroupedElGamalCiphertext3Handles(pubkeys, 7, openingLo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hi, err := NewGroupedElGamalCiphertext3Handles(pubkeys, 9, openingHi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount, err := keypair.DecryptU32(lo.Ciphertext(0)); err != nil || amount != 7 {
+		t.Fatalf("unexpected amount %d %v", amount, err)
+	}
+	validity, err := NewBatchedGroupedCiphertext3HandlesValidityProofData(pubkeys, lo, hi, 7, 9, openingLo, openingHi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validity.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if len(validity.ContextBytes()) != validity.ProofType().ContextSize() {
+		t.Fatalf("unexpected context size %d", len(validity.ContextBytes()))
+	}
+	validity.GroupedCiphertextHi = lo
+	if err := validity.Verify(); err == nil {
+		t.Fatal("expected an error for another ciphertext")
+	}
+}
+
+func TestRangeProof(t *testing.T) {
+	commitment, opening := NewPedersenCommitment(1 << 40)
+	u64, err := NewBatchedRangeProofData([]PedersenCommitment{commitment}, []uint64{1 << 40}, []int{64}, []*PedersenOpening{opening})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u64.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if u64.ProofType() != ProofTypeBatchedRangeProofU64 || len(u64.Proof) != 672 || len(u64.ContextBytes()) != 264 {
+		t.Fatalf("unexpected proof %d %d", u64.ProofType(), len(u64.Proof))
+	}
+
+	amounts := []uint64{1000, 65535, 1 << 31, 0}
+	bitLengths := []int{64, 16, 32, 16}
+	var commitments []PedersenCommitment
+	var openings []*PedersenOpening
+	for _, amount := range amounts {
+		commitment, opening := NewPedersenCommitment(amount)
+		commitments = append(commitments, commitment)
+		openings = append(openings, opening)
+	}
+	u128, err := NewBatchedRangeProofData(commitments, amounts, bitLengths, openings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u128.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if u128.ProofType() != ProofTypeBatchedRangeProofU128 || len(u128.Proof) != 736 {
+		t.Fatalf("unexpected proof %d %d", u128.ProofType(), len(u128.Proof))
+	}
+	u128.Commitments[1] = NewPedersenCommitmentWithOpening(65534, openings[1])
+	if err := u128.Verify(); err == nil {
+		t.Fatal("expected an error for another commitment")
+	}
+	if _, err := NewBatchedRangeProofData(commitments, []uint64{1000, 65536, 1 << 31, 0}, bitLengths, openings); err == nil {
+		t.Fatal("expected an error for an amount out of range")
+	}
+}
+
+func TestTranscript(t *testing.T) {
+	// the known answers of the merlin reference implementation, the transcripts of the proofs are built on it
+	tr := newTranscript("test protocol")
+	tr.AppendMessage("some label", []byte("some data"))
+	var challenge [32]byte
+	tr.ExtractBytes(challenge[:], "challenge")
+	if hex.EncodeToString(challenge[:]) != "d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615" {
+		t.Fatalf("unexpected challenge %x", challenge)
+	}
+
+	tr = newTranscript("test protocol")
+	tr.AppendMessage("step1", []byte("some data"))
+	data := bytes.Repeat([]byte{99}, 1024)
+	for i := 0; i < 32; i++ {
+		tr.ExtractBytes(challenge[:], "challenge")
+		tr.AppendMessage("bigdata", data)
+		tr.AppendMessage("challengedata", challenge[:])
+	}
+	if hex.EncodeToString(challenge[:]) != "a8c933f54fae76e3f9bea93648c1308e7dfa2152dd51674ff3ca438351cf003c" {
+		t.Fatalf("unexpected challenge %x", challenge)
+	}
+
+	// the helpers of the solana-zk-sdk are plain messages
+	helper, plain := newTranscript("zk"), newTranscript("zk")
+	helper.appendDomainSeparator("pubkey-validity-proof")
+	helper.appendU64("amount", 1234)
+	plain.AppendMessage("dom-sep", []byte("pubkey-validity-proof"))
+	plain.AppendMessage("amount", []byte{0xd2, 0x04, 0, 0, 0, 0, 0, 0})
+	if helper.challengeScalar("c").Equal(plain.challengeScalar("c")) != 1 {
+		t.Fatal("unexpected challenge scalar")
+	}
+	if err := newTranscript("zk").validateAndAppendPoint("Y", curve.NewCompressedRistretto().Identity()); err != ErrIdentityPoint {
+		t.Fatalf("unexpected error %v", err)
+	}
+}